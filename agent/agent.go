@@ -1,6 +1,7 @@
 package agent
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/binary"
@@ -11,6 +12,7 @@ import (
 	"net"
 	"net/http"
 	"net/netip"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -31,6 +33,7 @@ import (
 	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"storj.io/drpc"
 	"tailscale.com/net/speedtest"
 	"tailscale.com/tailcfg"
@@ -39,11 +42,13 @@ import (
 
 	"cdr.dev/slog"
 	"github.com/coder/coder/v2/agent/agentproc"
+	"github.com/coder/coder/v2/agent/agentrecord"
 	"github.com/coder/coder/v2/agent/agentscripts"
 	"github.com/coder/coder/v2/agent/agentssh"
 	"github.com/coder/coder/v2/agent/proto"
 	"github.com/coder/coder/v2/agent/reconnectingpty"
 	"github.com/coder/coder/v2/buildinfo"
+	"github.com/coder/coder/v2/cli/clistat"
 	"github.com/coder/coder/v2/cli/gitauth"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/codersdk"
@@ -174,6 +179,7 @@ func New(options Options) Agent {
 		lifecycleUpdate:                    make(chan struct{}, 1),
 		lifecycleReported:                  make(chan codersdk.WorkspaceAgentLifecycle, 1),
 		lifecycleStates:                    []agentsdk.PostLifecycleRequest{{State: codersdk.WorkspaceAgentLifecycleCreated}},
+		reportConnectionsUpdate:            make(chan struct{}, 1),
 		ignorePorts:                        options.IgnorePorts,
 		portCacheDuration:                  options.PortCacheDuration,
 		reportMetadataInterval:             options.ReportMetadataInterval,
@@ -197,6 +203,13 @@ func New(options Options) Agent {
 	close(a.coordDisconnected)
 	a.announcementBanners.Store(new([]codersdk.BannerConfig))
 	a.sessionToken.Store(new(string))
+	a.sessionRecorder = agentrecord.New(a.logger.Named("session-recorder"), a.tempDir, func() agentsdk.SessionRecordingPolicy {
+		manifest := a.manifest.Load()
+		if manifest == nil {
+			return agentsdk.SessionRecordingPolicy{}
+		}
+		return manifest.SessionRecording
+	})
 	a.init()
 	return a
 }
@@ -249,12 +262,25 @@ type agent struct {
 	lifecycleStates            []agentsdk.PostLifecycleRequest
 	lifecycleLastReportedIndex int // Keeps track of the last lifecycle state we successfully reported.
 
+	reportConnectionsUpdate chan struct{}
+	reportConnectionsMu     sync.Mutex // Protects following.
+	reportConnections       []*proto.ReportConnectionRequest
+
 	network       *tailnet.Conn
 	addresses     []netip.Prefix
 	statsReporter *statsReporter
 	logSender     *agentsdk.LogSender
+	// sessionRecorder records terminal sessions when the deployment asks for
+	// it.
+	sessionRecorder *agentrecord.Recorder
 
 	connCountReconnectingPTY atomic.Int64
+	// sessionInputCount counts input received in PTY sessions since the
+	// last stats report.
+	sessionInputCount atomic.Int64
+	// statter measures CPU usage for stats reports. It is nil if the host
+	// information could not be read.
+	statter *clistat.Statter
 
 	prometheusRegistry *prometheus.Registry
 	// metrics are prometheus registered metrics that will be collected and
@@ -281,11 +307,20 @@ func (a *agent) init() {
 		UpdateEnv:           a.updateCommandEnv,
 		WorkingDirectory:    func() string { return a.manifest.Load().Directory },
 		BlockFileTransfer:   a.blockFileTransfer,
+		SessionRecorder:     a.sessionRecorder,
+		ReportConnection:    a.reportConnection,
+		ReportInput:         func() { a.sessionInputCount.Add(1) },
 	})
 	if err != nil {
 		panic(err)
 	}
 	a.sshServer = sshSrv
+	statter, err := clistat.New(clistat.WithFS(a.filesystem))
+	if err != nil {
+		a.logger.Warn(a.hardCtx, "failed to create statter, cpu usage will not be reported", slog.Error(err))
+	} else {
+		a.statter = statter
+	}
 	a.scriptRunner = agentscripts.New(agentscripts.Options{
 		LogDir:      a.logDir,
 		DataDirBase: a.scriptDataDir,
@@ -384,9 +419,128 @@ func (a *agent) collectMetadata(ctx context.Context, md codersdk.WorkspaceAgentM
 	return result
 }
 
+// streamingMetadataDirective marks the script of a metadata item as a
+// long-running script that streams its values, when it's the first line of
+// the script. It's a shell comment, so agents that don't support streaming
+// run the script like any other.
+const streamingMetadataDirective = "# coder:streaming"
+
+// isStreamingMetadata returns true if the script of the metadata item starts
+// with streamingMetadataDirective.
+func isStreamingMetadata(md codersdk.WorkspaceAgentMetadataDescription) bool {
+	line, _, _ := strings.Cut(strings.TrimSpace(md.Script), "\n")
+	return strings.TrimSpace(line) == streamingMetadataDirective
+}
+
+// streamMetadata runs the script of a streaming metadata item until it exits
+// or ctx is canceled. Every key=value line the script writes to stdout is sent
+// to results as a new value for the metadata item with that key, provided the
+// key is declared in the manifest. If the script fails, the error is reported
+// on the streaming item itself.
+func (a *agent) streamMetadata(ctx context.Context, md codersdk.WorkspaceAgentMetadataDescription, declared []codersdk.WorkspaceAgentMetadataDescription, results chan<- metadataResultAndKey) {
+	logger := a.logger.Named("metadata").With(slog.F("key", md.Key))
+
+	send := func(key string, result *codersdk.WorkspaceAgentMetadataResult) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case results <- metadataResultAndKey{key: key, result: result, streaming: true}:
+			return true
+		}
+	}
+	sendError := func(format string, args ...any) {
+		send(md.Key, &codersdk.WorkspaceAgentMetadataResult{
+			CollectedAt: time.Now(),
+			Error:       fmt.Sprintf(format, args...),
+		})
+	}
+
+	keys := make(map[string]struct{}, len(declared))
+	for _, d := range declared {
+		keys[d.Key] = struct{}{}
+	}
+
+	cmdCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cmdPty, err := a.sshServer.CreateCommand(cmdCtx, md.Script, nil)
+	if err != nil {
+		sendError("create cmd: %+v", err)
+		return
+	}
+	cmd := cmdPty.AsExec()
+	cmd.Stdin = io.LimitReader(nil, 0)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		sendError("create stdout pipe: %+v", err)
+		return
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		sendError("create stderr pipe: %+v", err)
+		return
+	}
+	err = cmd.Start()
+	if err != nil {
+		sendError("start cmd: %+v", err)
+		return
+	}
+
+	// Only the last line written to stderr is kept, so that it can be
+	// included in the error if the script fails.
+	var lastStderrLine string
+	stderrDone := make(chan struct{})
+	go func() {
+		defer close(stderrDone)
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			lastStderrLine = scanner.Text()
+		}
+	}()
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSuffix(scanner.Text(), "\r"), "=")
+		key = strings.TrimSpace(key)
+		if !ok {
+			continue
+		}
+		if _, ok := keys[key]; !ok {
+			logger.Debug(ctx, "ignoring streamed value for undeclared metadata key", slog.F("streamed_key", key))
+			continue
+		}
+		if !send(key, &codersdk.WorkspaceAgentMetadataResult{
+			CollectedAt: time.Now(),
+			Value:       value,
+		}) {
+			break
+		}
+	}
+	// Stop the script if we stopped reading its output early, e.g. because
+	// a line was too long.
+	scanErr := scanner.Err()
+	if scanErr != nil {
+		cancel()
+	}
+	<-stderrDone
+	err = errors.Join(cmd.Wait(), scanErr)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		if lastStderrLine != "" {
+			err = xerrors.Errorf("%w: %s", err, lastStderrLine)
+		}
+		logger.Debug(ctx, "streaming metadata script failed", slog.Error(err))
+		sendError("run cmd: %+v", err)
+	}
+}
+
 type metadataResultAndKey struct {
 	result *codersdk.WorkspaceAgentMetadataResult
 	key    string
+	// streaming is true if the result was streamed by a long-running script
+	// and should be reported right away.
+	streaming bool
 }
 
 type trySingleflight struct {
@@ -503,8 +657,14 @@ func (a *agent) reportMetadata(ctx context.Context, conn drpc.Conn) error {
 			// Spawn a goroutine for each metadata collection, and use a
 			// channel to synchronize the results and avoid both messy
 			// mutex logic and overloading the API.
+			streaming := slices.ContainsFunc(manifest.Metadata, isStreamingMetadata)
 			for _, md := range manifest.Metadata {
 				md := md
+				if streaming && strings.TrimSpace(md.Script) == "" {
+					// The value of this item is streamed by the script of
+					// another item.
+					continue
+				}
 				// We send the result to the channel in the goroutine to avoid
 				// sending the same result multiple times. So, we don't care about
 				// the return values.
@@ -531,6 +691,16 @@ func (a *agent) reportMetadata(ctx context.Context, conn drpc.Conn) error {
 						}
 					}
 
+					if isStreamingMetadata(md) {
+						// Streaming scripts run until they exit, after which
+						// they are restarted once the interval has passed.
+						a.streamMetadata(ctx, md, manifest.Metadata, metadataResults)
+						lastCollectedAtMu.Lock()
+						lastCollectedAts[md.Key] = time.Now()
+						lastCollectedAtMu.Unlock()
+						return
+					}
+
 					timeout := md.Timeout
 					if timeout == 0 {
 						if md.Interval != 0 {
@@ -575,9 +745,35 @@ func (a *agent) reportMetadata(ctx context.Context, conn drpc.Conn) error {
 		reportTimeout   = 30 * time.Second
 		reportError     = make(chan error, 1)
 		reportInFlight  = false
+		// streamedPending is true if a streamed value arrived while a
+		// report was in flight.
+		streamedPending = false
 		aAPI            = proto.NewDRPCAgentClient(conn)
 	)
 
+	sendReport := func() {
+		metadata := make([]*proto.Metadata, 0, len(updatedMetadata))
+		for key, result := range updatedMetadata {
+			pr := agentsdk.ProtoFromMetadataResult(*result)
+			metadata = append(metadata, &proto.Metadata{
+				Key:    key,
+				Result: pr,
+			})
+			delete(updatedMetadata, key)
+		}
+
+		reportInFlight = true
+		streamedPending = false
+		go func() {
+			a.logger.Debug(ctx, "batch updating metadata")
+			ctx, cancel := context.WithTimeout(ctx, reportTimeout)
+			defer cancel()
+
+			_, err := aAPI.BatchUpdateMetadata(ctx, &proto.BatchUpdateMetadataRequest{Metadata: metadata})
+			reportError <- err
+		}()
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -586,6 +782,15 @@ func (a *agent) reportMetadata(ctx context.Context, conn drpc.Conn) error {
 			// This can overwrite unsent values, but that's fine because
 			// we're only interested about up-to-date values.
 			updatedMetadata[mr.key] = mr.result
+			// Streamed values are reported right away instead of on the
+			// next tick.
+			if mr.streaming {
+				if reportInFlight {
+					streamedPending = true
+				} else {
+					sendReport()
+				}
+			}
 			continue
 		case err := <-reportError:
 			logMsg := "batch update metadata complete"
@@ -595,6 +800,9 @@ func (a *agent) reportMetadata(ctx context.Context, conn drpc.Conn) error {
 			}
 			a.logger.Debug(ctx, logMsg)
 			reportInFlight = false
+			if streamedPending {
+				sendReport()
+			}
 		case <-report:
 			if len(updatedMetadata) == 0 {
 				continue
@@ -605,25 +813,7 @@ func (a *agent) reportMetadata(ctx context.Context, conn drpc.Conn) error {
 				a.logger.Debug(ctx, "skipped metadata report tick because report is in flight")
 				continue
 			}
-			metadata := make([]*proto.Metadata, 0, len(updatedMetadata))
-			for key, result := range updatedMetadata {
-				pr := agentsdk.ProtoFromMetadataResult(*result)
-				metadata = append(metadata, &proto.Metadata{
-					Key:    key,
-					Result: pr,
-				})
-				delete(updatedMetadata, key)
-			}
-
-			reportInFlight = true
-			go func() {
-				a.logger.Debug(ctx, "batch updating metadata")
-				ctx, cancel := context.WithTimeout(ctx, reportTimeout)
-				defer cancel()
-
-				_, err := aAPI.BatchUpdateMetadata(ctx, &proto.BatchUpdateMetadataRequest{Metadata: metadata})
-				reportError <- err
-			}()
+			sendReport()
 		}
 	}
 }
@@ -796,6 +986,18 @@ func (a *agent) run() (retErr error) {
 			return err
 		})
 
+	// recordings of sessions closed during shutdown should still be uploaded.
+	connMan.start("send session recordings", gracefulShutdownBehaviorRemain,
+		func(ctx context.Context, conn drpc.Conn) error {
+			return a.sessionRecorder.SendLoop(ctx, proto.NewDRPCAgentClient(conn))
+		})
+
+	// connections closed during shutdown should still be reported.
+	connMan.start("report connections", gracefulShutdownBehaviorRemain,
+		func(ctx context.Context, conn drpc.Conn) error {
+			return a.reportConnectionsLoop(ctx, proto.NewDRPCAgentClient(conn))
+		})
+
 	// part of graceful shut down is reporting the final lifecycle states, e.g "ShuttingDown" so the
 	// lifecycle reporting has to be via gracefulShutdownBehaviorRemain
 	connMan.start("report lifecycle", gracefulShutdownBehaviorRemain, a.reportLifecycle)
@@ -1141,13 +1343,14 @@ func (a *agent) trackGoroutine(fn func()) error {
 
 func (a *agent) createTailnet(ctx context.Context, agentID uuid.UUID, derpMap *tailcfg.DERPMap, derpForceWebSockets, disableDirectConnections bool) (_ *tailnet.Conn, err error) {
 	network, err := tailnet.NewConn(&tailnet.Options{
-		ID:                  agentID,
-		Addresses:           a.wireguardAddresses(agentID),
-		DERPMap:             derpMap,
-		DERPForceWebSockets: derpForceWebSockets,
-		Logger:              a.logger.Named("net.tailnet"),
-		ListenPort:          a.tailnetListenPort,
-		BlockEndpoints:      disableDirectConnections,
+		ID:                   agentID,
+		Addresses:            a.wireguardAddresses(agentID),
+		DERPMap:              derpMap,
+		DERPForceWebSockets:  derpForceWebSockets,
+		Logger:               a.logger.Named("net.tailnet"),
+		ListenPort:           a.tailnetListenPort,
+		BlockEndpoints:       disableDirectConnections,
+		ForwardedTCPCallback: a.reportForwardedTCP,
 	})
 	if err != nil {
 		return nil, xerrors.Errorf("create tailnet: %w", err)
@@ -1404,10 +1607,19 @@ func (a *agent) handleReconnectingPTY(ctx context.Context, logger slog.Logger, m
 	a.connCountReconnectingPTY.Add(1)
 	defer a.connCountReconnectingPTY.Add(-1)
 
-	connectionID := uuid.NewString()
+	connectionID := uuid.New()
 	connLogger := logger.With(slog.F("message_id", msg.ID), slog.F("connection_id", connectionID))
 	connLogger.Debug(ctx, "starting handler")
 
+	disconnected := a.reportConnection(connectionID, proto.Connection_RECONNECTING_PTY, conn.RemoteAddr().String())
+	defer func() {
+		if err := retErr; err != nil {
+			disconnected(1, err.Error())
+		} else {
+			disconnected(0, "")
+		}
+	}()
+
 	defer func() {
 		if err := retErr; err != nil {
 			a.closeMutex.Lock()
@@ -1460,8 +1672,11 @@ func (a *agent) handleReconnectingPTY(ctx context.Context, logger slog.Logger, m
 		}
 
 		rpty = reconnectingpty.New(ctx, cmd, &reconnectingpty.Options{
-			Timeout: a.reconnectingPTYTimeout,
-			Metrics: a.metrics.reconnectingPTYErrors,
+			Timeout:     a.reconnectingPTYTimeout,
+			Metrics:     a.metrics.reconnectingPTYErrors,
+			Recorder:    a.sessionRecorder,
+			Command:     msg.Command,
+			ReportInput: func() { a.sessionInputCount.Add(1) },
 		}, logger.With(slog.F("message_id", msg.ID)))
 
 		if err = a.trackGoroutine(func() {
@@ -1475,7 +1690,154 @@ func (a *agent) handleReconnectingPTY(ctx context.Context, logger slog.Logger, m
 		connected = true
 		sendConnected <- rpty
 	}
-	return rpty.Attach(ctx, connectionID, conn, msg.Height, msg.Width, connLogger)
+	return rpty.Attach(ctx, connectionID.String(), conn, msg.Height, msg.Width, connLogger)
+}
+
+// maxReportConnections is the maximum number of connection reports that are
+// buffered while coderd is unreachable. Older reports are dropped first.
+const maxReportConnections = 2048
+
+// reportConnection queues a report of a new connection and returns a function
+// that queues the matching disconnection.
+func (a *agent) reportConnection(id uuid.UUID, connectionType proto.Connection_Type, remoteAddr string) (disconnected func(code int, reason string)) {
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+	a.enqueueConnectionReport(&proto.Connection{
+		Id:        id[:],
+		Action:    proto.Connection_CONNECT,
+		Type:      connectionType,
+		Timestamp: timestamppb.New(time.Now()),
+		Ip:        ip,
+	})
+	return func(code int, reason string) {
+		c := &proto.Connection{
+			Id:         id[:],
+			Action:     proto.Connection_DISCONNECT,
+			Type:       connectionType,
+			Timestamp:  timestamppb.New(time.Now()),
+			Ip:         ip,
+			StatusCode: int32(code),
+		}
+		if reason != "" {
+			c.Reason = &reason
+		}
+		a.enqueueConnectionReport(c)
+	}
+}
+
+// reportForwardedTCP reports a TCP connection that was forwarded from the
+// tailnet to a local port, like workspace apps proxied by coderd and ports
+// forwarded with coder port-forward. Connections to the port of a workspace
+// app are reported as app connections.
+func (a *agent) reportForwardedTCP(src, dst netip.AddrPort) (closed func()) {
+	connectionType := proto.Connection_PORT_FORWARD
+	if a.isAppPort(dst.Port()) {
+		connectionType = proto.Connection_APP
+	}
+	disconnected := a.reportConnection(uuid.New(), connectionType, src.String())
+	return func() { disconnected(0, "") }
+}
+
+// isAppPort returns true if a workspace app of the agent is served on the
+// local port.
+func (a *agent) isAppPort(port uint16) bool {
+	manifest := a.manifest.Load()
+	if manifest == nil {
+		return false
+	}
+	for _, app := range manifest.Apps {
+		if app.External || app.URL == "" {
+			continue
+		}
+		u, err := url.Parse(app.URL)
+		if err != nil {
+			continue
+		}
+		appPort := u.Port()
+		if appPort == "" {
+			switch u.Scheme {
+			case "http":
+				appPort = "80"
+			case "https":
+				appPort = "443"
+			}
+		}
+		if appPort == strconv.Itoa(int(port)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *agent) enqueueConnectionReport(c *proto.Connection) {
+	a.reportConnectionsMu.Lock()
+	defer a.reportConnectionsMu.Unlock()
+	if len(a.reportConnections) >= maxReportConnections {
+		a.logger.Warn(a.hardCtx, "dropping connection report, too many pending reports", slog.F("connection_id", c.Id))
+		a.reportConnections[0] = nil // Release the pointer from the underlying array.
+		a.reportConnections = a.reportConnections[1:]
+	}
+	a.reportConnections = append(a.reportConnections, &proto.ReportConnectionRequest{Connection: c})
+	select {
+	case a.reportConnectionsUpdate <- struct{}{}:
+	default:
+	}
+}
+
+// reportConnectionsLoop sends queued connection reports to coderd, in order.
+func (a *agent) reportConnectionsLoop(ctx context.Context, aAPI proto.DRPCAgentClient) error {
+	for {
+		a.reportConnectionsMu.Lock()
+		if len(a.reportConnections) == 0 {
+			a.reportConnectionsMu.Unlock()
+			select {
+			case <-a.reportConnectionsUpdate:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		req := a.reportConnections[0]
+		// Release the lock while sending, new reports are only ever
+		// appended.
+		a.reportConnectionsMu.Unlock()
+
+		_, err := aAPI.ReportConnection(ctx, req)
+		if err != nil {
+			return xerrors.Errorf("report connection: %w", err)
+		}
+
+		a.reportConnectionsMu.Lock()
+		// The report may have been dropped while we were sending it.
+		if len(a.reportConnections) > 0 && a.reportConnections[0] == req {
+			a.reportConnections[0] = nil
+			a.reportConnections = a.reportConnections[1:]
+		}
+		a.reportConnectionsMu.Unlock()
+	}
+}
+
+// cpuUsagePercent returns the percentage of the CPU available to the
+// workspace that is in use. The container's CPU limit is preferred when the
+// agent runs in a container. It returns 0 if the usage can't be measured.
+func (a *agent) cpuUsagePercent() float64 {
+	if a.statter == nil {
+		return 0
+	}
+	res, err := a.statter.ContainerCPU()
+	if err == nil && (res == nil || res.Total == nil) {
+		res, err = a.statter.HostCPU()
+	}
+	if err != nil {
+		a.logger.Debug(a.hardCtx, "failed to measure cpu usage", slog.Error(err))
+		return 0
+	}
+	if res == nil || res.Total == nil || *res.Total == 0 {
+		return 0
+	}
+	return res.Used / *res.Total * 100
 }
 
 // Collect collects additional stats from the agent
@@ -1500,6 +1862,8 @@ func (a *agent) Collect(ctx context.Context, networkStats map[netlogtype.Connect
 	stats.SessionCountJetbrains = sshStats.JetBrains
 
 	stats.SessionCountReconnectingPty = a.connCountReconnectingPTY.Load()
+	stats.SessionInputCount = a.sessionInputCount.Swap(0)
+	stats.CpuUsagePercent = a.cpuUsagePercent()
 
 	// Compute the median connection latency!
 	a.logger.Debug(ctx, "starting peer latency measurement for stats")
@@ -1907,6 +2271,11 @@ lifecycleWaitLoop:
 	}
 	a.closeWaitGroup.Wait()
 
+	err = a.sessionRecorder.Close()
+	if err != nil {
+		a.logger.Warn(context.Background(), "close session recorder", slog.Error(err))
+	}
+
 	return nil
 }
 
@@ -130,6 +130,45 @@ func TestAgent_Stats_ReconnectingPTY(t *testing.T) {
 	)
 }
 
+func TestAgent_Stats_SessionInput(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+	defer cancel()
+
+	//nolint:dogsled
+	conn, _, stats, _, _ := setupAgent(t, agentsdk.Manifest{}, 0)
+
+	ptyConn, err := conn.ReconnectingPTY(ctx, uuid.New(), 128, 128, "bash")
+	require.NoError(t, err)
+	defer ptyConn.Close()
+
+	// Resizes alone are not input.
+	data, err := json.Marshal(workspacesdk.ReconnectingPTYRequest{
+		Height: 80,
+		Width:  80,
+	})
+	require.NoError(t, err)
+	_, err = ptyConn.Write(data)
+	require.NoError(t, err)
+
+	data, err = json.Marshal(workspacesdk.ReconnectingPTYRequest{
+		Data: "echo test\r\n",
+	})
+	require.NoError(t, err)
+	_, err = ptyConn.Write(data)
+	require.NoError(t, err)
+
+	var s *proto.Stats
+	require.Eventuallyf(t, func() bool {
+		var ok bool
+		s, ok = <-stats
+		return ok && s.SessionInputCount == 1
+	}, testutil.WaitLong, testutil.IntervalFast,
+		"never saw stats: %+v", s,
+	)
+}
+
 func TestAgent_Stats_Magic(t *testing.T) {
 	t.Parallel()
 	t.Run("StripsEnvironmentVariable", func(t *testing.T) {
@@ -758,6 +797,144 @@ func TestAgent_Session_TTY_FastCommandHasOutput(t *testing.T) {
 	}
 }
 
+func TestAgent_Session_TTY_Recording(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		// This might be our implementation, or ConPTY itself.
+		// It's difficult to find extensive tests for it, so
+		// it seems like it could be either.
+		t.Skip("ConPTY appears to be inconsistent on Windows.")
+	}
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	//nolint:dogsled
+	conn, client, _, _, _ := setupAgent(t, agentsdk.Manifest{
+		SessionRecording: agentsdk.SessionRecordingPolicy{Enabled: true},
+	}, 0, func(_ *agenttest.Client, o *agent.Options) {
+		o.TempDir = t.TempDir()
+	})
+	sshClient, err := conn.SSHClient(ctx)
+	require.NoError(t, err)
+	defer sshClient.Close()
+
+	session, err := sshClient.NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+	err = session.RequestPty("xterm", 128, 128, ssh.TerminalModes{})
+	require.NoError(t, err)
+	var stdout bytes.Buffer
+	session.Stdout = &stdout
+	err = session.Run("echo recorded")
+	require.NoError(t, err)
+	require.Contains(t, stdout.String(), "recorded")
+
+	var recordings []*proto.UploadSessionRecordingRequest
+	require.Eventually(t, func() bool {
+		recordings = client.GetSessionRecordings()
+		return len(recordings) == 1
+	}, testutil.WaitShort, testutil.IntervalFast)
+	require.Equal(t, proto.UploadSessionRecordingRequest_SSH, recordings[0].Type)
+	require.Equal(t, "echo recorded", recordings[0].Command)
+	require.False(t, recordings[0].InputRecorded)
+	require.True(t, bytes.HasPrefix(recordings[0].Data, []byte(`{"`)))
+	require.Contains(t, string(recordings[0].Data), `"o","recorded`)
+}
+
+func TestAgent_Session_ReportConnection(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	//nolint:dogsled
+	conn, client, _, _, _ := setupAgent(t, agentsdk.Manifest{}, 0)
+	sshClient, err := conn.SSHClient(ctx)
+	require.NoError(t, err)
+	defer sshClient.Close()
+
+	session, err := sshClient.NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+	command := "exit 3"
+	if runtime.GOOS == "windows" {
+		command = "cmd.exe /c exit 3"
+	}
+	err = session.Run(command)
+	var exitErr *ssh.ExitError
+	require.ErrorAs(t, err, &exitErr)
+
+	var reports []*proto.ReportConnectionRequest
+	require.Eventually(t, func() bool {
+		reports = client.GetConnectionReports()
+		return len(reports) == 2
+	}, testutil.WaitShort, testutil.IntervalFast)
+
+	connect, disconnect := reports[0].Connection, reports[1].Connection
+	require.Equal(t, proto.Connection_CONNECT, connect.Action)
+	require.Equal(t, proto.Connection_SSH, connect.Type)
+	require.NotEmpty(t, connect.Ip)
+	require.Equal(t, proto.Connection_DISCONNECT, disconnect.Action)
+	require.Equal(t, connect.Id, disconnect.Id)
+	require.EqualValues(t, 3, disconnect.StatusCode)
+}
+
+func TestAgent_ReportForwardedConnection(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		app            bool
+		connectionType proto.Connection_Type
+	}{
+		{name: "PortForward", connectionType: proto.Connection_PORT_FORWARD},
+		{name: "App", app: true, connectionType: proto.Connection_APP},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitLong)
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer l.Close()
+			go func() {
+				for {
+					c, err := l.Accept()
+					if err != nil {
+						return
+					}
+					_ = c.Close()
+				}
+			}()
+
+			var manifest agentsdk.Manifest
+			if tc.app {
+				manifest.Apps = []codersdk.WorkspaceApp{{
+					Slug: "app",
+					URL:  "http://" + l.Addr().String(),
+				}}
+			}
+			//nolint:dogsled
+			agentConn, client, _, _, _ := setupAgent(t, manifest, 0)
+			require.True(t, agentConn.AwaitReachable(ctx))
+			conn, err := agentConn.DialContext(ctx, "tcp", l.Addr().String())
+			require.NoError(t, err)
+			_ = conn.Close()
+
+			var reports []*proto.ReportConnectionRequest
+			require.Eventually(t, func() bool {
+				reports = client.GetConnectionReports()
+				return len(reports) == 2
+			}, testutil.WaitShort, testutil.IntervalFast)
+
+			connect, disconnect := reports[0].Connection, reports[1].Connection
+			require.Equal(t, proto.Connection_CONNECT, connect.Action)
+			require.Equal(t, tc.connectionType, connect.Type)
+			require.NotEmpty(t, connect.Ip)
+			require.Equal(t, proto.Connection_DISCONNECT, disconnect.Action)
+			require.Equal(t, connect.Id, disconnect.Id)
+		})
+	}
+}
+
 func TestAgent_Session_TTY_HugeOutputIsNotLost(t *testing.T) {
 	t.Parallel()
 
@@ -1224,6 +1401,62 @@ func TestAgent_Metadata(t *testing.T) {
 			t.Fatalf("expected metadata to be collected again")
 		}
 	})
+
+	t.Run("Streaming", func(t *testing.T) {
+		t.Parallel()
+		if runtime.GOOS == "windows" {
+			t.Skip("this test uses a POSIX shell script")
+		}
+
+		//nolint:dogsled
+		_, client, _, _, _ := setupAgent(t, agentsdk.Manifest{
+			Metadata: []codersdk.WorkspaceAgentMetadataDescription{
+				{
+					Key:    "gpu",
+					Script: "# coder:streaming\necho gpu=42; echo 'mem=1 GiB'; echo unknown=1; echo garbage; sleep 300",
+				},
+				{
+					// Declared so that the gpu script can stream its value.
+					Key: "mem",
+				},
+			},
+		}, 0, func(_ *agenttest.Client, opts *agent.Options) {
+			opts.ReportMetadataInterval = testutil.IntervalFast
+		})
+
+		var gotMd map[string]agentsdk.Metadata
+		require.Eventually(t, func() bool {
+			gotMd = client.GetMetadata()
+			return len(gotMd) == 2
+		}, testutil.WaitShort, testutil.IntervalFast)
+		require.Equal(t, "42", gotMd["gpu"].Value)
+		require.Empty(t, gotMd["gpu"].Error)
+		require.Equal(t, "1 GiB", gotMd["mem"].Value)
+	})
+
+	t.Run("StreamingError", func(t *testing.T) {
+		t.Parallel()
+		if runtime.GOOS == "windows" {
+			t.Skip("this test uses a POSIX shell script")
+		}
+
+		//nolint:dogsled
+		_, client, _, _, _ := setupAgent(t, agentsdk.Manifest{
+			Metadata: []codersdk.WorkspaceAgentMetadataDescription{
+				{
+					Key:    "queue",
+					Script: "# coder:streaming\necho queue=3; echo 'queue is gone' >&2; exit 1",
+				},
+			},
+		}, 0, func(_ *agenttest.Client, opts *agent.Options) {
+			opts.ReportMetadataInterval = testutil.IntervalFast
+		})
+
+		require.Eventually(t, func() bool {
+			md, ok := client.GetMetadata()["queue"]
+			return ok && strings.Contains(md.Error, "queue is gone")
+		}, testutil.WaitShort, testutil.IntervalFast)
+	})
 }
 
 func TestAgentMetadata_Timing(t *testing.T) {
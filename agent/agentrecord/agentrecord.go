@@ -0,0 +1,493 @@
+// Package agentrecord records terminal sessions in the asciicast v2 format and
+// uploads them to coderd.
+//
+// See https://docs.asciinema.org/manual/asciicast/v2/ for the format.
+package agentrecord
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/codersdk/agentsdk"
+)
+
+// Type is the kind of session being recorded.
+type Type string
+
+const (
+	TypeSSH             Type = "ssh"
+	TypeReconnectingPTY Type = "reconnecting_pty"
+)
+
+func (t Type) proto() proto.UploadSessionRecordingRequest_Type {
+	switch t {
+	case TypeSSH:
+		return proto.UploadSessionRecordingRequest_SSH
+	case TypeReconnectingPTY:
+		return proto.UploadSessionRecordingRequest_RECONNECTING_PTY
+	default:
+		return proto.UploadSessionRecordingRequest_TYPE_UNSPECIFIED
+	}
+}
+
+const (
+	// uploadChunkSize is the maximum amount of recording data sent in a
+	// single upload request.
+	uploadChunkSize = 1 << 20
+	// maxUploadAttempts is the number of times uploading a recording is
+	// attempted before it is dropped.
+	maxUploadAttempts = 5
+	uploadRetryDelay  = 5 * time.Second
+)
+
+// Uploader uploads recordings to coderd. It is implemented by the agent API
+// client.
+type Uploader interface {
+	UploadSessionRecording(ctx context.Context, req *proto.UploadSessionRecordingRequest) (*proto.UploadSessionRecordingResponse, error)
+}
+
+// Recorder creates recordings of terminal sessions and uploads them once they
+// are finished. A nil *Recorder never records anything.
+//
+// Recordings are spooled to a temporary directory until they are uploaded.
+// Recordings that have not been uploaded when the agent exits are lost.
+type Recorder struct {
+	logger  slog.Logger
+	baseDir string
+	policy  func() agentsdk.SessionRecordingPolicy
+
+	mu       sync.Mutex
+	dir      string
+	pending  []*finishedRecording
+	attempts int
+	notify   chan struct{}
+	closed   bool
+}
+
+// New creates a recorder that spools recordings to a directory inside baseDir.
+// The policy function is consulted every time a session starts.
+func New(logger slog.Logger, baseDir string, policy func() agentsdk.SessionRecordingPolicy) *Recorder {
+	return &Recorder{
+		logger:  logger,
+		baseDir: baseDir,
+		policy:  policy,
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// Start starts recording a session if recording is enabled. The returned
+// recording must be closed once the session ends. If recording is disabled, or
+// the recording could not be created, nil is returned; all methods of
+// *Recording are no-ops on nil.
+func (r *Recorder) Start(typ Type, command string, width, height uint16, term string) *Recording {
+	if r == nil {
+		return nil
+	}
+	policy := r.policy()
+	if !policy.Enabled {
+		return nil
+	}
+
+	logger := r.logger.With(slog.F("type", typ))
+	dir, err := r.spoolDir()
+	if err != nil {
+		logger.Error(context.Background(), "create session recording directory", slog.Error(err))
+		return nil
+	}
+
+	id := uuid.New()
+	path := filepath.Join(dir, id.String()+".cast")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		logger.Error(context.Background(), "create session recording file", slog.Error(err))
+		return nil
+	}
+
+	rec := &Recording{
+		recorder:    r,
+		logger:      logger.With(slog.F("recording_id", id)),
+		id:          id,
+		typ:         typ,
+		command:     command,
+		startedAt:   time.Now(),
+		recordInput: policy.RecordInput,
+		maxSize:     policy.MaxSize,
+		path:        path,
+		file:        f,
+		w:           bufio.NewWriter(f),
+	}
+
+	header := map[string]any{
+		"version":   2,
+		"width":     width,
+		"height":    height,
+		"timestamp": rec.startedAt.Unix(),
+	}
+	if term != "" {
+		header["env"] = map[string]string{"TERM": term}
+	}
+	rec.mu.Lock()
+	rec.writeLine(header)
+	rec.mu.Unlock()
+	return rec
+}
+
+func (r *Recorder) spoolDir() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return "", xerrors.New("recorder is closed")
+	}
+	if r.dir != "" {
+		return r.dir, nil
+	}
+	err := os.MkdirAll(r.baseDir, 0o700)
+	if err != nil {
+		return "", err
+	}
+	dir, err := os.MkdirTemp(r.baseDir, "coder-session-recordings-")
+	if err != nil {
+		return "", err
+	}
+	r.dir = dir
+	return dir, nil
+}
+
+func (r *Recorder) enqueue(fr *finishedRecording) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		_ = os.Remove(fr.path)
+		return
+	}
+	r.pending = append(r.pending, fr)
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// SendLoop uploads finished recordings until the context is canceled.
+// Recordings that fail to upload are retried later, and dropped after several
+// failed attempts.
+func (r *Recorder) SendLoop(ctx context.Context, dest Uploader) error {
+	if r == nil {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	for {
+		r.mu.Lock()
+		var next *finishedRecording
+		if len(r.pending) > 0 {
+			next = r.pending[0]
+		}
+		r.mu.Unlock()
+
+		if next == nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-r.notify:
+				continue
+			}
+		}
+
+		err := next.upload(ctx, dest)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.mu.Lock()
+			r.attempts++
+			attempts := r.attempts
+			r.mu.Unlock()
+			if attempts < maxUploadAttempts {
+				r.logger.Warn(ctx, "upload session recording, will retry",
+					slog.F("recording_id", next.id), slog.F("attempts", attempts), slog.Error(err))
+				t := time.NewTimer(uploadRetryDelay)
+				select {
+				case <-ctx.Done():
+					t.Stop()
+					return ctx.Err()
+				case <-t.C:
+				}
+				continue
+			}
+			r.logger.Error(ctx, "upload session recording failed, dropping it",
+				slog.F("recording_id", next.id), slog.F("attempts", attempts), slog.Error(err))
+		}
+
+		_ = os.Remove(next.path)
+		r.mu.Lock()
+		if len(r.pending) > 0 && r.pending[0] == next {
+			r.pending = r.pending[1:]
+		}
+		r.attempts = 0
+		r.mu.Unlock()
+	}
+}
+
+// Close stops the recorder and removes any recordings that have not been
+// uploaded yet.
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	r.pending = nil
+	if r.dir == "" {
+		return nil
+	}
+	return os.RemoveAll(r.dir)
+}
+
+// Recording is an in-progress recording of a terminal session. All methods are
+// safe for concurrent use and on a nil *Recording.
+type Recording struct {
+	recorder    *Recorder
+	logger      slog.Logger
+	id          uuid.UUID
+	typ         Type
+	command     string
+	startedAt   time.Time
+	recordInput bool
+	maxSize     int64
+	path        string
+
+	mu        sync.Mutex
+	file      *os.File
+	w         *bufio.Writer
+	size      int64
+	truncated bool
+	failed    bool
+	closed    bool
+	// partialOutput and partialInput hold incomplete UTF-8 sequences until
+	// the rest of the sequence is written.
+	partialOutput []byte
+	partialInput  []byte
+}
+
+// Output returns a writer that records session output. Writes never fail, so
+// it can be safely combined with io.MultiWriter.
+func (rec *Recording) Output() io.Writer {
+	if rec == nil {
+		return io.Discard
+	}
+	return eventWriter{rec: rec, code: "o"}
+}
+
+// Input returns a writer that records session input, if the policy asks for
+// input to be recorded. Writes never fail, so it can be safely combined with
+// io.TeeReader.
+func (rec *Recording) Input() io.Writer {
+	if rec == nil || !rec.recordInput {
+		return io.Discard
+	}
+	return eventWriter{rec: rec, code: "i"}
+}
+
+// Resize records a change of the terminal size.
+func (rec *Recording) Resize(width, height uint16) {
+	if rec == nil {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.closed {
+		return
+	}
+	rec.writeEvent("r", fmt.Sprintf("%dx%d", width, height))
+}
+
+// Close finishes the recording and queues it for upload.
+func (rec *Recording) Close() {
+	if rec == nil {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.closed {
+		return
+	}
+	rec.closed = true
+	endedAt := time.Now()
+
+	if len(rec.partialOutput) > 0 {
+		rec.writeEvent("o", string(rec.partialOutput))
+	}
+	if len(rec.partialInput) > 0 {
+		rec.writeEvent("i", string(rec.partialInput))
+	}
+	if !rec.failed {
+		if err := rec.w.Flush(); err != nil {
+			rec.logger.Error(context.Background(), "flush session recording", slog.Error(err))
+			rec.failed = true
+		}
+	}
+	if err := rec.file.Close(); err != nil && !rec.failed {
+		rec.logger.Error(context.Background(), "close session recording", slog.Error(err))
+		rec.failed = true
+	}
+	if rec.failed {
+		_ = os.Remove(rec.path)
+		return
+	}
+
+	rec.recorder.enqueue(&finishedRecording{
+		id:            rec.id,
+		typ:           rec.typ,
+		command:       rec.command,
+		startedAt:     rec.startedAt,
+		endedAt:       endedAt,
+		inputRecorded: rec.recordInput,
+		truncated:     rec.truncated,
+		path:          rec.path,
+	})
+}
+
+func (rec *Recording) write(code string, p []byte) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.closed || rec.truncated || rec.failed {
+		return
+	}
+
+	partial := &rec.partialOutput
+	if code == "i" {
+		partial = &rec.partialInput
+	}
+	data := append(*partial, p...)
+	complete, rest := splitIncompleteRune(data)
+	*partial = append([]byte(nil), rest...)
+	if len(complete) == 0 {
+		return
+	}
+	rec.writeEvent(code, string(complete))
+}
+
+// writeEvent writes an event line. rec.mu must be held.
+func (rec *Recording) writeEvent(code string, data string) {
+	elapsed := time.Since(rec.startedAt).Seconds()
+	// Microsecond precision is plenty for replaying a terminal.
+	elapsed = math.Round(elapsed*1e6) / 1e6
+	rec.writeLine([]any{elapsed, code, data})
+}
+
+// writeLine writes a JSON line, unless it would exceed the maximum size of the
+// recording. rec.mu must be held.
+func (rec *Recording) writeLine(v any) {
+	if rec.truncated || rec.failed {
+		return
+	}
+	line, err := json.Marshal(v)
+	if err != nil {
+		rec.logger.Error(context.Background(), "marshal session recording event", slog.Error(err))
+		return
+	}
+	line = append(line, '\n')
+	if rec.maxSize > 0 && rec.size+int64(len(line)) > rec.maxSize {
+		rec.truncated = true
+		return
+	}
+	_, err = rec.w.Write(line)
+	if err != nil {
+		rec.logger.Error(context.Background(), "write session recording", slog.Error(err))
+		rec.failed = true
+		return
+	}
+	rec.size += int64(len(line))
+}
+
+type eventWriter struct {
+	rec  *Recording
+	code string
+}
+
+func (w eventWriter) Write(p []byte) (int, error) {
+	w.rec.write(w.code, p)
+	return len(p), nil
+}
+
+// splitIncompleteRune splits off an incomplete UTF-8 sequence at the end of p,
+// so that multi-byte characters split across writes are recorded intact.
+func splitIncompleteRune(p []byte) (complete, rest []byte) {
+	for i := len(p) - 1; i >= 0 && i > len(p)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(p[i]) {
+			continue
+		}
+		if !utf8.FullRune(p[i:]) {
+			return p[:i], p[i:]
+		}
+		break
+	}
+	return p, nil
+}
+
+type finishedRecording struct {
+	id            uuid.UUID
+	typ           Type
+	command       string
+	startedAt     time.Time
+	endedAt       time.Time
+	inputRecorded bool
+	truncated     bool
+	path          string
+}
+
+// upload sends the recording in chunks. Every upload starts from the beginning
+// of the recording, which replaces any partially uploaded data.
+func (fr *finishedRecording) upload(ctx context.Context, dest Uploader) error {
+	f, err := os.Open(fr.path)
+	if err != nil {
+		return xerrors.Errorf("open recording: %w", err)
+	}
+	defer f.Close()
+
+	buf := make([]byte, uploadChunkSize)
+	var offset int64
+	for {
+		n, err := io.ReadFull(f, buf)
+		if err != nil && !xerrors.Is(err, io.ErrUnexpectedEOF) && !xerrors.Is(err, io.EOF) {
+			return xerrors.Errorf("read recording: %w", err)
+		}
+		if n == 0 && offset > 0 {
+			return nil
+		}
+		_, uErr := dest.UploadSessionRecording(ctx, &proto.UploadSessionRecordingRequest{
+			Id:            fr.id[:],
+			Type:          fr.typ.proto(),
+			Command:       fr.command,
+			StartedAt:     timestamppb.New(fr.startedAt),
+			EndedAt:       timestamppb.New(fr.endedAt),
+			InputRecorded: fr.inputRecorded,
+			Truncated:     fr.truncated,
+			Offset:        offset,
+			Data:          buf[:n],
+		})
+		if uErr != nil {
+			return xerrors.Errorf("upload recording at offset %d: %w", offset, uErr)
+		}
+		offset += int64(n)
+		if err != nil {
+			// The last chunk was short, so we've reached the end.
+			return nil
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package agentrecord_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/coder/v2/agent/agentrecord"
+	"github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/codersdk/agentsdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := agentrecord.New(slogtest.Make(t, nil), t.TempDir(), func() agentsdk.SessionRecordingPolicy {
+			return agentsdk.SessionRecordingPolicy{}
+		})
+		rec := recorder.Start(agentrecord.TypeSSH, "", 80, 24, "xterm")
+		require.Nil(t, rec)
+
+		// A nil recording is safe to use.
+		_, err := rec.Output().Write([]byte("hello"))
+		require.NoError(t, err)
+		rec.Resize(100, 40)
+		rec.Close()
+	})
+
+	t.Run("Record", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		recorder := agentrecord.New(slogtest.Make(t, nil), t.TempDir(), func() agentsdk.SessionRecordingPolicy {
+			return agentsdk.SessionRecordingPolicy{Enabled: true, RecordInput: true}
+		})
+		defer recorder.Close()
+
+		rec := recorder.Start(agentrecord.TypeSSH, "vim", 80, 24, "xterm-256color")
+		require.NotNil(t, rec)
+		_, _ = rec.Output().Write([]byte("hello "))
+		// A multi-byte character split across writes is recorded intact.
+		_, _ = rec.Output().Write([]byte("\xe2\x82"))
+		_, _ = rec.Output().Write([]byte("\xac"))
+		_, _ = rec.Input().Write([]byte("q"))
+		rec.Resize(120, 40)
+		rec.Close()
+
+		uploader := newFakeUploader()
+		go func() {
+			_ = recorder.SendLoop(ctx, uploader)
+		}()
+		req := testutil.RequireRecvCtx(ctx, t, uploader.done)
+		require.Equal(t, proto.UploadSessionRecordingRequest_SSH, req.Type)
+		require.Equal(t, "vim", req.Command)
+		require.True(t, req.InputRecorded)
+		require.False(t, req.Truncated)
+
+		lines := strings.Split(strings.TrimSpace(string(req.Data)), "\n")
+		require.Len(t, lines, 5)
+		var header map[string]any
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &header))
+		require.EqualValues(t, 2, header["version"])
+		require.EqualValues(t, 80, header["width"])
+		require.EqualValues(t, 24, header["height"])
+		require.Equal(t, map[string]any{"TERM": "xterm-256color"}, header["env"])
+
+		require.Equal(t, []any{"o", "hello "}, parseEvent(t, lines[1]))
+		require.Equal(t, []any{"o", "€"}, parseEvent(t, lines[2]))
+		require.Equal(t, []any{"i", "q"}, parseEvent(t, lines[3]))
+		require.Equal(t, []any{"r", "120x40"}, parseEvent(t, lines[4]))
+	})
+
+	t.Run("NoInput", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		recorder := agentrecord.New(slogtest.Make(t, nil), t.TempDir(), func() agentsdk.SessionRecordingPolicy {
+			return agentsdk.SessionRecordingPolicy{Enabled: true}
+		})
+		defer recorder.Close()
+
+		rec := recorder.Start(agentrecord.TypeReconnectingPTY, "", 80, 24, "")
+		_, _ = rec.Input().Write([]byte("secret"))
+		_, _ = rec.Output().Write([]byte("output"))
+		rec.Close()
+
+		uploader := newFakeUploader()
+		go func() {
+			_ = recorder.SendLoop(ctx, uploader)
+		}()
+		req := testutil.RequireRecvCtx(ctx, t, uploader.done)
+		require.Equal(t, proto.UploadSessionRecordingRequest_RECONNECTING_PTY, req.Type)
+		require.False(t, req.InputRecorded)
+		require.NotContains(t, string(req.Data), "secret")
+		require.Contains(t, string(req.Data), "output")
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		recorder := agentrecord.New(slogtest.Make(t, nil), t.TempDir(), func() agentsdk.SessionRecordingPolicy {
+			return agentsdk.SessionRecordingPolicy{Enabled: true, MaxSize: 200}
+		})
+		defer recorder.Close()
+
+		rec := recorder.Start(agentrecord.TypeSSH, "", 80, 24, "")
+		for i := 0; i < 10; i++ {
+			_, _ = rec.Output().Write([]byte("0123456789"))
+		}
+		rec.Close()
+
+		uploader := newFakeUploader()
+		go func() {
+			_ = recorder.SendLoop(ctx, uploader)
+		}()
+		req := testutil.RequireRecvCtx(ctx, t, uploader.done)
+		require.True(t, req.Truncated)
+		require.LessOrEqual(t, len(req.Data), 200)
+		// Only complete events are recorded.
+		require.True(t, bytes.HasSuffix(req.Data, []byte("\n")))
+	})
+
+	t.Run("LargeRecording", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		recorder := agentrecord.New(slogtest.Make(t, nil), t.TempDir(), func() agentsdk.SessionRecordingPolicy {
+			return agentsdk.SessionRecordingPolicy{Enabled: true}
+		})
+		defer recorder.Close()
+
+		// Write more than a single upload chunk.
+		rec := recorder.Start(agentrecord.TypeSSH, "", 80, 24, "")
+		chunk := bytes.Repeat([]byte("a"), 64<<10)
+		for i := 0; i < 20; i++ {
+			_, _ = rec.Output().Write(chunk)
+		}
+		rec.Close()
+
+		uploader := newFakeUploader()
+		go func() {
+			_ = recorder.SendLoop(ctx, uploader)
+		}()
+		req := testutil.RequireRecvCtx(ctx, t, uploader.done)
+		require.Greater(t, len(req.Data), 20*len(chunk))
+		require.Greater(t, uploader.calls(), 1)
+	})
+}
+
+func parseEvent(t *testing.T, line string) []any {
+	t.Helper()
+	var event []any
+	require.NoError(t, json.Unmarshal([]byte(line), &event))
+	require.Len(t, event, 3)
+	_, ok := event[0].(float64)
+	assert.True(t, ok, "event time must be a number")
+	return event[1:]
+}
+
+// fakeUploader assembles uploaded chunks and sends each complete recording to
+// done. A recording is complete once a chunk smaller than the maximum chunk
+// size is received.
+type fakeUploader struct {
+	mu         sync.Mutex
+	recordings map[uuid.UUID]*proto.UploadSessionRecordingRequest
+	numCalls   int
+	done       chan *proto.UploadSessionRecordingRequest
+}
+
+func newFakeUploader() *fakeUploader {
+	return &fakeUploader{
+		recordings: make(map[uuid.UUID]*proto.UploadSessionRecordingRequest),
+		done:       make(chan *proto.UploadSessionRecordingRequest, 10),
+	}
+}
+
+func (f *fakeUploader) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.numCalls
+}
+
+func (f *fakeUploader) UploadSessionRecording(_ context.Context, req *proto.UploadSessionRecordingRequest) (*proto.UploadSessionRecordingResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.numCalls++
+	id, err := uuid.FromBytes(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	recording, ok := f.recordings[id]
+	if req.Offset == 0 || !ok {
+		recording = &proto.UploadSessionRecordingRequest{
+			Id:            req.Id,
+			Type:          req.Type,
+			Command:       req.Command,
+			StartedAt:     req.StartedAt,
+			EndedAt:       req.EndedAt,
+			InputRecorded: req.InputRecorded,
+			Truncated:     req.Truncated,
+		}
+		f.recordings[id] = recording
+	}
+	if int64(len(recording.Data)) != req.Offset {
+		return nil, xerrors.Errorf("unexpected offset %d", req.Offset)
+	}
+	recording.Data = append(recording.Data, req.Data...)
+	if len(req.Data) < 1<<20 {
+		f.done <- recording
+	}
+	return &proto.UploadSessionRecordingResponse{}, nil
+}
@@ -30,6 +30,8 @@ import (
 
 	"cdr.dev/slog"
 
+	"github.com/coder/coder/v2/agent/agentrecord"
+	"github.com/coder/coder/v2/agent/proto"
 	"github.com/coder/coder/v2/agent/usershell"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/pty"
@@ -84,8 +86,21 @@ type Config struct {
 	X11DisplayOffset *int
 	// BlockFileTransfer restricts use of file transfer applications.
 	BlockFileTransfer bool
+	// SessionRecorder records PTY sessions, if session recording is enabled.
+	// Optional.
+	SessionRecorder *agentrecord.Recorder
+	// ReportConnection is called when a session or forwarding channel is
+	// opened. Optional.
+	ReportConnection ReportConnectionFunc
+	// ReportInput is called whenever input is received from the client in a
+	// PTY session. Optional.
+	ReportInput func()
 }
 
+// ReportConnectionFunc reports a new connection to the agent and returns a
+// function that must be called once the connection is closed.
+type ReportConnectionFunc func(id uuid.UUID, connectionType proto.Connection_Type, remoteAddr string) (disconnected func(code int, reason string))
+
 type Server struct {
 	mu        sync.RWMutex // Protects following.
 	fs        afero.Fs
@@ -137,6 +152,12 @@ func NewServer(ctx context.Context, logger slog.Logger, prometheusRegistry *prom
 	if config.AnnouncementBanners == nil {
 		config.AnnouncementBanners = func() *[]codersdk.BannerConfig { return &[]codersdk.BannerConfig{} }
 	}
+	if config.ReportConnection == nil {
+		config.ReportConnection = func(uuid.UUID, proto.Connection_Type, string) func(int, string) { return func(int, string) {} }
+	}
+	if config.ReportInput == nil {
+		config.ReportInput = func() {}
+	}
 	if config.WorkingDirectory == nil {
 		config.WorkingDirectory = func() string {
 			home, err := userHomeDir()
@@ -168,10 +189,16 @@ func NewServer(ctx context.Context, logger slog.Logger, prometheusRegistry *prom
 			"direct-tcpip": func(srv *ssh.Server, conn *gossh.ServerConn, newChan gossh.NewChannel, ctx ssh.Context) {
 				// Wrapper is designed to find and track JetBrains Gateway connections.
 				wrapped := NewJetbrainsChannelWatcher(ctx, s.logger, newChan, &s.connCountJetBrains)
-				ssh.DirectTCPIPHandler(srv, conn, wrapped, ctx)
+				connectionType := proto.Connection_PORT_FORWARD
+				if _, ok := wrapped.(*JetbrainsChannelWatcher); ok {
+					connectionType = proto.Connection_JETBRAINS
+				}
+				ssh.DirectTCPIPHandler(srv, conn, s.reportChannel(conn, wrapped, connectionType), ctx)
 			},
-			"direct-streamlocal@openssh.com": directStreamLocalHandler,
-			"session":                        ssh.DefaultSessionHandler,
+			"direct-streamlocal@openssh.com": func(srv *ssh.Server, conn *gossh.ServerConn, newChan gossh.NewChannel, ctx ssh.Context) {
+				directStreamLocalHandler(srv, conn, s.reportChannel(conn, newChan, proto.Connection_PORT_FORWARD), ctx)
+			},
+			"session": ssh.DefaultSessionHandler,
 		},
 		ConnectionFailedCallback: func(conn net.Conn, err error) {
 			s.logger.Warn(ctx, "ssh connection failed",
@@ -252,14 +279,57 @@ func (s *Server) ConnStats() ConnStats {
 	}
 }
 
+// inputReader calls report for every read that returns client input.
+type inputReader struct {
+	io.Reader
+	report func()
+}
+
+func (r *inputReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.report()
+	}
+	return n, err
+}
+
+// reportChannel wraps a forwarding channel so that it is reported as a
+// connection once accepted, and as disconnected once closed.
+func (s *Server) reportChannel(conn *gossh.ServerConn, newChan gossh.NewChannel, connectionType proto.Connection_Type) gossh.NewChannel {
+	return &reportingChannel{
+		NewChannel: newChan,
+		report: func() func(int, string) {
+			return s.config.ReportConnection(uuid.New(), connectionType, conn.RemoteAddr().String())
+		},
+	}
+}
+
+type reportingChannel struct {
+	gossh.NewChannel
+	report func() (disconnected func(code int, reason string))
+}
+
+func (c *reportingChannel) Accept() (gossh.Channel, <-chan *gossh.Request, error) {
+	ch, reqs, err := c.NewChannel.Accept()
+	if err != nil {
+		return ch, reqs, err
+	}
+	disconnected := c.report()
+	return &ChannelOnClose{
+		Channel: ch,
+		done:    func() { disconnected(0, "") },
+	}, reqs, nil
+}
+
 func (s *Server) sessionHandler(session ssh.Session) {
 	ctx := session.Context()
+	id := uuid.New()
 	logger := s.logger.With(
 		slog.F("remote_addr", session.RemoteAddr()),
 		slog.F("local_addr", session.LocalAddr()),
 		// Assigning a random uuid for each session is useful for tracking
 		// logs for the same ssh session.
-		slog.F("id", uuid.NewString()),
+		slog.F("id", id.String()),
 	)
 	logger.Info(ctx, "handling ssh session")
 
@@ -271,12 +341,29 @@ func (s *Server) sessionHandler(session ssh.Session) {
 	}
 	defer s.trackSession(session, false)
 
+	// JetBrains launches hundreds of ssh sessions, so we instead report the
+	// single persistent tcp forwarding channel, see reportChannel.
+	var failReason string
+	if magicType := magicSessionType(session.Environ()); magicType != MagicSessionTypeJetBrains {
+		connectionType := proto.Connection_SSH
+		if magicType == MagicSessionTypeVSCode {
+			connectionType = proto.Connection_VSCODE
+		}
+		disconnected := s.config.ReportConnection(id, connectionType, session.RemoteAddr().String())
+		exitSession := &exitStatusSession{Session: session}
+		session = exitSession
+		defer func() {
+			disconnected(exitSession.status, failReason)
+		}()
+	}
+
 	extraEnv := make([]string, 0)
 	x11, hasX11 := session.X11()
 	if hasX11 {
 		display, handled := s.x11Handler(session.Context(), x11)
 		if !handled {
 			_ = session.Exit(1)
+			failReason = "x11 handler failed"
 			logger.Error(ctx, "x11 handler failed")
 			return
 		}
@@ -292,6 +379,7 @@ func (s *Server) sessionHandler(session ssh.Session) {
 			_, _ = session.Write([]byte(errorMessage))
 		}
 		_ = session.Exit(BlockedFileTransferErrorCode)
+		failReason = BlockedFileTransferErrorMessage
 		return
 	}
 
@@ -303,6 +391,7 @@ func (s *Server) sessionHandler(session ssh.Session) {
 	default:
 		logger.Warn(ctx, "unsupported subsystem", slog.F("subsystem", ss))
 		_ = session.Exit(1)
+		failReason = fmt.Sprintf("unsupported subsystem %q", ss)
 		return
 	}
 
@@ -339,12 +428,35 @@ func (s *Server) sessionHandler(session ssh.Session) {
 		// This exit code is designed to be unlikely to be confused for a legit exit code
 		// from the process.
 		_ = session.Exit(MagicSessionErrorCode)
+		failReason = err.Error()
 		return
 	}
 	logger.Info(ctx, "normal ssh session exit")
 	_ = session.Exit(0)
 }
 
+// exitStatusSession records the exit status sent to the client.
+type exitStatusSession struct {
+	ssh.Session
+	status int
+}
+
+func (s *exitStatusSession) Exit(code int) error {
+	s.status = code
+	return s.Session.Exit(code)
+}
+
+// magicSessionType returns the lowercased magic session type set in env, if
+// any.
+func magicSessionType(env []string) string {
+	for _, kv := range env {
+		if strings.HasPrefix(kv, MagicSessionTypeEnvironmentVariable+"=") {
+			return strings.ToLower(strings.TrimPrefix(kv, MagicSessionTypeEnvironmentVariable+"="))
+		}
+	}
+	return ""
+}
+
 // fileTransferBlocked method checks if the file transfer commands should be blocked.
 //
 // Warning: consider this mechanism as "Do not trespass" sign, as a violator can still ssh to the host,
@@ -537,6 +649,9 @@ func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTy
 			}
 		}
 	}()
+	// Start returns nil if recording is disabled, which records nothing.
+	rec := s.config.SessionRecorder.Start(agentrecord.TypeSSH, session.RawCommand(), uint16(sshPty.Window.Width), uint16(sshPty.Window.Height), sshPty.Term)
+	defer rec.Close()
 	sigs := make(chan ssh.Signal, 1)
 	session.Signals(sigs)
 	defer func() {
@@ -561,6 +676,7 @@ func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTy
 					windowSize = nil
 					continue
 				}
+				rec.Resize(uint16(win.Width), uint16(win.Height))
 				resizeErr := ptty.Resize(uint16(win.Height), uint16(win.Width))
 				// If the pty is closed, then command has exited, no need to log.
 				if resizeErr != nil && !errors.Is(resizeErr, pty.ErrClosed) {
@@ -572,7 +688,7 @@ func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTy
 	}()
 
 	go func() {
-		_, err := io.Copy(ptty.InputWriter(), session)
+		_, err := io.Copy(ptty.InputWriter(), io.TeeReader(&inputReader{Reader: session, report: s.config.ReportInput}, rec.Input()))
 		if err != nil {
 			s.metrics.sessionErrors.WithLabelValues(magicTypeLabel, "yes", "input_io_copy").Add(1)
 		}
@@ -586,7 +702,7 @@ func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTy
 	//    after we've Read() all the buffered data from the PTY.
 	// 2. The client hangs up, which cancels the command's Context, and go will
 	//    kill the command's process.  This then has the same effect as (1).
-	n, err := io.Copy(session, ptty.OutputReader())
+	n, err := io.Copy(io.MultiWriter(session, rec.Output()), ptty.OutputReader())
 	logger.Debug(ctx, "copy output done", slog.F("bytes", n), slog.Error(err))
 	if err != nil {
 		s.metrics.sessionErrors.WithLabelValues(magicTypeLabel, "yes", "output_io_copy").Add(1)
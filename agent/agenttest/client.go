@@ -158,6 +158,14 @@ func (c *Client) SetLogsChannel(ch chan<- *agentproto.BatchCreateLogsRequest) {
 	c.fakeAgentAPI.SetLogsChannel(ch)
 }
 
+func (c *Client) GetSessionRecordings() []*agentproto.UploadSessionRecordingRequest {
+	return c.fakeAgentAPI.GetSessionRecordings()
+}
+
+func (c *Client) GetConnectionReports() []*agentproto.ReportConnectionRequest {
+	return c.fakeAgentAPI.GetConnectionReports()
+}
+
 type FakeAgentAPI struct {
 	sync.Mutex
 	t      testing.TB
@@ -170,6 +178,10 @@ type FakeAgentAPI struct {
 	logsCh          chan<- *agentproto.BatchCreateLogsRequest
 	lifecycleStates []codersdk.WorkspaceAgentLifecycle
 	metadata        map[string]agentsdk.Metadata
+	// sessionRecordings holds the uploaded recordings, with the data of all
+	// chunks concatenated.
+	sessionRecordings map[uuid.UUID]*agentproto.UploadSessionRecordingRequest
+	connectionReports []*agentproto.ReportConnectionRequest
 
 	getAnnouncementBannersFunc func() ([]codersdk.BannerConfig, error)
 }
@@ -301,6 +313,62 @@ func (f *FakeAgentAPI) BatchCreateLogs(ctx context.Context, req *agentproto.Batc
 	return &agentproto.BatchCreateLogsResponse{}, nil
 }
 
+func (f *FakeAgentAPI) GetSessionRecordings() []*agentproto.UploadSessionRecordingRequest {
+	f.Lock()
+	defer f.Unlock()
+	recordings := make([]*agentproto.UploadSessionRecordingRequest, 0, len(f.sessionRecordings))
+	for _, recording := range f.sessionRecordings {
+		recordings = append(recordings, recording)
+	}
+	return recordings
+}
+
+func (f *FakeAgentAPI) UploadSessionRecording(ctx context.Context, req *agentproto.UploadSessionRecordingRequest) (*agentproto.UploadSessionRecordingResponse, error) {
+	f.logger.Info(ctx, "upload session recording called", slog.F("id", req.Id), slog.F("offset", req.Offset), slog.F("len", len(req.Data)))
+	id, err := uuid.FromBytes(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	f.Lock()
+	defer f.Unlock()
+	if f.sessionRecordings == nil {
+		f.sessionRecordings = make(map[uuid.UUID]*agentproto.UploadSessionRecordingRequest)
+	}
+	if req.Offset == 0 {
+		f.sessionRecordings[id] = &agentproto.UploadSessionRecordingRequest{
+			Id:            req.Id,
+			Type:          req.Type,
+			Command:       req.Command,
+			StartedAt:     req.StartedAt,
+			EndedAt:       req.EndedAt,
+			InputRecorded: req.InputRecorded,
+			Truncated:     req.Truncated,
+			Data:          slices.Clone(req.Data),
+		}
+		return &agentproto.UploadSessionRecordingResponse{}, nil
+	}
+	recording, ok := f.sessionRecordings[id]
+	if !ok || int64(len(recording.Data)) != req.Offset {
+		return nil, xerrors.Errorf("offset %d does not match the end of recording %s", req.Offset, id)
+	}
+	recording.Data = append(recording.Data, req.Data...)
+	return &agentproto.UploadSessionRecordingResponse{}, nil
+}
+
+func (f *FakeAgentAPI) GetConnectionReports() []*agentproto.ReportConnectionRequest {
+	f.Lock()
+	defer f.Unlock()
+	return slices.Clone(f.connectionReports)
+}
+
+func (f *FakeAgentAPI) ReportConnection(ctx context.Context, req *agentproto.ReportConnectionRequest) (*agentproto.ReportConnectionResponse, error) {
+	f.logger.Info(ctx, "report connection called", slog.F("req", req))
+	f.Lock()
+	defer f.Unlock()
+	f.connectionReports = append(f.connectionReports, req)
+	return &agentproto.ReportConnectionResponse{}, nil
+}
+
 func NewFakeAgentAPI(t testing.TB, logger slog.Logger, manifest *agentproto.Manifest, statsCh chan *agentproto.Stats) *FakeAgentAPI {
 	return &FakeAgentAPI{
 		t:           t,
@@ -38,6 +38,12 @@ func (a *agent) apiHandler() http.Handler {
 	promHandler := PrometheusMetricsHandler(a.prometheusRegistry, a.logger)
 	r.Get("/api/v0/listening-ports", lp.handler)
 	r.Get("/api/v0/netcheck", a.HandleNetcheck)
+	r.Route("/api/v0/files", func(r chi.Router) {
+		r.Use(a.fileTransferMiddleware)
+		r.Get("/", a.HandleReadFile)
+		r.Put("/", a.HandleWriteFile)
+		r.Get("/list", a.HandleListDirectory)
+	})
 	r.Get("/debug/logs", a.HandleHTTPDebugLogs)
 	r.Get("/debug/magicsock", a.HandleHTTPDebugMagicsock)
 	r.Get("/debug/magicsock/debug-logging/{state}", a.HandleHTTPMagicsockDebugLoggingState)
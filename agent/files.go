@@ -0,0 +1,234 @@
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/afero"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/agent/agentssh"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// fileTransferMiddleware rejects file API requests when file transfers are
+// blocked, mirroring the restrictions placed on SSH sessions.
+func (a *agent) fileTransferMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if a.blockFileTransfer {
+			httpapi.Write(r.Context(), rw, http.StatusForbidden, codersdk.Response{
+				Message: agentssh.BlockedFileTransferErrorMessage,
+			})
+			return
+		}
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// HandleListDirectory lists the content of the directory given by the path
+// query parameter.
+func (a *agent) HandleListDirectory(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	path, ok := a.filePathParam(rw, r)
+	if !ok {
+		return
+	}
+
+	fi, err := a.filesystem.Stat(path)
+	if err != nil {
+		writeFileError(rw, r, path, err)
+		return
+	}
+	if !fi.IsDir() {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("%q is not a directory.", path),
+		})
+		return
+	}
+	infos, err := afero.ReadDir(a.filesystem, path)
+	if err != nil {
+		writeFileError(rw, r, path, err)
+		return
+	}
+
+	files := make([]codersdk.WorkspaceAgentFileInfo, 0, len(infos))
+	for _, info := range infos {
+		files = append(files, convertFileInfo(filepath.Join(path, info.Name()), info))
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.WorkspaceAgentDirectory{
+		Path:  path,
+		Files: files,
+	})
+}
+
+// HandleReadFile streams the content of the file given by the path query
+// parameter. Range requests are supported.
+func (a *agent) HandleReadFile(rw http.ResponseWriter, r *http.Request) {
+	path, ok := a.filePathParam(rw, r)
+	if !ok {
+		return
+	}
+
+	f, err := a.filesystem.Open(path)
+	if err != nil {
+		writeFileError(rw, r, path, err)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		writeFileError(rw, r, path, err)
+		return
+	}
+	if fi.IsDir() {
+		httpapi.Write(r.Context(), rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("%q is a directory.", path),
+		})
+		return
+	}
+
+	http.ServeContent(rw, r, fi.Name(), fi.ModTime(), f)
+}
+
+// HandleWriteFile writes the request body to the file given by the path query
+// parameter. The optional offset query parameter resumes a previous write:
+// existing content from offset onwards is replaced, and the offset must not be
+// past the end of the file.
+func (a *agent) HandleWriteFile(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	path, ok := a.filePathParam(rw, r)
+	if !ok {
+		return
+	}
+	var offset int64
+	if s := r.URL.Query().Get("offset"); s != "" {
+		var err error
+		offset, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || offset < 0 {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Query param \"offset\" must be a non-negative integer.",
+			})
+			return
+		}
+	}
+
+	fi, err := a.filesystem.Stat(path)
+	switch {
+	case err == nil && fi.IsDir():
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("%q is a directory.", path),
+		})
+		return
+	case err == nil && offset > fi.Size():
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Offset %d is past the end of %q.", offset, path),
+			Detail:  fmt.Sprintf("The file is %d bytes.", fi.Size()),
+		})
+		return
+	case err != nil && (offset > 0 || !errors.Is(err, os.ErrNotExist)):
+		writeFileError(rw, r, path, err)
+		return
+	}
+
+	err = a.writeFile(path, offset, r.Body)
+	if err != nil {
+		writeFileError(rw, r, path, err)
+		return
+	}
+	fi, err = a.filesystem.Stat(path)
+	if err != nil {
+		writeFileError(rw, r, path, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, convertFileInfo(path, fi))
+}
+
+func (a *agent) writeFile(path string, offset int64, content io.Reader) error {
+	flags := os.O_WRONLY | os.O_CREATE
+	if offset == 0 {
+		flags |= os.O_TRUNC
+	}
+	f, err := a.filesystem.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		if err := f.Truncate(offset); err != nil {
+			_ = f.Close()
+			return xerrors.Errorf("truncate: %w", err)
+		}
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			_ = f.Close()
+			return xerrors.Errorf("seek: %w", err)
+		}
+	}
+	if _, err := io.Copy(f, content); err != nil {
+		_ = f.Close()
+		return xerrors.Errorf("write: %w", err)
+	}
+	return f.Close()
+}
+
+// filePathParam returns the absolute path given by the path query parameter.
+// Relative paths are resolved against the home directory of the agent user.
+func (*agent) filePathParam(rw http.ResponseWriter, r *http.Request) (string, bool) {
+	path := r.URL.Query().Get("path")
+	if path == "" {
+		httpapi.Write(r.Context(), rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Query param \"path\" is required.",
+		})
+		return "", false
+	}
+	if path == "~" {
+		path = "."
+	} else if len(path) > 1 && path[0] == '~' && os.IsPathSeparator(path[1]) {
+		path = path[2:]
+	}
+	if !filepath.IsAbs(path) {
+		home, err := userHomeDir()
+		if err != nil {
+			httpapi.Write(r.Context(), rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Failed to get home directory.",
+				Detail:  err.Error(),
+			})
+			return "", false
+		}
+		path = filepath.Join(home, path)
+	}
+	return filepath.Clean(path), true
+}
+
+func writeFileError(rw http.ResponseWriter, r *http.Request, path string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		status = http.StatusNotFound
+	case errors.Is(err, os.ErrPermission):
+		status = http.StatusForbidden
+	}
+	httpapi.Write(r.Context(), rw, status, codersdk.Response{
+		Message: fmt.Sprintf("Failed to access %q.", path),
+		Detail:  err.Error(),
+	})
+}
+
+func convertFileInfo(path string, fi os.FileInfo) codersdk.WorkspaceAgentFileInfo {
+	return codersdk.WorkspaceAgentFileInfo{
+		Name:    fi.Name(),
+		Path:    path,
+		Size:    fi.Size(),
+		Mode:    fi.Mode().String(),
+		ModTime: fi.ModTime(),
+		IsDir:   fi.IsDir(),
+	}
+}
@@ -47,6 +47,8 @@ type DRPCAgentClient interface {
 	BatchUpdateMetadata(ctx context.Context, in *BatchUpdateMetadataRequest) (*BatchUpdateMetadataResponse, error)
 	BatchCreateLogs(ctx context.Context, in *BatchCreateLogsRequest) (*BatchCreateLogsResponse, error)
 	GetAnnouncementBanners(ctx context.Context, in *GetAnnouncementBannersRequest) (*GetAnnouncementBannersResponse, error)
+	UploadSessionRecording(ctx context.Context, in *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error)
+	ReportConnection(ctx context.Context, in *ReportConnectionRequest) (*ReportConnectionResponse, error)
 }
 
 type drpcAgentClient struct {
@@ -140,6 +142,24 @@ func (c *drpcAgentClient) GetAnnouncementBanners(ctx context.Context, in *GetAnn
 	return out, nil
 }
 
+func (c *drpcAgentClient) UploadSessionRecording(ctx context.Context, in *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error) {
+	out := new(UploadSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/coder.agent.v2.Agent/UploadSessionRecording", drpcEncoding_File_agent_proto_agent_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcAgentClient) ReportConnection(ctx context.Context, in *ReportConnectionRequest) (*ReportConnectionResponse, error) {
+	out := new(ReportConnectionResponse)
+	err := c.cc.Invoke(ctx, "/coder.agent.v2.Agent/ReportConnection", drpcEncoding_File_agent_proto_agent_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCAgentServer interface {
 	GetManifest(context.Context, *GetManifestRequest) (*Manifest, error)
 	GetServiceBanner(context.Context, *GetServiceBannerRequest) (*ServiceBanner, error)
@@ -150,6 +170,8 @@ type DRPCAgentServer interface {
 	BatchUpdateMetadata(context.Context, *BatchUpdateMetadataRequest) (*BatchUpdateMetadataResponse, error)
 	BatchCreateLogs(context.Context, *BatchCreateLogsRequest) (*BatchCreateLogsResponse, error)
 	GetAnnouncementBanners(context.Context, *GetAnnouncementBannersRequest) (*GetAnnouncementBannersResponse, error)
+	UploadSessionRecording(context.Context, *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error)
+	ReportConnection(context.Context, *ReportConnectionRequest) (*ReportConnectionResponse, error)
 }
 
 type DRPCAgentUnimplementedServer struct{}
@@ -190,9 +212,17 @@ func (s *DRPCAgentUnimplementedServer) GetAnnouncementBanners(context.Context, *
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCAgentUnimplementedServer) UploadSessionRecording(context.Context, *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCAgentUnimplementedServer) ReportConnection(context.Context, *ReportConnectionRequest) (*ReportConnectionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 type DRPCAgentDescription struct{}
 
-func (DRPCAgentDescription) NumMethods() int { return 9 }
+func (DRPCAgentDescription) NumMethods() int { return 11 }
 
 func (DRPCAgentDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -277,6 +307,24 @@ func (DRPCAgentDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver,
 						in1.(*GetAnnouncementBannersRequest),
 					)
 			}, DRPCAgentServer.GetAnnouncementBanners, true
+	case 9:
+		return "/coder.agent.v2.Agent/UploadSessionRecording", drpcEncoding_File_agent_proto_agent_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAgentServer).
+					UploadSessionRecording(
+						ctx,
+						in1.(*UploadSessionRecordingRequest),
+					)
+			}, DRPCAgentServer.UploadSessionRecording, true
+	case 10:
+		return "/coder.agent.v2.Agent/ReportConnection", drpcEncoding_File_agent_proto_agent_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAgentServer).
+					ReportConnection(
+						ctx,
+						in1.(*ReportConnectionRequest),
+					)
+			}, DRPCAgentServer.ReportConnection, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -429,3 +477,35 @@ func (x *drpcAgent_GetAnnouncementBannersStream) SendAndClose(m *GetAnnouncement
 	}
 	return x.CloseSend()
 }
+
+type DRPCAgent_UploadSessionRecordingStream interface {
+	drpc.Stream
+	SendAndClose(*UploadSessionRecordingResponse) error
+}
+
+type drpcAgent_UploadSessionRecordingStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAgent_UploadSessionRecordingStream) SendAndClose(m *UploadSessionRecordingResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_agent_proto_agent_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCAgent_ReportConnectionStream interface {
+	drpc.Stream
+	SendAndClose(*ReportConnectionResponse) error
+}
+
+type drpcAgent_ReportConnectionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAgent_ReportConnectionStream) SendAndClose(m *ReportConnectionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_agent_proto_agent_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
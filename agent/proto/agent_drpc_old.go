@@ -36,3 +36,17 @@ type DRPCAgentClient21 interface {
 	BatchCreateLogs(ctx context.Context, in *BatchCreateLogsRequest) (*BatchCreateLogsResponse, error)
 	GetAnnouncementBanners(ctx context.Context, in *GetAnnouncementBannersRequest) (*GetAnnouncementBannersResponse, error)
 }
+
+// DRPCAgentClient22 is the Agent API at v2.2. It is identical to 2.1, since the change was made on
+// the Tailnet API, which uses the same version number. It is useful if you want to be maximally
+// compatible with Coderd Release Versions from 2.13+
+type DRPCAgentClient22 interface {
+	DRPCAgentClient21
+}
+
+// DRPCAgentClient23 is the Agent API at v2.3. It adds UploadSessionRecording. It is useful if you
+// want to be maximally compatible with Coderd Release Versions that support session recordings
+type DRPCAgentClient23 interface {
+	DRPCAgentClient22
+	UploadSessionRecording(ctx context.Context, in *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error)
+}
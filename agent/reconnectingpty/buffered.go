@@ -14,6 +14,7 @@ import (
 
 	"cdr.dev/slog"
 
+	"github.com/coder/coder/v2/agent/agentrecord"
 	"github.com/coder/coder/v2/pty"
 )
 
@@ -28,7 +29,11 @@ type bufferedReconnectingPTY struct {
 	ptty    pty.PTYCmd
 	process pty.Process
 
-	metrics *prometheus.CounterVec
+	metrics     *prometheus.CounterVec
+	reportInput func()
+	// recording records the output of the pty for its whole lifetime.  It is
+	// nil if session recording is disabled.
+	recording *agentrecord.Recording
 
 	state *ptyState
 	// timer will close the reconnecting pty when it expires.  The timer will be
@@ -44,6 +49,7 @@ func newBuffered(ctx context.Context, cmd *pty.Cmd, options *Options, logger slo
 		activeConns: map[string]net.Conn{},
 		command:     cmd,
 		metrics:     options.Metrics,
+		reportInput: options.ReportInput,
 		state:       newState(),
 		timeout:     options.Timeout,
 	}
@@ -68,6 +74,8 @@ func newBuffered(ctx context.Context, cmd *pty.Cmd, options *Options, logger slo
 	}
 	rpty.ptty = ptty
 	rpty.process = process
+	// The pty is resized to the size of the client once it attaches.
+	rpty.recording = options.Recorder.Start(agentrecord.TypeReconnectingPTY, options.Command, 80, 24, "xterm-256color")
 
 	go rpty.lifecycle(ctx, logger)
 
@@ -96,6 +104,7 @@ func newBuffered(ctx context.Context, cmd *pty.Cmd, options *Options, logger slo
 				break
 			}
 			part := buffer[:read]
+			_, _ = rpty.recording.Output().Write(part)
 			rpty.state.cond.L.Lock()
 			_, err = rpty.circularBuffer.Write(part)
 			if err != nil {
@@ -167,6 +176,8 @@ func (rpty *bufferedReconnectingPTY) lifecycle(ctx context.Context, logger slog.
 		logger.Debug(ctx, "killed process with error", slog.Error(err))
 	}
 
+	rpty.recording.Close()
+
 	logger.Info(ctx, "closed reconnecting pty")
 	rpty.state.setState(StateDone, reasonErr)
 }
@@ -197,6 +208,7 @@ func (rpty *bufferedReconnectingPTY) Attach(ctx context.Context, connID string,
 	go heartbeat(ctx, rpty.timer, rpty.timeout)
 
 	// Resize the PTY to initial height + width.
+	rpty.recording.Resize(width, height)
 	err = rpty.ptty.Resize(height, width)
 	if err != nil {
 		// We can continue after this, it's not fatal!
@@ -205,7 +217,7 @@ func (rpty *bufferedReconnectingPTY) Attach(ctx context.Context, connID string,
 	}
 
 	// Pipe conn -> pty and block.  pty -> conn is handled in newBuffered().
-	readConnLoop(ctx, conn, rpty.ptty, rpty.metrics, logger)
+	readConnLoop(ctx, conn, rpty.ptty, rpty.recording, rpty.metrics, rpty.reportInput, logger)
 	return nil
 }
 
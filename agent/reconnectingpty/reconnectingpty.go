@@ -14,6 +14,7 @@ import (
 	"golang.org/x/xerrors"
 
 	"cdr.dev/slog"
+	"github.com/coder/coder/v2/agent/agentrecord"
 	"github.com/coder/coder/v2/codersdk/workspacesdk"
 	"github.com/coder/coder/v2/pty"
 )
@@ -31,6 +32,14 @@ type Options struct {
 	Timeout time.Duration
 	// Metrics tracks various error counters.
 	Metrics *prometheus.CounterVec
+	// Recorder records the session if session recording is enabled. Optional.
+	Recorder *agentrecord.Recorder
+	// Command is the command requested by the client. It is only used to
+	// describe recordings.
+	Command string
+	// ReportInput is called whenever input is received from a connection.
+	// Optional.
+	ReportInput func()
 }
 
 // ReconnectingPTY is a pty that can be reconnected within a timeout and to
@@ -59,6 +68,9 @@ func New(ctx context.Context, cmd *pty.Cmd, options *Options, logger slog.Logger
 	if options.Timeout == 0 {
 		options.Timeout = 5 * time.Minute
 	}
+	if options.ReportInput == nil {
+		options.ReportInput = func() {}
+	}
 	// Screen seems flaky on Darwin.  Locally the tests pass 100% of the time (100
 	// runs) but in CI screen often incorrectly claims the session name does not
 	// exist even though screen -list shows it.  For now, restrict screen to
@@ -192,8 +204,10 @@ func (s *ptyState) waitForStateOrContext(ctx context.Context, state State) (Stat
 }
 
 // readConnLoop reads messages from conn and writes to ptty as needed.  Blocks
-// until EOF or an error writing to ptty or reading from conn.
-func readConnLoop(ctx context.Context, conn net.Conn, ptty pty.PTYCmd, metrics *prometheus.CounterVec, logger slog.Logger) {
+// until EOF or an error writing to ptty or reading from conn.  Input and resizes
+// are recorded to rec, which may be nil, and reportInput is called for every
+// message that carries input.
+func readConnLoop(ctx context.Context, conn net.Conn, ptty pty.PTYCmd, rec *agentrecord.Recording, metrics *prometheus.CounterVec, reportInput func(), logger slog.Logger) {
 	decoder := json.NewDecoder(conn)
 	for {
 		var req workspacesdk.ReconnectingPTYRequest
@@ -205,16 +219,21 @@ func readConnLoop(ctx context.Context, conn net.Conn, ptty pty.PTYCmd, metrics *
 			logger.Warn(ctx, "reconnecting pty failed with read error", slog.Error(err))
 			return
 		}
+		_, _ = rec.Input().Write([]byte(req.Data))
 		_, err = ptty.InputWriter().Write([]byte(req.Data))
 		if err != nil {
 			logger.Warn(ctx, "reconnecting pty failed with write error", slog.Error(err))
 			metrics.WithLabelValues("input_writer").Add(1)
 			return
 		}
+		if req.Data != "" {
+			reportInput()
+		}
 		// Check if a resize needs to happen!
 		if req.Height == 0 || req.Width == 0 {
 			continue
 		}
+		rec.Resize(req.Width, req.Height)
 		err = ptty.Resize(req.Height, req.Width)
 		if err != nil {
 			// We can continue after this, it's not fatal!
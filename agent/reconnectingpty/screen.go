@@ -20,6 +20,7 @@ import (
 	"golang.org/x/xerrors"
 
 	"cdr.dev/slog"
+	"github.com/coder/coder/v2/agent/agentrecord"
 	"github.com/coder/coder/v2/pty"
 )
 
@@ -44,7 +45,11 @@ type screenReconnectingPTY struct {
 
 	configFile string
 
-	metrics *prometheus.CounterVec
+	metrics     *prometheus.CounterVec
+	reportInput func()
+	// recorder records each attached screen client separately.
+	recorder         *agentrecord.Recorder
+	recordingCommand string
 
 	state *ptyState
 	// timer will close the reconnecting pty when it expires.  The timer will be
@@ -61,10 +66,13 @@ type screenReconnectingPTY struct {
 // own which causes it to spawn with the specified size.
 func newScreen(ctx context.Context, cmd *pty.Cmd, options *Options, logger slog.Logger) *screenReconnectingPTY {
 	rpty := &screenReconnectingPTY{
-		command: cmd,
-		metrics: options.Metrics,
-		state:   newState(),
-		timeout: options.Timeout,
+		command:          cmd,
+		metrics:          options.Metrics,
+		reportInput:      options.ReportInput,
+		recorder:         options.Recorder,
+		recordingCommand: options.Command,
+		state:            newState(),
+		timeout:          options.Timeout,
 	}
 
 	go rpty.lifecycle(ctx, logger)
@@ -169,7 +177,12 @@ func (rpty *screenReconnectingPTY) Attach(ctx context.Context, _ string, conn ne
 
 	go heartbeat(ctx, rpty.timer, rpty.timeout)
 
-	ptty, process, err := rpty.doAttach(ctx, conn, height, width, logger)
+	// Screen replays the current screen contents to each new client, so each
+	// attach is recorded on its own.
+	rec := rpty.recorder.Start(agentrecord.TypeReconnectingPTY, rpty.recordingCommand, width, height, "xterm-256color")
+	defer rec.Close()
+
+	ptty, process, err := rpty.doAttach(ctx, conn, rec, height, width, logger)
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			// Likely the process was too short-lived and canceled the version command.
@@ -195,14 +208,14 @@ func (rpty *screenReconnectingPTY) Attach(ctx context.Context, _ string, conn ne
 	}()
 
 	// Pipe conn -> pty and block.
-	readConnLoop(ctx, conn, ptty, rpty.metrics, logger)
+	readConnLoop(ctx, conn, ptty, rec, rpty.metrics, rpty.reportInput, logger)
 	return nil
 }
 
 // doAttach spawns the screen client and starts the heartbeat.  It exists
 // separately only so we can defer the mutex unlock which is not possible in
 // Attach since it blocks.
-func (rpty *screenReconnectingPTY) doAttach(ctx context.Context, conn net.Conn, height, width uint16, logger slog.Logger) (pty.PTYCmd, pty.Process, error) {
+func (rpty *screenReconnectingPTY) doAttach(ctx context.Context, conn net.Conn, rec *agentrecord.Recording, height, width uint16, logger slog.Logger) (pty.PTYCmd, pty.Process, error) {
 	// Ensure another attach does not come in and spawn a duplicate session.
 	rpty.mutex.Lock()
 	defer rpty.mutex.Unlock()
@@ -281,6 +294,7 @@ func (rpty *screenReconnectingPTY) doAttach(ctx context.Context, conn net.Conn,
 				break
 			}
 			part := buffer[:read]
+			_, _ = rec.Output().Write(part)
 			_, err = conn.Write(part)
 			if err != nil {
 				// Connection might have been closed.
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/serpent"
+
+	"github.com/coder/coder/v2/codersdk"
+)
+
+func (r *RootCmd) audit() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "audit",
+		Short: "Manage audit logs",
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.auditConnections(),
+			r.auditExport(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) auditExport() *serpent.Command {
+	const dateLayout = "2006-01-02"
+	var (
+		searchQuery string
+		from        string
+		to          string
+		format      string
+		outputPath  string
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "export",
+		Short: "Export audit logs as JSON Lines or CSV",
+		Long: "Export every audit log matching a search query, newest first. Unlike the audit page, the export is not paginated.\n" + FormatExamples(
+			Example{
+				Description: "Export audit logs for the first quarter of 2024 as CSV",
+				Command:     "coder audit export --format csv --from 2024-01-01 --to 2024-03-31",
+			},
+			Example{
+				Description: "Export deleted workspaces as JSON Lines",
+				Command:     `coder audit export --search "resource_type:workspace action:delete"`,
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			terms := []string{}
+			if searchQuery != "" {
+				terms = append(terms, searchQuery)
+			}
+			for _, date := range []struct {
+				flag  string
+				term  string
+				value string
+			}{
+				{flag: "from", term: "date_from", value: from},
+				{flag: "to", term: "date_to", value: to},
+			} {
+				if date.value == "" {
+					continue
+				}
+				if _, err := time.Parse(dateLayout, date.value); err != nil {
+					return xerrors.Errorf("invalid --%s date %q, expected format YYYY-MM-DD", date.flag, date.value)
+				}
+				terms = append(terms, date.term+":"+date.value)
+			}
+
+			body, err := client.ExportAuditLogs(inv.Context(), codersdk.AuditLogExportRequest{
+				SearchQuery: strings.Join(terms, " "),
+				Format:      codersdk.AuditLogExportFormat(format),
+			})
+			if err != nil {
+				return xerrors.Errorf("export audit logs: %w", err)
+			}
+			defer body.Close()
+
+			if outputPath == "" || outputPath == "-" {
+				_, err = io.Copy(inv.Stdout, body)
+				if err != nil {
+					return xerrors.Errorf("write audit logs: %w", err)
+				}
+				return nil
+			}
+
+			f, err := os.Create(outputPath)
+			if err != nil {
+				return xerrors.Errorf("create output file: %w", err)
+			}
+			defer f.Close()
+			n, err := io.Copy(f, body)
+			if err != nil {
+				_ = os.Remove(outputPath) // best effort
+				return xerrors.Errorf("write audit logs to %s: %w", outputPath, err)
+			}
+			if err := f.Close(); err != nil {
+				return xerrors.Errorf("close output file: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stderr, "Wrote %d bytes of audit logs to %s\n", n, outputPath)
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "search",
+			Description: "Only export audit logs matching a search query, e.g. \"resource_type:workspace action:delete\".",
+			Value:       serpent.StringOf(&searchQuery),
+		},
+		{
+			Flag:        "from",
+			Description: "Only export audit logs from this date (YYYY-MM-DD, inclusive).",
+			Value:       serpent.StringOf(&from),
+		},
+		{
+			Flag:        "to",
+			Description: "Only export audit logs up to this date (YYYY-MM-DD, inclusive).",
+			Value:       serpent.StringOf(&to),
+		},
+		{
+			Flag:        "format",
+			Description: "Output format.",
+			Default:     string(codersdk.AuditLogExportFormatJSONL),
+			Value:       serpent.EnumOf(&format, string(codersdk.AuditLogExportFormatJSONL), string(codersdk.AuditLogExportFormatCSV)),
+		},
+		{
+			Flag:          "output-file",
+			FlagShorthand: "O",
+			Description:   "File path to write the audit logs to. Defaults to stdout.",
+			Value:         serpent.StringOf(&outputPath),
+		},
+	}
+	return cmd
+}
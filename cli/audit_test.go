@@ -0,0 +1,78 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestAuditExport(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	client := coderdtest.New(t, nil)
+	user := coderdtest.CreateFirstUser(t, client)
+	require.NoError(t, client.CreateTestAuditLog(ctx, codersdk.CreateTestAuditLogRequest{
+		ResourceID: user.UserID,
+		Time:       time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}))
+	require.NoError(t, client.CreateTestAuditLog(ctx, codersdk.CreateTestAuditLogRequest{
+		ResourceID: user.UserID,
+	}))
+
+	t.Run("Stdout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "audit", "export")
+		clitest.SetupConfig(t, client, root)
+		var out bytes.Buffer
+		inv.Stdout = &out
+		require.NoError(t, inv.WithContext(ctx).Run())
+		require.Len(t, bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n")), 2)
+	})
+
+	t.Run("OutputFile", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		path := filepath.Join(t.TempDir(), "audit.csv")
+		inv, root := clitest.New(t, "audit", "export",
+			"--from", "2024-01-01",
+			"--to", "2024-03-31",
+			"--format", "csv",
+			"--output-file", path,
+		)
+		clitest.SetupConfig(t, client, root)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		records, err := csv.NewReader(f).ReadAll()
+		require.NoError(t, err)
+		// The header and the audit log from February.
+		require.Len(t, records, 2)
+		require.Equal(t, user.UserID.String(), records[1][11])
+	})
+
+	t.Run("InvalidDate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "audit", "export", "--from", "01/01/2024")
+		clitest.SetupConfig(t, client, root)
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "invalid --from date")
+	})
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/serpent"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+type connectionLogTableRow struct {
+	ConnectedAt time.Time `table:"connected at,default_sort"`
+	Workspace   string    `table:"workspace"`
+	Agent       string    `table:"agent"`
+	Type        string    `table:"type"`
+	User        string    `table:"user"`
+	IP          string    `table:"ip"`
+	Duration    string    `table:"duration"`
+	Code        string    `table:"code"`
+	Reason      string    `table:"reason"`
+}
+
+func (r *RootCmd) auditConnections() *serpent.Command {
+	var (
+		searchQuery string
+		limit       int64
+	)
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(
+			cliui.TableFormat([]connectionLogTableRow{}, []string{"connected at", "workspace", "type", "user", "ip", "duration", "code"}),
+			func(data any) (any, error) {
+				logs, ok := data.([]codersdk.ConnectionLog)
+				if !ok {
+					return nil, xerrors.Errorf("expected []codersdk.ConnectionLog got %T", data)
+				}
+
+				rows := make([]connectionLogTableRow, 0, len(logs))
+				for _, log := range logs {
+					row := connectionLogTableRow{
+						ConnectedAt: log.ConnectTime,
+						Workspace:   log.WorkspaceOwnerUsername + "/" + log.WorkspaceName,
+						Agent:       log.AgentName,
+						Type:        string(log.Type),
+						User:        "unknown",
+						Duration:    "ongoing",
+						Reason:      log.DisconnectReason,
+					}
+					if log.IP.IsValid() {
+						row.IP = log.IP.String()
+					}
+					if log.User != nil {
+						row.User = log.User.Username
+					}
+					if log.DisconnectTime != nil {
+						row.Duration = log.DisconnectTime.Sub(log.ConnectTime).Round(time.Second).String()
+					}
+					if log.Code != nil {
+						row.Code = fmt.Sprint(*log.Code)
+					}
+					rows = append(rows, row)
+				}
+				return rows, nil
+			},
+		),
+		cliui.JSONFormat(),
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "connections",
+		Short: "List connections to workspaces",
+		Long: "Connections are reported by workspace agents when a session opens and closes. Web terminal, SSH, IDE and port forwarding connections are included.\n" + FormatExamples(
+			Example{
+				Description: "List the SSH connections to a workspace",
+				Command:     `coder audit connections --search "alice/dev type:ssh"`,
+			},
+			Example{
+				Description: "List connections that are still open",
+				Command:     `coder audit connections --search "status:ongoing"`,
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			res, err := client.ConnectionLogs(inv.Context(), codersdk.ConnectionLogsRequest{
+				SearchQuery: searchQuery,
+				Pagination: codersdk.Pagination{
+					Limit: int(limit),
+				},
+			})
+			if err != nil {
+				return xerrors.Errorf("get connection logs: %w", err)
+			}
+			if len(res.ConnectionLogs) == 0 {
+				cliui.Info(inv.Stderr, "No connections found.")
+				return nil
+			}
+
+			out, err := formatter.Format(inv.Context(), res.ConnectionLogs)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "search",
+			Description: "Only list connections matching a search query, e.g. \"alice/dev type:ssh status:completed\".",
+			Value:       serpent.StringOf(&searchQuery),
+		},
+		{
+			Flag:        "limit",
+			Description: "Maximum number of connections to list.",
+			Default:     "100",
+			Value:       serpent.Int64Of(&limit),
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
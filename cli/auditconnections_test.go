@@ -0,0 +1,72 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestAuditConnections(t *testing.T) {
+	t.Parallel()
+
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+	ws := dbfake.WorkspaceBuild(t, db, database.Workspace{
+		OrganizationID: owner.OrganizationID,
+		OwnerID:        owner.UserID,
+		Name:           "dev",
+	}).Do().Workspace
+	for _, connectionType := range []database.ConnectionType{database.ConnectionTypeSsh, database.ConnectionTypeVscode} {
+		_ = dbgen.ConnectionLog(t, db, database.ConnectionLog{
+			OrganizationID:   owner.OrganizationID,
+			WorkspaceOwnerID: owner.UserID,
+			WorkspaceID:      ws.ID,
+			WorkspaceName:    ws.Name,
+			Type:             connectionType,
+			UserID:           uuid.NullUUID{UUID: owner.UserID, Valid: true},
+		})
+	}
+
+	t.Run("Table", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "audit", "connections")
+		clitest.SetupConfig(t, client, root)
+		var out bytes.Buffer
+		inv.Stdout = &out
+		require.NoError(t, inv.WithContext(ctx).Run())
+		require.Contains(t, out.String(), "/dev")
+		require.Contains(t, out.String(), "vscode")
+		require.Contains(t, out.String(), "ongoing")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "audit", "connections", "--search", "type:ssh", "--output", "json")
+		clitest.SetupConfig(t, client, root)
+		var out bytes.Buffer
+		inv.Stdout = &out
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		var logs []codersdk.ConnectionLog
+		require.NoError(t, json.Unmarshal(out.Bytes(), &logs))
+		require.Len(t, logs, 1)
+		require.Equal(t, codersdk.ConnectionTypeSSH, logs[0].Type)
+		require.NotNil(t, logs[0].User)
+		require.Equal(t, owner.UserID, logs[0].User.ID)
+	})
+}
@@ -59,6 +59,14 @@ const (
 	ProvisioningStateRunning = "Running"
 )
 
+// formatQueueWait formats the estimated wait of a queued job, e.g. "~5m".
+func formatQueueWait(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+	return "~" + strings.TrimSuffix(d.Round(time.Minute).String(), "0s")
+}
+
 // ProvisionerJob renders a provisioner job with interactive cancellation.
 func ProvisionerJob(ctx context.Context, wr io.Writer, opts ProvisionerJobOptions) error {
 	if opts.FetchInterval == 0 {
@@ -89,6 +97,9 @@ func ProvisionerJob(ctx context.Context, wr io.Writer, opts ProvisionerJobOption
 			} else {
 				queuePos = fmt.Sprintf("position: %d", currentQueuePos)
 			}
+			if job.EstimatedStartAt != nil {
+				queuePos += ", starts in " + formatQueueWait(time.Until(*job.EstimatedStartAt))
+			}
 
 			out = pretty.Sprintf(DefaultStyles.Warn, "%s (%s)", currentStage, queuePos)
 		}
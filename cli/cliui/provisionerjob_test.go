@@ -104,6 +104,7 @@ func TestProvisionerJob(t *testing.T) {
 		tests := []struct {
 			name     string
 			queuePos int
+			estimate time.Duration
 			expected string
 		}{
 			{
@@ -121,6 +122,12 @@ func TestProvisionerJob(t *testing.T) {
 				queuePos: 4,
 				expected: fmt.Sprintf(`%s %s$`, stage, regexp.QuoteMeta("(position: 4)")),
 			},
+			{
+				name:     "estimate",
+				queuePos: 4,
+				estimate: 2*time.Minute + 10*time.Second,
+				expected: fmt.Sprintf(`%s %s$`, stage, regexp.QuoteMeta("(position: 4, starts in ~2m)")),
+			},
 		}
 
 		for _, tc := range tests {
@@ -133,6 +140,10 @@ func TestProvisionerJob(t *testing.T) {
 				test.JobMutex.Lock()
 				test.Job.QueuePosition = tc.queuePos
 				test.Job.QueueSize = tc.queuePos
+				if tc.estimate > 0 {
+					estimate := time.Now().Add(tc.estimate)
+					test.Job.EstimatedStartAt = &estimate
+				}
 				test.JobMutex.Unlock()
 
 				ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitShort)
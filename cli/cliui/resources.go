@@ -119,6 +119,59 @@ func WorkspaceResources(writer io.Writer, resources []codersdk.WorkspaceResource
 	return err
 }
 
+// WorkspaceResourceChanges displays the resource changes of a workspace plan,
+// using the same markers as `terraform plan`.
+// ┌────────────────────────────────────────────┐
+// │ ACTION       RESOURCE                      │
+// ├────────────────────────────────────────────┤
+// │ + create     docker_volume.home            │
+// │ ~ update     coder_agent.main              │
+// │ -/+ replace  docker_container.workspace[0] │
+// └────────────────────────────────────────────┘
+// Plan: 1 to create, 1 to update, 1 to replace, 0 to destroy.
+func WorkspaceResourceChanges(writer io.Writer, changes []codersdk.WorkspaceResourceChange) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(writer, "No changes. The workspace resources will not be modified.")
+		return err
+	}
+
+	counts := map[codersdk.WorkspaceResourceChangeAction]int{}
+	tableWriter := table.NewWriter()
+	tableWriter.SetStyle(table.StyleLight)
+	tableWriter.Style().Options.SeparateColumns = false
+	tableWriter.AppendHeader(table.Row{"Action", "Resource"})
+	for _, change := range changes {
+		counts[change.Action]++
+		tableWriter.AppendRow(table.Row{
+			renderResourceChangeAction(change.Action),
+			Bold(change.Address),
+		})
+	}
+	_, err := fmt.Fprintf(writer, "%s\nPlan: %d to create, %d to update, %d to replace, %d to destroy.\n",
+		tableWriter.Render(),
+		counts[codersdk.WorkspaceResourceChangeActionCreate],
+		counts[codersdk.WorkspaceResourceChangeActionUpdate],
+		counts[codersdk.WorkspaceResourceChangeActionReplace],
+		counts[codersdk.WorkspaceResourceChangeActionDestroy],
+	)
+	return err
+}
+
+func renderResourceChangeAction(action codersdk.WorkspaceResourceChangeAction) string {
+	switch action {
+	case codersdk.WorkspaceResourceChangeActionCreate:
+		return pretty.Sprint(DefaultStyles.Keyword, "+ create")
+	case codersdk.WorkspaceResourceChangeActionUpdate:
+		return pretty.Sprint(DefaultStyles.Warn, "~ update")
+	case codersdk.WorkspaceResourceChangeActionReplace:
+		return pretty.Sprint(DefaultStyles.Error, "-/+ replace")
+	case codersdk.WorkspaceResourceChangeActionDestroy:
+		return pretty.Sprint(DefaultStyles.Error, "- destroy")
+	default:
+		return pretty.Sprint(DefaultStyles.Placeholder, string(action))
+	}
+}
+
 func renderAgentStatus(agent codersdk.WorkspaceAgent) string {
 	switch agent.Status {
 	case codersdk.WorkspaceAgentConnecting:
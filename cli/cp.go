@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) cp() *serpent.Command {
+	var resume bool
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "cp <source> <destination>",
+		Short: "Copy a file to or from a workspace",
+		Long: "Remote paths are written as <workspace>:<path>, and relative remote paths are resolved against the home directory " +
+			"of the workspace user. Use - as the local path to read from stdin or write to stdout.\n" + FormatExamples(
+			Example{
+				Description: "Upload a file to the home directory of a workspace",
+				Command:     "coder cp ./data.csv my-workspace:",
+			},
+			Example{
+				Description: "Download a file from a specific agent of a workspace",
+				Command:     "coder cp my-workspace.main:/var/log/app.log .",
+			},
+			Example{
+				Description: "Resume an interrupted upload",
+				Command:     "coder cp --resume ./backup.tar my-workspace:backups/",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			srcWorkspace, srcPath, srcRemote := parseRemotePath(inv.Args[0])
+			dstWorkspace, dstPath, dstRemote := parseRemotePath(inv.Args[1])
+			if srcRemote == dstRemote {
+				return xerrors.New("exactly one of source and destination must be a workspace path, e.g. my-workspace:/path/to/file")
+			}
+
+			if dstRemote {
+				_, workspaceAgent, err := getWorkspaceAndAgent(ctx, inv, client, false, dstWorkspace)
+				if err != nil {
+					return err
+				}
+				return uploadFile(inv, client, workspaceAgent, srcPath, dstWorkspace, dstPath, resume)
+			}
+			_, workspaceAgent, err := getWorkspaceAndAgent(ctx, inv, client, false, srcWorkspace)
+			if err != nil {
+				return err
+			}
+			return downloadFile(inv, client, workspaceAgent, srcWorkspace, srcPath, dstPath, resume)
+		},
+		Options: serpent.OptionSet{
+			{
+				Flag:        "resume",
+				Description: "Resume an interrupted copy by only transferring the part of the source that is missing from the destination.",
+				Value:       serpent.BoolOf(&resume),
+			},
+		},
+	}
+	return cmd
+}
+
+func uploadFile(inv *serpent.Invocation, client *codersdk.Client, workspaceAgent codersdk.WorkspaceAgent, src, workspace, dst string, resume bool) error {
+	ctx := inv.Context()
+
+	var (
+		content io.Reader = inv.Stdin
+		name    string
+		size    int64
+	)
+	if src == "-" {
+		if resume {
+			return xerrors.New("--resume cannot be used when reading from stdin")
+		}
+	} else {
+		f, err := os.Open(src)
+		if err != nil {
+			return xerrors.Errorf("open source: %w", err)
+		}
+		defer f.Close()
+		fi, err := f.Stat()
+		if err != nil {
+			return xerrors.Errorf("stat source: %w", err)
+		}
+		if fi.IsDir() {
+			return xerrors.Errorf("%q is a directory, only files can be copied", src)
+		}
+		content = f
+		name = fi.Name()
+		size = fi.Size()
+	}
+
+	// Copying into a directory keeps the name of the source file.
+	if name != "" {
+		isDir := dst == "" || dst == "~" || strings.HasSuffix(dst, "/")
+		if !isDir {
+			_, err := client.WorkspaceAgentListDirectory(ctx, workspaceAgent.ID, dst)
+			isDir = err == nil
+		}
+		if isDir {
+			dst = joinRemotePath(dst, name)
+		}
+	} else if dst == "" || strings.HasSuffix(dst, "/") {
+		return xerrors.New("a destination file name is required when reading from stdin")
+	}
+
+	var offset int64
+	if resume {
+		var err error
+		offset, err = remoteFileSize(inv, client, workspaceAgent, dst)
+		if err != nil {
+			return err
+		}
+		if offset > size {
+			offset = 0
+		}
+		//nolint:forcetypeassert // Resuming is only possible for files.
+		if _, err := content.(io.Seeker).Seek(offset, io.SeekStart); err != nil {
+			return xerrors.Errorf("seek source: %w", err)
+		}
+	}
+
+	start := time.Now()
+	info, err := client.WorkspaceAgentWriteFile(ctx, workspaceAgent.ID, dst, offset, content)
+	if err != nil {
+		return xerrors.Errorf("write file: %w", err)
+	}
+	cliui.Infof(inv.Stderr, "Copied %s to %s:%s in %s.", humanize.IBytes(uint64(info.Size-offset)), workspace, info.Path, time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// remoteFileSize returns the size of a remote file so that an upload can be
+// resumed. Files that do not exist yet are uploaded from the start.
+func remoteFileSize(inv *serpent.Invocation, client *codersdk.Client, workspaceAgent codersdk.WorkspaceAgent, dst string) (int64, error) {
+	dir, err := client.WorkspaceAgentListDirectory(inv.Context(), workspaceAgent.ID, path.Dir(dst))
+	if err != nil {
+		return 0, xerrors.Errorf("list destination directory: %w", err)
+	}
+	for _, file := range dir.Files {
+		if file.Name == path.Base(dst) && !file.IsDir {
+			return file.Size, nil
+		}
+	}
+	return 0, nil
+}
+
+func downloadFile(inv *serpent.Invocation, client *codersdk.Client, workspaceAgent codersdk.WorkspaceAgent, workspace, src, dst string, resume bool) error {
+	ctx := inv.Context()
+	if src == "" || strings.HasSuffix(src, "/") {
+		return xerrors.New("a source file name is required")
+	}
+
+	var (
+		out    io.Writer = inv.Stdout
+		offset int64
+	)
+	if dst == "-" {
+		if resume {
+			return xerrors.New("--resume cannot be used when writing to stdout")
+		}
+	} else {
+		if fi, err := os.Stat(dst); err == nil && fi.IsDir() {
+			dst = filepath.Join(dst, path.Base(strings.ReplaceAll(src, `\`, "/")))
+		}
+		flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+		if resume {
+			flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+		}
+		f, err := os.OpenFile(dst, flags, 0o644)
+		if err != nil {
+			return xerrors.Errorf("open destination: %w", err)
+		}
+		defer f.Close()
+		if resume {
+			fi, err := f.Stat()
+			if err != nil {
+				return xerrors.Errorf("stat destination: %w", err)
+			}
+			offset = fi.Size()
+		}
+		out = f
+	}
+
+	start := time.Now()
+	body, err := client.WorkspaceAgentReadFile(ctx, workspaceAgent.ID, src, offset)
+	if err != nil {
+		var sdkErr *codersdk.Error
+		if offset > 0 && errors.As(err, &sdkErr) && sdkErr.StatusCode() == http.StatusRequestedRangeNotSatisfiable {
+			cliui.Infof(inv.Stderr, "Nothing to resume, %s is not smaller than %s:%s.", dst, workspace, src)
+			return nil
+		}
+		return xerrors.Errorf("read file: %w", err)
+	}
+	defer body.Close()
+	n, err := io.Copy(out, body)
+	if err != nil {
+		return xerrors.Errorf("copy file: %w", err)
+	}
+	if dst != "-" {
+		cliui.Infof(inv.Stderr, "Copied %s from %s:%s in %s.", humanize.IBytes(uint64(n)), workspace, src, time.Since(start).Round(time.Millisecond))
+	}
+	return nil
+}
+
+// parseRemotePath splits a <workspace>:<path> argument. Arguments without a
+// workspace are local paths, including Windows paths with a drive letter.
+func parseRemotePath(arg string) (workspace string, p string, remote bool) {
+	idx := strings.Index(arg, ":")
+	if idx <= 0 || strings.ContainsAny(arg[:idx], `/\`) {
+		return "", arg, false
+	}
+	if runtime.GOOS == "windows" && idx == 1 {
+		return "", arg, false
+	}
+	return arg[:idx], arg[idx+1:], true
+}
+
+func joinRemotePath(dir, name string) string {
+	if dir == "" {
+		return name
+	}
+	return strings.TrimSuffix(dir, "/") + "/" + name
+}
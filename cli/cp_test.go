@@ -0,0 +1,122 @@
+package cli_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestCp(t *testing.T) {
+	t.Parallel()
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t)
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+	t.Run("Upload", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		src := filepath.Join(t.TempDir(), "upload.txt")
+		require.NoError(t, os.WriteFile(src, []byte("hello world"), 0o600))
+		// Copying into a directory keeps the source file name.
+		dstDir := t.TempDir()
+
+		inv, root := clitest.New(t, "cp", src, workspace.Name+":"+dstDir)
+		clitest.SetupConfig(t, client, root)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		got, err := os.ReadFile(filepath.Join(dstDir, "upload.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(got))
+	})
+
+	t.Run("UploadStdin", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		dst := filepath.Join(t.TempDir(), "stdin.txt")
+
+		inv, root := clitest.New(t, "cp", "-", workspace.Name+":"+dst)
+		clitest.SetupConfig(t, client, root)
+		inv.Stdin = strings.NewReader("from stdin")
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		got, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, "from stdin", string(got))
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		src := filepath.Join(t.TempDir(), "download.txt")
+		require.NoError(t, os.WriteFile(src, []byte("hello world"), 0o600))
+		dst := filepath.Join(t.TempDir(), "local.txt")
+
+		inv, root := clitest.New(t, "cp", workspace.Name+":"+src, dst)
+		clitest.SetupConfig(t, client, root)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		got, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(got))
+	})
+
+	t.Run("DownloadStdout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		src := filepath.Join(t.TempDir(), "download.txt")
+		require.NoError(t, os.WriteFile(src, []byte("hello world"), 0o600))
+
+		inv, root := clitest.New(t, "cp", workspace.Name+":"+src, "-")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+		require.Equal(t, "hello world", stdout.String())
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		src := filepath.Join(t.TempDir(), "big.bin")
+		require.NoError(t, os.WriteFile(src, []byte("0123456789"), 0o600))
+		dst := filepath.Join(t.TempDir(), "big.bin")
+		require.NoError(t, os.WriteFile(dst, []byte("01234"), 0o600))
+
+		inv, root := clitest.New(t, "cp", "--resume", workspace.Name+":"+src, dst)
+		clitest.SetupConfig(t, client, root)
+		var stderr bytes.Buffer
+		inv.Stderr = &stderr
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		got, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, "0123456789", string(got))
+		// Only the missing part of the file is transferred.
+		require.Contains(t, stderr.String(), "Copied 5 B")
+	})
+
+	t.Run("NoWorkspace", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "cp", "a.txt", "./b.txt")
+		clitest.SetupConfig(t, client, root)
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "exactly one of source and destination must be a workspace path")
+	})
+}
@@ -351,6 +351,9 @@ type prepWorkspaceBuildArgs struct {
 	Action            WorkspaceCLIAction
 	TemplateVersionID uuid.UUID
 	NewWorkspaceName  string
+	// PlanWorkspaceID makes the dry-run plan against the state of the
+	// workspace, and shows the resource changes instead of a preview.
+	PlanWorkspaceID uuid.UUID
 
 	LastBuildParameters       []codersdk.WorkspaceBuildParameter
 	SourceWorkspaceParameters []codersdk.WorkspaceBuildParameter
@@ -413,6 +416,7 @@ func prepWorkspaceBuild(inv *serpent.Invocation, client *codersdk.Client, args p
 	// Run a dry-run with the given parameters to check correctness
 	dryRun, err := client.CreateTemplateVersionDryRun(inv.Context(), templateVersion.ID, codersdk.CreateTemplateVersionDryRunRequest{
 		WorkspaceName:       args.NewWorkspaceName,
+		WorkspaceID:         args.PlanWorkspaceID,
 		RichParameterValues: buildParameters,
 	})
 	if err != nil {
@@ -438,6 +442,29 @@ func prepWorkspaceBuild(inv *serpent.Invocation, client *codersdk.Client, args p
 		return nil, xerrors.Errorf("dry-run workspace: %w", err)
 	}
 
+	if args.PlanWorkspaceID != uuid.Nil {
+		changes, err := client.TemplateVersionDryRunResourceChanges(inv.Context(), templateVersion.ID, dryRun.ID)
+		if err != nil {
+			return nil, xerrors.Errorf("get workspace dry-run resource changes: %w", err)
+		}
+		err = cliui.WorkspaceResourceChanges(inv.Stdout, changes)
+		if err != nil {
+			return nil, xerrors.Errorf("render resource changes: %w", err)
+		}
+		if slices.ContainsFunc(changes, func(change codersdk.WorkspaceResourceChange) bool {
+			return change.Action.Destructive()
+		}) {
+			_, err = cliui.Prompt(inv, cliui.PromptOptions{
+				Text:      "Resources will be replaced or destroyed, and any data they hold lost. Continue?",
+				IsConfirm: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return buildParameters, nil
+	}
+
 	resources, err := client.TemplateVersionDryRunResources(inv.Context(), templateVersion.ID, dryRun.ID)
 	if err != nil {
 		return nil, xerrors.Errorf("get workspace dry-run resources: %w", err)
@@ -0,0 +1,437 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/serpent"
+)
+
+// ExecResult is the result of running a command in a workspace with
+// `coder exec`.
+type ExecResult struct {
+	Workspace string `json:"workspace"`
+	Agent     string `json:"agent"`
+	// ExitCode is the exit code of the command. It is -1 if the command could
+	// not be run, and 124 if it timed out.
+	ExitCode   int    `json:"exit_code"`
+	Stdout     string `json:"stdout"`
+	Stderr     string `json:"stderr"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+const (
+	execExitCodeNotRun  = -1
+	execExitCodeTimeout = 124
+)
+
+type execTarget struct {
+	name  string
+	agent codersdk.WorkspaceAgent
+	err   error
+}
+
+type execOptions struct {
+	args    []string
+	env     [][2]string
+	workdir string
+	timeout time.Duration
+}
+
+func (r *RootCmd) exec() *serpent.Command {
+	var (
+		search    string
+		agentName string
+		env       []string
+		workdir   string
+		timeout   time.Duration
+		parallel  int64
+		formatter = cliui.NewOutputFormatter(
+			cliui.TextFormat(),
+			cliui.JSONFormat(),
+		)
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Annotations: workspaceCommand,
+		Use:         "exec [<workspace>] -- <command> [args...]",
+		Short:       "Run a non-interactive command in one or more workspaces",
+		Long: "The remote exit code is returned when running in a single workspace. When running in multiple workspaces, " +
+			"the output of each workspace is prefixed with its name and the exit code is 1 if the command failed in any of them. " +
+			"A single argument is run by the shell as is, so it can use pipes and other shell features. " +
+			"Multiple arguments are quoted, so that each reaches the command as given.\n" + FormatExamples(
+			Example{
+				Description: "Run a command in a workspace",
+				Command:     "coder exec my-workspace -- git status",
+			},
+			Example{
+				Description: "Run a command in all of your running workspaces of a template",
+				Command:     `coder exec --search "owner:me template:docker status:running" -- df -h`,
+			},
+			Example{
+				Description: "Collect the results of a command as JSON",
+				Command:     `coder exec --search "owner:me status:running" --output json -- cat /etc/os-release`,
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(1, -1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx, stop := inv.SignalNotifyContext(inv.Context(), StopSignals...)
+			defer stop()
+
+			opts := execOptions{timeout: timeout}
+			for _, e := range env {
+				k, v, ok := strings.Cut(e, "=")
+				if !ok {
+					return xerrors.Errorf("invalid environment variable setting %q", e)
+				}
+				opts.env = append(opts.env, [2]string{k, v})
+			}
+			if parallel < 1 {
+				return xerrors.New("--parallel must be at least 1")
+			}
+
+			var (
+				targets []execTarget
+				args    = inv.Args
+			)
+			if search == "" {
+				if len(args) < 2 {
+					return xerrors.New("a workspace and a command are required, e.g. coder exec my-workspace -- ls")
+				}
+				workspace, workspaceAgent, err := getWorkspaceAndAgent(ctx, inv, client, false, args[0])
+				if err != nil {
+					return err
+				}
+				targets = append(targets, execTarget{name: workspace.Name, agent: workspaceAgent})
+				args = args[1:]
+			} else {
+				res, err := client.Workspaces(ctx, codersdk.WorkspaceFilter{FilterQuery: search})
+				if err != nil {
+					return xerrors.Errorf("search workspaces: %w", err)
+				}
+				if len(res.Workspaces) == 0 {
+					return xerrors.Errorf("no workspaces match %q", search)
+				}
+				for _, workspace := range res.Workspaces {
+					target := execTarget{name: workspace.OwnerName + "/" + workspace.Name}
+					switch {
+					case workspace.LatestBuild.Status != codersdk.WorkspaceStatusRunning:
+						target.err = xerrors.Errorf("workspace is %s, it must be running", workspace.LatestBuild.Status)
+					case agentName == "" && countAgents(workspace) > 1:
+						target.err = xerrors.New("workspace has multiple agents, use --agent to select one")
+					default:
+						target.agent, target.err = getWorkspaceAgent(workspace, agentName)
+					}
+					targets = append(targets, target)
+				}
+			}
+			opts.args = args
+			opts.workdir = workdir
+
+			jsonOutput := formatter.FormatID() == cliui.JSONFormat().ID()
+			results := make([]ExecResult, len(targets))
+			var (
+				mu sync.Mutex
+				eg errgroup.Group
+			)
+			eg.SetLimit(int(parallel))
+			for i, target := range targets {
+				i, target := i, target
+				eg.Go(func() error {
+					switch {
+					case jsonOutput:
+						var stdout, stderr bytes.Buffer
+						results[i] = r.execInWorkspace(ctx, inv, client, target, opts, &stdout, &stderr)
+						results[i].Stdout = stdout.String()
+						results[i].Stderr = stderr.String()
+					case len(targets) > 1:
+						prefix := target.name + ": "
+						stdout := &linePrefixWriter{mu: &mu, w: inv.Stdout, prefix: prefix}
+						stderr := &linePrefixWriter{mu: &mu, w: inv.Stderr, prefix: prefix}
+						results[i] = r.execInWorkspace(ctx, inv, client, target, opts, stdout, stderr)
+						stdout.Flush()
+						if results[i].Error != "" {
+							_, _ = fmt.Fprintf(stderr, "error: %s\n", results[i].Error)
+						}
+						stderr.Flush()
+					default:
+						results[i] = r.execInWorkspace(ctx, inv, client, target, opts, inv.Stdout, inv.Stderr)
+					}
+					return nil
+				})
+			}
+			_ = eg.Wait()
+
+			if jsonOutput {
+				out, err := formatter.Format(ctx, results)
+				if err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintln(inv.Stdout, out)
+			}
+
+			if len(results) == 1 {
+				result := results[0]
+				if result.Error != "" && !jsonOutput {
+					return ExitError(execExitCode(result), xerrors.New(result.Error))
+				}
+				if code := execExitCode(result); code != 0 {
+					return ExitError(code, nil)
+				}
+				return nil
+			}
+			var failed int
+			for _, result := range results {
+				if execExitCode(result) != 0 {
+					failed++
+				}
+			}
+			if failed > 0 {
+				if !jsonOutput {
+					cliui.Warnf(inv.Stderr, "The command failed in %d of %d workspaces.", failed, len(results))
+				}
+				return ExitError(1, nil)
+			}
+			return nil
+		},
+		Options: serpent.OptionSet{
+			{
+				Flag:        "search",
+				Description: "Run the command in every workspace matching this search query, which uses the same syntax as the workspaces list in the dashboard.",
+				Value:       serpent.StringOf(&search),
+			},
+			{
+				Flag:        "agent",
+				Description: "The agent to run the command in when using --search. Required for workspaces with multiple agents.",
+				Value:       serpent.StringOf(&agentName),
+			},
+			{
+				Flag:          "env",
+				FlagShorthand: "e",
+				Description:   "Set environment variable(s) for the command. Can be specified multiple times.",
+				Value:         serpent.StringArrayOf(&env),
+			},
+			{
+				Flag:          "workdir",
+				FlagShorthand: "w",
+				Description:   "The directory to run the command in. Relative paths are resolved against the agent directory.",
+				Value:         serpent.StringOf(&workdir),
+			},
+			{
+				Flag:        "timeout",
+				Description: "Stop the command if it has not finished within this duration. The exit code of timed out commands is 124.",
+				Value:       serpent.DurationOf(&timeout),
+			},
+			{
+				Flag:        "parallel",
+				Description: "The maximum number of workspaces to run the command in at the same time when using --search.",
+				Default:     "10",
+				Value:       serpent.Int64Of(&parallel),
+			},
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+// execInWorkspace runs a command in a single workspace, streaming its output to
+// stdout and stderr.
+func (r *RootCmd) execInWorkspace(ctx context.Context, inv *serpent.Invocation, client *codersdk.Client, target execTarget, opts execOptions, stdout, stderr io.Writer) ExecResult {
+	start := time.Now()
+	result := ExecResult{
+		Workspace: target.name,
+		Agent:     target.agent.Name,
+		ExitCode:  execExitCodeNotRun,
+	}
+	fail := func(err error) ExecResult {
+		result.Error = err.Error()
+		result.DurationMS = time.Since(start).Milliseconds()
+		return result
+	}
+	if target.err != nil {
+		return fail(target.err)
+	}
+	if target.agent.Status != codersdk.WorkspaceAgentConnected {
+		return fail(xerrors.Errorf("agent %q is %s, it must be connected", target.agent.Name, target.agent.Status))
+	}
+
+	command := execCommand(opts.args, target.agent.OperatingSystem)
+	if opts.workdir != "" {
+		if target.agent.OperatingSystem == "windows" {
+			return fail(xerrors.New("--workdir is not supported for Windows workspaces"))
+		}
+		command = fmt.Sprintf("cd %s && %s", shellquote.Join(opts.workdir), command)
+	}
+
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+
+	conn, err := workspacesdk.New(client).DialAgent(ctx, target.agent.ID, &workspacesdk.DialAgentOptions{
+		Logger:          inv.Logger,
+		BlockEndpoints:  r.disableDirect,
+		EnableTelemetry: !r.disableNetworkTelemetry,
+	})
+	if err != nil {
+		return fail(execContextError(ctx, opts, xerrors.Errorf("dial agent: %w", err), &result))
+	}
+	defer conn.Close()
+	if !conn.AwaitReachable(ctx) {
+		return fail(execContextError(ctx, opts, xerrors.New("agent is unreachable"), &result))
+	}
+	sshClient, err := conn.SSHClient(ctx)
+	if err != nil {
+		return fail(execContextError(ctx, opts, xerrors.Errorf("ssh client: %w", err), &result))
+	}
+	defer sshClient.Close()
+	session, err := sshClient.NewSession()
+	if err != nil {
+		return fail(xerrors.Errorf("ssh session: %w", err))
+	}
+	defer session.Close()
+	for _, kv := range opts.env {
+		if err := session.Setenv(kv[0], kv[1]); err != nil {
+			return fail(xerrors.Errorf("setenv: %w", err))
+		}
+	}
+	session.Stdout = stdout
+	session.Stderr = stderr
+	if err := session.Start(command); err != nil {
+		return fail(xerrors.Errorf("start command: %w", err))
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Wait()
+	}()
+	select {
+	case <-ctx.Done():
+		_ = session.Signal(gossh.SIGKILL)
+		_ = session.Close()
+		return fail(execContextError(ctx, opts, ctx.Err(), &result))
+	case err = <-done:
+	}
+
+	exitCode, err := execWaitExitCode(err)
+	if err != nil {
+		return fail(err)
+	}
+	result.ExitCode = exitCode
+	result.DurationMS = time.Since(start).Milliseconds()
+	return result
+}
+
+// execCommand builds the command to run from the arguments. A single
+// argument is run as is, so that it can use pipes and other shell features.
+// Multiple arguments are quoted, so that each reaches the command as given.
+// Windows shells don't share a quoting syntax, so there the arguments are
+// joined as is.
+func execCommand(args []string, agentOS string) string {
+	if len(args) == 1 || agentOS == "windows" {
+		return strings.Join(args, " ")
+	}
+	return shellquote.Join(args...)
+}
+
+// execWaitExitCode returns the exit code of a command from the error of
+// waiting for it.
+func execWaitExitCode(err error) (int, error) {
+	var (
+		exitErr    *gossh.ExitError
+		missingErr *gossh.ExitMissingError
+	)
+	switch {
+	case err == nil:
+		return 0, nil
+	case errors.As(err, &exitErr):
+		return exitErr.ExitStatus(), nil
+	case errors.As(err, &missingErr):
+		return execExitCodeNotRun, xerrors.New("SSH connection ended unexpectedly")
+	default:
+		return execExitCodeNotRun, xerrors.Errorf("wait for command: %w", err)
+	}
+}
+
+// execContextError replaces err with a friendlier message if the command
+// timed out.
+func execContextError(ctx context.Context, opts execOptions, err error, result *ExecResult) error {
+	if opts.timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		result.ExitCode = execExitCodeTimeout
+		return xerrors.Errorf("command timed out after %s", opts.timeout)
+	}
+	return err
+}
+
+func countAgents(workspace codersdk.Workspace) int {
+	var n int
+	for _, resource := range workspace.LatestBuild.Resources {
+		n += len(resource.Agents)
+	}
+	return n
+}
+
+func execExitCode(result ExecResult) int {
+	if result.ExitCode == execExitCodeNotRun {
+		return 1
+	}
+	return result.ExitCode
+}
+
+// linePrefixWriter prefixes every line written to w, so that the output of
+// commands running in multiple workspaces at once can be told apart. Writes to
+// w are serialized with mu.
+type linePrefixWriter struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	prefix string
+	buf    []byte
+}
+
+func (p *linePrefixWriter) Write(b []byte) (int, error) {
+	p.buf = append(p.buf, b...)
+	for {
+		i := bytes.IndexByte(p.buf, '\n')
+		if i < 0 {
+			break
+		}
+		p.mu.Lock()
+		_, err := fmt.Fprintf(p.w, "%s%s", p.prefix, p.buf[:i+1])
+		p.mu.Unlock()
+		p.buf = p.buf[i+1:]
+		if err != nil {
+			return len(b), err
+		}
+	}
+	return len(b), nil
+}
+
+// Flush writes any remaining output that did not end with a newline.
+func (p *linePrefixWriter) Flush() {
+	if len(p.buf) == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, _ = fmt.Fprintf(p.w, "%s%s\n", p.prefix, p.buf)
+	p.buf = nil
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/xerrors"
+)
+
+func TestExecCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []string
+		agentOS string
+		want    string
+	}{
+		{"single argument", []string{"echo a | wc -c"}, "linux", "echo a | wc -c"},
+		{"multiple arguments", []string{"echo", "a  b"}, "linux", "echo 'a  b'"},
+		{"shell characters", []string{"echo", "$HOME;", "it's"}, "linux", `echo \$HOME\; it\'s`},
+		{"windows", []string{"echo", "a  b"}, "windows", "echo a  b"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, execCommand(tt.args, tt.agentOS))
+		})
+	}
+}
+
+func TestExecWaitExitCode(t *testing.T) {
+	t.Parallel()
+
+	code, err := execWaitExitCode(nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, code)
+
+	// The connection ending before the command reported its exit status
+	// has its own error.
+	code, err = execWaitExitCode(xerrors.Errorf("wait: %w", &gossh.ExitMissingError{}))
+	require.EqualError(t, err, "SSH connection ended unexpectedly")
+	require.Equal(t, execExitCodeNotRun, code)
+
+	code, err = execWaitExitCode(xerrors.New("broken pipe"))
+	require.ErrorContains(t, err, "wait for command: broken pipe")
+	require.Equal(t, execExitCodeNotRun, code)
+}
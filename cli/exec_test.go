@@ -0,0 +1,151 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/cli"
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestExec(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("commands use a POSIX shell")
+	}
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t)
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+	t.Run("Streams", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "exec", workspace.Name, "--", "echo out; echo err >&2; exit 3")
+		clitest.SetupConfig(t, client, root)
+		var stdout, stderr bytes.Buffer
+		inv.Stdout = &stdout
+		inv.Stderr = &stderr
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "exit code 3")
+		require.Equal(t, "out\n", stdout.String())
+		require.Equal(t, "err\n", stderr.String())
+	})
+
+	t.Run("EnvAndWorkdir", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		dir := t.TempDir()
+		inv, root := clitest.New(t, "exec", workspace.Name, "-e", "GREETING=hello", "-w", dir, "--", "echo $GREETING; pwd")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+		require.Equal(t, "hello\n"+dir+"\n", stdout.String())
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "exec", workspace.Name, "--timeout", "1s", "--output", "json", "--", "sleep 60")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "exit code 124")
+
+		var results []cli.ExecResult
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+		require.Len(t, results, 1)
+		require.Equal(t, 124, results[0].ExitCode)
+		require.Contains(t, results[0].Error, "timed out")
+	})
+}
+
+func TestExecSearch(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("commands use a POSIX shell")
+	}
+
+	ownerClient, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	client, member := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+	var names []string
+	for i := 0; i < 2; i++ {
+		r := dbfake.WorkspaceBuild(t, db, database.Workspace{
+			OrganizationID: owner.OrganizationID,
+			OwnerID:        member.ID,
+		}).WithAgent().Do()
+		_ = agenttest.New(t, client.URL, r.AgentToken)
+		coderdtest.AwaitWorkspaceAgents(t, client, r.Workspace.ID)
+		names = append(names, member.Username+"/"+r.Workspace.Name)
+	}
+	// Workspaces of other users do not match.
+	dbfake.WorkspaceBuild(t, db, database.Workspace{
+		OrganizationID: owner.OrganizationID,
+		OwnerID:        owner.UserID,
+	}).WithAgent().Do()
+	sort.Strings(names)
+
+	t.Run("Text", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "exec", "--search", "owner:me", "--", "echo", "hi")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		sort.Strings(lines)
+		require.Equal(t, []string{names[0] + ": hi", names[1] + ": hi"}, lines)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "exec", "--search", "owner:me", "--output", "json", "--", "echo out; echo err >&2")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		var results []cli.ExecResult
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+		require.Len(t, results, 2)
+		for _, result := range results {
+			require.Contains(t, names, result.Workspace)
+			require.Equal(t, 0, result.ExitCode)
+			require.Equal(t, "out\n", result.Stdout)
+			require.Equal(t, "err\n", result.Stderr)
+			require.Empty(t, result.Error)
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "exec", "--search", "name:doesnotexist", "--", "true")
+		clitest.SetupConfig(t, client, root)
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "no workspaces match")
+	})
+}
@@ -131,6 +131,7 @@ func parseParameterMapFile(parameterFile string) (map[string]string, error) {
 // buildFlags contains options relating to troubleshooting provisioner jobs.
 type buildFlags struct {
 	provisionerLogDebug bool
+	plan                bool
 }
 
 func (bf *buildFlags) cliOptions() []serpent.Option {
@@ -145,3 +146,14 @@ This is useful for troubleshooting build issues.`,
 		},
 	}
 }
+
+// planOption is only offered by commands that build an existing workspace,
+// since the plan is made against its current state.
+func (bf *buildFlags) planOption() serpent.Option {
+	return serpent.Option{
+		Flag: "plan",
+		Description: "Show the changes the build will make to the workspace resources before starting it, " +
+			"and ask for confirmation if any resource will be replaced or destroyed.",
+		Value: serpent.BoolOf(&bf.plan),
+	}
+}
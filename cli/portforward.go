@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"net"
 	"net/netip"
 	"os"
@@ -11,7 +12,9 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
+	gossh "golang.org/x/crypto/ssh"
 	"golang.org/x/xerrors"
 
 	"cdr.dev/slog"
@@ -28,6 +31,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 	var (
 		tcpForwards      []string // <port>:<port>
 		udpForwards      []string // <port>:<port>
+		unixForwards     []string // <path|port>:<path|port>
 		disableAutostart bool
 		appearanceConfig codersdk.AppearanceConfig
 	)
@@ -57,6 +61,14 @@ func (r *RootCmd) portForward() *serpent.Command {
 				Description: "Port forward specifying the local address to bind to",
 				Command:     "coder port-forward <workspace> --tcp 1.2.3.4:8080:8080",
 			},
+			Example{
+				Description: "Port forward the Docker socket in the workspace to a local Unix socket",
+				Command:     "coder port-forward <workspace> --unix ./docker.sock:/var/run/docker.sock",
+			},
+			Example{
+				Description: "Port forward a Unix socket in the workspace to local TCP port 5432",
+				Command:     "coder port-forward <workspace> --unix 5432:/var/run/postgresql/.s.PGSQL.5432",
+			},
 		),
 		Middleware: serpent.Chain(
 			serpent.RequireNArgs(1),
@@ -67,7 +79,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 			ctx, cancel := context.WithCancel(inv.Context())
 			defer cancel()
 
-			specs, err := parsePortForwards(tcpForwards, udpForwards)
+			specs, err := parsePortForwards(tcpForwards, udpForwards, unixForwards)
 			if err != nil {
 				return xerrors.Errorf("parse port-forward specs: %w", err)
 			}
@@ -118,6 +130,9 @@ func (r *RootCmd) portForward() *serpent.Command {
 			}
 			defer conn.Close()
 
+			dialer := &portForwardDialer{conn: conn}
+			defer dialer.Close()
+
 			// Start all listeners.
 			var (
 				wg                = new(sync.WaitGroup)
@@ -135,7 +150,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 			defer closeAllListeners()
 
 			for i, spec := range specs {
-				l, err := listenAndPortForward(ctx, inv, conn, wg, spec, logger)
+				l, err := listenAndPortForward(ctx, inv, dialer, wg, spec, logger)
 				if err != nil {
 					logger.Error(ctx, "failed to listen", slog.F("spec", spec), slog.Error(err))
 					return err
@@ -191,6 +206,12 @@ func (r *RootCmd) portForward() *serpent.Command {
 			Description: "Forward UDP port(s) from the workspace to the local machine. The UDP connection has TCP-like semantics to support stateful UDP protocols.",
 			Value:       serpent.StringArrayOf(&udpForwards),
 		},
+		{
+			Flag:        "unix",
+			Env:         "CODER_PORT_FORWARD_UNIX",
+			Description: "Forward Unix socket(s) from the workspace to the local machine, in the form <local>:<remote>. Either side may be a TCP port instead of a socket path to forward between a TCP port and a Unix socket.",
+			Value:       serpent.StringArrayOf(&unixForwards),
+		},
 		sshDisableAutostartOption(serpent.BoolOf(&disableAutostart)),
 	}
 
@@ -200,7 +221,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 func listenAndPortForward(
 	ctx context.Context,
 	inv *serpent.Invocation,
-	conn *workspacesdk.AgentConn,
+	dialer *portForwardDialer,
 	wg *sync.WaitGroup,
 	spec portForwardSpec,
 	logger slog.Logger,
@@ -208,6 +229,13 @@ func listenAndPortForward(
 	logger = logger.With(slog.F("network", spec.listenNetwork), slog.F("address", spec.listenAddress))
 	_, _ = fmt.Fprintf(inv.Stderr, "Forwarding '%v://%v' locally to '%v://%v' in the workspace\n", spec.listenNetwork, spec.listenAddress, spec.dialNetwork, spec.dialAddress)
 
+	if spec.listenNetwork == "unix" {
+		err := removeStaleUnixSocket(spec.listenAddress)
+		if err != nil {
+			return nil, xerrors.Errorf("listen '%v://%v': %w", spec.listenNetwork, spec.listenAddress, err)
+		}
+	}
+
 	l, err := inv.Net.Listen(spec.listenNetwork, spec.listenAddress)
 	if err != nil {
 		return nil, xerrors.Errorf("listen '%v://%v': %w", spec.listenNetwork, spec.listenAddress, err)
@@ -233,7 +261,7 @@ func listenAndPortForward(
 
 			go func(netConn net.Conn) {
 				defer netConn.Close()
-				remoteConn, err := conn.DialContext(ctx, spec.dialNetwork, spec.dialAddress)
+				remoteConn, err := dialer.DialContext(ctx, spec.dialNetwork, spec.dialAddress)
 				if err != nil {
 					_, _ = fmt.Fprintf(inv.Stderr, "Failed to dial '%v://%v' in workspace: %s\n", spec.dialNetwork, spec.dialAddress, err)
 					return
@@ -250,15 +278,81 @@ func listenAndPortForward(
 	return l, nil
 }
 
+// removeStaleUnixSocket removes the socket at path if nothing is listening on
+// it anymore, e.g. because a previous port-forward was killed before it could
+// clean up after itself.
+func removeStaleUnixSocket(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return xerrors.Errorf("stat: %w", err)
+	}
+	if info.Mode().Type() != fs.ModeSocket {
+		return xerrors.Errorf("%q already exists and is not a socket", path)
+	}
+
+	c, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		_ = c.Close()
+		return xerrors.Errorf("%q is already in use", path)
+	}
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return xerrors.Errorf("remove stale socket: %w", err)
+	}
+	return nil
+}
+
+// portForwardDialer dials addresses in the workspace. Unix sockets can't be
+// dialed over the tailnet directly, so they are dialed through the SSH server
+// of the agent, which is only connected to once the first socket is dialed.
+type portForwardDialer struct {
+	conn *workspacesdk.AgentConn
+
+	mu        sync.Mutex
+	sshClient *gossh.Client
+}
+
+func (d *portForwardDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if network != "unix" {
+		return d.conn.DialContext(ctx, network, address)
+	}
+
+	d.mu.Lock()
+	if d.sshClient == nil {
+		sshClient, err := d.conn.SSHClient(ctx)
+		if err != nil {
+			d.mu.Unlock()
+			return nil, xerrors.Errorf("connect to workspace SSH server: %w", err)
+		}
+		d.sshClient = sshClient
+	}
+	sshClient := d.sshClient
+	d.mu.Unlock()
+
+	return sshClient.DialContext(ctx, network, address)
+}
+
+func (d *portForwardDialer) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.sshClient == nil {
+		return nil
+	}
+	return d.sshClient.Close()
+}
+
 type portForwardSpec struct {
-	listenNetwork string // tcp, udp
+	listenNetwork string // tcp, udp, unix
 	listenAddress string // <ip>:<port> or path
 
-	dialNetwork string // tcp, udp
+	dialNetwork string // tcp, udp, unix
 	dialAddress string // <ip>:<port> or path
 }
 
-func parsePortForwards(tcpSpecs, udpSpecs []string) ([]portForwardSpec, error) {
+func parsePortForwards(tcpSpecs, udpSpecs, unixSpecs []string) ([]portForwardSpec, error) {
 	specs := []portForwardSpec{}
 
 	for _, specEntry := range tcpSpecs {
@@ -297,6 +391,15 @@ func parsePortForwards(tcpSpecs, udpSpecs []string) ([]portForwardSpec, error) {
 		}
 	}
 
+	// Unix specs are not split on commas, as socket paths may contain them.
+	for _, spec := range unixSpecs {
+		unixSpec, err := parseUnixForward(spec)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to parse Unix socket port-forward specification %q: %w", spec, err)
+		}
+		specs = append(specs, unixSpec)
+	}
+
 	// Check for duplicate entries.
 	locals := map[string]struct{}{}
 	for _, spec := range specs {
@@ -310,6 +413,52 @@ func parsePortForwards(tcpSpecs, udpSpecs []string) ([]portForwardSpec, error) {
 	return specs, nil
 }
 
+// parseUnixForward parses a "<local>:<remote>" Unix socket forward, where
+// either side may be a TCP port instead of a socket path. The local side may
+// also be "<ip>:<port>" to bind to a specific address. A single socket path is
+// forwarded to the same path locally.
+func parseUnixForward(in string) (portForwardSpec, error) {
+	var (
+		spec      portForwardSpec
+		localAddr = netip.AddrFrom4([4]byte{127, 0, 0, 1})
+		parts     = strings.Split(in, ":")
+	)
+
+	switch len(parts) {
+	case 1:
+		parts = append(parts, parts[0])
+	case 2:
+	case 3:
+		_localAddr, err := netip.ParseAddr(parts[0])
+		if err != nil {
+			return spec, xerrors.Errorf("invalid ip %q: %w", parts[0], err)
+		}
+		if _, err := parsePort(parts[1]); err != nil {
+			return spec, xerrors.Errorf("a local address must be followed by a port: %w", err)
+		}
+		localAddr = _localAddr
+		parts = parts[1:]
+	default:
+		return spec, xerrors.New("socket paths cannot contain colons")
+	}
+	if parts[0] == "" || parts[1] == "" {
+		return spec, xerrors.New("local and remote must not be empty")
+	}
+
+	spec.listenNetwork, spec.listenAddress = "unix", parts[0]
+	if port, err := parsePort(parts[0]); err == nil {
+		spec.listenNetwork, spec.listenAddress = "tcp", netip.AddrPortFrom(localAddr, port).String()
+	}
+	spec.dialNetwork, spec.dialAddress = "unix", parts[1]
+	if port, err := parsePort(parts[1]); err == nil {
+		spec.dialNetwork, spec.dialAddress = "tcp", netip.AddrPortFrom(netip.AddrFrom4([4]byte{127, 0, 0, 1}), port).String()
+	}
+	if spec.listenNetwork == "tcp" && spec.dialNetwork == "tcp" {
+		return spec, xerrors.New("at least one side must be a socket path, use --tcp to forward TCP ports")
+	}
+	return spec, nil
+}
+
 func parsePort(in string) (uint16, error) {
 	port, err := strconv.ParseUint(strings.TrimSpace(in), 10, 16)
 	if err != nil {
@@ -78,7 +78,7 @@ func Test_parsePortForwards(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := parsePortForwards(tt.args.tcpSpecs, tt.args.udpSpecs)
+			got, err := parsePortForwards(tt.args.tcpSpecs, tt.args.udpSpecs, nil)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("parsePortForwards() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -88,3 +88,99 @@ func Test_parsePortForwards(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseUnixForward(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		spec    string
+		want    portForwardSpec
+		wantErr bool
+	}{
+		{
+			name: "Unix to Unix",
+			spec: "./docker.sock:/var/run/docker.sock",
+			want: portForwardSpec{
+				listenNetwork: "unix",
+				listenAddress: "./docker.sock",
+				dialNetwork:   "unix",
+				dialAddress:   "/var/run/docker.sock",
+			},
+		},
+		{
+			name: "Same path",
+			spec: "/tmp/app.sock",
+			want: portForwardSpec{
+				listenNetwork: "unix",
+				listenAddress: "/tmp/app.sock",
+				dialNetwork:   "unix",
+				dialAddress:   "/tmp/app.sock",
+			},
+		},
+		{
+			name: "TCP to Unix",
+			spec: "5432:/var/run/postgresql/.s.PGSQL.5432",
+			want: portForwardSpec{
+				listenNetwork: "tcp",
+				listenAddress: "127.0.0.1:5432",
+				dialNetwork:   "unix",
+				dialAddress:   "/var/run/postgresql/.s.PGSQL.5432",
+			},
+		},
+		{
+			name: "TCP with address to Unix",
+			spec: "0.0.0.0:5432:/var/run/postgresql/.s.PGSQL.5432",
+			want: portForwardSpec{
+				listenNetwork: "tcp",
+				listenAddress: "0.0.0.0:5432",
+				dialNetwork:   "unix",
+				dialAddress:   "/var/run/postgresql/.s.PGSQL.5432",
+			},
+		},
+		{
+			name: "Unix to TCP",
+			spec: "./app.sock:8080",
+			want: portForwardSpec{
+				listenNetwork: "unix",
+				listenAddress: "./app.sock",
+				dialNetwork:   "tcp",
+				dialAddress:   "127.0.0.1:8080",
+			},
+		},
+		{
+			name:    "TCP to TCP",
+			spec:    "8080:8080",
+			wantErr: true,
+		},
+		{
+			name:    "Empty remote",
+			spec:    "./app.sock:",
+			wantErr: true,
+		},
+		{
+			name:    "Bad address",
+			spec:    "foo:8080:/tmp/app.sock",
+			wantErr: true,
+		},
+		{
+			name:    "Too many colons",
+			spec:    "a:b:c:d",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseUnixForward(tt.spec)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
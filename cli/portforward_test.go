@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -92,6 +96,31 @@ func TestPortForward(t *testing.T) {
 			},
 			localAddress: []string{"10.10.10.99:9999", "10.10.10.10:1010"},
 		},
+		{
+			name:         "Unix",
+			network:      "unix",
+			flag:         []string{"--unix=local1.sock:%v", "--unix=local2.sock:%v"},
+			setupRemote:  setupUnixListener,
+			localAddress: []string{"local1.sock", "local2.sock"},
+		},
+		{
+			name:         "TCPToUnix",
+			network:      "tcp",
+			flag:         []string{"--unix=4444:%v", "--unix=4445:%v"},
+			setupRemote:  setupUnixListener,
+			localAddress: []string{"127.0.0.1:4444", "127.0.0.1:4445"},
+		},
+		{
+			name:    "UnixToTCP",
+			network: "unix",
+			flag:    []string{"--unix=local3.sock:%v", "--unix=local4.sock:%v"},
+			setupRemote: func(t *testing.T) net.Listener {
+				l, err := net.Listen("tcp", "127.0.0.1:0")
+				require.NoError(t, err, "create TCP listener")
+				return l
+			},
+			localAddress: []string{"local3.sock", "local4.sock"},
+		},
 	}
 
 	// Setup agent once to be shared between test-cases (avoid expensive
@@ -112,6 +141,9 @@ func TestPortForward(t *testing.T) {
 		c := c
 		t.Run(c.name+"_OnePort", func(t *testing.T) {
 			t.Parallel()
+			if runtime.GOOS == "windows" && strings.Contains(c.name, "Unix") {
+				t.Skip("Unix socket forwarding is not tested on Windows")
+			}
 			p1 := setupTestListener(t, c.setupRemote(t))
 
 			// Create a flag that forwards from local to listener 1.
@@ -165,6 +197,9 @@ func TestPortForward(t *testing.T) {
 
 		t.Run(c.name+"_TwoPorts", func(t *testing.T) {
 			t.Parallel()
+			if runtime.GOOS == "windows" && strings.Contains(c.name, "Unix") {
+				t.Skip("Unix socket forwarding is not tested on Windows")
+			}
 			var (
 				p1 = setupTestListener(t, c.setupRemote(t))
 				p2 = setupTestListener(t, c.setupRemote(t))
@@ -228,6 +263,9 @@ func TestPortForward(t *testing.T) {
 
 		// Start listeners and populate arrays with the cases.
 		for _, c := range cases {
+			if runtime.GOOS == "windows" && strings.Contains(c.name, "Unix") {
+				continue
+			}
 			p := setupTestListener(t, c.setupRemote(t))
 
 			dials = append(dials, addr{
@@ -287,6 +325,58 @@ func TestPortForward(t *testing.T) {
 	})
 }
 
+func TestPortForward_UnixStaleSocket(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix socket forwarding is not tested on Windows")
+	}
+
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	admin := coderdtest.CreateFirstUser(t, client)
+	member, memberUser := coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+	workspace := runAgent(t, client, memberUser.ID, db)
+
+	remote := setupTestListener(t, setupUnixListener(t))
+
+	// Leave a socket behind that nothing listens on, like a port-forward that
+	// was killed would.
+	localSock := filepath.Join(tempDirUnixSocket(t), "local.sock")
+	stale, err := net.Listen("unix", localSock)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	_, err = os.Stat(localSock)
+	require.NoError(t, err, "stale socket exists")
+
+	inv, root := clitest.New(t, "port-forward", workspace.Name, "--unix", localSock+":"+remote)
+	clitest.SetupConfig(t, member, root)
+	pty := ptytest.New(t).Attach(inv)
+	inv.Stderr = pty.Output()
+	inv.Net = osNet{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+	defer cancel()
+	errC := make(chan error)
+	go func() {
+		errC <- inv.WithContext(ctx).Run()
+	}()
+	pty.ExpectMatchContext(ctx, "Ready!")
+
+	var d net.Dialer
+	c, err := d.DialContext(ctx, "unix", localSock)
+	require.NoError(t, err, "dial local socket")
+	defer c.Close()
+	testDial(t, c)
+
+	cancel()
+	err = <-errC
+	require.ErrorIs(t, err, context.Canceled)
+
+	// The socket is removed when port-forward exits.
+	_, err = os.Stat(localSock)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
 // runAgent creates a fake workspace and starts an agent locally for that
 // workspace. The agent will be cleaned up on test completion.
 // nolint:unused
@@ -342,13 +432,24 @@ func setupTestListener(t *testing.T, l net.Listener) string {
 	}()
 
 	addr := l.Addr().String()
-	_, port, err := net.SplitHostPort(addr)
-	require.NoErrorf(t, err, "split non-Unix listen path %q", addr)
-	addr = port
+	if l.Addr().Network() != "unix" {
+		_, port, err := net.SplitHostPort(addr)
+		require.NoErrorf(t, err, "split non-Unix listen path %q", addr)
+		addr = port
+	}
 
 	return addr
 }
 
+// setupUnixListener creates a Unix socket listener to emulate a service in the
+// workspace.
+func setupUnixListener(t *testing.T) net.Listener {
+	t.Helper()
+	l, err := net.Listen("unix", filepath.Join(tempDirUnixSocket(t), "remote.sock"))
+	require.NoError(t, err, "create Unix listener")
+	return l
+}
+
 var dialTestPayload = []byte("dean-was-here123")
 
 func testDial(t *testing.T, c net.Conn) {
@@ -399,6 +500,13 @@ func (a addr) String() string {
 	return a.network + "|" + a.addr
 }
 
+// osNet listens on the real network.
+type osNet struct{}
+
+func (osNet) Listen(network, address string) (net.Listener, error) {
+	return net.Listen(network, address)
+}
+
 type inProcNet struct {
 	sync.Mutex
 
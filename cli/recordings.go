@@ -0,0 +1,310 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) recordings() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "recordings",
+		Short: "List, replay and download recorded terminal sessions",
+		Long: "Terminal sessions are recorded when session recording is enabled on the deployment. Recordings use the asciicast v2 format.\n" + FormatExamples(
+			Example{
+				Description: "List the recorded sessions of a workspace",
+				Command:     "coder recordings list my-workspace",
+			},
+			Example{
+				Description: "Replay a recorded session at twice the speed",
+				Command:     "coder recordings play my-workspace <recording-id> --speed 2",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.recordingsList(),
+			r.recordingsPlay(),
+			r.recordingsDownload(),
+		},
+	}
+	return cmd
+}
+
+type recordingTableRow struct {
+	ID        string        `table:"id"`
+	Type      string        `table:"type"`
+	Command   string        `table:"command"`
+	StartedAt time.Time     `table:"started at,default_sort"`
+	Duration  time.Duration `table:"duration"`
+	Size      string        `table:"size"`
+	Input     bool          `table:"input"`
+	Truncated bool          `table:"truncated"`
+}
+
+func (r *RootCmd) recordingsList() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(
+			cliui.TableFormat([]recordingTableRow{}, []string{"id", "type", "command", "started at", "duration", "size"}),
+			func(data any) (any, error) {
+				recordings, ok := data.([]codersdk.WorkspaceSessionRecording)
+				if !ok {
+					return nil, xerrors.Errorf("expected []codersdk.WorkspaceSessionRecording got %T", data)
+				}
+
+				rows := make([]recordingTableRow, 0, len(recordings))
+				for _, recording := range recordings {
+					command := recording.Command
+					if command == "" {
+						command = "(shell)"
+					}
+					rows = append(rows, recordingTableRow{
+						ID:        recording.ID.String(),
+						Type:      string(recording.Type),
+						Command:   command,
+						StartedAt: recording.StartedAt,
+						Duration:  recording.EndedAt.Sub(recording.StartedAt).Round(time.Second),
+						Size:      humanize.IBytes(uint64(recording.Size)),
+						Input:     recording.InputRecorded,
+						Truncated: recording.Truncated,
+					})
+				}
+				return rows, nil
+			},
+		),
+		cliui.JSONFormat(),
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "list <workspace>",
+		Short: "List the recorded terminal sessions of a workspace",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+			recordings, err := client.WorkspaceSessionRecordings(inv.Context(), workspace.ID)
+			if err != nil {
+				return xerrors.Errorf("get session recordings: %w", err)
+			}
+			if len(recordings) == 0 {
+				cliui.Infof(inv.Stderr, "No recorded sessions found for %s.", workspace.Name)
+				return nil
+			}
+
+			out, err := formatter.Format(inv.Context(), recordings)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func (r *RootCmd) recordingsPlay() *serpent.Command {
+	var (
+		speed   float64
+		maxIdle time.Duration
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "play <workspace> <recording-id>",
+		Short: "Replay a recorded terminal session in your terminal",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			if speed <= 0 {
+				return xerrors.Errorf("--speed must be greater than zero")
+			}
+			body, err := openRecording(inv, client)
+			if err != nil {
+				return err
+			}
+			defer body.Close()
+			return playAsciicast(inv.Context(), inv.Stdout, body, speed, maxIdle)
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "speed",
+			Description: "Playback speed multiplier.",
+			Default:     "1",
+			Value:       serpent.Float64Of(&speed),
+		},
+		{
+			Flag:        "max-idle",
+			Description: "Limit pauses between output to this duration. Set to 0 to replay pauses as recorded.",
+			Default:     "2s",
+			Value:       serpent.DurationOf(&maxIdle),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) recordingsDownload() *serpent.Command {
+	var outputPath string
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "download <workspace> <recording-id>",
+		Short: "Download a recorded terminal session in the asciicast v2 format",
+		Long: "The downloaded recording can be replayed with any asciicast player, such as asciinema.\n" + FormatExamples(
+			Example{
+				Description: "Download a recording to a file",
+				Command:     "coder recordings download my-workspace <recording-id> -O session.cast",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			body, err := openRecording(inv, client)
+			if err != nil {
+				return err
+			}
+			defer body.Close()
+
+			if outputPath == "" || outputPath == "-" {
+				_, err = io.Copy(inv.Stdout, body)
+				if err != nil {
+					return xerrors.Errorf("write recording: %w", err)
+				}
+				return nil
+			}
+
+			f, err := os.Create(outputPath)
+			if err != nil {
+				return xerrors.Errorf("create output file: %w", err)
+			}
+			defer f.Close()
+			n, err := io.Copy(f, body)
+			if err != nil {
+				_ = os.Remove(outputPath) // best effort
+				return xerrors.Errorf("write recording to %s: %w", outputPath, err)
+			}
+			if err := f.Close(); err != nil {
+				return xerrors.Errorf("close output file: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stderr, "Wrote %d bytes to %s\n", n, outputPath)
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:          "output-file",
+			FlagShorthand: "O",
+			Description:   "File path to write the recording to. Defaults to stdout.",
+			Value:         serpent.StringOf(&outputPath),
+		},
+	}
+	return cmd
+}
+
+func openRecording(inv *serpent.Invocation, client *codersdk.Client) (io.ReadCloser, error) {
+	workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	recordingID, err := uuid.Parse(inv.Args[1])
+	if err != nil {
+		return nil, xerrors.Errorf("invalid recording ID %q: %w", inv.Args[1], err)
+	}
+	body, err := client.WorkspaceSessionRecordingData(inv.Context(), workspace.ID, recordingID)
+	if err != nil {
+		return nil, xerrors.Errorf("get session recording: %w", err)
+	}
+	return body, nil
+}
+
+// playAsciicast writes the output events of an asciicast v2 recording to w,
+// pausing between events as recorded. Input and resize events are skipped.
+func playAsciicast(ctx context.Context, w io.Writer, r io.Reader, speed float64, maxIdle time.Duration) error {
+	scanner := bufio.NewScanner(r)
+	// Events can be large when a lot of output is written at once.
+	scanner.Buffer(make([]byte, 0, 64<<10), 16<<20)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return xerrors.Errorf("read recording: %w", err)
+		}
+		return xerrors.New("recording is empty")
+	}
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return xerrors.Errorf("parse recording header: %w", err)
+	}
+	if header.Version != 2 {
+		return xerrors.Errorf("unsupported asciicast version %d", header.Version)
+	}
+
+	var last float64
+	for scanner.Scan() {
+		var event []json.RawMessage
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || len(event) != 3 {
+			return xerrors.Errorf("parse recording event %q", scanner.Text())
+		}
+		var (
+			at   float64
+			code string
+			data string
+		)
+		if err := json.Unmarshal(event[0], &at); err != nil {
+			return xerrors.Errorf("parse event time: %w", err)
+		}
+		if err := json.Unmarshal(event[1], &code); err != nil {
+			return xerrors.Errorf("parse event code: %w", err)
+		}
+		if code != "o" {
+			continue
+		}
+		if err := json.Unmarshal(event[2], &data); err != nil {
+			return xerrors.Errorf("parse event data: %w", err)
+		}
+
+		delay := time.Duration((at - last) / speed * float64(time.Second))
+		last = at
+		if maxIdle > 0 && delay > maxIdle {
+			delay = maxIdle
+		}
+		if delay > 0 {
+			t := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return ctx.Err()
+			case <-t.C:
+			}
+		}
+		if _, err := io.WriteString(w, data); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return xerrors.Errorf("read recording: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestRecordings(t *testing.T) {
+	t.Parallel()
+
+	ownerClient, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	client, member := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+	r := dbfake.WorkspaceBuild(t, db, database.Workspace{
+		OwnerID:        member.ID,
+		OrganizationID: owner.OrganizationID,
+	}).Do()
+
+	data := []byte(`{"version":2,"width":80,"height":24}` + "\n" +
+		`[0.1,"o","hello "]` + "\n" +
+		`[0.2,"i","q"]` + "\n" +
+		`[0.3,"r","100x40"]` + "\n" +
+		`[5.0,"o","world"]` + "\n")
+	recording := dbgen.WorkspaceSessionRecording(t, db, database.WorkspaceSessionRecording{
+		WorkspaceID: r.Workspace.ID,
+		AgentID:     uuid.New(),
+		Command:     "htop",
+		StartedAt:   dbtime.Now().Add(-time.Minute),
+		Data:        data,
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		inv, root := clitest.New(t, "recordings", "list", r.Workspace.Name, "--output", "json")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		var recordings []codersdk.WorkspaceSessionRecording
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &recordings))
+		require.Len(t, recordings, 1)
+		require.Equal(t, recording.ID, recordings[0].ID)
+		require.Equal(t, "htop", recordings[0].Command)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		path := filepath.Join(t.TempDir(), "session.cast")
+		inv, root := clitest.New(t, "recordings", "download", r.Workspace.Name, recording.ID.String(), "-O", path)
+		clitest.SetupConfig(t, client, root)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+	})
+
+	t.Run("Play", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		inv, root := clitest.New(t, "recordings", "play", r.Workspace.Name, recording.ID.String(), "--max-idle", "10ms")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		// Only output events are replayed.
+		require.Equal(t, "hello world", stdout.String())
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		inv, root := clitest.New(t, "recordings", "play", r.Workspace.Name, "not-a-uuid")
+		clitest.SetupConfig(t, client, root)
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "invalid recording ID")
+	})
+}
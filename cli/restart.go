@@ -95,6 +95,7 @@ func (r *RootCmd) restart() *serpent.Command {
 
 	cmd.Options = append(cmd.Options, parameterFlags.allOptions()...)
 	cmd.Options = append(cmd.Options, bflags.cliOptions()...)
+	cmd.Options = append(cmd.Options, bflags.planOption())
 
 	return cmd
 }
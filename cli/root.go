@@ -82,6 +82,7 @@ const (
 func (r *RootCmd) CoreSubcommands() []*serpent.Command {
 	// Please re-sort this list alphabetically if you change it!
 	return []*serpent.Command{
+		r.audit(),
 		r.completion(),
 		r.dotfiles(),
 		r.externalAuth(),
@@ -102,15 +103,19 @@ func (r *RootCmd) CoreSubcommands() []*serpent.Command {
 		// Workspace Commands
 		r.autoupdate(),
 		r.configSSH(),
+		r.cp(),
 		r.create(),
 		r.deleteWorkspace(),
+		r.exec(),
 		r.favorite(),
 		r.list(),
 		r.open(),
 		r.ping(),
+		r.recordings(),
 		r.rename(),
 		r.restart(),
 		r.schedules(),
+		r.sharing(),
 		r.show(),
 		r.speedtest(),
 		r.ssh(),
@@ -120,6 +125,7 @@ func (r *RootCmd) CoreSubcommands() []*serpent.Command {
 		r.unfavorite(),
 		r.update(),
 		r.whoami(),
+		r.workspaces(),
 
 		// Hidden
 		r.expCmd(),
@@ -689,7 +695,22 @@ func namedWorkspace(ctx context.Context, client *codersdk.Client, identifier str
 	if err != nil {
 		return codersdk.Workspace{}, err
 	}
-	return client.WorkspaceByOwnerAndName(ctx, owner, name, codersdk.WorkspaceOptions{})
+	workspace, err := client.WorkspaceByOwnerAndName(ctx, owner, name, codersdk.WorkspaceOptions{})
+	if err == nil || owner == codersdk.Me {
+		return workspace, err
+	}
+	// Users a workspace is shared with may not be allowed to look up its
+	// owner, so fall back to searching the workspaces they can read.
+	res, searchErr := client.Workspaces(ctx, codersdk.WorkspaceFilter{Owner: owner, Name: name})
+	if searchErr != nil {
+		return codersdk.Workspace{}, err
+	}
+	for _, w := range res.Workspaces {
+		if strings.EqualFold(w.OwnerName, owner) && w.Name == name {
+			return w, nil
+		}
+	}
+	return codersdk.Workspace{}, err
 }
 
 func initAppearance(client *codersdk.Client, outConfig *codersdk.AppearanceConfig) serpent.MiddlewareFunc {
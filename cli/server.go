@@ -80,6 +80,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database/migrations"
 	"github.com/coder/coder/v2/coderd/database/pubsub"
 	"github.com/coder/coder/v2/coderd/devtunnel"
+	"github.com/coder/coder/v2/coderd/driftcheck"
 	"github.com/coder/coder/v2/coderd/externalauth"
 	"github.com/coder/coder/v2/coderd/gitsshkey"
 	"github.com/coder/coder/v2/coderd/httpmw"
@@ -1096,6 +1097,12 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 			hangDetector.Start()
 			defer hangDetector.Close()
 
+			driftCheckTicker := time.NewTicker(driftcheck.PollInterval)
+			defer driftCheckTicker.Stop()
+			driftChecker := driftcheck.New(ctx, options.Database, options.Pubsub, logger, vals, driftCheckTicker.C)
+			driftChecker.Start()
+			defer driftChecker.Close()
+
 			waitForProvisionerJobs := false
 			// Currently there is no way to ask the server to shut
 			// itself down, so any exit signal will result in a non-zero
@@ -1496,8 +1503,14 @@ func newProvisionerDaemon(
 						Logger:        logger.Named("terraform"),
 						WorkDirectory: workDir,
 					},
-					CachePath: tfDir,
-					Tracer:    tracer,
+					CachePath:     tfDir,
+					Flavor:        terraform.Flavor(cfg.Provisioner.TerraformFlavor),
+					InstallMirror: cfg.Provisioner.TerraformInstallMirror.String(),
+					// The built-in provisioners share a plugin cache, so
+					// providers are only downloaded once.
+					PluginCachePath: filepath.Join(cfg.CacheDir.String(), "tf-plugins"),
+					ProviderMirror:  cfg.Provisioner.TerraformProviderMirror.String(),
+					Tracer:          tracer,
 				})
 				if err != nil && !xerrors.Is(err, context.Canceled) {
 					select {
@@ -0,0 +1,248 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) sharing() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:     "sharing",
+		Aliases: []string{"share"},
+		Short:   "Share a workspace with other users and groups",
+		Long: "Users and groups a workspace is shared with can connect to it over SSH and access its apps and ports. " +
+			"The \"admin\" role additionally allows them to start, stop and update the workspace. " +
+			"Only the owner of the workspace and administrators can change who it is shared with.\n" + FormatExamples(
+			Example{
+				Description: "Share a workspace with a user",
+				Command:     "coder sharing add my-workspace --user alice",
+			},
+			Example{
+				Description: "Share a workspace with a group and let its members manage the workspace",
+				Command:     "coder sharing add my-workspace --group developers:admin",
+			},
+			Example{
+				Description: "Stop sharing a workspace with a user",
+				Command:     "coder sharing remove my-workspace --user alice",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.sharingAdd(),
+			r.sharingList(),
+			r.sharingRemove(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) sharingAdd() *serpent.Command {
+	var users, groups []string
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "add <workspace>",
+		Short: "Share a workspace with users and groups",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			if len(users) == 0 && len(groups) == 0 {
+				return xerrors.New("at least one --user or --group must be provided")
+			}
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+			req, err := workspaceACLRequest(users, groups, true)
+			if err != nil {
+				return err
+			}
+			err = client.UpdateWorkspaceACL(inv.Context(), workspace.ID, req)
+			if err != nil {
+				return xerrors.Errorf("update workspace ACL: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Shared workspace %s.\n", cliui.Keyword(workspace.Name))
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:          "user",
+			FlagShorthand: "u",
+			Description:   "Username of a user to share the workspace with, optionally followed by the role, for example \"alice:admin\". The role is \"use\" or \"admin\" and defaults to \"use\".",
+			Value:         serpent.StringArrayOf(&users),
+		},
+		{
+			Flag:          "group",
+			FlagShorthand: "g",
+			Description:   "Name of a group to share the workspace with, optionally followed by the role, for example \"developers:admin\". The role is \"use\" or \"admin\" and defaults to \"use\".",
+			Value:         serpent.StringArrayOf(&groups),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) sharingRemove() *serpent.Command {
+	var users, groups []string
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "remove <workspace>",
+		Short: "Stop sharing a workspace with users and groups",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			if len(users) == 0 && len(groups) == 0 {
+				return xerrors.New("at least one --user or --group must be provided")
+			}
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+			req, err := workspaceACLRequest(users, groups, false)
+			if err != nil {
+				return err
+			}
+			err = client.UpdateWorkspaceACL(inv.Context(), workspace.ID, req)
+			if err != nil {
+				return xerrors.Errorf("update workspace ACL: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Stopped sharing workspace %s.\n", cliui.Keyword(workspace.Name))
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:          "user",
+			FlagShorthand: "u",
+			Description:   "Username of a user to stop sharing the workspace with.",
+			Value:         serpent.StringArrayOf(&users),
+		},
+		{
+			Flag:          "group",
+			FlagShorthand: "g",
+			Description:   "Name of a group to stop sharing the workspace with.",
+			Value:         serpent.StringArrayOf(&groups),
+		},
+	}
+	return cmd
+}
+
+type sharingTableRow struct {
+	Name string `table:"name,default_sort"`
+	Type string `table:"type"`
+	Role string `table:"role"`
+}
+
+func (r *RootCmd) sharingList() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(
+			cliui.TableFormat([]sharingTableRow{}, []string{"name", "type", "role"}),
+			func(data any) (any, error) {
+				acl, ok := data.(codersdk.WorkspaceACL)
+				if !ok {
+					return nil, xerrors.Errorf("expected codersdk.WorkspaceACL got %T", data)
+				}
+
+				rows := make([]sharingTableRow, 0, len(acl.Users)+len(acl.Groups))
+				for _, user := range acl.Users {
+					rows = append(rows, sharingTableRow{Name: user.Username, Type: "user", Role: string(user.Role)})
+				}
+				for _, group := range acl.Groups {
+					rows = append(rows, sharingTableRow{Name: group.Name, Type: "group", Role: string(group.Role)})
+				}
+				return rows, nil
+			},
+		),
+		cliui.JSONFormat(),
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "list <workspace>",
+		Short: "List the users and groups a workspace is shared with",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+			acl, err := client.WorkspaceACL(inv.Context(), workspace.ID)
+			if err != nil {
+				return xerrors.Errorf("get workspace ACL: %w", err)
+			}
+			if len(acl.Users) == 0 && len(acl.Groups) == 0 {
+				cliui.Infof(inv.Stderr, "Workspace %s is not shared with anyone.", workspace.Name)
+				return nil
+			}
+
+			out, err := formatter.Format(inv.Context(), acl)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+// workspaceACLRequest converts the flags to the request that grants the users
+// and groups their roles, or removes them if add is false. Users and groups
+// are referred to by name and resolved by the server.
+func workspaceACLRequest(users, groups []string, add bool) (codersdk.UpdateWorkspaceACL, error) {
+	req := codersdk.UpdateWorkspaceACL{
+		UserRoles:  map[string]codersdk.WorkspaceRole{},
+		GroupRoles: map[string]codersdk.WorkspaceRole{},
+	}
+	for _, arg := range users {
+		name, role, err := parseWorkspaceRole(arg, add)
+		if err != nil {
+			return codersdk.UpdateWorkspaceACL{}, err
+		}
+		req.UserRoles[name] = role
+	}
+	for _, arg := range groups {
+		name, role, err := parseWorkspaceRole(arg, add)
+		if err != nil {
+			return codersdk.UpdateWorkspaceACL{}, err
+		}
+		req.GroupRoles[name] = role
+	}
+	return req, nil
+}
+
+// parseWorkspaceRole parses a "name[:role]" flag value. When removing, no
+// role may be given.
+func parseWorkspaceRole(arg string, add bool) (string, codersdk.WorkspaceRole, error) {
+	name, roleStr, hasRole := strings.Cut(arg, ":")
+	if !add {
+		if hasRole {
+			return "", "", xerrors.Errorf("%q: a role cannot be given when removing", arg)
+		}
+		return name, codersdk.WorkspaceRoleDeleted, nil
+	}
+	role := codersdk.WorkspaceRoleUse
+	if hasRole {
+		role = codersdk.WorkspaceRole(roleStr)
+	}
+	switch role {
+	case codersdk.WorkspaceRoleUse, codersdk.WorkspaceRoleAdmin:
+	default:
+		return "", "", xerrors.Errorf("%q: role must be %q or %q", arg, codersdk.WorkspaceRoleUse, codersdk.WorkspaceRoleAdmin)
+	}
+	return name, role, nil
+}
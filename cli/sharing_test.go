@@ -0,0 +1,61 @@
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestSharing(t *testing.T) {
+	t.Parallel()
+
+	client, store := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+	memberClient, member := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+	sharedClient, shared := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+	workspace := dbfake.WorkspaceBuild(t, store, database.Workspace{
+		OrganizationID: owner.OrganizationID,
+		OwnerID:        member.ID,
+	}).Do().Workspace
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	inv, root := clitest.New(t, "sharing", "add", workspace.Name, "--user", shared.Username+":admin")
+	clitest.SetupConfig(t, memberClient, root)
+	require.NoError(t, inv.WithContext(ctx).Run())
+
+	acl, err := memberClient.WorkspaceACL(ctx, workspace.ID)
+	require.NoError(t, err)
+	require.Len(t, acl.Users, 1)
+	require.Equal(t, shared.ID, acl.Users[0].ID)
+	require.Equal(t, codersdk.WorkspaceRoleAdmin, acl.Users[0].Role)
+
+	// The shared user can refer to the workspace by its owner and name.
+	inv, root = clitest.New(t, "sharing", "list", member.Username+"/"+workspace.Name)
+	clitest.SetupConfig(t, sharedClient, root)
+	var stdout bytes.Buffer
+	inv.Stdout = &stdout
+	require.NoError(t, inv.WithContext(ctx).Run())
+	require.Contains(t, stdout.String(), shared.Username)
+	require.Contains(t, stdout.String(), "admin")
+
+	inv, root = clitest.New(t, "sharing", "remove", workspace.Name, "--user", shared.Username)
+	clitest.SetupConfig(t, memberClient, root)
+	require.NoError(t, inv.WithContext(ctx).Run())
+
+	acl, err = memberClient.WorkspaceACL(ctx, workspace.ID)
+	require.NoError(t, err)
+	require.Empty(t, acl.Users)
+
+	// Roles cannot be given when removing.
+	inv, root = clitest.New(t, "sharing", "remove", workspace.Name, "--user", shared.Username+":use")
+	clitest.SetupConfig(t, memberClient, root)
+	require.Error(t, inv.WithContext(ctx).Run())
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/xerrors"
 
 	"github.com/coder/coder/v2/cli/cliui"
@@ -111,10 +112,16 @@ func buildWorkspaceStartRequest(inv *serpent.Invocation, client *codersdk.Client
 		return codersdk.CreateWorkspaceBuildRequest{}, xerrors.Errorf("unable to parse rich parameter defaults: %w", err)
 	}
 
+	var planWorkspaceID uuid.UUID
+	if buildFlags.plan {
+		planWorkspaceID = workspace.ID
+	}
+
 	buildParameters, err := prepWorkspaceBuild(inv, client, prepWorkspaceBuildArgs{
 		Action:              action,
 		TemplateVersionID:   version,
 		NewWorkspaceName:    workspace.Name,
+		PlanWorkspaceID:     planWorkspaceID,
 		LastBuildParameters: lastBuildParameters,
 
 		PromptBuildOptions:    parameterFlags.promptBuildOptions,
@@ -23,6 +23,8 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 		icon                           string
 		defaultTTL                     time.Duration
 		activityBump                   time.Duration
+		idleDetection                  bool
+		idleCPUThreshold               int64
 		autostopRequirementDaysOfWeek  []string
 		autostopRequirementWeeks       int64
 		autostartRequirementDaysOfWeek []string
@@ -107,6 +109,17 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 				activityBump = time.Duration(template.ActivityBumpMillis) * time.Millisecond
 			}
 
+			if !userSetOption(inv, "idle-detection") {
+				idleDetection = template.IdleDetectionEnabled
+			}
+
+			if !userSetOption(inv, "idle-cpu-threshold") {
+				idleCPUThreshold = int64(template.IdleCPUThresholdPercent)
+			}
+			if idleCPUThreshold < 0 || idleCPUThreshold > 100 {
+				return xerrors.Errorf("--idle-cpu-threshold must be between 0 and 100")
+			}
+
 			if !userSetOption(inv, "allow-user-autostop") {
 				allowUserAutostop = template.AllowUserAutostop
 			}
@@ -167,12 +180,14 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 			}
 
 			req := codersdk.UpdateTemplateMeta{
-				Name:               name,
-				DisplayName:        displayName,
-				Description:        description,
-				Icon:               icon,
-				DefaultTTLMillis:   defaultTTL.Milliseconds(),
-				ActivityBumpMillis: activityBump.Milliseconds(),
+				Name:                    name,
+				DisplayName:             displayName,
+				Description:             description,
+				Icon:                    icon,
+				DefaultTTLMillis:        defaultTTL.Milliseconds(),
+				ActivityBumpMillis:      activityBump.Milliseconds(),
+				IdleDetectionEnabled:    idleDetection,
+				IdleCPUThresholdPercent: int32(idleCPUThreshold),
 				AutostopRequirement: &codersdk.TemplateAutostopRequirement{
 					DaysOfWeek: autostopRequirementDaysOfWeek,
 					Weeks:      autostopRequirementWeeks,
@@ -237,6 +252,16 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 			Description: "Edit the template activity bump - workspaces created from this template will have their shutdown time bumped by this value when activity is detected. Maps to \"Activity bump\" in the UI.",
 			Value:       serpent.DurationOf(&activityBump),
 		},
+		{
+			Flag:        "idle-detection",
+			Description: "Only bump the shutdown time of workspaces created from this template on user input in terminals, app requests and, if --idle-cpu-threshold is set, CPU load, instead of on any open connection. Editors that connect over SSH without a terminal, such as VS Code Desktop and JetBrains Gateway, and forwarded ports don't count as input, so set --idle-cpu-threshold if users mainly work in an IDE.",
+			Value:       serpent.BoolOf(&idleDetection),
+		},
+		{
+			Flag:        "idle-cpu-threshold",
+			Description: "Edit the CPU usage percentage above which workspaces created from this template are considered in use when idle detection is enabled. Set to 0 to ignore CPU load.",
+			Value:       serpent.Int64Of(&idleCPUThreshold),
+		},
 		{
 			Flag:        "autostart-requirement-weekdays",
 			Description: "Edit the template autostart requirement weekdays - workspaces created from this template can only autostart on the given weekdays. To unset this value for the template (and allow autostart on all days), pass 'all'.",
@@ -75,6 +75,35 @@ func TestTemplateEdit(t *testing.T) {
 		assert.Equal(t, defaultTTL.Milliseconds(), updated.DefaultTTLMillis)
 		assert.Equal(t, allowUserCancelWorkspaceJobs, updated.AllowUserCancelWorkspaceJobs)
 	})
+	t.Run("IdlePolicy", func(t *testing.T) {
+		t.Parallel()
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+		templateAdmin, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.RoleTemplateAdmin())
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		_ = coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+
+		inv, root := clitest.New(t, "templates", "edit", template.Name, "--idle-detection", "--idle-cpu-threshold", "60", "-y")
+		clitest.SetupConfig(t, templateAdmin, root)
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		err := inv.WithContext(ctx).Run()
+		require.NoError(t, err)
+
+		updated, err := client.Template(ctx, template.ID)
+		require.NoError(t, err)
+		assert.True(t, updated.IdleDetectionEnabled)
+		assert.EqualValues(t, 60, updated.IdleCPUThresholdPercent)
+		// Other schedule settings are left untouched.
+		assert.Equal(t, template.DefaultTTLMillis, updated.DefaultTTLMillis)
+		assert.Equal(t, template.ActivityBumpMillis, updated.ActivityBumpMillis)
+
+		inv, root = clitest.New(t, "templates", "edit", template.Name, "--idle-cpu-threshold", "101", "-y")
+		clitest.SetupConfig(t, templateAdmin, root)
+		err = inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "must be between 0 and 100")
+	})
 	t.Run("FirstEmptyThenNotModified", func(t *testing.T) {
 		t.Parallel()
 		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
@@ -0,0 +1,246 @@
+//go:build !slim
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/provisioner/terraform"
+	"github.com/coder/serpent"
+)
+
+func (*RootCmd) templateLint() *serpent.Command {
+	sarif := &sarifFormat{}
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(cliui.TextFormat(), func(data any) (any, error) {
+			diags, ok := data.([]terraform.LintDiagnostic)
+			if !ok {
+				return nil, xerrors.Errorf("expected []terraform.LintDiagnostic, got %T", data)
+			}
+			if len(diags) == 0 {
+				return "No problems found.", nil
+			}
+			var sb strings.Builder
+			for _, diag := range diags {
+				_, _ = fmt.Fprintln(&sb, diag.String())
+			}
+			errors, warnings := countLintDiagnostics(diags)
+			_, _ = fmt.Fprintf(&sb, "\n%d error(s), %d warning(s)", errors, warnings)
+			return sb.String(), nil
+		}),
+		cliui.JSONFormat(),
+		sarif,
+	)
+
+	cmd := &serpent.Command{
+		Use:   "lint [directory]",
+		Short: "Check a template for errors without pushing it",
+		Long: "Templates are checked offline, so only values that don't depend on variables, resources or functions are validated.\n" + FormatExamples(
+			Example{
+				Description: "Check the template in the current directory",
+				Command:     "coder templates lint",
+			},
+			Example{
+				Description: "Report problems to GitHub code scanning",
+				Command:     "coder templates lint ./my-template --output sarif > results.sarif",
+			},
+		),
+		Middleware: serpent.RequireRangeArgs(0, 1),
+		Handler: func(inv *serpent.Invocation) error {
+			dir := "."
+			if len(inv.Args) > 0 {
+				dir = inv.Args[0]
+			}
+			dir, err := filepath.Abs(dir)
+			if err != nil {
+				return xerrors.Errorf("resolve directory: %w", err)
+			}
+			sarif.templateDir = dir
+			sarif.workingDir, err = os.Getwd()
+			if err != nil {
+				return xerrors.Errorf("get working directory: %w", err)
+			}
+
+			diags, err := terraform.Lint(inv.Context(), inv.Logger, dir)
+			if err != nil {
+				return err
+			}
+			if diags == nil {
+				diags = []terraform.LintDiagnostic{}
+			}
+
+			out, err := formatter.Format(inv.Context(), diags)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+
+			if errors, _ := countLintDiagnostics(diags); errors > 0 {
+				return xerrors.Errorf("template has %d error(s)", errors)
+			}
+			return nil
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func countLintDiagnostics(diags []terraform.LintDiagnostic) (errors, warnings int) {
+	for _, diag := range diags {
+		switch diag.Severity {
+		case terraform.LintSeverityError:
+			errors++
+		case terraform.LintSeverityWarning:
+			warnings++
+		}
+	}
+	return errors, warnings
+}
+
+// sarifFormat formats lint diagnostics as a SARIF log, which code scanning
+// tools such as GitHub's accept.
+type sarifFormat struct {
+	// workingDir is the directory that file locations are relative to. Code
+	// scanning tools resolve them against the root of the repository, where
+	// the command usually runs.
+	workingDir string
+	// templateDir is the linted directory, which diagnostic filenames are
+	// relative to.
+	templateDir string
+}
+
+var _ cliui.OutputFormat = &sarifFormat{}
+
+func (*sarifFormat) ID() string {
+	return "sarif"
+}
+
+func (*sarifFormat) AttachOptions(_ *serpent.OptionSet) {}
+
+func (f *sarifFormat) Format(_ context.Context, data any) (string, error) {
+	diags, ok := data.([]terraform.LintDiagnostic)
+	if !ok {
+		return "", xerrors.Errorf("expected []terraform.LintDiagnostic, got %T", data)
+	}
+
+	type message struct {
+		Text string `json:"text"`
+	}
+	type rule struct {
+		ID               string  `json:"id"`
+		ShortDescription message `json:"shortDescription"`
+	}
+	type region struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn,omitempty"`
+	}
+	type physicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *region               `json:"region,omitempty"`
+	}
+	type location struct {
+		PhysicalLocation physicalLocation `json:"physicalLocation"`
+	}
+	type result struct {
+		RuleID    string     `json:"ruleId"`
+		Level     string     `json:"level"`
+		Message   message    `json:"message"`
+		Locations []location `json:"locations,omitempty"`
+	}
+
+	rules := make([]rule, 0, len(terraform.LintRules))
+	for _, r := range terraform.LintRules {
+		rules = append(rules, rule{ID: r.ID, ShortDescription: message{Text: r.Description}})
+	}
+	results := make([]result, 0, len(diags))
+	for _, diag := range diags {
+		res := result{
+			RuleID:  diag.Rule,
+			Level:   string(diag.Severity),
+			Message: message{Text: diag.Message},
+		}
+		if diag.Filename != "" {
+			var loc location
+			loc.PhysicalLocation.ArtifactLocation = f.artifactLocation(diag.Filename)
+			if diag.Line > 0 {
+				loc.PhysicalLocation.Region = &region{StartLine: diag.Line, StartColumn: diag.Column}
+			}
+			res.Locations = []location{loc}
+		}
+		results = append(results, res)
+	}
+
+	run := map[string]any{
+		"tool": map[string]any{
+			"driver": map[string]any{
+				"name":           "coder templates lint",
+				"informationUri": "https://coder.com/docs/reference/cli/templates_lint",
+				"rules":          rules,
+			},
+		},
+		"results": results,
+	}
+	if f.workingDir != "" {
+		run["originalUriBaseIds"] = map[string]any{
+			sarifSourceRoot: map[string]any{"uri": fileURI(f.workingDir, true)},
+		}
+	}
+	log := map[string]any{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs":    []any{run},
+	}
+	out, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return "", xerrors.Errorf("marshal SARIF: %w", err)
+	}
+	return string(out), nil
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+// sarifSourceRoot is the conventional base of file locations in the root of
+// the analyzed source.
+const sarifSourceRoot = "%SRCROOT%"
+
+// artifactLocation returns the location of a file in the template directory,
+// relative to the working directory when the template is in it.
+func (f *sarifFormat) artifactLocation(filename string) sarifArtifactLocation {
+	if f.templateDir == "" {
+		return sarifArtifactLocation{URI: filepath.ToSlash(filename)}
+	}
+	path := filepath.Join(f.templateDir, filepath.FromSlash(filename))
+	if f.workingDir != "" {
+		rel, err := filepath.Rel(f.workingDir, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return sarifArtifactLocation{URI: filepath.ToSlash(rel), URIBaseID: sarifSourceRoot}
+		}
+	}
+	return sarifArtifactLocation{URI: fileURI(path, false)}
+}
+
+// fileURI returns the file URI of an absolute path. Directory URIs must end
+// with a slash to be used as a base.
+func fileURI(path string, dir bool) string {
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(path)}
+	if !strings.HasPrefix(u.Path, "/") {
+		// Windows paths start with a drive letter.
+		u.Path = "/" + u.Path
+	}
+	if dir && !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u.String()
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/provisioner/terraform"
+)
+
+func TestSARIFFormat(t *testing.T) {
+	t.Parallel()
+
+	type sarifLog struct {
+		Runs []struct {
+			OriginalURIBaseIDs map[string]struct {
+				URI string `json:"uri"`
+			} `json:"originalUriBaseIds"`
+			Results []struct {
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	format := func(t *testing.T, f *sarifFormat) sarifLog {
+		t.Helper()
+		out, err := f.Format(context.Background(), []terraform.LintDiagnostic{{
+			Rule:     terraform.LintRuleAppSlug.ID,
+			Severity: terraform.LintSeverityError,
+			Message:  "invalid app slug",
+			Filename: "main.tf",
+			Line:     1,
+		}})
+		require.NoError(t, err)
+		var log sarifLog
+		require.NoError(t, json.Unmarshal([]byte(out), &log))
+		require.Len(t, log.Runs, 1)
+		require.Len(t, log.Runs[0].Results, 1)
+		require.Len(t, log.Runs[0].Results[0].Locations, 1)
+		return log
+	}
+
+	t.Run("InWorkingDirectory", func(t *testing.T) {
+		t.Parallel()
+		repo := t.TempDir()
+		log := format(t, &sarifFormat{
+			workingDir:  repo,
+			templateDir: filepath.Join(repo, "templates", "docker"),
+		})
+		location := log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation
+		require.Equal(t, "templates/docker/main.tf", location.URI)
+		require.Equal(t, sarifSourceRoot, location.URIBaseID)
+		require.Equal(t, fileURI(repo, true), log.Runs[0].OriginalURIBaseIDs[sarifSourceRoot].URI)
+	})
+
+	t.Run("OutsideWorkingDirectory", func(t *testing.T) {
+		t.Parallel()
+		template := t.TempDir()
+		log := format(t, &sarifFormat{
+			workingDir:  filepath.Join(template, "repo"),
+			templateDir: template,
+		})
+		location := log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation
+		require.Equal(t, fileURI(filepath.Join(template, "main.tf"), false), location.URI)
+		require.Empty(t, location.URIBaseID)
+	})
+}
@@ -0,0 +1,19 @@
+//go:build slim
+
+package cli
+
+import "github.com/coder/serpent"
+
+func (*RootCmd) templateLint() *serpent.Command {
+	return &serpent.Command{
+		Use:   "lint [directory]",
+		Short: "Check a template for errors without pushing it",
+		// We accept RawArgs so all commands and flags are accepted.
+		RawArgs: true,
+		Hidden:  true,
+		Handler: func(inv *serpent.Invocation) error {
+			SlimUnsupported(inv.Stderr, "templates lint")
+			return nil
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/provisioner/terraform"
+	"github.com/coder/coder/v2/pty/ptytest"
+)
+
+func TestTemplateLint(t *testing.T) {
+	t.Parallel()
+
+	const invalidTemplate = `
+resource "coder_agent" "main" {
+  os   = "linux"
+  arch = "amd64"
+}
+
+resource "null_resource" "dev" {
+  triggers = {
+    token = coder_agent.main.token
+  }
+}
+
+resource "coder_app" "code" {
+  agent_id = coder_agent.main.id
+  slug     = "Code_Server"
+}
+`
+
+	writeTemplate := func(t *testing.T, content string) string {
+		t.Helper()
+		dir := t.TempDir()
+		err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(content), 0o600)
+		require.NoError(t, err)
+		return dir
+	}
+
+	t.Run("Example", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		inv, _ := clitest.New(t, "templates", "init", "--id", "docker", dir)
+		clitest.Run(t, inv)
+
+		inv, _ = clitest.New(t, "templates", "lint", dir)
+		pty := ptytest.New(t).Attach(inv)
+		clitest.Start(t, inv)
+		pty.ExpectMatch("No problems found.")
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		t.Parallel()
+		dir := writeTemplate(t, invalidTemplate)
+		inv, _ := clitest.New(t, "templates", "lint", dir)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		err := inv.Run()
+		require.ErrorContains(t, err, "template has 1 error(s)")
+		require.Contains(t, stdout.String(), `main.tf:15:14: error: invalid app slug "Code_Server"`)
+		require.Contains(t, stdout.String(), "1 error(s), 0 warning(s)")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+		dir := writeTemplate(t, invalidTemplate)
+		inv, _ := clitest.New(t, "templates", "lint", dir, "--output", "json")
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		err := inv.Run()
+		require.Error(t, err)
+
+		var diags []terraform.LintDiagnostic
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &diags))
+		require.Len(t, diags, 1)
+		require.Equal(t, terraform.LintRuleAppSlug.ID, diags[0].Rule)
+		require.Equal(t, terraform.LintSeverityError, diags[0].Severity)
+		require.Equal(t, "main.tf", diags[0].Filename)
+		require.Equal(t, 15, diags[0].Line)
+	})
+
+	t.Run("SARIF", func(t *testing.T) {
+		t.Parallel()
+		dir := writeTemplate(t, invalidTemplate)
+		inv, _ := clitest.New(t, "templates", "lint", dir, "--output", "sarif")
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		err := inv.Run()
+		require.Error(t, err)
+
+		var log struct {
+			Version string `json:"version"`
+			Runs    []struct {
+				Results []struct {
+					RuleID    string `json:"ruleId"`
+					Level     string `json:"level"`
+					Locations []struct {
+						PhysicalLocation struct {
+							ArtifactLocation struct {
+								URI string `json:"uri"`
+							} `json:"artifactLocation"`
+							Region struct {
+								StartLine int `json:"startLine"`
+							} `json:"region"`
+						} `json:"physicalLocation"`
+					} `json:"locations"`
+				} `json:"results"`
+			} `json:"runs"`
+		}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &log))
+		require.Equal(t, "2.1.0", log.Version)
+		require.Len(t, log.Runs, 1)
+		require.Len(t, log.Runs[0].Results, 1)
+		result := log.Runs[0].Results[0]
+		require.Equal(t, terraform.LintRuleAppSlug.ID, result.RuleID)
+		require.Equal(t, "error", result.Level)
+		require.Len(t, result.Locations, 1)
+		// The template is outside of the working directory, so it's
+		// referenced by its absolute path.
+		uri := result.Locations[0].PhysicalLocation.ArtifactLocation.URI
+		require.True(t, strings.HasPrefix(uri, "file:///"), uri)
+		require.True(t, strings.HasSuffix(uri, "/main.tf"), uri)
+		require.Equal(t, 15, result.Locations[0].PhysicalLocation.Region.StartLine)
+	})
+
+	t.Run("Warnings", func(t *testing.T) {
+		t.Parallel()
+		dir := writeTemplate(t, `resource "null_resource" "dev" {}`)
+		inv, _ := clitest.New(t, "templates", "lint", dir)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		clitest.Run(t, inv)
+		require.Contains(t, stdout.String(), "warning: the template doesn't define a coder_agent")
+	})
+
+	t.Run("NoTemplate", func(t *testing.T) {
+		t.Parallel()
+		inv, _ := clitest.New(t, "templates", "lint", t.TempDir())
+		err := inv.Run()
+		require.ErrorContains(t, err, "no Terraform configuration files found")
+	})
+}
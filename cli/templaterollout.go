@@ -0,0 +1,293 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/pretty"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) templateRollout() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "rollout",
+		Short: "Roll out a template version to a canary cohort of workspaces",
+		Long: "While a version is rolled out, workspaces in the canary cohort are updated to the version when they are autostarted. " +
+			"Promote the version to make it the active version, or roll it back to move the canary cohort back to the active version.\n" + FormatExamples(
+			Example{
+				Description: "Roll out a version to 10% of the workspaces of a template",
+				Command:     "coder templates rollout start my-template --version v2 --percent 10",
+			},
+			Example{
+				Description: "Compare the build failures of the canary cohort with the active version",
+				Command:     "coder templates rollout status my-template",
+			},
+			Example{
+				Description: "Make the rolled out version the active version",
+				Command:     "coder templates rollout promote my-template",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.templateRolloutStart(),
+			r.templateRolloutStatus(),
+			r.templateRolloutPromote(),
+			r.templateRolloutRollback(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) templateRolloutStart() *serpent.Command {
+	var (
+		versionName string
+		percent     int64
+		groupName   string
+	)
+	client := new(codersdk.Client)
+	orgContext := NewOrganizationContext()
+	cmd := &serpent.Command{
+		Use:   "start <template>",
+		Short: "Start the canary rollout of a template version",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			if percent < 0 || percent > 100 {
+				return xerrors.New("--percent must be between 0 and 100")
+			}
+			if percent == 0 && groupName == "" {
+				return xerrors.New("at least one of --percent or --group must be provided")
+			}
+			organization, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return xerrors.Errorf("get current organization: %w", err)
+			}
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+			version, err := client.TemplateVersionByName(ctx, template.ID, versionName)
+			if err != nil {
+				return xerrors.Errorf("get template version by name: %w", err)
+			}
+			req := codersdk.CreateTemplateVersionRolloutRequest{
+				TemplateVersionID: version.ID,
+				Percent:           int32(percent),
+			}
+			if groupName != "" {
+				group, err := client.GroupByOrgAndName(ctx, organization.ID, groupName)
+				if err != nil {
+					return xerrors.Errorf("get group %q: %w", groupName, err)
+				}
+				req.GroupID = &group.ID
+			}
+
+			_, err = client.CreateTemplateVersionRollout(ctx, template.ID, req)
+			if err != nil {
+				return xerrors.Errorf("create rollout: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Started the rollout of version %s of template %s at %s.\n",
+				cliui.Keyword(version.Name), cliui.Keyword(template.Name), cliui.Timestamp(time.Now()))
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "version",
+			Description: "Name of the template version to roll out.",
+			Value:       serpent.StringOf(&versionName),
+			Required:    true,
+		},
+		{
+			Flag:        "percent",
+			Description: "Percentage of the workspaces of the template in the canary cohort.",
+			Value:       serpent.Int64Of(&percent),
+		},
+		{
+			Flag:        "group",
+			Description: "Name of a group whose members' workspaces are in the canary cohort regardless of the percentage.",
+			Value:       serpent.StringOf(&groupName),
+		},
+	}
+	orgContext.AttachOptions(cmd)
+	return cmd
+}
+
+type templateRolloutRow struct {
+	// For json format:
+	Rollout codersdk.TemplateVersionRollout `table:"-"`
+
+	// For table format:
+	Version      string `json:"-" table:"version,default_sort"`
+	Status       string `json:"-" table:"status"`
+	Percent      string `json:"-" table:"percent"`
+	Group        string `json:"-" table:"group"`
+	CanaryBuilds string `json:"-" table:"canary builds"`
+	ActiveBuilds string `json:"-" table:"active builds"`
+}
+
+func (r *RootCmd) templateRolloutStatus() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.TableFormat([]templateRolloutRow{}, nil),
+		cliui.JSONFormat(),
+	)
+	client := new(codersdk.Client)
+	orgContext := NewOrganizationContext()
+	cmd := &serpent.Command{
+		Use:   "status <template>",
+		Short: "Show the canary rollout of a template and the build failures of the canary cohort",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			organization, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return xerrors.Errorf("get current organization: %w", err)
+			}
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+			rollout, err := client.TemplateVersionRollout(ctx, template.ID)
+			if err != nil {
+				return xerrors.Errorf("get rollout: %w", err)
+			}
+
+			row := templateRolloutRow{
+				Rollout:      rollout,
+				Version:      rollout.TemplateVersionName,
+				Status:       string(rollout.Status),
+				Percent:      fmt.Sprintf("%d%%", rollout.Percent),
+				CanaryBuilds: formatRolloutBuildStats(rollout.CanaryBuilds),
+				ActiveBuilds: formatRolloutBuildStats(rollout.ActiveBuilds),
+			}
+			if rollout.GroupID != nil {
+				group, err := client.Group(ctx, *rollout.GroupID)
+				if err != nil {
+					return xerrors.Errorf("get group: %w", err)
+				}
+				row.Group = group.Name
+			}
+
+			out, err := formatter.Format(ctx, []templateRolloutRow{row})
+			if err != nil {
+				return xerrors.Errorf("render table: %w", err)
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	orgContext.AttachOptions(cmd)
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func formatRolloutBuildStats(stats codersdk.TemplateVersionRolloutBuildStats) string {
+	return fmt.Sprintf("%d/%d failed (%.1f%%)", stats.FailedBuilds, stats.TotalBuilds, stats.FailureRate()*100)
+}
+
+func (r *RootCmd) templateRolloutPromote() *serpent.Command {
+	client := new(codersdk.Client)
+	orgContext := NewOrganizationContext()
+	cmd := &serpent.Command{
+		Use:   "promote <template>",
+		Short: "Make the version of the canary rollout the active version of the template",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			organization, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return xerrors.Errorf("get current organization: %w", err)
+			}
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+			rollout, err := client.TemplateVersionRollout(ctx, template.ID)
+			if err != nil {
+				return xerrors.Errorf("get rollout: %w", err)
+			}
+			_, err = cliui.Prompt(inv, cliui.PromptOptions{
+				Text:      fmt.Sprintf("Promote version %s to the active version of %s?", pretty.Sprint(cliui.DefaultStyles.Code, rollout.TemplateVersionName), pretty.Sprint(cliui.DefaultStyles.Code, template.Name)),
+				IsConfirm: true,
+				Default:   cliui.ConfirmNo,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = client.PromoteTemplateVersionRollout(ctx, template.ID)
+			if err != nil {
+				return xerrors.Errorf("promote rollout: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Promoted version %s to the active version of template %s.\n",
+				cliui.Keyword(rollout.TemplateVersionName), cliui.Keyword(template.Name))
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{cliui.SkipPromptOption()}
+	orgContext.AttachOptions(cmd)
+	return cmd
+}
+
+func (r *RootCmd) templateRolloutRollback() *serpent.Command {
+	client := new(codersdk.Client)
+	orgContext := NewOrganizationContext()
+	cmd := &serpent.Command{
+		Use:   "rollback <template>",
+		Short: "Roll back the canary rollout of a template",
+		Long:  "Workspaces of the canary cohort move back to the active version when they are next autostarted.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			organization, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return xerrors.Errorf("get current organization: %w", err)
+			}
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+			rollout, err := client.TemplateVersionRollout(ctx, template.ID)
+			if err != nil {
+				return xerrors.Errorf("get rollout: %w", err)
+			}
+			_, err = cliui.Prompt(inv, cliui.PromptOptions{
+				Text:      fmt.Sprintf("Roll back version %s of %s?", pretty.Sprint(cliui.DefaultStyles.Code, rollout.TemplateVersionName), pretty.Sprint(cliui.DefaultStyles.Code, template.Name)),
+				IsConfirm: true,
+				Default:   cliui.ConfirmNo,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = client.RollBackTemplateVersionRollout(ctx, template.ID)
+			if err != nil {
+				return xerrors.Errorf("roll back rollout: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Rolled back version %s of template %s.\n",
+				cliui.Keyword(rollout.TemplateVersionName), cliui.Keyword(template.Name))
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{cliui.SkipPromptOption()}
+	orgContext.AttachOptions(cmd)
+	return cmd
+}
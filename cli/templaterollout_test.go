@@ -0,0 +1,97 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestTemplateRollout(t *testing.T) {
+	t.Parallel()
+
+	ownerClient := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	client, _ := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID, rbac.RoleTemplateAdmin())
+
+	// setup creates a template with a second version to roll out.
+	setup := func(t *testing.T) (codersdk.Template, codersdk.TemplateVersion) {
+		t.Helper()
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		_ = coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		canary := coderdtest.UpdateTemplateVersion(t, client, owner.OrganizationID, nil, template.ID)
+		_ = coderdtest.AwaitTemplateVersionJobCompleted(t, client, canary.ID)
+		return template, canary
+	}
+
+	t.Run("StartPromote", func(t *testing.T) {
+		t.Parallel()
+		template, canary := setup(t)
+
+		inv, root := clitest.New(t, "templates", "rollout", "start", template.Name, "--version", canary.Name, "--percent", "20")
+		clitest.SetupConfig(t, client, root)
+		clitest.Run(t, inv)
+
+		inv, root = clitest.New(t, "templates", "rollout", "status", template.Name, "--output", "json")
+		clitest.SetupConfig(t, client, root)
+		var out bytes.Buffer
+		inv.Stdout = &out
+		clitest.Run(t, inv)
+
+		var rows []struct {
+			Rollout codersdk.TemplateVersionRollout
+		}
+		require.NoError(t, json.Unmarshal(out.Bytes(), &rows))
+		require.Len(t, rows, 1)
+		require.Equal(t, canary.ID, rows[0].Rollout.TemplateVersionID)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusCanary, rows[0].Rollout.Status)
+		require.EqualValues(t, 20, rows[0].Rollout.Percent)
+
+		inv, root = clitest.New(t, "templates", "rollout", "promote", template.Name, "-y")
+		clitest.SetupConfig(t, client, root)
+		clitest.Run(t, inv)
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		updated, err := client.Template(ctx, template.ID)
+		require.NoError(t, err)
+		require.Equal(t, canary.ID, updated.ActiveVersionID)
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		t.Parallel()
+		template, canary := setup(t)
+
+		inv, root := clitest.New(t, "templates", "rollout", "start", template.Name, "--version", canary.Name, "--percent", "20")
+		clitest.SetupConfig(t, client, root)
+		clitest.Run(t, inv)
+
+		inv, root = clitest.New(t, "templates", "rollout", "rollback", template.Name, "-y")
+		clitest.SetupConfig(t, client, root)
+		clitest.Run(t, inv)
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		updated, err := client.Template(ctx, template.ID)
+		require.NoError(t, err)
+		require.Equal(t, template.ActiveVersionID, updated.ActiveVersionID)
+		_, err = client.TemplateVersionRollout(ctx, template.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("RequiresPercentOrGroup", func(t *testing.T) {
+		t.Parallel()
+		template, canary := setup(t)
+
+		inv, root := clitest.New(t, "templates", "rollout", "start", template.Name, "--version", canary.Name)
+		clitest.SetupConfig(t, client, root)
+		err := inv.Run()
+		require.ErrorContains(t, err, "at least one of --percent or --group")
+	})
+}
@@ -30,11 +30,13 @@ func (r *RootCmd) templates() *serpent.Command {
 			r.templateCreate(),
 			r.templateEdit(),
 			r.templateInit(),
+			r.templateLint(),
 			r.templateList(),
 			r.templatePush(),
 			r.templateVersions(),
 			r.templateDelete(),
 			r.templatePull(),
+			r.templateRollout(),
 			r.archiveTemplateVersions(),
 		},
 	}
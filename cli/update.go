@@ -5,6 +5,7 @@ import (
 
 	"golang.org/x/xerrors"
 
+	"github.com/coder/coder/v2/cli/cliui"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/serpent"
 )
@@ -24,6 +25,7 @@ func (r *RootCmd) update() *serpent.Command {
 			serpent.RequireNArgs(1),
 			r.InitClient(client),
 		),
+		Options: serpent.OptionSet{cliui.SkipPromptOption()},
 		Handler: func(inv *serpent.Invocation) error {
 			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
 			if err != nil {
@@ -57,5 +59,6 @@ func (r *RootCmd) update() *serpent.Command {
 
 	cmd.Options = append(cmd.Options, parameterFlags.allOptions()...)
 	cmd.Options = append(cmd.Options, bflags.cliOptions()...)
+	cmd.Options = append(cmd.Options, bflags.planOption())
 	return cmd
 }
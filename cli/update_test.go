@@ -78,6 +78,62 @@ func TestUpdate(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, version2.ID.String(), ws.LatestBuild.TemplateVersionID.String())
 	})
+
+	t.Run("Plan", func(t *testing.T) {
+		t.Parallel()
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+		member, memberUser := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+		version1 := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version1.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version1.ID)
+		workspace := coderdtest.CreateWorkspace(t, member, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, member, workspace.LatestBuild.ID)
+
+		version2 := coderdtest.UpdateTemplateVersion(t, client, owner.OrganizationID, &echo.Responses{
+			Parse:          echo.ParseComplete,
+			ProvisionApply: echo.ApplyComplete,
+			ProvisionPlan: []*proto.Response{{
+				Type: &proto.Response_Plan{
+					Plan: &proto.PlanComplete{
+						ResourceChanges: []*proto.ResourceChange{{
+							Address: "docker_container.workspace[0]",
+							Type:    "docker_container",
+							Name:    "workspace",
+							Action:  proto.ResourceChange_REPLACE,
+						}},
+					},
+				},
+			}},
+		}, template.ID)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version2.ID)
+		err := client.UpdateActiveTemplateVersion(context.Background(), template.ID, codersdk.UpdateActiveTemplateVersion{
+			ID: version2.ID,
+		})
+		require.NoError(t, err)
+
+		inv, root := clitest.New(t, "update", workspace.Name, "--plan")
+		clitest.SetupConfig(t, member, root)
+		doneChan := make(chan struct{})
+		pty := ptytest.New(t).Attach(inv)
+		go func() {
+			defer close(doneChan)
+			err := inv.Run()
+			assert.NoError(t, err)
+		}()
+
+		pty.ExpectMatch("docker_container.workspace[0]")
+		pty.ExpectMatch("1 to replace")
+		// The replaced container must be confirmed before the build starts.
+		pty.ExpectMatch("Continue?")
+		pty.WriteLine("yes")
+		<-doneChan
+
+		ws, err := member.WorkspaceByOwnerAndName(context.Background(), memberUser.Username, workspace.Name, codersdk.WorkspaceOptions{})
+		require.NoError(t, err)
+		require.Equal(t, version2.ID, ws.LatestBuild.TemplateVersionID)
+	})
 }
 
 func TestUpdateWithRichParameters(t *testing.T) {
@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) workspaces() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:     "workspaces",
+		Short:   "Manage bulk operations and snapshots of workspaces",
+		Aliases: []string{"workspace"},
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.workspacesBulk(),
+			r.workspacesSnapshot(),
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/pretty"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) workspacesBulk() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "bulk",
+		Short: "Start, stop, update or delete all workspaces matching a search query",
+		Long: "Builds are enqueued by the server in the background at a limited rate to protect provisioner capacity. " +
+			"Each workspace is built as if you had built it yourself, so workspaces you cannot build are reported as failed.\n" + FormatExamples(
+			Example{
+				Description: "Update all outdated workspaces of a template",
+				Command:     `coder workspaces bulk update -s "template:docker outdated:true"`,
+			},
+			Example{
+				Description: "Stop all running workspaces of a user",
+				Command:     `coder workspaces bulk stop -s "owner:alice status:running"`,
+			},
+			Example{
+				Description: "Show the progress of a bulk operation",
+				Command:     "coder workspaces bulk status <id>",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.workspacesBulkAction(codersdk.WorkspaceBulkOperationActionStart, "Start all workspaces matching a search query"),
+			r.workspacesBulkAction(codersdk.WorkspaceBulkOperationActionStop, "Stop all workspaces matching a search query"),
+			r.workspacesBulkAction(codersdk.WorkspaceBulkOperationActionUpdate, "Update all workspaces matching a search query to the active template version"),
+			r.workspacesBulkAction(codersdk.WorkspaceBulkOperationActionDelete, "Delete all workspaces matching a search query"),
+			r.workspacesBulkStatus(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) workspacesBulkAction(action codersdk.WorkspaceBulkOperationAction, short string) *serpent.Command {
+	var (
+		search string
+		detach bool
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   string(action),
+		Short: short,
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			res, err := client.Workspaces(ctx, codersdk.WorkspaceFilter{FilterQuery: search})
+			if err != nil {
+				return xerrors.Errorf("search workspaces: %w", err)
+			}
+			if res.Count == 0 {
+				return xerrors.Errorf("no workspaces match %q", search)
+			}
+
+			names := make([]string, 0, len(res.Workspaces))
+			for _, ws := range res.Workspaces {
+				names = append(names, ws.OwnerName+"/"+ws.Name)
+			}
+			const maxListed = 10
+			if len(names) > maxListed {
+				names = append(names[:maxListed], fmt.Sprintf("and %d more", len(names)-maxListed))
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Matching workspaces:\n  %s\n\n", strings.Join(names, "\n  "))
+			_, err = cliui.Prompt(inv, cliui.PromptOptions{
+				Text:      fmt.Sprintf("%s %s?", bulkActionVerb(action), pretty.Sprint(cliui.DefaultStyles.Code, pluralize(res.Count, "workspace"))),
+				IsConfirm: true,
+				Default:   cliui.ConfirmNo,
+			})
+			if err != nil {
+				return err
+			}
+
+			op, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+				Action: action,
+				Search: search,
+			})
+			if err != nil {
+				return xerrors.Errorf("create bulk operation: %w", err)
+			}
+			if detach {
+				_, _ = fmt.Fprintf(inv.Stdout, "Started bulk operation %s at %s. Show its progress with %s.\n",
+					cliui.Keyword(op.ID.String()), cliui.Timestamp(time.Now()),
+					pretty.Sprint(cliui.DefaultStyles.Code, "coder workspaces bulk status "+op.ID.String()))
+				return nil
+			}
+			return watchWorkspaceBulkOperation(inv, client, op.ID)
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:          "search",
+			FlagShorthand: "s",
+			Description:   "Search for workspaces with a query, as in \"coder list --search\".",
+			Value:         serpent.StringOf(&search),
+			Required:      true,
+		},
+		{
+			Flag:        "detach",
+			Description: "Return once the bulk operation was created instead of waiting for its builds.",
+			Value:       serpent.BoolOf(&detach),
+		},
+		cliui.SkipPromptOption(),
+	}
+	return cmd
+}
+
+// watchWorkspaceBulkOperation prints the progress of a bulk operation until
+// all of its builds completed.
+func watchWorkspaceBulkOperation(inv *serpent.Invocation, client *codersdk.Client, id uuid.UUID) error {
+	ctx := inv.Context()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	printed := map[uuid.UUID]string{}
+	for {
+		op, err := client.WorkspaceBulkOperation(ctx, id)
+		if err != nil {
+			return xerrors.Errorf("get bulk operation: %w", err)
+		}
+		for _, ws := range op.Workspaces {
+			state := workspaceBulkOperationState(ws)
+			if ws.Status == codersdk.WorkspaceBulkOperationWorkspaceStatusPending || printed[ws.WorkspaceID] == state {
+				continue
+			}
+			printed[ws.WorkspaceID] = state
+			_, _ = fmt.Fprintf(inv.Stdout, "%s: %s\n", cliui.Keyword(ws.WorkspaceOwnerName+"/"+ws.WorkspaceName), state)
+		}
+		if op.Done() {
+			return summarizeWorkspaceBulkOperation(inv, op)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func summarizeWorkspaceBulkOperation(inv *serpent.Invocation, op codersdk.WorkspaceBulkOperation) error {
+	var succeeded, skipped, failed int
+	for _, ws := range op.Workspaces {
+		switch {
+		case ws.Status == codersdk.WorkspaceBulkOperationWorkspaceStatusSkipped:
+			skipped++
+		case ws.Status == codersdk.WorkspaceBulkOperationWorkspaceStatusFailed,
+			ws.JobStatus != nil && *ws.JobStatus != codersdk.ProvisionerJobSucceeded:
+			failed++
+		default:
+			succeeded++
+		}
+	}
+	_, _ = fmt.Fprintf(inv.Stdout, "\nBulk operation %s completed: %d succeeded, %d skipped, %d failed.\n",
+		cliui.Keyword(op.ID.String()), succeeded, skipped, failed)
+	if failed > 0 {
+		return xerrors.Errorf("%s failed", pluralize(failed, "workspace"))
+	}
+	return nil
+}
+
+// workspaceBulkOperationState describes the progress of a workspace, e.g.
+// "enqueued (running)" or "skipped: Workspace is already stopped.".
+func workspaceBulkOperationState(ws codersdk.WorkspaceBulkOperationWorkspace) string {
+	state := string(ws.Status)
+	if ws.JobStatus != nil {
+		state += fmt.Sprintf(" (%s)", *ws.JobStatus)
+	}
+	if ws.Message != "" {
+		state += ": " + ws.Message
+	}
+	return state
+}
+
+func bulkActionVerb(action codersdk.WorkspaceBulkOperationAction) string {
+	s := string(action)
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+type workspaceBulkOperationRow struct {
+	// For json format:
+	Workspace codersdk.WorkspaceBulkOperationWorkspace `table:"-"`
+
+	// For table format:
+	Name    string `json:"-" table:"workspace,default_sort"`
+	Status  string `json:"-" table:"status"`
+	Build   string `json:"-" table:"build"`
+	Message string `json:"-" table:"message"`
+}
+
+func (r *RootCmd) workspacesBulkStatus() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.TableFormat([]workspaceBulkOperationRow{}, nil),
+		cliui.JSONFormat(),
+	)
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "status <id>",
+		Short: "Show the progress of the builds of a bulk operation",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			id, err := uuid.Parse(inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("parse bulk operation id: %w", err)
+			}
+			op, err := client.WorkspaceBulkOperation(ctx, id)
+			if err != nil {
+				return xerrors.Errorf("get bulk operation: %w", err)
+			}
+
+			rows := make([]workspaceBulkOperationRow, 0, len(op.Workspaces))
+			for _, ws := range op.Workspaces {
+				row := workspaceBulkOperationRow{
+					Workspace: ws,
+					Name:      ws.WorkspaceOwnerName + "/" + ws.WorkspaceName,
+					Status:    string(ws.Status),
+					Message:   ws.Message,
+				}
+				if ws.JobStatus != nil {
+					row.Build = string(*ws.JobStatus)
+				}
+				rows = append(rows, row)
+			}
+			out, err := formatter.Format(ctx, rows)
+			if err != nil {
+				return xerrors.Errorf("render table: %w", err)
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
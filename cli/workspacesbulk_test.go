@@ -0,0 +1,73 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspacesBulk(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	member, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+	version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+	first := coderdtest.CreateWorkspace(t, member, template.ID)
+	coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, first.LatestBuild.ID)
+	second := coderdtest.CreateWorkspace(t, client, template.ID)
+	coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, second.LatestBuild.ID)
+
+	inv, root := clitest.New(t, "workspaces", "bulk", "stop", "--search", "template:"+template.Name, "-y")
+	clitest.SetupConfig(t, client, root)
+	var out bytes.Buffer
+	inv.Stdout = &out
+	clitest.Run(t, inv)
+	require.Contains(t, out.String(), "2 succeeded, 0 skipped, 0 failed")
+
+	ctx := testutil.Context(t, testutil.WaitMedium)
+	for _, id := range []uuid.UUID{first.ID, second.ID} {
+		workspace, err := client.Workspace(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, codersdk.WorkspaceTransitionStop, workspace.LatestBuild.Transition)
+	}
+
+	// Stopping again skips both workspaces.
+	inv, root = clitest.New(t, "workspaces", "bulk", "stop", "--search", "template:"+template.Name, "-y", "--detach")
+	clitest.SetupConfig(t, client, root)
+	out.Reset()
+	inv.Stdout = &out
+	clitest.Run(t, inv)
+	id := regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`).FindString(out.String())
+	require.NotEmpty(t, id, out.String())
+
+	var rows []struct {
+		Workspace codersdk.WorkspaceBulkOperationWorkspace
+	}
+	require.Eventually(t, func() bool {
+		inv, root := clitest.New(t, "workspaces", "bulk", "status", id, "--output", "json")
+		clitest.SetupConfig(t, client, root)
+		var out bytes.Buffer
+		inv.Stdout = &out
+		clitest.Run(t, inv)
+		require.NoError(t, json.Unmarshal(out.Bytes(), &rows))
+		for _, row := range rows {
+			if row.Workspace.Status != codersdk.WorkspaceBulkOperationWorkspaceStatusSkipped {
+				return false
+			}
+		}
+		return true
+	}, testutil.WaitMedium, testutil.IntervalFast)
+	require.Len(t, rows, 2)
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) workspacesSnapshot() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "snapshot",
+		Short: "Export and restore snapshots of workspace builds",
+		Long: "A snapshot contains the template version, parameter values and " +
+			"agent metadata of the latest build of a workspace. Template " +
+			"administrators also receive the Terraform state of the build.",
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.workspacesSnapshotExport(),
+			r.workspacesSnapshotRestore(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) workspacesSnapshotExport() *serpent.Command {
+	var deleted bool
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "export <workspace> [file]",
+		Short: "Export a snapshot of the latest build of a workspace.",
+		Long: "Snapshots of deleted workspaces are taken from the build that deleted " +
+			"them, so they contain the template version and parameter values of the " +
+			"workspace, but no agent metadata or state to restore.",
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(1, 2),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			var (
+				workspace codersdk.Workspace
+				err       error
+			)
+			if deleted {
+				owner, name, splitErr := splitNamedWorkspace(inv.Args[0])
+				if splitErr != nil {
+					return splitErr
+				}
+				workspace, err = client.WorkspaceByOwnerAndName(inv.Context(), owner, name, codersdk.WorkspaceOptions{IncludeDeleted: true})
+			} else {
+				workspace, err = namedWorkspace(inv.Context(), client, inv.Args[0])
+			}
+			if err != nil {
+				return err
+			}
+			snapshot, err := client.WorkspaceSnapshot(inv.Context(), workspace.ID)
+			if err != nil {
+				return xerrors.Errorf("get snapshot: %w", err)
+			}
+			data, err := json.MarshalIndent(snapshot, "", "  ")
+			if err != nil {
+				return xerrors.Errorf("marshal snapshot: %w", err)
+			}
+
+			if len(inv.Args) < 2 || inv.Args[1] == "-" {
+				_, _ = fmt.Fprintln(inv.Stdout, string(data))
+				return nil
+			}
+			if len(snapshot.State) == 0 {
+				cliui.Warn(inv.Stderr, "The snapshot does not contain the Terraform state of the build. Only template administrators can export state.")
+			}
+			return os.WriteFile(inv.Args[1], data, 0o600)
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "deleted",
+			Description: "Export the most recently deleted workspace with the given name if no such workspace exists.",
+			Value:       serpent.BoolOf(&deleted),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) workspacesSnapshotRestore() *serpent.Command {
+	var restoreState bool
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "restore <file> <name>",
+		Short: "Create a new workspace from a snapshot.",
+		Long: FormatExamples(
+			Example{
+				Description: "Restore a snapshot as a new workspace",
+				Command:     "coder workspaces snapshot restore my-workspace.json my-restored-workspace",
+			},
+			Example{
+				Description: "Restore a snapshot and adopt the resources of the original build",
+				Command:     "coder workspaces snapshot restore --restore-state my-workspace.json my-restored-workspace",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			var (
+				data []byte
+				err  error
+			)
+			if inv.Args[0] == "-" {
+				data, err = io.ReadAll(inv.Stdin)
+			} else {
+				data, err = os.ReadFile(inv.Args[0])
+			}
+			if err != nil {
+				return xerrors.Errorf("read snapshot: %w", err)
+			}
+			var snapshot codersdk.WorkspaceSnapshot
+			err = json.Unmarshal(data, &snapshot)
+			if err != nil {
+				return xerrors.Errorf("decode snapshot: %w", err)
+			}
+
+			workspace, err := client.RestoreWorkspaceSnapshot(inv.Context(), codersdk.Me, codersdk.RestoreWorkspaceSnapshotRequest{
+				Name:         inv.Args[1],
+				Snapshot:     snapshot,
+				RestoreState: restoreState,
+			})
+			if err != nil {
+				return xerrors.Errorf("restore snapshot: %w", err)
+			}
+
+			err = cliui.WorkspaceBuild(inv.Context(), inv.Stdout, client, workspace.LatestBuild.ID)
+			if err != nil {
+				return xerrors.Errorf("watch build: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(
+				inv.Stdout,
+				"\nThe %s workspace has been restored from %s at %s!\n",
+				cliui.Keyword(workspace.Name),
+				cliui.Keyword(snapshot.OwnerName+"/"+snapshot.WorkspaceName),
+				cliui.Timestamp(time.Now()),
+			)
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag: "restore-state",
+			Description: "Import the Terraform state of the snapshot, so the new workspace adopts the resources of the " +
+				"snapshotted build instead of provisioning new ones. Only template administrators may restore state.",
+			Value: serpent.BoolOf(&restoreState),
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,165 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/provisioner/echo"
+	"github.com/coder/coder/v2/provisionersdk/proto"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestSnapshotExport(t *testing.T) {
+	t.Parallel()
+
+	client, store := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+	templateAdmin, taUser := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.RoleTemplateAdmin())
+	wantState := []byte("some state")
+	r := dbfake.WorkspaceBuild(t, store, database.Workspace{
+		OrganizationID: owner.OrganizationID,
+		OwnerID:        taUser.ID,
+	}).
+		Seed(database.WorkspaceBuild{ProvisionerState: wantState}).
+		Do()
+
+	t.Run("File", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+		inv, root := clitest.New(t, "workspaces", "snapshot", "export", r.Workspace.Name, snapshotPath)
+		clitest.SetupConfig(t, templateAdmin, root)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		data, err := os.ReadFile(snapshotPath)
+		require.NoError(t, err)
+		var snapshot codersdk.WorkspaceSnapshot
+		require.NoError(t, json.Unmarshal(data, &snapshot))
+		require.Equal(t, r.Workspace.ID, snapshot.WorkspaceID)
+		require.Equal(t, r.Build.TemplateVersionID, snapshot.TemplateVersionID)
+		require.Equal(t, wantState, snapshot.State)
+	})
+
+	t.Run("Stdout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "workspaces", "snapshot", "export", r.Workspace.Name)
+		clitest.SetupConfig(t, templateAdmin, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		var snapshot codersdk.WorkspaceSnapshot
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &snapshot))
+		require.Equal(t, codersdk.WorkspaceSnapshotVersion, snapshot.Version)
+		require.Equal(t, r.Workspace.Name, snapshot.WorkspaceName)
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		deleted := dbfake.WorkspaceBuild(t, store, database.Workspace{
+			OrganizationID: owner.OrganizationID,
+			OwnerID:        taUser.ID,
+		}).
+			Seed(database.WorkspaceBuild{Transition: database.WorkspaceTransitionDelete}).
+			Do()
+		// nolint:gocritic // this is a test
+		err := store.UpdateWorkspaceDeletedByID(dbauthz.AsProvisionerd(ctx), database.UpdateWorkspaceDeletedByIDParams{
+			ID:      deleted.Workspace.ID,
+			Deleted: true,
+		})
+		require.NoError(t, err)
+
+		inv, root := clitest.New(t, "workspaces", "snapshot", "export", deleted.Workspace.Name)
+		clitest.SetupConfig(t, templateAdmin, root)
+		require.Error(t, inv.WithContext(ctx).Run())
+
+		inv, root = clitest.New(t, "workspaces", "snapshot", "export", "--deleted", deleted.Workspace.Name)
+		clitest.SetupConfig(t, templateAdmin, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		var snapshot codersdk.WorkspaceSnapshot
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &snapshot))
+		require.Equal(t, deleted.Workspace.ID, snapshot.WorkspaceID)
+		require.Equal(t, codersdk.WorkspaceTransitionDelete, snapshot.Transition)
+	})
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	templateAdmin, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.RoleTemplateAdmin())
+	wantState := []byte("some magic state")
+	version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, &echo.Responses{
+		Parse:         echo.ParseComplete,
+		ProvisionPlan: echo.PlanComplete,
+		ProvisionApply: []*proto.Response{{
+			Type: &proto.Response_Apply{
+				Apply: &proto.ApplyComplete{
+					State: wantState,
+				},
+			},
+		}},
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+	workspace := coderdtest.CreateWorkspace(t, templateAdmin, template.ID)
+	coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	snapshot, err := templateAdmin.WorkspaceSnapshot(ctx, workspace.ID)
+	require.NoError(t, err)
+	data, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, os.WriteFile(snapshotPath, data, 0o600))
+
+	t.Run("File", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "workspaces", "snapshot", "restore", "--restore-state", snapshotPath, "restored")
+		clitest.SetupConfig(t, templateAdmin, root)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		restored, err := templateAdmin.WorkspaceByOwnerAndName(ctx, codersdk.Me, "restored", codersdk.WorkspaceOptions{})
+		require.NoError(t, err)
+		require.Equal(t, version.ID, restored.LatestBuild.TemplateVersionID)
+		state, err := templateAdmin.WorkspaceBuildState(ctx, restored.LatestBuild.ID)
+		require.NoError(t, err)
+		require.Equal(t, wantState, state)
+	})
+
+	t.Run("Stdin", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		inv, root := clitest.New(t, "workspaces", "snapshot", "restore", "-", "restored-stdin")
+		clitest.SetupConfig(t, templateAdmin, root)
+		inv.Stdin = bytes.NewReader(data)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		_, err := templateAdmin.WorkspaceByOwnerAndName(ctx, codersdk.Me, "restored-stdin", codersdk.WorkspaceOptions{})
+		require.NoError(t, err)
+	})
+}
@@ -42,6 +42,8 @@ type API struct {
 	*AppsAPI
 	*MetadataAPI
 	*LogsAPI
+	*SessionRecordingAPI
+	*ConnectionLogAPI
 	*tailnet.DRPCService
 
 	mu                sync.Mutex
@@ -73,6 +75,7 @@ type Options struct {
 	DerpMapUpdateFrequency    time.Duration
 	ExternalAuthConfigs       []*externalauth.Config
 	Experiments               codersdk.Experiments
+	SessionRecording          codersdk.SessionRecordingConfig
 
 	// Optional:
 	// WorkspaceID avoids a future lookup to find the workspace ID by setting
@@ -94,6 +97,7 @@ func New(opts Options) *API {
 		ExternalAuthConfigs:      opts.ExternalAuthConfigs,
 		DisableDirectConnections: opts.DisableDirectConnections,
 		DerpForceWebSockets:      opts.DerpForceWebSockets,
+		SessionRecording:         opts.SessionRecording,
 		AgentFn:                  api.agent,
 		Database:                 opts.Database,
 		DerpMapFn:                opts.DerpMapFn,
@@ -152,6 +156,22 @@ func New(opts Options) *API {
 		PublishWorkspaceAgentLogsUpdateFn: opts.PublishWorkspaceAgentLogsUpdateFn,
 	}
 
+	api.SessionRecordingAPI = &SessionRecordingAPI{
+		AgentFn:       api.agent,
+		WorkspaceIDFn: api.workspaceID,
+		Database:      opts.Database,
+		Log:           opts.Log,
+		MaxSize:       opts.SessionRecording.MaxSize.Value(),
+	}
+
+	api.ConnectionLogAPI = &ConnectionLogAPI{
+		AgentFn:            api.agent,
+		WorkspaceIDFn:      api.workspaceID,
+		Database:           opts.Database,
+		Log:                opts.Log,
+		TailnetCoordinator: opts.TailnetCoordinator,
+	}
+
 	api.DRPCService = &tailnet.DRPCService{
 		CoordPtr:                opts.TailnetCoordinator,
 		Logger:                  opts.Log,
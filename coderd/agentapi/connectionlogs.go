@@ -0,0 +1,155 @@
+package agentapi
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"net/netip"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	agentproto "github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/tailnet"
+)
+
+type ConnectionLogAPI struct {
+	AgentFn            func(context.Context) (database.WorkspaceAgent, error)
+	WorkspaceIDFn      func(context.Context, *database.WorkspaceAgent) (uuid.UUID, error)
+	Database           database.Store
+	Log                slog.Logger
+	TailnetCoordinator *atomic.Pointer[tailnet.Coordinator]
+}
+
+// ReportConnection records a connection to or from the agent being opened or
+// closed. The connect and disconnect events of a connection share an ID and
+// are merged into a single connection log.
+func (a *ConnectionLogAPI) ReportConnection(ctx context.Context, req *agentproto.ReportConnectionRequest) (*agentproto.ReportConnectionResponse, error) {
+	conn := req.GetConnection()
+	if conn == nil {
+		return nil, xerrors.New("connection is required")
+	}
+	connectionID, err := uuid.FromBytes(conn.Id)
+	if err != nil {
+		return nil, xerrors.Errorf("parse connection ID %q: %w", conn.Id, err)
+	}
+	connectionType, err := connectionTypeFromProto(conn.Type)
+	if err != nil {
+		return nil, err
+	}
+	if conn.Action != agentproto.Connection_CONNECT && conn.Action != agentproto.Connection_DISCONNECT {
+		return nil, xerrors.Errorf("unknown connection action %q", conn.Action)
+	}
+	if conn.Timestamp == nil {
+		return nil, xerrors.New("timestamp is required")
+	}
+
+	workspaceAgent, err := a.AgentFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	workspaceID, err := a.WorkspaceIDFn(ctx, &workspaceAgent)
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := a.Database.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return nil, xerrors.Errorf("get workspace by id %q: %w", workspaceID, err)
+	}
+
+	var ip pqtype.Inet
+	addr, err := netip.ParseAddr(conn.Ip)
+	if err == nil {
+		bits := addr.BitLen()
+		ip = pqtype.Inet{
+			IPNet: net.IPNet{
+				IP:   addr.AsSlice(),
+				Mask: net.CIDRMask(bits, bits),
+			},
+			Valid: true,
+		}
+	}
+
+	params := database.UpsertConnectionLogParams{
+		ID:               uuid.New(),
+		ConnectTime:      conn.Timestamp.AsTime(),
+		OrganizationID:   workspace.OrganizationID,
+		WorkspaceOwnerID: workspace.OwnerID,
+		WorkspaceID:      workspace.ID,
+		WorkspaceName:    workspace.Name,
+		AgentID:          workspaceAgent.ID,
+		AgentName:        workspaceAgent.Name,
+		Type:             connectionType,
+		Ip:               ip,
+		UserID:           a.userByIP(ctx, workspaceAgent.ID, addr),
+		ConnectionID:     connectionID,
+	}
+	if conn.Action == agentproto.Connection_DISCONNECT {
+		params.DisconnectTime = sql.NullTime{Time: conn.Timestamp.AsTime(), Valid: true}
+		params.Code = sql.NullInt32{Int32: conn.StatusCode, Valid: true}
+		params.DisconnectReason = sql.NullString{String: conn.GetReason(), Valid: conn.Reason != nil}
+	}
+
+	_, err = a.Database.UpsertConnectionLog(ctx, params)
+	if err != nil {
+		return nil, xerrors.Errorf("upsert connection log: %w", err)
+	}
+
+	return &agentproto.ReportConnectionResponse{}, nil
+}
+
+// userByIP finds the user behind a tailnet address by matching it against the
+// addresses of the peers coordinating with the agent. Connections that do not
+// come from a known peer, like those proxied by coderd, have no user.
+func (a *ConnectionLogAPI) userByIP(ctx context.Context, agentID uuid.UUID, addr netip.Addr) uuid.NullUUID {
+	if !addr.IsValid() || a.TailnetCoordinator == nil {
+		return uuid.NullUUID{}
+	}
+	coord := a.TailnetCoordinator.Load()
+	if coord == nil {
+		return uuid.NullUUID{}
+	}
+
+	//nolint:gocritic // The agent cannot read the peer mapping itself.
+	peers, err := a.Database.GetWorkspaceAgentClientPeersByAgentID(dbauthz.AsSystemRestricted(ctx), agentID)
+	if err != nil {
+		a.Log.Warn(ctx, "failed to get workspace agent client peers", slog.Error(err))
+		return uuid.NullUUID{}
+	}
+	for _, peer := range peers {
+		node := (*coord).Node(peer.PeerID)
+		if node == nil {
+			continue
+		}
+		for _, prefix := range node.Addresses {
+			if prefix.Contains(addr) {
+				return uuid.NullUUID{UUID: peer.UserID, Valid: true}
+			}
+		}
+	}
+	return uuid.NullUUID{}
+}
+
+func connectionTypeFromProto(t agentproto.Connection_Type) (database.ConnectionType, error) {
+	switch t {
+	case agentproto.Connection_SSH:
+		return database.ConnectionTypeSsh, nil
+	case agentproto.Connection_VSCODE:
+		return database.ConnectionTypeVscode, nil
+	case agentproto.Connection_JETBRAINS:
+		return database.ConnectionTypeJetbrains, nil
+	case agentproto.Connection_RECONNECTING_PTY:
+		return database.ConnectionTypeReconnectingPty, nil
+	case agentproto.Connection_PORT_FORWARD:
+		return database.ConnectionTypePortForward, nil
+	case agentproto.Connection_APP:
+		return database.ConnectionTypeApp, nil
+	default:
+		return "", xerrors.Errorf("unknown connection type %q", t)
+	}
+}
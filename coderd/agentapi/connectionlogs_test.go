@@ -0,0 +1,159 @@
+package agentapi_test
+
+import (
+	"context"
+	"database/sql"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	agentproto "github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/coderd/agentapi"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbmock"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/tailnet"
+	"github.com/coder/coder/v2/tailnet/tailnettest"
+)
+
+func TestReportConnection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		agent = database.WorkspaceAgent{
+			ID:   uuid.New(),
+			Name: "main",
+		}
+		workspace = database.Workspace{
+			ID:             uuid.New(),
+			OwnerID:        uuid.New(),
+			OrganizationID: uuid.New(),
+			Name:           "dev",
+		}
+		connectionID = uuid.New()
+		timestamp    = dbtime.Now().Add(-time.Minute)
+	)
+
+	newAPI := func(t *testing.T, db database.Store, coord tailnet.Coordinator) *agentapi.ConnectionLogAPI {
+		var coordPtr atomic.Pointer[tailnet.Coordinator]
+		if coord != nil {
+			coordPtr.Store(&coord)
+		}
+		return &agentapi.ConnectionLogAPI{
+			AgentFn: func(context.Context) (database.WorkspaceAgent, error) {
+				return agent, nil
+			},
+			WorkspaceIDFn: func(context.Context, *database.WorkspaceAgent) (uuid.UUID, error) {
+				return workspace.ID, nil
+			},
+			Database:           db,
+			Log:                slogtest.Make(t, nil),
+			TailnetCoordinator: &coordPtr,
+		}
+	}
+
+	t.Run("Connect", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		dbM := dbmock.NewMockStore(ctrl)
+		coord := tailnettest.NewMockCoordinator(ctrl)
+		api := newAPI(t, dbM, coord)
+
+		var (
+			userID = uuid.New()
+			peerID = uuid.New()
+		)
+		dbM.EXPECT().GetWorkspaceByID(gomock.Any(), workspace.ID).Return(workspace, nil)
+		dbM.EXPECT().GetWorkspaceAgentClientPeersByAgentID(gomock.Any(), agent.ID).Return([]database.WorkspaceAgentClientPeer{{
+			PeerID:  peerID,
+			AgentID: agent.ID,
+			UserID:  userID,
+		}}, nil)
+		coord.EXPECT().Node(peerID).Return(&tailnet.Node{
+			Addresses: []netip.Prefix{netip.MustParsePrefix("fd7a:115c:a1e0:4353:89d9:4ca8:9c42:8d2d/128")},
+		})
+		dbM.EXPECT().UpsertConnectionLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
+			assert.Equal(t, connectionID, arg.ConnectionID)
+			assert.Equal(t, workspace.OwnerID, arg.WorkspaceOwnerID)
+			assert.Equal(t, workspace.OrganizationID, arg.OrganizationID)
+			assert.Equal(t, workspace.Name, arg.WorkspaceName)
+			assert.Equal(t, agent.Name, arg.AgentName)
+			assert.Equal(t, database.ConnectionTypeSsh, arg.Type)
+			assert.True(t, timestamp.Equal(arg.ConnectTime))
+			assert.Equal(t, "fd7a:115c:a1e0:4353:89d9:4ca8:9c42:8d2d", arg.Ip.IPNet.IP.String())
+			assert.Equal(t, uuid.NullUUID{UUID: userID, Valid: true}, arg.UserID)
+			assert.False(t, arg.DisconnectTime.Valid)
+			assert.False(t, arg.Code.Valid)
+			return database.ConnectionLog{}, nil
+		})
+
+		_, err := api.ReportConnection(context.Background(), &agentproto.ReportConnectionRequest{
+			Connection: &agentproto.Connection{
+				Id:        connectionID[:],
+				Action:    agentproto.Connection_CONNECT,
+				Type:      agentproto.Connection_SSH,
+				Timestamp: timestamppb.New(timestamp),
+				Ip:        "fd7a:115c:a1e0:4353:89d9:4ca8:9c42:8d2d",
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Disconnect", func(t *testing.T) {
+		t.Parallel()
+
+		dbM := dbmock.NewMockStore(gomock.NewController(t))
+		api := newAPI(t, dbM, nil)
+
+		reason := "session ended"
+		dbM.EXPECT().GetWorkspaceByID(gomock.Any(), workspace.ID).Return(workspace, nil)
+		dbM.EXPECT().UpsertConnectionLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
+			assert.Equal(t, database.ConnectionTypeReconnectingPty, arg.Type)
+			assert.False(t, arg.UserID.Valid)
+			assert.True(t, arg.DisconnectTime.Valid)
+			assert.True(t, timestamp.Equal(arg.DisconnectTime.Time))
+			assert.Equal(t, sql.NullInt32{Int32: 1, Valid: true}, arg.Code)
+			assert.Equal(t, sql.NullString{String: reason, Valid: true}, arg.DisconnectReason)
+			return database.ConnectionLog{}, nil
+		})
+
+		_, err := api.ReportConnection(context.Background(), &agentproto.ReportConnectionRequest{
+			Connection: &agentproto.Connection{
+				Id:         connectionID[:],
+				Action:     agentproto.Connection_DISCONNECT,
+				Type:       agentproto.Connection_RECONNECTING_PTY,
+				Timestamp:  timestamppb.New(timestamp),
+				Ip:         "127.0.0.1",
+				StatusCode: 1,
+				Reason:     &reason,
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("InvalidType", func(t *testing.T) {
+		t.Parallel()
+
+		dbM := dbmock.NewMockStore(gomock.NewController(t))
+		api := newAPI(t, dbM, nil)
+
+		_, err := api.ReportConnection(context.Background(), &agentproto.ReportConnectionRequest{
+			Connection: &agentproto.Connection{
+				Id:        connectionID[:],
+				Action:    agentproto.Connection_CONNECT,
+				Type:      agentproto.Connection_TYPE_UNSPECIFIED,
+				Timestamp: timestamppb.New(timestamp),
+			},
+		})
+		require.ErrorContains(t, err, "unknown connection type")
+	})
+}
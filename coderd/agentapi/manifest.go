@@ -29,6 +29,7 @@ type ManifestAPI struct {
 	ExternalAuthConfigs      []*externalauth.Config
 	DisableDirectConnections bool
 	DerpForceWebSockets      bool
+	SessionRecording         codersdk.SessionRecordingConfig
 
 	AgentFn       func(context.Context) (database.WorkspaceAgent, error)
 	WorkspaceIDFn func(context.Context, *database.WorkspaceAgent) (uuid.UUID, error)
@@ -134,6 +135,11 @@ func (a *ManifestAPI) GetManifest(ctx context.Context, _ *agentproto.GetManifest
 		Scripts:  dbAgentScriptsToProto(scripts),
 		Apps:     apps,
 		Metadata: dbAgentMetadataToProtoDescription(metadata),
+		SessionRecording: &agentproto.SessionRecordingPolicy{
+			Enabled:     a.SessionRecording.Enabled.Value(),
+			RecordInput: a.SessionRecording.RecordInput.Value(),
+			MaxSize:     a.SessionRecording.MaxSize.Value(),
+		},
 	}, nil
 }
 
@@ -280,6 +280,10 @@ func TestGetManifest(t *testing.T) {
 			},
 			DisableDirectConnections: true,
 			DerpForceWebSockets:      true,
+			SessionRecording: codersdk.SessionRecordingConfig{
+				Enabled: true,
+				MaxSize: 1 << 20,
+			},
 
 			AgentFn: func(ctx context.Context) (database.WorkspaceAgent, error) {
 				return agent, nil
@@ -323,6 +327,10 @@ func TestGetManifest(t *testing.T) {
 			Scripts:  protoScripts,
 			Apps:     protoApps,
 			Metadata: protoMetadata,
+			SessionRecording: &agentproto.SessionRecordingPolicy{
+				Enabled: true,
+				MaxSize: 1 << 20,
+			},
 		}
 
 		// Log got and expected with spew.
@@ -386,10 +394,11 @@ func TestGetManifest(t *testing.T) {
 			// tailnet.DERPMapToProto() is extensively tested elsewhere, so it's
 			// not necessary to manually recreate a big DERP map here like we
 			// did for apps and metadata.
-			DerpMap:  tailnet.DERPMapToProto(derpMapFn()),
-			Scripts:  protoScripts,
-			Apps:     protoApps,
-			Metadata: protoMetadata,
+			DerpMap:          tailnet.DERPMapToProto(derpMapFn()),
+			Scripts:          protoScripts,
+			Apps:             protoApps,
+			Metadata:         protoMetadata,
+			SessionRecording: &agentproto.SessionRecordingPolicy{},
 		}
 
 		// Log got and expected with spew.
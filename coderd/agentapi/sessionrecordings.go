@@ -0,0 +1,117 @@
+package agentapi
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	agentproto "github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+)
+
+type SessionRecordingAPI struct {
+	AgentFn       func(context.Context) (database.WorkspaceAgent, error)
+	WorkspaceIDFn func(context.Context, *database.WorkspaceAgent) (uuid.UUID, error)
+	Database      database.Store
+	Log           slog.Logger
+	// MaxSize is the maximum size of a recording in bytes. Zero means
+	// unlimited.
+	MaxSize int64
+
+	TimeNowFn func() time.Time // defaults to dbtime.Now()
+}
+
+func (a *SessionRecordingAPI) now() time.Time {
+	if a.TimeNowFn != nil {
+		return a.TimeNowFn()
+	}
+	return dbtime.Now()
+}
+
+// UploadSessionRecording stores a chunk of a recorded terminal session. The
+// first chunk of a recording (offset zero) carries its metadata and creates or
+// replaces the recording, later chunks are appended to it.
+func (a *SessionRecordingAPI) UploadSessionRecording(ctx context.Context, req *agentproto.UploadSessionRecordingRequest) (*agentproto.UploadSessionRecordingResponse, error) {
+	workspaceAgent, err := a.AgentFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.FromBytes(req.Id)
+	if err != nil {
+		return nil, xerrors.Errorf("parse recording ID %q: %w", req.Id, err)
+	}
+	if req.Offset < 0 {
+		return nil, xerrors.Errorf("invalid offset %d", req.Offset)
+	}
+	if a.MaxSize > 0 && req.Offset+int64(len(req.Data)) > a.MaxSize {
+		return nil, xerrors.Errorf("recording exceeds the maximum size of %d bytes", a.MaxSize)
+	}
+
+	if req.Offset > 0 {
+		_, err = a.Database.AppendWorkspaceSessionRecordingData(ctx, database.AppendWorkspaceSessionRecordingDataParams{
+			ID:      id,
+			AgentID: workspaceAgent.ID,
+			Offset:  req.Offset,
+			Data:    req.Data,
+		})
+		if xerrors.Is(err, sql.ErrNoRows) {
+			return nil, xerrors.Errorf("offset %d does not match the end of recording %s", req.Offset, id)
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("append session recording data: %w", err)
+		}
+		return &agentproto.UploadSessionRecordingResponse{}, nil
+	}
+
+	var recordingType database.WorkspaceSessionRecordingType
+	switch req.Type {
+	case agentproto.UploadSessionRecordingRequest_SSH:
+		recordingType = database.WorkspaceSessionRecordingTypeSsh
+	case agentproto.UploadSessionRecordingRequest_RECONNECTING_PTY:
+		recordingType = database.WorkspaceSessionRecordingTypeReconnectingPty
+	default:
+		return nil, xerrors.Errorf("unknown session recording type %q", req.Type)
+	}
+	if req.StartedAt == nil || req.EndedAt == nil {
+		return nil, xerrors.New("started_at and ended_at are required")
+	}
+
+	workspaceID, err := a.WorkspaceIDFn(ctx, &workspaceAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = a.Database.InsertWorkspaceSessionRecording(ctx, database.InsertWorkspaceSessionRecordingParams{
+		ID:            id,
+		WorkspaceID:   workspaceID,
+		AgentID:       workspaceAgent.ID,
+		Type:          recordingType,
+		Command:       req.Command,
+		StartedAt:     req.StartedAt.AsTime(),
+		EndedAt:       req.EndedAt.AsTime(),
+		InputRecorded: req.InputRecorded,
+		Truncated:     req.Truncated,
+		CreatedAt:     a.now(),
+		Data:          req.Data,
+	})
+	if xerrors.Is(err, sql.ErrNoRows) {
+		// The recording ID is taken by another agent.
+		return nil, xerrors.Errorf("recording %s belongs to another agent", id)
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("insert session recording: %w", err)
+	}
+
+	a.Log.Debug(ctx, "stored workspace session recording",
+		slog.F("workspace_id", workspaceID),
+		slog.F("recording_id", id),
+		slog.F("type", recordingType),
+	)
+	return &agentproto.UploadSessionRecordingResponse{}, nil
+}
@@ -0,0 +1,153 @@
+package agentapi_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	agentproto "github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/coderd/agentapi"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbmock"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+)
+
+func TestUploadSessionRecording(t *testing.T) {
+	t.Parallel()
+
+	var (
+		agent = database.WorkspaceAgent{
+			ID: uuid.New(),
+		}
+		workspaceID = uuid.New()
+		recordingID = uuid.New()
+		startedAt   = dbtime.Now().Add(-time.Minute)
+		endedAt     = dbtime.Now()
+	)
+
+	newAPI := func(t *testing.T, db database.Store, maxSize int64) *agentapi.SessionRecordingAPI {
+		now := dbtime.Now()
+		return &agentapi.SessionRecordingAPI{
+			AgentFn: func(context.Context) (database.WorkspaceAgent, error) {
+				return agent, nil
+			},
+			WorkspaceIDFn: func(context.Context, *database.WorkspaceAgent) (uuid.UUID, error) {
+				return workspaceID, nil
+			},
+			Database:  db,
+			Log:       slogtest.Make(t, nil),
+			MaxSize:   maxSize,
+			TimeNowFn: func() time.Time { return now },
+		}
+	}
+
+	t.Run("FirstChunk", func(t *testing.T) {
+		t.Parallel()
+
+		dbM := dbmock.NewMockStore(gomock.NewController(t))
+		api := newAPI(t, dbM, 0)
+
+		dbM.EXPECT().InsertWorkspaceSessionRecording(gomock.Any(), database.InsertWorkspaceSessionRecordingParams{
+			ID:            recordingID,
+			WorkspaceID:   workspaceID,
+			AgentID:       agent.ID,
+			Type:          database.WorkspaceSessionRecordingTypeReconnectingPty,
+			Command:       "htop",
+			StartedAt:     startedAt,
+			EndedAt:       endedAt,
+			InputRecorded: true,
+			Truncated:     false,
+			CreatedAt:     api.TimeNowFn(),
+			Data:          []byte("header\n"),
+		}).Return(database.WorkspaceSessionRecording{}, nil)
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			Id:            recordingID[:],
+			Type:          agentproto.UploadSessionRecordingRequest_RECONNECTING_PTY,
+			Command:       "htop",
+			StartedAt:     timestamppb.New(startedAt),
+			EndedAt:       timestamppb.New(endedAt),
+			InputRecorded: true,
+			Data:          []byte("header\n"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("NextChunk", func(t *testing.T) {
+		t.Parallel()
+
+		dbM := dbmock.NewMockStore(gomock.NewController(t))
+		api := newAPI(t, dbM, 0)
+
+		dbM.EXPECT().AppendWorkspaceSessionRecordingData(gomock.Any(), database.AppendWorkspaceSessionRecordingDataParams{
+			ID:      recordingID,
+			AgentID: agent.ID,
+			Offset:  7,
+			Data:    []byte("event\n"),
+		}).Return(int64(13), nil)
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			Id:     recordingID[:],
+			Type:   agentproto.UploadSessionRecordingRequest_SSH,
+			Offset: 7,
+			Data:   []byte("event\n"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("OffsetMismatch", func(t *testing.T) {
+		t.Parallel()
+
+		dbM := dbmock.NewMockStore(gomock.NewController(t))
+		api := newAPI(t, dbM, 0)
+
+		dbM.EXPECT().AppendWorkspaceSessionRecordingData(gomock.Any(), gomock.Any()).Return(int64(0), sql.ErrNoRows)
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			Id:     recordingID[:],
+			Type:   agentproto.UploadSessionRecordingRequest_SSH,
+			Offset: 100,
+			Data:   []byte("event\n"),
+		})
+		require.ErrorContains(t, err, "does not match the end of recording")
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		t.Parallel()
+
+		// No database calls are expected.
+		dbM := dbmock.NewMockStore(gomock.NewController(t))
+		api := newAPI(t, dbM, 10)
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			Id:        recordingID[:],
+			Type:      agentproto.UploadSessionRecordingRequest_SSH,
+			StartedAt: timestamppb.New(startedAt),
+			EndedAt:   timestamppb.New(endedAt),
+			Data:      []byte("more than ten bytes"),
+		})
+		require.ErrorContains(t, err, "exceeds the maximum size")
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		t.Parallel()
+
+		dbM := dbmock.NewMockStore(gomock.NewController(t))
+		api := newAPI(t, dbM, 0)
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			Id:        recordingID[:],
+			StartedAt: timestamppb.New(startedAt),
+			EndedAt:   timestamppb.New(endedAt),
+			Data:      []byte("header\n"),
+		})
+		require.ErrorContains(t, err, "unknown session recording type")
+	})
+}
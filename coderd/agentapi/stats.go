@@ -73,6 +73,7 @@ func (a *StatsAPI) UpdateStats(ctx context.Context, req *agentproto.UpdateStatsR
 		workspace,
 		workspaceAgent,
 		getWorkspaceAgentByIDRow.TemplateName,
+		getWorkspaceAgentByIDRow.TemplateIdleDetectionEnabled,
 		req.Stats,
 	)
 	if err != nil {
@@ -61,7 +61,7 @@ func TestUpdateStates(t *testing.T) {
 
 			templateScheduleStore = schedule.MockTemplateScheduleStore{
 				GetFn: func(context.Context, database.Store, uuid.UUID) (schedule.TemplateScheduleOptions, error) {
-					panic("should not be called")
+					return schedule.TemplateScheduleOptions{}, nil
 				},
 				SetFn: func(context.Context, database.Store, database.Template, schedule.TemplateScheduleOptions) (database.Template, error) {
 					panic("not implemented")
@@ -390,7 +390,6 @@ func TestUpdateStates(t *testing.T) {
 
 			templateScheduleStore = schedule.MockTemplateScheduleStore{
 				GetFn: func(context.Context, database.Store, uuid.UUID) (schedule.TemplateScheduleOptions, error) {
-					t.Fatal("getfn should not be called")
 					return schedule.TemplateScheduleOptions{}, nil
 				},
 				SetFn: func(context.Context, database.Store, database.Template, schedule.TemplateScheduleOptions) (database.Template, error) {
@@ -511,6 +510,151 @@ func TestUpdateStates(t *testing.T) {
 		}
 		require.True(t, updateAgentMetricsFnCalled)
 	})
+
+	t.Run("IdlePolicy", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name  string
+			stats *agentproto.Stats
+			bump  bool
+		}{
+			{
+				name:  "ConnectionOnly",
+				stats: &agentproto.Stats{ConnectionCount: 3},
+				bump:  false,
+			},
+			{
+				name:  "Input",
+				stats: &agentproto.Stats{ConnectionCount: 1, SessionInputCount: 12},
+				bump:  true,
+			},
+			{
+				name:  "CPUAboveThreshold",
+				stats: &agentproto.Stats{CpuUsagePercent: 75},
+				bump:  true,
+			},
+			{
+				name:  "CPUBelowThreshold",
+				stats: &agentproto.Stats{ConnectionCount: 1, CpuUsagePercent: 10},
+				bump:  false,
+			},
+		} {
+			tc := tc
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				var (
+					now = dbtime.Now()
+					dbM = dbmock.NewMockStore(gomock.NewController(t))
+
+					templateScheduleStore = schedule.MockTemplateScheduleStore{
+						GetFn: func(context.Context, database.Store, uuid.UUID) (schedule.TemplateScheduleOptions, error) {
+							return schedule.TemplateScheduleOptions{
+								IdlePolicy: schedule.TemplateIdlePolicy{
+									Enabled:             true,
+									CPUThresholdPercent: 50,
+								},
+							}, nil
+						},
+						SetFn: func(context.Context, database.Store, database.Template, schedule.TemplateScheduleOptions) (database.Template, error) {
+							panic("not implemented")
+						},
+					}
+				)
+				api := agentapi.StatsAPI{
+					AgentFn: func(context.Context) (database.WorkspaceAgent, error) {
+						return agent, nil
+					},
+					Database: dbM,
+					StatsReporter: workspacestats.NewReporter(workspacestats.ReporterOptions{
+						Database:              dbM,
+						Pubsub:                pubsub.NewInMemory(),
+						StatsBatcher:          &workspacestatstest.StatsBatcher{},
+						TemplateScheduleStore: templateScheduleStorePtr(templateScheduleStore),
+					}),
+					AgentStatsRefreshInterval: 10 * time.Second,
+					TimeNowFn: func() time.Time {
+						return now
+					},
+				}
+
+				dbM.EXPECT().GetWorkspaceByAgentID(gomock.Any(), agent.ID).Return(database.GetWorkspaceByAgentIDRow{
+					Workspace:                    workspace,
+					TemplateName:                 template.Name,
+					TemplateIdleDetectionEnabled: true,
+				}, nil)
+				if tc.bump {
+					dbM.EXPECT().ActivityBumpWorkspace(gomock.Any(), database.ActivityBumpWorkspaceParams{
+						WorkspaceID:   workspace.ID,
+						NextAutostart: time.Time{}.UTC(),
+					}).Return(nil)
+				}
+				dbM.EXPECT().UpdateWorkspaceLastUsedAt(gomock.Any(), database.UpdateWorkspaceLastUsedAtParams{
+					ID:         workspace.ID,
+					LastUsedAt: now,
+				}).Return(nil)
+
+				_, err := api.UpdateStats(context.Background(), &agentproto.UpdateStatsRequest{Stats: tc.stats})
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("NoIdlePolicy", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			now = dbtime.Now()
+			dbM = dbmock.NewMockStore(gomock.NewController(t))
+
+			// The template schedule is not loaded for templates without an
+			// idle policy and workspaces without an autostart schedule.
+			templateScheduleStore = schedule.MockTemplateScheduleStore{
+				GetFn: func(context.Context, database.Store, uuid.UUID) (schedule.TemplateScheduleOptions, error) {
+					t.Error("template schedule should not be loaded")
+					return schedule.TemplateScheduleOptions{}, nil
+				},
+				SetFn: func(context.Context, database.Store, database.Template, schedule.TemplateScheduleOptions) (database.Template, error) {
+					panic("not implemented")
+				},
+			}
+		)
+		api := agentapi.StatsAPI{
+			AgentFn: func(context.Context) (database.WorkspaceAgent, error) {
+				return agent, nil
+			},
+			Database: dbM,
+			StatsReporter: workspacestats.NewReporter(workspacestats.ReporterOptions{
+				Database:              dbM,
+				Pubsub:                pubsub.NewInMemory(),
+				StatsBatcher:          &workspacestatstest.StatsBatcher{},
+				TemplateScheduleStore: templateScheduleStorePtr(templateScheduleStore),
+			}),
+			AgentStatsRefreshInterval: 10 * time.Second,
+			TimeNowFn: func() time.Time {
+				return now
+			},
+		}
+
+		dbM.EXPECT().GetWorkspaceByAgentID(gomock.Any(), agent.ID).Return(database.GetWorkspaceByAgentIDRow{
+			Workspace:    workspace,
+			TemplateName: template.Name,
+		}, nil)
+		dbM.EXPECT().ActivityBumpWorkspace(gomock.Any(), database.ActivityBumpWorkspaceParams{
+			WorkspaceID:   workspace.ID,
+			NextAutostart: time.Time{}.UTC(),
+		}).Return(nil)
+		dbM.EXPECT().UpdateWorkspaceLastUsedAt(gomock.Any(), database.UpdateWorkspaceLastUsedAtParams{
+			ID:         workspace.ID,
+			LastUsedAt: now,
+		}).Return(nil)
+
+		_, err := api.UpdateStats(context.Background(), &agentproto.UpdateStatsRequest{
+			Stats: &agentproto.Stats{ConnectionCount: 1, CpuUsagePercent: 75},
+		})
+		require.NoError(t, err)
+	})
 }
 
 func templateScheduleStorePtr(store schedule.TemplateScheduleStore) *atomic.Pointer[schedule.TemplateScheduleStore] {
@@ -248,6 +248,43 @@ const docTemplate = `{
                 }
             }
         },
+        "/audit/export": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Export audit logs",
+                "operationId": "export-audit-logs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "jsonl",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/audit/testgenerate": {
             "post": {
                 "security": [
@@ -343,6 +380,52 @@ const docTemplate = `{
                 }
             }
         },
+        "/connectionlogs": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Get connection logs",
+                "operationId": "get-connection-logs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ConnectionLogResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/csp/reports": {
             "post": {
                 "security": [
@@ -491,6 +574,59 @@ const docTemplate = `{
                 }
             }
         },
+        "/debug/health/history": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debug"
+                ],
+                "summary": "Get healthcheck history",
+                "operationId": "get-healthcheck-history",
+                "parameters": [
+                    {
+                        "enum": [
+                            "DERP",
+                            "AccessURL",
+                            "Websocket",
+                            "Database",
+                            "WorkspaceProxy",
+                            "ProvisionerDaemons",
+                            "Pubsub",
+                            "Notifications",
+                            "ExternalAuth"
+                        ],
+                        "type": "string",
+                        "description": "Healthcheck section",
+                        "name": "section",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results, 100 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/healthsdk.HealthcheckSectionResult"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/debug/health/settings": {
             "get": {
                 "security": [
@@ -2477,7 +2613,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/organizations/{organization}/members": {
+        "/organizations/{organization}/maintenance-windows": {
             "get": {
                 "security": [
                     {
@@ -2488,13 +2624,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Organizations"
                 ],
-                "summary": "List organization members",
-                "operationId": "list-organization-members",
+                "summary": "Get maintenance windows by organization",
+                "operationId": "get-maintenance-windows-by-organization",
                 "parameters": [
                     {
                         "type": "string",
+                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
@@ -2507,28 +2644,29 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.OrganizationMemberWithUserData"
+                                "$ref": "#/definitions/codersdk.MaintenanceWindow"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/members/roles": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Organizations"
                 ],
-                "summary": "Get member roles by organization",
-                "operationId": "get-member-roles-by-organization",
+                "summary": "Create maintenance window",
+                "operationId": "create-maintenance-window",
                 "parameters": [
                     {
                         "type": "string",
@@ -2537,37 +2675,39 @@ const docTemplate = `{
                         "name": "organization",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Create maintenance window request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateMaintenanceWindowRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.AssignableRoles"
-                            }
+                            "$ref": "#/definitions/codersdk.MaintenanceWindow"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/organizations/{organization}/maintenance-windows/{maintenancewindow}": {
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Members"
+                    "Organizations"
                 ],
-                "summary": "Upsert a custom organization role",
-                "operationId": "upsert-a-custom-organization-role",
+                "summary": "Delete maintenance window",
+                "operationId": "delete-maintenance-window",
                 "parameters": [
                     {
                         "type": "string",
@@ -2578,28 +2718,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Upsert role request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Maintenance window ID",
+                        "name": "maintenancewindow",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Role"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             },
-            "post": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -2612,10 +2745,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Organizations"
                 ],
-                "summary": "Insert a custom organization role",
-                "operationId": "insert-a-custom-organization-role",
+                "summary": "Update maintenance window",
+                "operationId": "update-maintenance-window",
                 "parameters": [
                     {
                         "type": "string",
@@ -2626,12 +2759,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Insert role request",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Maintenance window ID",
+                        "name": "maintenancewindow",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update maintenance window request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
+                            "$ref": "#/definitions/codersdk.UpdateMaintenanceWindowRequest"
                         }
                     }
                 ],
@@ -2639,17 +2780,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Role"
-                            }
+                            "$ref": "#/definitions/codersdk.MaintenanceWindow"
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/members/roles/{roleName}": {
-            "delete": {
+        "/organizations/{organization}/members": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -2661,23 +2799,15 @@ const docTemplate = `{
                 "tags": [
                     "Members"
                 ],
-                "summary": "Delete a custom organization role",
-                "operationId": "delete-a-custom-organization-role",
+                "summary": "List organization members",
+                "operationId": "list-organization-members",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Role name",
-                        "name": "roleName",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2686,15 +2816,15 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.Role"
+                                "$ref": "#/definitions/codersdk.OrganizationMemberWithUserData"
                             }
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/members/{user}": {
-            "post": {
+        "/organizations/{organization}/members/roles": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -2706,59 +2836,238 @@ const docTemplate = `{
                 "tags": [
                     "Members"
                 ],
-                "summary": "Add organization member",
-                "operationId": "add-organization-member",
+                "summary": "Get member roles by organization",
+                "operationId": "get-member-roles-by-organization",
                 "parameters": [
                     {
                         "type": "string",
+                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationMember"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.AssignableRoles"
+                            }
                         }
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Members"
                 ],
-                "summary": "Remove organization member",
-                "operationId": "remove-organization-member",
+                "summary": "Upsert a custom organization role",
+                "operationId": "upsert-a-custom-organization-role",
                 "parameters": [
                     {
                         "type": "string",
+                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    }
+                        "description": "Upsert role request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Role"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "summary": "Insert a custom organization role",
+                "operationId": "insert-a-custom-organization-role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Insert role request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Role"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/{organization}/members/roles/{roleName}": {
+            "delete": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "summary": "Delete a custom organization role",
+                "operationId": "delete-a-custom-organization-role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "roleName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Role"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/{organization}/members/{user}": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "summary": "Add organization member",
+                "operationId": "add-organization-member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OrganizationMember"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "summary": "Remove organization member",
+                "operationId": "remove-organization-member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "204": {
@@ -4066,7 +4375,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/templates/{template}/versions": {
+        "/templates/{template}/rollout": {
             "get": {
                 "security": [
                     {
@@ -4079,8 +4388,8 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "List template versions by template ID",
-                "operationId": "list-template-versions-by-template-id",
+                "summary": "Get template version rollout",
+                "operationId": "get-template-version-rollout",
                 "parameters": [
                     {
                         "type": "string",
@@ -4089,46 +4398,18 @@ const docTemplate = `{
                         "name": "template",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "After ID",
-                        "name": "after_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Include archived versions in the list",
-                        "name": "include_archived",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page offset",
-                        "name": "offset",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.TemplateVersion"
-                            }
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
                         }
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4143,18 +4424,9 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Update active template version by template ID",
-                "operationId": "update-active-template-version-by-template-id",
+                "summary": "Create template version rollout",
+                "operationId": "create-template-version-rollout",
                 "parameters": [
-                    {
-                        "description": "Modified template version",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateActiveTemplateVersion"
-                        }
-                    },
                     {
                         "type": "string",
                         "format": "uuid",
@@ -4162,36 +4434,42 @@ const docTemplate = `{
                         "name": "template",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Create template version rollout request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateTemplateVersionRolloutRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/versions/archive": {
+        "/templates/{template}/rollout/promote": {
             "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Archive template unused versions by template id",
-                "operationId": "archive-template-unused-versions-by-template-id",
+                "summary": "Promote template version rollout",
+                "operationId": "promote-template-version-rollout",
                 "parameters": [
                     {
                         "type": "string",
@@ -4200,29 +4478,210 @@ const docTemplate = `{
                         "name": "template",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Archive request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ArchiveTemplateVersionsRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/versions/{templateversionname}": {
-            "get": {
+        "/templates/{template}/rollout/rollback": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Roll back template version rollout",
+                "operationId": "roll-back-template-version-rollout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{template}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "List template versions by template ID",
+                "operationId": "list-template-versions-by-template-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "After ID",
+                        "name": "after_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived versions in the list",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.TemplateVersion"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Update active template version by template ID",
+                "operationId": "update-active-template-version-by-template-id",
+                "parameters": [
+                    {
+                        "description": "Modified template version",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateActiveTemplateVersion"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{template}/versions/archive": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Archive template unused versions by template id",
+                "operationId": "archive-template-unused-versions-by-template-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Archive request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ArchiveTemplateVersionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{template}/versions/{templateversionname}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4613,6 +5072,52 @@ const docTemplate = `{
                 }
             }
         },
+        "/templateversions/{templateversion}/dry-run/{jobID}/resource-changes": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Get template version dry-run resource changes by job ID",
+                "operationId": "get-template-version-dry-run-resource-changes-by-job-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Job ID",
+                        "name": "jobID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceResourceChange"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/templateversions/{templateversion}/dry-run/{jobID}/resources": {
             "get": {
                 "security": [
@@ -6467,36 +6972,83 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace-quota/{user}": {
-            "get": {
+        "/users/{user}/workspaces/restore": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "description": "Creates a new workspace from a snapshot using the template\nversion and parameters of the snapshotted build. Restoring\nthe Terraform state requires permission to update the template.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Workspaces"
                 ],
-                "summary": "Get workspace quota by user deprecated",
-                "operationId": "get-workspace-quota-by-user-deprecated",
-                "deprecated": true,
+                "summary": "Restore workspace snapshot",
+                "operationId": "restore-workspace-snapshot",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
+                        "description": "Username, UUID, or me",
                         "name": "user",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Restore workspace snapshot request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.RestoreWorkspaceSnapshotRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceQuota"
+                            "$ref": "#/definitions/codersdk.Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace-quota/{user}": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Get workspace quota by user deprecated",
+                "operationId": "get-workspace-quota-by-user-deprecated",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceQuota"
                         }
                     }
                 }
@@ -6965,6 +7517,154 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspaceagents/{workspaceagent}/files": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "description": "Range requests are supported to read part of the file.",
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Read file from workspace agent",
+                "operationId": "read-file-from-workspace-agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Byte range to read",
+                        "name": "Range",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "206": {
+                        "description": "Partial Content"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "description": "The request body is written to the file, which is created if it does not exist.\nExisting content from offset onwards is replaced, which allows interrupted uploads to be resumed.",
+                "consumes": [
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Write file to workspace agent",
+                "operationId": "write-file-to-workspace-agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset to write from",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "description": "File content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentFileInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaceagents/{workspaceagent}/files/list": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "description": "Relative paths are resolved against the home directory of the workspace agent user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "List directory in workspace agent",
+                "operationId": "list-directory-in-workspace-agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Directory path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentDirectory"
+                        }
+                    }
+                }
+            }
+        },
         "/workspaceagents/{workspaceagent}/listening-ports": {
             "get": {
                 "security": [
@@ -7417,6 +8117,81 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspacebulkoperations": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "description": "Builds all workspaces matching a search query. Builds are\nenqueued in the background at a limited rate and authorized\nas the requester.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Create workspace bulk operation",
+                "operationId": "create-workspace-bulk-operation",
+                "parameters": [
+                    {
+                        "description": "Create workspace bulk operation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceBulkOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperation"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspacebulkoperations/{workspacebulkoperation}": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace bulk operation",
+                "operationId": "get-workspace-bulk-operation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace bulk operation ID",
+                        "name": "workspacebulkoperation",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperation"
+                        }
+                    }
+                }
+            }
+        },
         "/workspaceproxies": {
             "get": {
                 "security": [
@@ -7898,21 +8673,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspaces/{workspace}/autostart": {
-            "put": {
+        "/workspaces/{workspace}/acl": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Workspaces"
                 ],
-                "summary": "Update workspace autostart schedule by ID",
-                "operationId": "update-workspace-autostart-schedule-by-id",
+                "summary": "Get workspace ACL",
+                "operationId": "get-workspace-acl",
                 "parameters": [
                     {
                         "type": "string",
@@ -7921,26 +8696,18 @@ const docTemplate = `{
                         "name": "workspace",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Schedule update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutostartRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceACL"
+                        }
                     }
                 }
-            }
-        },
-        "/workspaces/{workspace}/autoupdates": {
-            "put": {
+            },
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7952,8 +8719,8 @@ const docTemplate = `{
                 "tags": [
                     "Workspaces"
                 ],
-                "summary": "Update workspace automatic updates by ID",
-                "operationId": "update-workspace-automatic-updates-by-id",
+                "summary": "Update workspace ACL",
+                "operationId": "update-workspace-acl",
                 "parameters": [
                     {
                         "type": "string",
@@ -7964,12 +8731,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Automatic updates request",
+                        "description": "Update workspace ACL request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutomaticUpdatesRequest"
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceACL"
                         }
                     }
                 ],
@@ -7980,14 +8747,96 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspaces/{workspace}/builds": {
-            "get": {
+        "/workspaces/{workspace}/autostart": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace autostart schedule by ID",
+                "operationId": "update-workspace-autostart-schedule-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutostartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/autoupdates": {
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace automatic updates by ID",
+                "operationId": "update-workspace-automatic-updates-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Automatic updates request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutomaticUpdatesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/builds": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
@@ -8358,6 +9207,82 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspaces/{workspace}/recordings": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace session recordings",
+                "operationId": "get-workspace-session-recordings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceSessionRecording"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/recordings/{recording}": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "description": "The recording is returned in the asciicast v2 format.",
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace session recording data",
+                "operationId": "get-workspace-session-recording-data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Recording ID",
+                        "name": "recording",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/workspaces/{workspace}/resolve-autostart": {
             "get": {
                 "security": [
@@ -8393,6 +9318,42 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspaces/{workspace}/snapshot": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "description": "The Terraform state of the build is only included if the\nuser is allowed to update the template of the workspace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace snapshot",
+                "operationId": "get-workspace-snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceSnapshot"
+                        }
+                    }
+                }
+            }
+        },
         "/workspaces/{workspace}/timings": {
             "get": {
                 "security": [
@@ -9087,6 +10048,92 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.AuditLoggingConfig": {
+            "type": "object",
+            "properties": {
+                "http": {
+                    "description": "HTTP settings.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.AuditLoggingHTTPConfig"
+                        }
+                    ]
+                },
+                "syslog": {
+                    "description": "Syslog settings.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.AuditLoggingSyslogConfig"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.AuditLoggingHTTPConfig": {
+            "type": "object",
+            "properties": {
+                "batch_size": {
+                    "description": "The maximum number of audit logs sent in a single request.",
+                    "type": "integer"
+                },
+                "buffer_size": {
+                    "description": "How many audit logs are buffered in memory while waiting to be sent.",
+                    "type": "integer"
+                },
+                "endpoint": {
+                    "description": "The URL to which batches of audit logs are sent with an HTTP POST request. Leave empty to disable HTTP streaming.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/serpent.URL"
+                        }
+                    ]
+                },
+                "flush_interval": {
+                    "description": "How often pending audit logs are sent, if a batch has not been filled sooner.",
+                    "type": "integer"
+                },
+                "headers": {
+                    "description": "Additional headers (in the form 'Name=value') to send with each request, e.g. for authentication.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "max_retries": {
+                    "description": "How many times a failed request is retried before its batch is spooled to disk.",
+                    "type": "integer"
+                },
+                "spool_directory": {
+                    "description": "The directory in which undeliverable audit logs are spooled until the endpoint is available again.",
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.AuditLoggingSyslogConfig": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "The address (host:port) of the syslog server. Leave empty to disable syslog streaming.",
+                    "type": "string"
+                },
+                "app_name": {
+                    "description": "The APP-NAME of the emitted messages.",
+                    "type": "string"
+                },
+                "ca_file": {
+                    "description": "CAFile specifies the location of the CA certificate used to verify the syslog server when using TLS.",
+                    "type": "string"
+                },
+                "insecure_skip_verify": {
+                    "description": "InsecureSkipVerify skips verification of the syslog server's certificate when using TLS.",
+                    "type": "boolean"
+                },
+                "protocol": {
+                    "description": "The transport over which messages are sent (available options: 'udp', 'tcp', 'tls').",
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.AuthMethod": {
             "type": "object",
             "properties": {
@@ -9257,12 +10304,14 @@ const docTemplate = `{
             "enum": [
                 "initiator",
                 "autostart",
-                "autostop"
+                "autostop",
+                "drift"
             ],
             "x-enum-varnames": [
                 "BuildReasonInitiator",
                 "BuildReasonAutostart",
-                "BuildReasonAutostop"
+                "BuildReasonAutostop",
+                "BuildReasonDrift"
             ]
         },
         "codersdk.ConnectionLatency": {
@@ -9278,27 +10327,130 @@ const docTemplate = `{
                 }
             }
         },
-        "codersdk.ConvertLoginRequest": {
+        "codersdk.ConnectionLog": {
             "type": "object",
-            "required": [
-                "password",
-                "to_type"
-            ],
             "properties": {
-                "password": {
+                "agent_name": {
                     "type": "string"
                 },
-                "to_type": {
-                    "description": "ToType is the login type to convert to.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/codersdk.LoginType"
-                        }
-                    ]
-                }
-            }
-        },
-        "codersdk.CreateFirstUserRequest": {
+                "code": {
+                    "type": "integer"
+                },
+                "connect_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "disconnect_reason": {
+                    "type": "string"
+                },
+                "disconnect_time": {
+                    "description": "DisconnectTime is nil while the connection is ongoing.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "organization": {
+                    "$ref": "#/definitions/codersdk.MinimalOrganization"
+                },
+                "type": {
+                    "enum": [
+                        "ssh",
+                        "vscode",
+                        "jetbrains",
+                        "reconnecting_pty",
+                        "port_forward",
+                        "app"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.ConnectionType"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "User is the user that connected. It is nil when the connection could\nnot be attributed to a user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.MinimalUser"
+                        }
+                    ]
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "workspace_name": {
+                    "type": "string"
+                },
+                "workspace_owner_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "workspace_owner_username": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.ConnectionLogResponse": {
+            "type": "object",
+            "properties": {
+                "connection_logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.ConnectionLog"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.ConnectionType": {
+            "type": "string",
+            "enum": [
+                "ssh",
+                "vscode",
+                "jetbrains",
+                "reconnecting_pty",
+                "port_forward",
+                "app"
+            ],
+            "x-enum-varnames": [
+                "ConnectionTypeSSH",
+                "ConnectionTypeVSCode",
+                "ConnectionTypeJetBrains",
+                "ConnectionTypeReconnectingPTY",
+                "ConnectionTypePortForward",
+                "ConnectionTypeApp"
+            ]
+        },
+        "codersdk.ConvertLoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "to_type"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "to_type": {
+                    "description": "ToType is the login type to convert to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.LoginType"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.CreateFirstUserRequest": {
             "type": "object",
             "required": [
                 "email",
@@ -9385,6 +10537,38 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateMaintenanceWindowRequest": {
+            "type": "object",
+            "required": [
+                "duration_ms",
+                "name",
+                "schedule"
+            ],
+            "properties": {
+                "block_builds": {
+                    "type": "boolean"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notice_ms": {
+                    "type": "integer"
+                },
+                "schedule": {
+                    "type": "string"
+                },
+                "template_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "codersdk.CreateOrganizationRequest": {
             "type": "object",
             "required": [
@@ -9523,6 +10707,11 @@ const docTemplate = `{
                         "$ref": "#/definitions/codersdk.VariableValue"
                     }
                 },
+                "workspace_id": {
+                    "description": "WorkspaceID plans the template version against the state of an\nexisting workspace of the same template. The resource changes of the\ndry-run then describe what updating the workspace would do.",
+                    "type": "string",
+                    "format": "uuid"
+                },
                 "workspace_name": {
                     "type": "string"
                 }
@@ -9584,6 +10773,27 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateTemplateVersionRolloutRequest": {
+            "type": "object",
+            "required": [
+                "template_version_id"
+            ],
+            "properties": {
+                "group_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "percent": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "codersdk.CreateTestAuditLogRequest": {
             "type": "object",
             "properties": {
@@ -9765,6 +10975,31 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateWorkspaceBulkOperationRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "search"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "start",
+                        "stop",
+                        "update",
+                        "delete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperationAction"
+                        }
+                    ]
+                },
+                "search": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.CreateWorkspaceProxyRequest": {
             "type": "object",
             "required": [
@@ -10029,6 +11264,9 @@ const docTemplate = `{
                 "allow_workspace_renames": {
                     "type": "boolean"
                 },
+                "audit_logging": {
+                    "$ref": "#/definitions/codersdk.AuditLoggingConfig"
+                },
                 "autobuild_poll_interval": {
                     "type": "integer"
                 },
@@ -10156,6 +11394,9 @@ const docTemplate = `{
                 "session_lifetime": {
                     "$ref": "#/definitions/codersdk.SessionLifetime"
                 },
+                "session_recording": {
+                    "$ref": "#/definitions/codersdk.SessionRecordingConfig"
+                },
                 "ssh_keygen_algorithm": {
                     "type": "string"
                 },
@@ -10204,6 +11445,9 @@ const docTemplate = `{
                 "wildcard_access_url": {
                     "type": "string"
                 },
+                "workspace_drift": {
+                    "$ref": "#/definitions/codersdk.WorkspaceDriftConfig"
+                },
                 "write_config": {
                     "type": "boolean"
                 }
@@ -10657,6 +11901,9 @@ const docTemplate = `{
                 },
                 "threshold_database": {
                     "type": "integer"
+                },
+                "threshold_pubsub": {
+                    "type": "integer"
                 }
             }
         },
@@ -10864,6 +12111,54 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.MaintenanceWindow": {
+            "type": "object",
+            "properties": {
+                "block_builds": {
+                    "description": "BlockBuilds rejects builds that start workspaces during the window.",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "message": {
+                    "description": "Message is shown to users in the announcement banner and notification\nof the window.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notice_ms": {
+                    "description": "NoticeMillis is how long before the start of the window users are\nnotified and the announcement banner is shown.",
+                    "type": "integer"
+                },
+                "organization_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "schedule": {
+                    "description": "Schedule is a weekly cron expression of the start of the window, e.g.\n\"CRON_TZ=Europe/Berlin 0 2 * * 6\".",
+                    "type": "string"
+                },
+                "template_id": {
+                    "description": "TemplateID limits the window to the workspaces of a template. The\nwindow applies to all workspaces of the organization if it is empty.",
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
         "codersdk.MinimalOrganization": {
             "type": "object",
             "required": [
@@ -10968,6 +12263,14 @@ const docTemplate = `{
         "codersdk.NotificationsConfig": {
             "type": "object",
             "properties": {
+                "digest_enabled": {
+                    "description": "Whether to coalesce multiple notifications of the same template for the same user into a single digest message.",
+                    "type": "boolean"
+                },
+                "digest_window": {
+                    "description": "How long to wait for further notifications to coalesce into a digest, measured from the first notification.",
+                    "type": "integer"
+                },
                 "dispatch_timeout": {
                     "description": "How long to wait while a notification is being sent before giving up.",
                     "type": "integer"
@@ -10997,13 +12300,21 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "method": {
-                    "description": "Which delivery method to use (available options: 'smtp', 'webhook').",
+                    "description": "Which delivery method to use (available options: 'smtp', 'webhook', 'slack', 'teams').",
                     "type": "string"
                 },
                 "retry_interval": {
                     "description": "The minimum time between retries.",
                     "type": "integer"
                 },
+                "slack": {
+                    "description": "Slack settings.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.NotificationsSlackConfig"
+                        }
+                    ]
+                },
                 "sync_buffer_size": {
                     "description": "The notifications system buffers message updates in memory to ease pressure on the database.\nThis option controls how many updates are kept in memory. The lower this value the\nlower the change of state inconsistency in a non-graceful shutdown - but it also increases load on the\ndatabase. It is recommended to keep this option at its default value.",
                     "type": "integer"
@@ -11012,6 +12323,14 @@ const docTemplate = `{
                     "description": "The notifications system buffers message updates in memory to ease pressure on the database.\nThis option controls how often it synchronizes its state with the database. The shorter this value the\nlower the change of state inconsistency in a non-graceful shutdown - but it also increases load on the\ndatabase. It is recommended to keep this option at its default value.",
                     "type": "integer"
                 },
+                "teams": {
+                    "description": "Microsoft Teams settings.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.NotificationsTeamsConfig"
+                        }
+                    ]
+                },
                 "webhook": {
                     "description": "Webhook settings.",
                     "allOf": [
@@ -11121,6 +12440,40 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.NotificationsSlackConfig": {
+            "type": "object",
+            "properties": {
+                "bot_token": {
+                    "description": "The bot token used to authenticate against the Slack Web API. When set, messages are sent via chat.postMessage\ninstead of an incoming webhook.",
+                    "type": "string"
+                },
+                "channel": {
+                    "description": "The channel to which messages will be posted when using a bot token.",
+                    "type": "string"
+                },
+                "endpoint": {
+                    "description": "The incoming webhook URL to which messages will be sent. When a bot token is configured, this overrides the\nURL of the chat.postMessage API.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/serpent.URL"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.NotificationsTeamsConfig": {
+            "type": "object",
+            "properties": {
+                "endpoint": {
+                    "description": "The incoming webhook URL to which Adaptive Card messages will be sent.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/serpent.URL"
+                        }
+                    ]
+                }
+            }
+        },
         "codersdk.NotificationsWebhookConfig": {
             "type": "object",
             "properties": {
@@ -11660,6 +13013,21 @@ const docTemplate = `{
                 },
                 "force_cancel_interval": {
                     "type": "integer"
+                },
+                "max_jobs_per_organization": {
+                    "type": "integer"
+                },
+                "max_jobs_per_user": {
+                    "type": "integer"
+                },
+                "terraform_flavor": {
+                    "type": "string"
+                },
+                "terraform_install_mirror": {
+                    "type": "string"
+                },
+                "terraform_provider_mirror": {
+                    "type": "string"
                 }
             }
         },
@@ -11737,6 +13105,11 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "estimated_start_at": {
+                    "description": "EstimatedStartAt is when a queued job is expected to start, based on\nits queue position and the duration of recently completed jobs. It is\nomitted if there is no estimate.",
+                    "type": "string",
+                    "format": "date-time"
+                },
                 "file_id": {
                     "type": "string",
                     "format": "uuid"
@@ -12273,7 +13646,9 @@ const docTemplate = `{
                 "organization",
                 "oauth2_provider_app",
                 "oauth2_provider_app_secret",
-                "custom_role"
+                "custom_role",
+                "workspace_bulk_operation",
+                "template_version_rollout"
             ],
             "x-enum-varnames": [
                 "ResourceTypeTemplate",
@@ -12292,7 +13667,9 @@ const docTemplate = `{
                 "ResourceTypeOrganization",
                 "ResourceTypeOAuth2ProviderApp",
                 "ResourceTypeOAuth2ProviderAppSecret",
-                "ResourceTypeCustomRole"
+                "ResourceTypeCustomRole",
+                "ResourceTypeWorkspaceBulkOperation",
+                "ResourceTypeTemplateVersionRollout"
             ]
         },
         "codersdk.Response": {
@@ -12315,6 +13692,24 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.RestoreWorkspaceSnapshotRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "restore_state": {
+                    "description": "RestoreState imports the Terraform state of the snapshot into the new\nworkspace, so that it adopts the resources of the snapshotted build\ninstead of provisioning new ones. Only template managers may restore\nstate.",
+                    "type": "boolean"
+                },
+                "snapshot": {
+                    "$ref": "#/definitions/codersdk.WorkspaceSnapshot"
+                }
+            }
+        },
         "codersdk.Role": {
             "type": "object",
             "properties": {
@@ -12434,6 +13829,23 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.SessionRecordingConfig": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "description": "Enabled records the output of SSH and reconnecting PTY sessions.",
+                    "type": "boolean"
+                },
+                "max_size": {
+                    "description": "MaxSize is the maximum size of a single recording in bytes. Recordings\nare truncated once they reach this size.",
+                    "type": "integer"
+                },
+                "record_input": {
+                    "description": "RecordInput additionally records what is typed into the sessions.",
+                    "type": "boolean"
+                }
+            }
+        },
         "codersdk.SlimRole": {
             "type": "object",
             "properties": {
@@ -12603,6 +14015,13 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
+                "idle_cpu_threshold_percent": {
+                    "type": "integer"
+                },
+                "idle_detection_enabled": {
+                    "description": "IdleDetectionEnabled only bumps workspace deadlines on user input, app\nrequests and, if IdleCPUThresholdPercent is set, CPU load, instead of\non any open connection.",
+                    "type": "boolean"
+                },
                 "max_port_share_level": {
                     "$ref": "#/definitions/codersdk.WorkspaceAgentPortShareLevel"
                 },
@@ -13166,6 +14585,99 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.TemplateVersionRollout": {
+            "type": "object",
+            "properties": {
+                "active_builds": {
+                    "description": "ActiveBuilds are the completed builds of the active version since the\nrollout started, to compare the canary against.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutBuildStats"
+                        }
+                    ]
+                },
+                "canary_builds": {
+                    "description": "CanaryBuilds are the completed builds of the version of the rollout\nsince the rollout started.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutBuildStats"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "created_by_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "group_id": {
+                    "description": "GroupID is the group whose members' workspaces are in the canary cohort\nregardless of the percentage.",
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "percent": {
+                    "description": "Percent is the percentage of the workspaces of the template in the\ncanary cohort.",
+                    "type": "integer"
+                },
+                "status": {
+                    "enum": [
+                        "canary",
+                        "promoted",
+                        "rolled_back"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutStatus"
+                        }
+                    ]
+                },
+                "template_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_version_name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "codersdk.TemplateVersionRolloutBuildStats": {
+            "type": "object",
+            "properties": {
+                "failed_builds": {
+                    "type": "integer"
+                },
+                "total_builds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.TemplateVersionRolloutStatus": {
+            "type": "string",
+            "enum": [
+                "canary",
+                "promoted",
+                "rolled_back"
+            ],
+            "x-enum-varnames": [
+                "TemplateVersionRolloutStatusCanary",
+                "TemplateVersionRolloutStatusPromoted",
+                "TemplateVersionRolloutStatusRolledBack"
+            ]
+        },
         "codersdk.TemplateVersionVariable": {
             "type": "object",
             "properties": {
@@ -13298,6 +14810,34 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateMaintenanceWindowRequest": {
+            "type": "object",
+            "required": [
+                "duration_ms",
+                "name",
+                "schedule"
+            ],
+            "properties": {
+                "block_builds": {
+                    "type": "boolean"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notice_ms": {
+                    "type": "integer"
+                },
+                "schedule": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.UpdateOrganizationRequest": {
             "type": "object",
             "properties": {
@@ -13415,6 +14955,32 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateWorkspaceACL": {
+            "type": "object",
+            "properties": {
+                "group_roles": {
+                    "description": "GroupRoles is a mapping of group ID or name to role. Names are looked\nup in the organization of the workspace. An empty role removes the\ngroup from the ACL.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/codersdk.WorkspaceRole"
+                    },
+                    "example": {
+                        "8bd26b20-f3e8-48be-a903-46bb920cf671": "admin"
+                    }
+                },
+                "user_roles": {
+                    "description": "UserRoles is a mapping of user ID or username to role. An empty role\nremoves the user from the ACL.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/codersdk.WorkspaceRole"
+                    },
+                    "example": {
+                        "4df59e74-c027-470b-ab4d-cbba8963a5e9": "use",
+                        "alice": "admin"
+                    }
+                }
+            }
+        },
         "codersdk.UpdateWorkspaceAutomaticUpdatesRequest": {
             "type": "object",
             "properties": {
@@ -13849,6 +15415,14 @@ const docTemplate = `{
                     "type": "string",
                     "format": "date-time"
                 },
+                "drift": {
+                    "description": "Drift shows whether the infrastructure of the workspace was changed\nor deleted outside of Coder since its latest build.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceDrift"
+                        }
+                    ]
+                },
                 "favorite": {
                     "type": "boolean"
                 },
@@ -13926,6 +15500,23 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.WorkspaceACL": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceGroup"
+                    }
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceUser"
+                    }
+                }
+            }
+        },
         "codersdk.WorkspaceAgent": {
             "type": "object",
             "properties": {
@@ -14068,6 +15659,47 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.WorkspaceAgentDirectory": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceAgentFileInfo"
+                    }
+                },
+                "path": {
+                    "description": "Path is the absolute path of the directory in the workspace.",
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.WorkspaceAgentFileInfo": {
+            "type": "object",
+            "properties": {
+                "is_dir": {
+                    "type": "boolean"
+                },
+                "mod_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "mode": {
+                    "description": "Mode is the file mode in the format of ls, e.g. \"-rw-r--r--\".",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path is the absolute path of the file in the workspace.",
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
         "codersdk.WorkspaceAgentHealth": {
             "type": "object",
             "properties": {
@@ -14443,7 +16075,8 @@ const docTemplate = `{
                     "enum": [
                         "initiator",
                         "autostart",
-                        "autostop"
+                        "autostop",
+                        "drift"
                     ],
                     "allOf": [
                         {
@@ -14529,65 +16162,280 @@ const docTemplate = `{
                 }
             }
         },
-        "codersdk.WorkspaceConnectionLatencyMS": {
-            "type": "object",
-            "properties": {
-                "p50": {
-                    "type": "number"
-                },
-                "p95": {
-                    "type": "number"
-                }
-            }
-        },
-        "codersdk.WorkspaceDeploymentStats": {
+        "codersdk.WorkspaceBulkOperation": {
             "type": "object",
             "properties": {
-                "building": {
-                    "type": "integer"
-                },
-                "connection_latency_ms": {
-                    "$ref": "#/definitions/codersdk.WorkspaceConnectionLatencyMS"
+                "action": {
+                    "enum": [
+                        "start",
+                        "stop",
+                        "update",
+                        "delete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperationAction"
+                        }
+                    ]
                 },
-                "failed": {
-                    "type": "integer"
+                "completed_at": {
+                    "description": "CompletedAt is set once the builds of all workspaces were enqueued,\nskipped or failed to enqueue. Enqueued builds may still be running.",
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "pending": {
-                    "type": "integer"
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "running": {
-                    "type": "integer"
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "rx_bytes": {
-                    "type": "integer"
+                "initiator_id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "stopped": {
-                    "type": "integer"
+                "search": {
+                    "type": "string"
                 },
-                "tx_bytes": {
-                    "type": "integer"
-                }
-            }
-        },
-        "codersdk.WorkspaceHealth": {
-            "type": "object",
-            "properties": {
-                "failing_agents": {
-                    "description": "FailingAgents lists the IDs of the agents that are failing, if any.",
+                "workspaces": {
                     "type": "array",
                     "items": {
-                        "type": "string",
-                        "format": "uuid"
+                        "$ref": "#/definitions/codersdk.WorkspaceBulkOperationWorkspace"
                     }
-                },
-                "healthy": {
-                    "description": "Healthy is true if the workspace is healthy.",
-                    "type": "boolean",
-                    "example": false
                 }
             }
         },
-        "codersdk.WorkspaceProxy": {
+        "codersdk.WorkspaceBulkOperationAction": {
+            "type": "string",
+            "enum": [
+                "start",
+                "stop",
+                "update",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceBulkOperationActionStart",
+                "WorkspaceBulkOperationActionStop",
+                "WorkspaceBulkOperationActionUpdate",
+                "WorkspaceBulkOperationActionDelete"
+            ]
+        },
+        "codersdk.WorkspaceBulkOperationWorkspace": {
+            "type": "object",
+            "properties": {
+                "job_status": {
+                    "description": "JobStatus is the status of the provisioner job of the enqueued build.",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "succeeded",
+                        "canceling",
+                        "canceled",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.ProvisionerJobStatus"
+                        }
+                    ]
+                },
+                "message": {
+                    "description": "Message is the reason the workspace was skipped or its build failed to\nenqueue.",
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "enqueued",
+                        "skipped",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperationWorkspaceStatus"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "workspace_build_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "workspace_name": {
+                    "type": "string"
+                },
+                "workspace_owner_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.WorkspaceBulkOperationWorkspaceStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "enqueued",
+                "skipped",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceBulkOperationWorkspaceStatusPending",
+                "WorkspaceBulkOperationWorkspaceStatusEnqueued",
+                "WorkspaceBulkOperationWorkspaceStatusSkipped",
+                "WorkspaceBulkOperationWorkspaceStatusFailed"
+            ]
+        },
+        "codersdk.WorkspaceConnectionLatencyMS": {
+            "type": "object",
+            "properties": {
+                "p50": {
+                    "type": "number"
+                },
+                "p95": {
+                    "type": "number"
+                }
+            }
+        },
+        "codersdk.WorkspaceDeploymentStats": {
+            "type": "object",
+            "properties": {
+                "building": {
+                    "type": "integer"
+                },
+                "connection_latency_ms": {
+                    "$ref": "#/definitions/codersdk.WorkspaceConnectionLatencyMS"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "running": {
+                    "type": "integer"
+                },
+                "rx_bytes": {
+                    "type": "integer"
+                },
+                "stopped": {
+                    "type": "integer"
+                },
+                "tx_bytes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.WorkspaceDrift": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "description": "CheckedAt is the time the latest drift check completed.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "resources": {
+                    "description": "Resources lists the resources that were changed or deleted outside of\nCoder, if any.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceResourceChange"
+                    }
+                },
+                "status": {
+                    "enum": [
+                        "unknown",
+                        "in_sync",
+                        "drifted"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceDriftStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.WorkspaceDriftConfig": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action is what is done when a workspace has drifted (available options:\n'none', 'notify', 'reconcile').",
+                    "type": "string"
+                },
+                "check_interval": {
+                    "description": "CheckInterval is the time between two drift checks of a running\nworkspace. Drift detection is disabled if it is zero.",
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.WorkspaceDriftStatus": {
+            "type": "string",
+            "enum": [
+                "unknown",
+                "in_sync",
+                "drifted"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceDriftStatusUnknown",
+                "WorkspaceDriftStatusInSync",
+                "WorkspaceDriftStatusDrifted"
+            ]
+        },
+        "codersdk.WorkspaceGroup": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string",
+                    "format": "uri"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "enum": [
+                        "admin",
+                        "use"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceRole"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.WorkspaceHealth": {
+            "type": "object",
+            "properties": {
+                "failing_agents": {
+                    "description": "FailingAgents lists the IDs of the agents that are failing, if any.",
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                },
+                "healthy": {
+                    "description": "Healthy is true if the workspace is healthy.",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "codersdk.WorkspaceProxy": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -14701,23 +16549,220 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
-                "job_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "job_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "metadata": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceResourceMetadata"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "workspace_transition": {
+                    "enum": [
+                        "start",
+                        "stop",
+                        "delete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceTransition"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.WorkspaceResourceChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "enum": [
+                        "create",
+                        "update",
+                        "replace",
+                        "destroy"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceResourceChangeAction"
+                        }
+                    ]
+                },
+                "address": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.WorkspaceResourceChangeAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "replace",
+                "destroy"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceResourceChangeActionCreate",
+                "WorkspaceResourceChangeActionUpdate",
+                "WorkspaceResourceChangeActionReplace",
+                "WorkspaceResourceChangeActionDestroy"
+            ]
+        },
+        "codersdk.WorkspaceResourceMetadata": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "sensitive": {
+                    "type": "boolean"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.WorkspaceRole": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "use",
+                ""
+            ],
+            "x-enum-varnames": [
+                "WorkspaceRoleAdmin",
+                "WorkspaceRoleUse",
+                "WorkspaceRoleDeleted"
+            ]
+        },
+        "codersdk.WorkspaceSessionRecording": {
+            "type": "object",
+            "properties": {
+                "agent_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "command": {
+                    "description": "Command is the command the session was started with. It is empty for\nlogin shells.",
+                    "type": "string"
+                },
+                "ended_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "input_recorded": {
+                    "description": "InputRecorded is true if the recording includes what was typed into the\nsession.",
+                    "type": "boolean"
+                },
+                "size": {
+                    "description": "Size is the size of the recording in bytes.",
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "truncated": {
+                    "description": "Truncated is true if the recording reached the maximum size before the\nsession ended.",
+                    "type": "boolean"
+                },
+                "type": {
+                    "enum": [
+                        "ssh",
+                        "reconnecting_pty"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceSessionRecordingType"
+                        }
+                    ]
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "codersdk.WorkspaceSessionRecordingType": {
+            "type": "string",
+            "enum": [
+                "ssh",
+                "reconnecting_pty"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceSessionRecordingTypeSSH",
+                "WorkspaceSessionRecordingTypeReconnectingPTY"
+            ]
+        },
+        "codersdk.WorkspaceSnapshot": {
+            "type": "object",
+            "properties": {
+                "agent_metadata": {
+                    "description": "AgentMetadata is the metadata last reported by the agents of the\nworkspace. It is informational only and is not restored.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceSnapshotAgentMetadata"
+                    }
+                },
+                "build_number": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "organization_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "owner_name": {
+                    "type": "string"
+                },
+                "rich_parameter_values": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceBuildParameter"
+                    }
                 },
-                "metadata": {
+                "state": {
+                    "description": "State is the Terraform state of the build. It is only included for\nusers that are allowed to manage the template, as it may contain\nsecrets.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/codersdk.WorkspaceResourceMetadata"
+                        "type": "integer"
                     }
                 },
-                "name": {
+                "template_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_name": {
                     "type": "string"
                 },
-                "type": {
+                "template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_version_name": {
                     "type": "string"
                 },
-                "workspace_transition": {
+                "transition": {
                     "enum": [
                         "start",
                         "stop",
@@ -14728,17 +16773,37 @@ const docTemplate = `{
                             "$ref": "#/definitions/codersdk.WorkspaceTransition"
                         }
                     ]
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "workspace_name": {
+                    "type": "string"
                 }
             }
         },
-        "codersdk.WorkspaceResourceMetadata": {
+        "codersdk.WorkspaceSnapshotAgentMetadata": {
             "type": "object",
             "properties": {
-                "key": {
+                "agent_name": {
                     "type": "string"
                 },
-                "sensitive": {
-                    "type": "boolean"
+                "collected_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
                 },
                 "value": {
                     "type": "string"
@@ -14796,6 +16861,37 @@ const docTemplate = `{
                 "WorkspaceTransitionDelete"
             ]
         },
+        "codersdk.WorkspaceUser": {
+            "type": "object",
+            "required": [
+                "id",
+                "username"
+            ],
+            "properties": {
+                "avatar_url": {
+                    "type": "string",
+                    "format": "uri"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "role": {
+                    "enum": [
+                        "admin",
+                        "use"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceRole"
+                        }
+                    ]
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.WorkspacesResponse": {
             "type": "object",
             "properties": {
@@ -14862,7 +16958,12 @@ const docTemplate = `{
                 "EDERP02",
                 "EPD01",
                 "EPD02",
-                "EPD03"
+                "EPD03",
+                "EPS01",
+                "EPS02",
+                "ENOT01",
+                "EEXT01",
+                "EEXT02"
             ],
             "x-enum-varnames": [
                 "CodeUnknown",
@@ -14882,7 +16983,12 @@ const docTemplate = `{
                 "CodeDERPOneNodeUnhealthy",
                 "CodeProvisionerDaemonsNoProvisionerDaemons",
                 "CodeProvisionerDaemonVersionMismatch",
-                "CodeProvisionerDaemonAPIMajorVersionDeprecated"
+                "CodeProvisionerDaemonAPIMajorVersionDeprecated",
+                "CodePubsubMeasureFailed",
+                "CodePubsubSlow",
+                "CodeNotificationsMethodFailed",
+                "CodeExternalAuthUnreachable",
+                "CodeExternalAuthCredentialsRejected"
             ]
         },
         "health.Message": {
@@ -15158,6 +17264,58 @@ const docTemplate = `{
                 }
             }
         },
+        "healthsdk.ExternalAuthReport": {
+            "type": "object",
+            "properties": {
+                "dismissed": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/healthsdk.ExternalAuthReportItem"
+                    }
+                },
+                "severity": {
+                    "enum": [
+                        "ok",
+                        "warning",
+                        "error"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/health.Severity"
+                        }
+                    ]
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.Message"
+                    }
+                }
+            }
+        },
+        "healthsdk.ExternalAuthReportItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.Message"
+                    }
+                }
+            }
+        },
         "healthsdk.HealthSection": {
             "type": "string",
             "enum": [
@@ -15166,7 +17324,10 @@ const docTemplate = `{
                 "Websocket",
                 "Database",
                 "WorkspaceProxy",
-                "ProvisionerDaemons"
+                "ProvisionerDaemons",
+                "Pubsub",
+                "Notifications",
+                "ExternalAuth"
             ],
             "x-enum-varnames": [
                 "HealthSectionDERP",
@@ -15174,7 +17335,10 @@ const docTemplate = `{
                 "HealthSectionWebsocket",
                 "HealthSectionDatabase",
                 "HealthSectionWorkspaceProxy",
-                "HealthSectionProvisionerDaemons"
+                "HealthSectionProvisionerDaemons",
+                "HealthSectionPubsub",
+                "HealthSectionNotifications",
+                "HealthSectionExternalAuth"
             ]
         },
         "healthsdk.HealthSettings": {
@@ -15204,13 +17368,22 @@ const docTemplate = `{
                 "derp": {
                     "$ref": "#/definitions/healthsdk.DERPHealthReport"
                 },
+                "external_auth": {
+                    "$ref": "#/definitions/healthsdk.ExternalAuthReport"
+                },
                 "healthy": {
                     "description": "Healthy is true if the report returns no errors.\nDeprecated: use ` + "`" + `Severity` + "`" + ` instead",
                     "type": "boolean"
                 },
+                "notifications": {
+                    "$ref": "#/definitions/healthsdk.NotificationsReport"
+                },
                 "provisioner_daemons": {
                     "$ref": "#/definitions/healthsdk.ProvisionerDaemonsReport"
                 },
+                "pubsub": {
+                    "$ref": "#/definitions/healthsdk.PubsubReport"
+                },
                 "severity": {
                     "description": "Severity indicates the status of Coder health.",
                     "enum": [
@@ -15237,6 +17410,97 @@ const docTemplate = `{
                 }
             }
         },
+        "healthsdk.HealthcheckSectionResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "section": {
+                    "$ref": "#/definitions/healthsdk.HealthSection"
+                },
+                "severity": {
+                    "enum": [
+                        "ok",
+                        "warning",
+                        "error"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/health.Severity"
+                        }
+                    ]
+                },
+                "time": {
+                    "description": "Time is the time the report was generated at.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.Message"
+                    }
+                }
+            }
+        },
+        "healthsdk.NotificationsReport": {
+            "type": "object",
+            "properties": {
+                "dismissed": {
+                    "type": "boolean"
+                },
+                "enabled": {
+                    "description": "Enabled is false if the notifications experiment is not enabled, in which\ncase no delivery methods are checked.",
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/healthsdk.NotificationsReportItem"
+                    }
+                },
+                "severity": {
+                    "enum": [
+                        "ok",
+                        "warning",
+                        "error"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/health.Severity"
+                        }
+                    ]
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.Message"
+                    }
+                }
+            }
+        },
+        "healthsdk.NotificationsReportItem": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "description": "Default is true if this is the deployment's default delivery method.",
+                    "type": "boolean"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.Message"
+                    }
+                }
+            }
+        },
         "healthsdk.ProvisionerDaemonsReport": {
             "type": "object",
             "properties": {
@@ -15286,6 +17550,44 @@ const docTemplate = `{
                 }
             }
         },
+        "healthsdk.PubsubReport": {
+            "type": "object",
+            "properties": {
+                "dismissed": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "latency": {
+                    "type": "string"
+                },
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "severity": {
+                    "enum": [
+                        "ok",
+                        "warning",
+                        "error"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/health.Severity"
+                        }
+                    ]
+                },
+                "threshold_ms": {
+                    "type": "integer"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.Message"
+                    }
+                }
+            }
+        },
         "healthsdk.STUNReport": {
             "type": "object",
             "properties": {
@@ -1,13 +1,17 @@
 package coderd
 
 import (
+	"bufio"
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/netip"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +24,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
 	"github.com/coder/coder/v2/coderd/searchquery"
@@ -87,6 +92,226 @@ func (api *API) auditLogs(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// auditLogExportPageSize is the number of audit logs fetched per query while
+// exporting.
+const auditLogExportPageSize = 1000
+
+// @Summary Export audit logs
+// @ID export-audit-logs
+// @Security CoderSessionToken
+// @Tags Audit
+// @Param q query string false "Search query"
+// @Param format query string false "Export format" Enums(jsonl,csv)
+// @Success 200
+// @Router /audit/export [get]
+func (api *API) exportAuditLogs(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	apiKey := httpmw.APIKey(r)
+
+	format := codersdk.AuditLogExportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = codersdk.AuditLogExportFormatJSONL
+	}
+	var (
+		contentType string
+		newWriter   func(io.Writer) auditLogExportWriter
+	)
+	switch format {
+	case codersdk.AuditLogExportFormatJSONL:
+		contentType = "application/x-ndjson"
+		newWriter = newAuditLogJSONLWriter
+	case codersdk.AuditLogExportFormatCSV:
+		contentType = "text/csv"
+		newWriter = newAuditLogCSVWriter
+	default:
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Invalid export format %q.", format),
+			Validations: []codersdk.ValidationError{
+				{Field: "format", Detail: "Must be one of: jsonl, csv."},
+			},
+		})
+		return
+	}
+
+	queryStr := r.URL.Query().Get("q")
+	filter, errs := searchquery.AuditLogs(ctx, api.Database, queryStr)
+	if len(errs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid audit search query.",
+			Validations: errs,
+		})
+		return
+	}
+	if filter.Username == "me" {
+		filter.UserID = apiKey.UserID
+		filter.Username = ""
+	}
+	// Audit logs created while exporting are excluded, which also keeps
+	// the pages below stable.
+	now := dbtime.Now()
+	if filter.DateTo.IsZero() || filter.DateTo.After(now) {
+		filter.DateTo = now
+	}
+
+	// Check authorization before committing to a successful response.
+	filter.LimitOpt = auditLogExportPageSize
+	dblogs, err := api.Database.GetAuditLogsOffset(ctx, filter)
+	if dbauthz.IsNotAuthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	rw.Header().Set("Content-Type", contentType)
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=audit-logs.%s", format))
+	rw.WriteHeader(http.StatusOK)
+	flusher, _ := rw.(http.Flusher)
+	writer := newWriter(rw)
+
+	// Pages are walked backwards in time by moving the upper bound to the
+	// oldest audit log seen so far, rather than by offset, so that the cost
+	// of each query does not grow with the export. Audit logs which share
+	// the boundary timestamp are fetched again, so they are tracked to
+	// avoid writing duplicates.
+	boundary := map[uuid.UUID]struct{}{}
+	for {
+		for _, dblog := range dblogs {
+			if _, ok := boundary[dblog.AuditLog.ID]; ok {
+				continue
+			}
+			alog, _ := api.convertAuditLogRow(ctx, dblog)
+			err = writer.Write(alog)
+			if err != nil {
+				api.Logger.Debug(ctx, "write audit log export", slog.Error(err))
+				return
+			}
+		}
+		err = writer.Flush()
+		if err != nil {
+			api.Logger.Debug(ctx, "flush audit log export", slog.Error(err))
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if len(dblogs) < int(filter.LimitOpt) {
+			return
+		}
+
+		oldest := dblogs[len(dblogs)-1].AuditLog.Time
+		if !oldest.Equal(filter.DateTo) {
+			boundary = map[uuid.UUID]struct{}{}
+		}
+		for _, dblog := range dblogs {
+			if dblog.AuditLog.Time.Equal(oldest) {
+				boundary[dblog.AuditLog.ID] = struct{}{}
+			}
+		}
+		filter.DateTo = oldest
+		filter.LimitOpt = int32(auditLogExportPageSize + len(boundary))
+
+		dblogs, err = api.Database.GetAuditLogsOffset(ctx, filter)
+		if err != nil {
+			// The response has already started, so the best we can do is
+			// to abort it so the client does not mistake it for a
+			// complete export.
+			api.Logger.Error(ctx, "fetch audit logs for export", slog.Error(err))
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+type auditLogExportWriter interface {
+	Write(alog codersdk.AuditLog) error
+	Flush() error
+}
+
+type auditLogJSONLWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newAuditLogJSONLWriter(w io.Writer) auditLogExportWriter {
+	bw := bufio.NewWriter(w)
+	return &auditLogJSONLWriter{w: bw, enc: json.NewEncoder(bw)}
+}
+
+func (w *auditLogJSONLWriter) Write(alog codersdk.AuditLog) error {
+	return w.enc.Encode(alog)
+}
+
+func (w *auditLogJSONLWriter) Flush() error {
+	return w.w.Flush()
+}
+
+// auditLogCSVHeader lists the columns of a CSV export.
+var auditLogCSVHeader = []string{
+	"id", "time", "organization_id", "organization_name", "user_id", "username", "email",
+	"ip", "user_agent", "action", "resource_type", "resource_id", "resource_target",
+	"status_code", "request_id", "description", "diff", "additional_fields",
+}
+
+type auditLogCSVWriter struct {
+	w *csv.Writer
+}
+
+// newAuditLogCSVWriter writes the header right away, so that an export
+// without any audit logs is still a valid CSV file. The header is buffered
+// and any error writing it is returned by Flush.
+func newAuditLogCSVWriter(w io.Writer) auditLogExportWriter {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(auditLogCSVHeader)
+	return &auditLogCSVWriter{w: cw}
+}
+
+func (w *auditLogCSVWriter) Write(alog codersdk.AuditLog) error {
+	var orgName, userID, username, email, ip string
+	if alog.Organization != nil {
+		orgName = alog.Organization.Name
+	}
+	if alog.User != nil {
+		userID = alog.User.ID.String()
+		username = alog.User.Username
+		email = alog.User.Email
+	}
+	if alog.IP.IsValid() {
+		ip = alog.IP.String()
+	}
+	diff, err := json.Marshal(alog.Diff)
+	if err != nil {
+		return xerrors.Errorf("marshal diff: %w", err)
+	}
+
+	return w.w.Write([]string{
+		alog.ID.String(),
+		alog.Time.Format(time.RFC3339Nano),
+		alog.OrganizationID.String(),
+		orgName,
+		userID,
+		username,
+		email,
+		ip,
+		alog.UserAgent,
+		string(alog.Action),
+		string(alog.ResourceType),
+		alog.ResourceID.String(),
+		alog.ResourceTarget,
+		strconv.Itoa(int(alog.StatusCode)),
+		alog.RequestID.String(),
+		alog.Description,
+		string(diff),
+		string(alog.AdditionalFields),
+	})
+}
+
+func (w *auditLogCSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
 // @Summary Generate fake audit log
 // @ID generate-fake-audit-log
 // @Security CoderSessionToken
@@ -182,6 +407,17 @@ func (api *API) convertAuditLogs(ctx context.Context, dblogs []database.GetAudit
 }
 
 func (api *API) convertAuditLog(ctx context.Context, dblog database.GetAuditLogsOffsetRow) codersdk.AuditLog {
+	alog, additionalFields := api.convertAuditLogRow(ctx, dblog)
+	alog.IsDeleted = api.auditLogIsResourceDeleted(ctx, dblog)
+	if !alog.IsDeleted {
+		alog.ResourceLink = api.auditLogResourceLink(ctx, dblog, additionalFields)
+	}
+	return alog
+}
+
+// convertAuditLogRow converts an audit log without looking up the current
+// state of its resource, which would require additional queries per row.
+func (api *API) convertAuditLogRow(ctx context.Context, dblog database.GetAuditLogsOffsetRow) (codersdk.AuditLog, audit.AdditionalFields) {
 	ip, _ := netip.AddrFromSlice(dblog.AuditLog.Ip.IPNet.IP)
 
 	diff := codersdk.AuditDiff{}
@@ -228,16 +464,6 @@ func (api *API) convertAuditLog(ctx context.Context, dblog database.GetAuditLogs
 		api.Logger.Error(ctx, "marshal additional fields", slog.Error(err))
 	}
 
-	var (
-		isDeleted    = api.auditLogIsResourceDeleted(ctx, dblog)
-		resourceLink string
-	)
-	if isDeleted {
-		resourceLink = ""
-	} else {
-		resourceLink = api.auditLogResourceLink(ctx, dblog, additionalFields)
-	}
-
 	alog := codersdk.AuditLog{
 		ID:        dblog.AuditLog.ID,
 		RequestID: dblog.AuditLog.RequestID,
@@ -256,8 +482,6 @@ func (api *API) convertAuditLog(ctx context.Context, dblog database.GetAuditLogs
 		AdditionalFields: dblog.AuditLog.AdditionalFields,
 		User:             user,
 		Description:      auditLogDescription(dblog),
-		ResourceLink:     resourceLink,
-		IsDeleted:        isDeleted,
 	}
 
 	if dblog.AuditLog.OrganizationID != uuid.Nil {
@@ -269,7 +493,7 @@ func (api *API) convertAuditLog(ctx context.Context, dblog database.GetAuditLogs
 		}
 	}
 
-	return alog
+	return alog, additionalFields
 }
 
 func auditLogDescription(alog database.GetAuditLogsOffsetRow) string {
@@ -22,6 +22,9 @@ type AdditionalFields struct {
 	BuildReason    database.BuildReason `json:"build_reason"`
 	WorkspaceOwner string               `json:"workspace_owner"`
 	WorkspaceID    uuid.UUID            `json:"workspace_id"`
+	// BulkOperationID is set for builds enqueued by a workspace bulk
+	// operation, to relate them to the audited operation.
+	BulkOperationID string `json:"bulk_operation_id,omitempty"`
 }
 
 func NewNop() Auditor {
@@ -120,6 +120,10 @@ func ResourceTarget[T Auditable](tgt T) string {
 		return typed.Name
 	case database.NotificationTemplate:
 		return typed.Name
+	case database.WorkspaceBulkOperation:
+		return fmt.Sprintf("%s %q", typed.Action, typed.Search)
+	case database.TemplateVersionRollout:
+		return typed.TemplateVersionID.String()
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceTarget", tgt))
 	}
@@ -168,6 +172,10 @@ func ResourceID[T Auditable](tgt T) uuid.UUID {
 		return typed.ID
 	case database.NotificationTemplate:
 		return typed.ID
+	case database.WorkspaceBulkOperation:
+		return typed.ID
+	case database.TemplateVersionRollout:
+		return typed.ID
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceID", tgt))
 	}
@@ -213,6 +221,10 @@ func ResourceType[T Auditable](tgt T) database.ResourceType {
 		return database.ResourceTypeOrganization
 	case database.NotificationTemplate:
 		return database.ResourceTypeNotificationTemplate
+	case database.WorkspaceBulkOperation:
+		return database.ResourceTypeWorkspaceBulkOperation
+	case database.TemplateVersionRollout:
+		return database.ResourceTypeTemplateVersionRollout
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceType", typed))
 	}
@@ -260,6 +272,11 @@ func ResourceRequiresOrgID[T Auditable]() bool {
 		return true
 	case database.NotificationTemplate:
 		return false
+	case database.WorkspaceBulkOperation:
+		// Bulk operations can span the workspaces of many organizations.
+		return false
+	case database.TemplateVersionRollout:
+		return true
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceRequiresOrgID", tgt))
 	}
@@ -445,6 +462,9 @@ func BackgroundAudit[T Auditable](ctx context.Context, p *BackgroundAuditParams[
 
 type WorkspaceBuildBaggage struct {
 	IP string
+	// BulkOperationID is the workspace bulk operation that enqueued the
+	// build, if any.
+	BulkOperationID string
 }
 
 func (b WorkspaceBuildBaggage) Props() ([]baggage.Property, error) {
@@ -452,8 +472,17 @@ func (b WorkspaceBuildBaggage) Props() ([]baggage.Property, error) {
 	if err != nil {
 		return nil, xerrors.Errorf("create ip kv property: %w", err)
 	}
+	props := []baggage.Property{ipProp}
+
+	if b.BulkOperationID != "" {
+		bulkProp, err := baggage.NewKeyValueProperty("bulk_operation_id", b.BulkOperationID)
+		if err != nil {
+			return nil, xerrors.Errorf("create bulk operation id kv property: %w", err)
+		}
+		props = append(props, bulkProp)
+	}
 
-	return []baggage.Property{ipProp}, nil
+	return props, nil
 }
 
 func WorkspaceBuildBaggageFromRequest(r *http.Request) WorkspaceBuildBaggage {
@@ -491,6 +520,8 @@ func BaggageFromContext(ctx context.Context) WorkspaceBuildBaggage {
 		switch prop.Key() {
 		case "ip":
 			d.IP, _ = prop.Value()
+		case "bulk_operation_id":
+			d.BulkOperationID, _ = prop.Value()
 		default:
 		}
 	}
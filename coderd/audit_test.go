@@ -1,9 +1,13 @@
 package coderd_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
 	"testing"
 	"time"
@@ -17,6 +21,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
 )
 
 func TestAuditLogs(t *testing.T) {
@@ -387,3 +392,100 @@ func TestAuditLogsFilter(t *testing.T) {
 		}
 	})
 }
+
+func TestExportAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	client := coderdtest.New(t, nil)
+	user := coderdtest.CreateFirstUser(t, client)
+
+	old := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, req := range []codersdk.CreateTestAuditLogRequest{
+		{ResourceID: user.UserID, Time: old},
+		{ResourceID: user.UserID},
+		{ResourceID: uuid.New(), ResourceType: codersdk.ResourceTypeTemplate},
+	} {
+		require.NoError(t, client.CreateTestAuditLog(ctx, req))
+	}
+
+	export := func(t *testing.T, req codersdk.AuditLogExportRequest) []byte {
+		t.Helper()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		body, err := client.ExportAuditLogs(ctx, req)
+		require.NoError(t, err)
+		defer body.Close()
+		out, err := io.ReadAll(body)
+		require.NoError(t, err)
+		return out
+	}
+
+	t.Run("JSONL", func(t *testing.T) {
+		t.Parallel()
+
+		out := export(t, codersdk.AuditLogExportRequest{})
+		lines := bytes.Split(bytes.TrimSpace(out), []byte("\n"))
+		require.Len(t, lines, 3)
+
+		var alogs []codersdk.AuditLog
+		for _, line := range lines {
+			var alog codersdk.AuditLog
+			require.NoError(t, json.Unmarshal(line, &alog))
+			alogs = append(alogs, alog)
+		}
+		// Newest first.
+		require.Equal(t, codersdk.ResourceTypeTemplate, alogs[0].ResourceType)
+		require.True(t, alogs[2].Time.Equal(old))
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		t.Parallel()
+
+		out := export(t, codersdk.AuditLogExportRequest{
+			SearchQuery: "resource_type:user",
+			Format:      codersdk.AuditLogExportFormatCSV,
+		})
+		records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, "id", records[0][0])
+		require.Equal(t, "resource_type", records[0][10])
+		require.Equal(t, "user", records[1][10])
+		require.Equal(t, user.UserID.String(), records[1][11])
+	})
+
+	t.Run("CSVEmpty", func(t *testing.T) {
+		t.Parallel()
+
+		out := export(t, codersdk.AuditLogExportRequest{
+			SearchQuery: "resource_type:group",
+			Format:      codersdk.AuditLogExportFormatCSV,
+		})
+		records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, "id", records[0][0])
+	})
+
+	t.Run("DateRange", func(t *testing.T) {
+		t.Parallel()
+
+		out := export(t, codersdk.AuditLogExportRequest{
+			SearchQuery: "date_from:2020-01-01 date_to:2020-01-01",
+		})
+		lines := bytes.Split(bytes.TrimSpace(out), []byte("\n"))
+		require.Len(t, lines, 1)
+	})
+
+	t.Run("InvalidFormat", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		_, err := client.ExportAuditLogs(ctx, codersdk.AuditLogExportRequest{
+			Format: "xml",
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+}
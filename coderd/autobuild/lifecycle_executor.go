@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -21,7 +22,9 @@ import (
 	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
 	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/maintenance"
 	"github.com/coder/coder/v2/coderd/notifications"
+	"github.com/coder/coder/v2/coderd/rollout"
 	"github.com/coder/coder/v2/coderd/schedule"
 	"github.com/coder/coder/v2/coderd/wsbuilder"
 )
@@ -127,6 +130,16 @@ func (e *Executor) runOnce(t time.Time) Stats {
 		return stats
 	}
 
+	// Workspaces are not transitioned while a maintenance window of their
+	// organization or template is in effect. If the windows cannot be
+	// loaded, skip this tick instead of risking builds during maintenance.
+	windows, err := e.db.GetMaintenanceWindows(e.ctx)
+	if err != nil {
+		e.log.Error(e.ctx, "get maintenance windows", slog.Error(err))
+		return stats
+	}
+	e.notifyMaintenanceWindows(windows, currentTick)
+
 	// We only use errgroup here for convenience of API, not for early
 	// cancellation. This means we only return nil errors in th eg.Go.
 	eg := errgroup.Group{}
@@ -144,14 +157,14 @@ func (e *Executor) runOnce(t time.Time) Stats {
 		eg.Go(func() error {
 			err := func() error {
 				var (
-					job                   *database.ProvisionerJob
-					auditLog              *auditParams
-					shouldNotifyDormancy  bool
-					nextBuild             *database.WorkspaceBuild
-					activeTemplateVersion database.TemplateVersion
-					ws                    database.Workspace
-					tmpl                  database.Template
-					didAutoUpdate         bool
+					job                  *database.ProvisionerJob
+					auditLog             *auditParams
+					shouldNotifyDormancy bool
+					nextBuild            *database.WorkspaceBuild
+					newTemplateVersion   database.TemplateVersion
+					ws                   database.Workspace
+					tmpl                 database.Template
+					didAutoUpdate        bool
 				)
 				err := e.db.InTx(func(tx database.Store) error {
 					var err error
@@ -163,6 +176,14 @@ func (e *Executor) runOnce(t time.Time) Stats {
 						return xerrors.Errorf("get workspace by id: %w", err)
 					}
 
+					if occurrences := maintenance.ForWorkspace(windows, ws.OrganizationID, ws.TemplateID, currentTick); len(occurrences) > 0 {
+						log.Debug(e.ctx, "skipping workspace during maintenance",
+							slog.F("maintenance_window", occurrences[0].Window.Name),
+							slog.F("until", occurrences[0].End),
+						)
+						return nil
+					}
+
 					user, err := tx.GetUserByID(e.ctx, ws.OwnerID)
 					if err != nil {
 						return xerrors.Errorf("get user by id: %w", err)
@@ -189,7 +210,7 @@ func (e *Executor) runOnce(t time.Time) Stats {
 						return xerrors.Errorf("get template by ID: %w", err)
 					}
 
-					activeTemplateVersion, err = tx.GetTemplateVersionByID(e.ctx, tmpl.ActiveVersionID)
+					activeTemplateVersion, err := tx.GetTemplateVersionByID(e.ctx, tmpl.ActiveVersionID)
 					if err != nil {
 						return xerrors.Errorf("get active template version by ID: %w", err)
 					}
@@ -212,15 +233,36 @@ func (e *Executor) runOnce(t time.Time) Stats {
 							SetLastWorkspaceBuildJobInTx(&latestJob).
 							Reason(reason)
 						log.Debug(e.ctx, "auto building workspace", slog.F("transition", nextTransition))
-						if nextTransition == database.WorkspaceTransitionStart &&
-							useActiveVersion(accessControl, ws) {
-							log.Debug(e.ctx, "autostarting with active version")
-							builder = builder.ActiveVersion()
-
-							if latestBuild.TemplateVersionID != tmpl.ActiveVersionID {
-								// control flag to know if the workspace was auto-updated,
-								// so the lifecycle executor can notify the user
-								didAutoUpdate = true
+						if nextTransition == database.WorkspaceTransitionStart {
+							// Canary rollouts take precedence over the active
+							// version, so the canary cohort is built with the
+							// canary version.
+							rolloutVersionID, err := rollout.TargetVersion(e.ctx, tx, tmpl, ws, latestBuild.TemplateVersionID)
+							if err != nil {
+								return xerrors.Errorf("get rollout target version: %w", err)
+							}
+							switch {
+							case rolloutVersionID != uuid.Nil:
+								log.Debug(e.ctx, "autostarting with rollout version", slog.F("template_version_id", rolloutVersionID))
+								builder = builder.VersionID(rolloutVersionID)
+
+								if latestBuild.TemplateVersionID != rolloutVersionID {
+									newTemplateVersion, err = tx.GetTemplateVersionByID(e.ctx, rolloutVersionID)
+									if err != nil {
+										return xerrors.Errorf("get rollout template version by ID: %w", err)
+									}
+									didAutoUpdate = true
+								}
+							case useActiveVersion(accessControl, ws):
+								log.Debug(e.ctx, "autostarting with active version")
+								builder = builder.ActiveVersion()
+
+								if latestBuild.TemplateVersionID != tmpl.ActiveVersionID {
+									// control flag to know if the workspace was auto-updated,
+									// so the lifecycle executor can notify the user
+									newTemplateVersion = activeTemplateVersion
+									didAutoUpdate = true
+								}
 							}
 						}
 
@@ -301,8 +343,8 @@ func (e *Executor) runOnce(t time.Time) Stats {
 							"name":                     ws.Name,
 							"initiator":                "autobuild",
 							"reason":                   nextBuildReason,
-							"template_version_name":    activeTemplateVersion.Name,
-							"template_version_message": activeTemplateVersion.Message,
+							"template_version_name":    newTemplateVersion.Name,
+							"template_version_message": newTemplateVersion.Message,
 						}, "autobuild",
 						// Associate this notification with all the related entities.
 						ws.ID, ws.OwnerID, ws.TemplateID, ws.OrganizationID,
@@ -367,6 +409,73 @@ func (e *Executor) runOnce(t time.Time) Stats {
 	return stats
 }
 
+// notifyMaintenanceWindows notifies the owners of affected workspaces about
+// maintenance windows that start within their notice period. Every occurrence
+// of a window is only announced once, even with several replicas: each
+// occurrence is claimed in the database before notifying users of it.
+func (e *Executor) notifyMaintenanceWindows(windows []database.MaintenanceWindow, currentTick time.Time) {
+	for _, window := range windows {
+		occurrence, ok := maintenance.Upcoming(window, currentTick)
+		if !ok || (window.LastNotifiedStart.Valid && window.LastNotifiedStart.Time.Equal(occurrence.Start)) {
+			continue
+		}
+		log := e.log.With(
+			slog.F("maintenance_window_id", window.ID),
+			slog.F("maintenance_window_name", window.Name),
+			slog.F("starts_at", occurrence.Start),
+		)
+
+		params := database.GetWorkspacesParams{
+			OrganizationID: window.OrganizationID,
+		}
+		if window.TemplateID.Valid {
+			params.TemplateIDs = []uuid.UUID{window.TemplateID.UUID}
+		}
+		workspaces, err := e.db.GetWorkspaces(e.ctx, params)
+		if err != nil {
+			log.Error(e.ctx, "get workspaces affected by maintenance window", slog.Error(err))
+			continue
+		}
+
+		claimed, err := e.db.UpdateMaintenanceWindowLastNotifiedStart(e.ctx, database.UpdateMaintenanceWindowLastNotifiedStartParams{
+			ID:                window.ID,
+			LastNotifiedStart: sql.NullTime{Time: occurrence.Start, Valid: true},
+		})
+		if err != nil {
+			log.Error(e.ctx, "update last notified start of maintenance window", slog.Error(err))
+			continue
+		}
+		if claimed == 0 {
+			// Another replica is notifying users of this occurrence.
+			continue
+		}
+
+		startsAt, endsAt := occurrence.FormatTimes()
+		notified := make(map[uuid.UUID]struct{})
+		for _, ws := range workspaces {
+			if _, ok := notified[ws.OwnerID]; ok {
+				continue
+			}
+			notified[ws.OwnerID] = struct{}{}
+			_, err := e.notificationsEnqueuer.Enqueue(e.ctx, ws.OwnerID, notifications.TemplateMaintenanceWindowScheduled,
+				map[string]string{
+					"name":         window.Name,
+					"starts_at":    startsAt,
+					"ends_at":      endsAt,
+					"block_builds": strconv.FormatBool(window.BlockBuilds),
+					"message":      window.Message,
+				}, "lifecycle_executor",
+				// Associate this notification with all the related entities.
+				window.ID, ws.OwnerID, window.OrganizationID,
+			)
+			if err != nil {
+				log.Warn(e.ctx, "failed to notify of scheduled maintenance", slog.Error(err), slog.F("user_id", ws.OwnerID))
+			}
+		}
+		log.Info(e.ctx, "notified users of scheduled maintenance", slog.F("users", len(notified)))
+	}
+}
+
 // getNextTransition returns the next eligible transition for the workspace
 // as well as the reason for why it is transitioning. It is possible
 // for this function to return a nil error as well as an empty transition.
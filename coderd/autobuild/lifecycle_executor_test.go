@@ -2,6 +2,7 @@ package autobuild_test
 
 import (
 	"context"
+	"database/sql"
 	"os"
 	"testing"
 	"time"
@@ -18,6 +19,8 @@ import (
 	"github.com/coder/coder/v2/coderd/coderdtest"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/coderd/notifications"
 	"github.com/coder/coder/v2/coderd/schedule"
 	"github.com/coder/coder/v2/coderd/schedule/cron"
@@ -218,6 +221,67 @@ func TestExecutorAutostartTemplateUpdated(t *testing.T) {
 	}
 }
 
+func TestExecutorAutostartCanaryRollout(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sched    = mustSchedule(t, "CRON_TZ=UTC 0 * * * *")
+		ctx      = testutil.Context(t, testutil.WaitLong)
+		tickCh   = make(chan time.Time)
+		statsCh  = make(chan autobuild.Stats)
+		enqueuer = testutil.FakeNotificationsEnqueuer{}
+		client   = coderdtest.New(t, &coderdtest.Options{
+			AutobuildTicker:          tickCh,
+			IncludeProvisionerDaemon: true,
+			AutobuildStats:           statsCh,
+			NotificationsEnqueuer:    &enqueuer,
+		})
+		// Given: a workspace that does not update automatically
+		workspace = mustProvisionWorkspace(t, client, func(cwr *codersdk.CreateWorkspaceRequest) {
+			cwr.AutostartSchedule = ptr.Ref(sched.String())
+			cwr.AutomaticUpdates = codersdk.AutomaticUpdatesNever
+		})
+		activeVersionID = workspace.LatestBuild.TemplateVersionID
+	)
+	workspace = coderdtest.MustTransitionWorkspace(t, client, workspace.ID, database.WorkspaceTransitionStart, database.WorkspaceTransitionStop)
+
+	// Given: a new version is rolled out to all workspaces
+	canary := coderdtest.UpdateTemplateVersion(t, client, workspace.OrganizationID, nil, workspace.TemplateID)
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, canary.ID)
+	_, err := client.CreateTemplateVersionRollout(ctx, workspace.TemplateID, codersdk.CreateTemplateVersionRolloutRequest{
+		TemplateVersionID: canary.ID,
+		Percent:           100,
+	})
+	require.NoError(t, err)
+
+	// When: the workspace is autostarted
+	tickCh <- sched.Next(workspace.LatestBuild.CreatedAt)
+	stats := <-statsCh
+	require.Len(t, stats.Errors, 0)
+	require.Equal(t, database.WorkspaceTransitionStart, stats.Transitions[workspace.ID])
+
+	// Then: it is updated to the canary version and the owner is notified
+	workspace = coderdtest.MustWorkspace(t, client, workspace.ID)
+	require.Equal(t, canary.ID, workspace.LatestBuild.TemplateVersionID)
+	require.Len(t, enqueuer.Sent, 1)
+	require.Equal(t, canary.Name, enqueuer.Sent[0].Labels["template_version_name"])
+
+	// When: the rollout is rolled back and the workspace is autostarted again
+	_, err = client.RollBackTemplateVersionRollout(ctx, workspace.TemplateID)
+	require.NoError(t, err)
+	coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+	workspace = coderdtest.MustTransitionWorkspace(t, client, workspace.ID, database.WorkspaceTransitionStart, database.WorkspaceTransitionStop)
+	tickCh <- sched.Next(workspace.LatestBuild.CreatedAt)
+	close(tickCh)
+	stats = <-statsCh
+	require.Len(t, stats.Errors, 0)
+	require.Equal(t, database.WorkspaceTransitionStart, stats.Transitions[workspace.ID])
+
+	// Then: it moves back to the active version
+	workspace = coderdtest.MustWorkspace(t, client, workspace.ID)
+	require.Equal(t, activeVersionID, workspace.LatestBuild.TemplateVersionID)
+}
+
 func TestExecutorAutostartAlreadyRunning(t *testing.T) {
 	t.Parallel()
 
@@ -1126,6 +1190,177 @@ func TestNotifications(t *testing.T) {
 	})
 }
 
+func TestExecutorMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DefersAutostart", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			sched      = mustSchedule(t, "CRON_TZ=UTC 0 * * * *")
+			tickCh     = make(chan time.Time)
+			statsCh    = make(chan autobuild.Stats)
+			client, db = coderdtest.NewWithDatabase(t, &coderdtest.Options{
+				AutobuildTicker:          tickCh,
+				IncludeProvisionerDaemon: true,
+				AutobuildStats:           statsCh,
+			})
+			// Given: we have a user with a workspace that has autostart enabled
+			workspace = mustProvisionWorkspace(t, client, func(cwr *codersdk.CreateWorkspaceRequest) {
+				cwr.AutostartSchedule = ptr.Ref(sched.String())
+			})
+		)
+		// Given: workspace is stopped
+		workspace = coderdtest.MustTransitionWorkspace(t, client, workspace.ID, database.WorkspaceTransitionStart, database.WorkspaceTransitionStop)
+		// Given: a maintenance window of the template starts at the same time
+		// as the workspace is scheduled to autostart
+		_ = dbgen.MaintenanceWindow(t, db, database.MaintenanceWindow{
+			OrganizationID: workspace.OrganizationID,
+			TemplateID:     uuid.NullUUID{UUID: workspace.TemplateID, Valid: true},
+			Schedule:       sched.String(),
+			Duration:       int64(30 * time.Minute),
+		})
+
+		// When: the autobuild executor ticks during the window
+		next := sched.Next(workspace.LatestBuild.CreatedAt)
+		go func() {
+			tickCh <- next.Add(time.Minute)
+		}()
+
+		// Then: the workspace should not be started
+		stats := <-statsCh
+		assert.Len(t, stats.Errors, 0)
+		assert.Len(t, stats.Transitions, 0)
+
+		// When: the autobuild executor ticks after the window ended
+		go func() {
+			tickCh <- next.Add(31 * time.Minute)
+			close(tickCh)
+		}()
+
+		// Then: the workspace should be started
+		stats = <-statsCh
+		assert.Len(t, stats.Errors, 0)
+		assert.Len(t, stats.Transitions, 1)
+		assert.Equal(t, database.WorkspaceTransitionStart, stats.Transitions[workspace.ID])
+	})
+
+	t.Run("Notifies", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tickCh     = make(chan time.Time)
+			statsCh    = make(chan autobuild.Stats)
+			notifyEnq  = testutil.FakeNotificationsEnqueuer{}
+			client, db = coderdtest.NewWithDatabase(t, &coderdtest.Options{
+				AutobuildTicker:          tickCh,
+				IncludeProvisionerDaemon: true,
+				AutobuildStats:           statsCh,
+				NotificationsEnqueuer:    &notifyEnq,
+			})
+			workspace = mustProvisionWorkspace(t, client)
+			window    = dbgen.MaintenanceWindow(t, db, database.MaintenanceWindow{
+				OrganizationID: workspace.OrganizationID,
+				Name:           "weekly-upgrade",
+				Message:        "Upgrading the cluster.",
+				Schedule:       "CRON_TZ=UTC 0 2 * * 6",
+				Duration:       int64(2 * time.Hour),
+				NoticePeriod:   int64(24 * time.Hour),
+				BlockBuilds:    true,
+			})
+			start = mustSchedule(t, window.Schedule).Next(time.Now())
+		)
+
+		// When: the autobuild executor ticks before the notice period, and
+		// twice within it
+		go func() {
+			tickCh <- start.Add(-48 * time.Hour)
+			tickCh <- start.Add(-time.Hour)
+			tickCh <- start.Add(-time.Hour + time.Minute)
+			close(tickCh)
+		}()
+		for i := 0; i < 3; i++ {
+			<-statsCh
+		}
+
+		// Then: the owner of the workspace is notified once
+		var sent []*testutil.Notification
+		for _, n := range notifyEnq.Sent {
+			if n.TemplateID == notifications.TemplateMaintenanceWindowScheduled {
+				sent = append(sent, n)
+			}
+		}
+		require.Len(t, sent, 1)
+		require.Equal(t, workspace.OwnerID, sent[0].UserID)
+		require.Equal(t, "weekly-upgrade", sent[0].Labels["name"])
+		require.Equal(t, "true", sent[0].Labels["block_builds"])
+		require.Equal(t, "Upgrading the cluster.", sent[0].Labels["message"])
+		require.Contains(t, sent[0].Targets, window.ID)
+	})
+
+	t.Run("NotifiesOnceAcrossReplicas", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			db, ps    = dbtestutil.NewDB(t)
+			tickCh    = make(chan time.Time)
+			statsCh   = make(chan autobuild.Stats)
+			notifyEnq = testutil.FakeNotificationsEnqueuer{}
+			client    = coderdtest.New(t, &coderdtest.Options{
+				// Every tick reads the windows as if no replica had notified
+				// users yet, like replicas ticking at the same time do.
+				Database:                 staleMaintenanceWindowsStore{Store: db},
+				Pubsub:                   ps,
+				AutobuildTicker:          tickCh,
+				IncludeProvisionerDaemon: true,
+				AutobuildStats:           statsCh,
+				NotificationsEnqueuer:    &notifyEnq,
+			})
+			workspace = mustProvisionWorkspace(t, client)
+			window    = dbgen.MaintenanceWindow(t, db, database.MaintenanceWindow{
+				OrganizationID: workspace.OrganizationID,
+				Schedule:       "CRON_TZ=UTC 0 2 * * 6",
+				Duration:       int64(2 * time.Hour),
+				NoticePeriod:   int64(24 * time.Hour),
+			})
+			start = mustSchedule(t, window.Schedule).Next(time.Now())
+		)
+
+		// When: the autobuild executor ticks twice within the notice period
+		go func() {
+			tickCh <- start.Add(-time.Hour)
+			tickCh <- start.Add(-time.Hour + time.Minute)
+			close(tickCh)
+		}()
+		for i := 0; i < 2; i++ {
+			<-statsCh
+		}
+
+		// Then: the owner of the workspace is notified once
+		var sent int
+		for _, n := range notifyEnq.Sent {
+			if n.TemplateID == notifications.TemplateMaintenanceWindowScheduled {
+				sent++
+			}
+		}
+		require.Equal(t, 1, sent)
+	})
+}
+
+// staleMaintenanceWindowsStore returns maintenance windows as if no replica
+// had notified users of them yet.
+type staleMaintenanceWindowsStore struct {
+	database.Store
+}
+
+func (s staleMaintenanceWindowsStore) GetMaintenanceWindows(ctx context.Context) ([]database.MaintenanceWindow, error) {
+	windows, err := s.Store.GetMaintenanceWindows(ctx)
+	for i := range windows {
+		windows[i].LastNotifiedStart = sql.NullTime{}
+	}
+	return windows, err
+}
+
 func mustProvisionWorkspace(t *testing.T, client *codersdk.Client, mut ...func(*codersdk.CreateWorkspaceRequest)) codersdk.Workspace {
 	t.Helper()
 	user := coderdtest.CreateFirstUser(t, client)
@@ -0,0 +1,3 @@
+// Package bulkbuild contains logic for enqueueing the workspace builds
+// of workspace bulk operations in the background.
+package bulkbuild
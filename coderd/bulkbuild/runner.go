@@ -0,0 +1,332 @@
+package bulkbuild
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
+	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/wsbuilder"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const (
+	// DefaultInterval is the default minimum time between two builds
+	// enqueued by bulk operations.
+	DefaultInterval = time.Second
+	// DefaultBurst is the default number of builds that bulk operations may
+	// enqueue at once before DefaultInterval applies.
+	DefaultBurst = 10
+)
+
+// errClaimed is returned from the build transaction when the workspace was
+// already processed, e.g. by the runner of another replica.
+var errClaimed = xerrors.New("workspace of bulk operation already processed")
+
+// Runner enqueues the builds of workspace bulk operations. All operations of
+// a runner share a rate limit to protect provisioner capacity.
+type Runner struct {
+	ctx        context.Context
+	db         database.Store
+	ps         pubsub.Pubsub
+	authorizer rbac.Authorizer
+	log        slog.Logger
+	dv         *codersdk.DeploymentValues
+	limiter    *rate.Limiter
+
+	mu      sync.Mutex
+	running map[uuid.UUID]struct{}
+	wg      sync.WaitGroup
+}
+
+// Options configures the rate at which a Runner enqueues builds.
+type Options struct {
+	// Interval is the minimum time between two enqueued builds.
+	Interval time.Duration
+	// Burst is the number of builds that may be enqueued at once.
+	Burst int
+}
+
+// New returns a new bulk operation runner. Operations stop when ctx is
+// canceled and are resumed by Resume.
+func New(ctx context.Context, db database.Store, ps pubsub.Pubsub, authorizer rbac.Authorizer, log slog.Logger, dv *codersdk.DeploymentValues, opts Options) *Runner {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.Burst <= 0 {
+		opts.Burst = DefaultBurst
+	}
+	return &Runner{
+		ctx:        ctx,
+		db:         db,
+		ps:         ps,
+		authorizer: authorizer,
+		log:        log.Named("bulkbuild"),
+		dv:         dv,
+		limiter:    rate.NewLimiter(rate.Every(opts.Interval), opts.Burst),
+		running:    map[uuid.UUID]struct{}{},
+	}
+}
+
+// Start processes a bulk operation in the background. It is a no-op if the
+// operation is already being processed by this runner.
+func (r *Runner) Start(id uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.running[id]; ok {
+		return
+	}
+	if r.ctx.Err() != nil {
+		return
+	}
+	r.running[id] = struct{}{}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			r.mu.Lock()
+			delete(r.running, id)
+			r.mu.Unlock()
+		}()
+		err := r.run(id)
+		if err != nil && r.ctx.Err() == nil {
+			r.log.Error(r.ctx, "run workspace bulk operation", slog.F("bulk_operation_id", id), slog.Error(err))
+		}
+	}()
+}
+
+// Resume starts all bulk operations that have not completed, e.g. because
+// coderd was restarted while they were running.
+func (r *Runner) Resume() error {
+	// nolint:gocritic // Operations of all users are resumed.
+	ops, err := r.db.GetIncompleteWorkspaceBulkOperations(dbauthz.AsSystemRestricted(r.ctx))
+	if err != nil {
+		return xerrors.Errorf("get incomplete workspace bulk operations: %w", err)
+	}
+	for _, op := range ops {
+		r.Start(op.ID)
+	}
+	return nil
+}
+
+// Close waits for all running operations to stop. The context passed to New
+// must be canceled first.
+func (r *Runner) Close() error {
+	r.wg.Wait()
+	return nil
+}
+
+func (r *Runner) run(id uuid.UUID) error {
+	// nolint:gocritic // The runner acts as the initiator of the operation
+	// once its subject is known.
+	sysCtx := dbauthz.AsSystemRestricted(r.ctx)
+	op, err := r.db.GetWorkspaceBulkOperationByID(sysCtx, id)
+	if err != nil {
+		return xerrors.Errorf("get workspace bulk operation: %w", err)
+	}
+	if op.CompletedAt.Valid {
+		return nil
+	}
+	log := r.log.With(slog.F("bulk_operation_id", op.ID), slog.F("action", op.Action))
+
+	// Builds are authorized as the initiator with the scope of the API key
+	// that created the operation, so an operation can never do more than its
+	// initiator could do workspace by workspace.
+	subject, _, err := httpmw.UserRBACSubject(r.ctx, r.db, op.InitiatorID, rbac.ScopeName(op.InitiatorScope))
+	if err != nil {
+		return xerrors.Errorf("get initiator subject: %w", err)
+	}
+	ctx := dbauthz.As(r.ctx, subject)
+
+	items, err := r.db.GetWorkspaceBulkOperationWorkspacesByBulkOperationID(sysCtx, op.ID)
+	if err != nil {
+		return xerrors.Errorf("get workspaces of bulk operation: %w", err)
+	}
+	for _, item := range items {
+		if item.Status != database.WorkspaceBulkOperationWorkspaceStatusPending {
+			continue
+		}
+		err = r.limiter.Wait(r.ctx)
+		if err != nil {
+			return err
+		}
+		err = r.build(ctx, subject, op, item.WorkspaceID)
+		if err == nil || xerrors.Is(err, errClaimed) {
+			continue
+		}
+		if r.ctx.Err() != nil {
+			return r.ctx.Err()
+		}
+
+		message := err.Error()
+		var buildErr wsbuilder.BuildError
+		if xerrors.As(err, &buildErr) {
+			message = buildErr.Message
+			var authErr dbauthz.NotAuthorizedError
+			if xerrors.As(err, &authErr) {
+				message = "Not authorized to build the workspace."
+			}
+		}
+		log.Warn(r.ctx, "failed to enqueue workspace build", slog.F("workspace_id", item.WorkspaceID), slog.Error(err))
+		_, err = r.db.UpdateWorkspaceBulkOperationWorkspace(sysCtx, database.UpdateWorkspaceBulkOperationWorkspaceParams{
+			Status:          database.WorkspaceBulkOperationWorkspaceStatusFailed,
+			Message:         message,
+			UpdatedAt:       dbtime.Now(),
+			BulkOperationID: op.ID,
+			WorkspaceID:     item.WorkspaceID,
+		})
+		if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+			return xerrors.Errorf("mark workspace of bulk operation failed: %w", err)
+		}
+	}
+
+	err = r.db.UpdateWorkspaceBulkOperationCompletedAt(sysCtx, database.UpdateWorkspaceBulkOperationCompletedAtParams{
+		ID:          op.ID,
+		CompletedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+	})
+	if err != nil {
+		return xerrors.Errorf("complete workspace bulk operation: %w", err)
+	}
+	log.Info(r.ctx, "completed workspace bulk operation", slog.F("workspaces", len(items)))
+	return nil
+}
+
+// build enqueues the build of a single workspace of the operation, or skips
+// it if it is already in the requested state.
+func (r *Runner) build(ctx context.Context, subject rbac.Subject, op database.WorkspaceBulkOperation, workspaceID uuid.UUID) error {
+	var job *database.ProvisionerJob
+	err := r.db.InTx(func(tx database.Store) error {
+		workspace, err := tx.GetWorkspaceByID(ctx, workspaceID)
+		if err != nil {
+			return xerrors.Errorf("get workspace: %w", err)
+		}
+		reason, err := skipReason(ctx, tx, op.Action, workspace)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			return claim(ctx, tx, op.ID, workspaceID, database.WorkspaceBulkOperationWorkspaceStatusSkipped, uuid.NullUUID{}, reason)
+		}
+
+		builder := wsbuilder.New(workspace, transition(op.Action)).
+			Initiator(op.InitiatorID).
+			// Bulk builds must not delay the builds users are waiting on.
+			Priority(provisionerjobs.PriorityBackground).
+			DeploymentValues(r.dv)
+		if op.Action == database.WorkspaceBulkOperationActionUpdate {
+			builder = builder.ActiveVersion()
+		}
+		build, buildJob, err := builder.Build(ctx, tx, func(action policy.Action, object rbac.Objecter) bool {
+			return r.authorizer.Authorize(ctx, subject, action, object.RBACObject()) == nil
+		}, audit.WorkspaceBuildBaggage{
+			IP:              "127.0.0.1",
+			BulkOperationID: op.ID.String(),
+		})
+		if err != nil {
+			return err
+		}
+		job = buildJob
+		return claim(ctx, tx, op.ID, workspaceID, database.WorkspaceBulkOperationWorkspaceStatusEnqueued, uuid.NullUUID{UUID: build.ID, Valid: true}, "")
+	}, nil)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return nil
+	}
+
+	err = provisionerjobs.PostJob(r.ps, *job)
+	if err != nil {
+		r.log.Error(r.ctx, "failed to post provisioner job to pubsub", slog.Error(err))
+	}
+	err = r.ps.Publish(codersdk.WorkspaceNotifyChannel(workspaceID), []byte{})
+	if err != nil {
+		r.log.Warn(r.ctx, "failed to publish workspace update", slog.F("workspace_id", workspaceID), slog.Error(err))
+	}
+	return nil
+}
+
+// claim records the outcome of a workspace. It fails with errClaimed if the
+// workspace is no longer pending, which rolls back the build.
+func claim(ctx context.Context, tx database.Store, opID, workspaceID uuid.UUID, status database.WorkspaceBulkOperationWorkspaceStatus, buildID uuid.NullUUID, message string) error {
+	_, err := tx.UpdateWorkspaceBulkOperationWorkspace(ctx, database.UpdateWorkspaceBulkOperationWorkspaceParams{
+		Status:           status,
+		WorkspaceBuildID: buildID,
+		Message:          message,
+		UpdatedAt:        dbtime.Now(),
+		BulkOperationID:  opID,
+		WorkspaceID:      workspaceID,
+	})
+	if xerrors.Is(err, sql.ErrNoRows) {
+		return errClaimed
+	}
+	if err != nil {
+		return xerrors.Errorf("update workspace of bulk operation: %w", err)
+	}
+	return nil
+}
+
+// skipReason returns why the workspace does not need a build, or an empty
+// string if it does.
+func skipReason(ctx context.Context, tx database.Store, action database.WorkspaceBulkOperationAction, workspace database.Workspace) (string, error) {
+	if workspace.Deleted {
+		return "Workspace was deleted.", nil
+	}
+	latest, err := tx.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		return "", xerrors.Errorf("get latest workspace build: %w", err)
+	}
+	job, err := tx.GetProvisionerJobByID(ctx, latest.JobID)
+	if err != nil {
+		return "", xerrors.Errorf("get latest workspace build job: %w", err)
+	}
+	succeeded := job.JobStatus == database.ProvisionerJobStatusSucceeded
+
+	switch action {
+	case database.WorkspaceBulkOperationActionStart:
+		if succeeded && latest.Transition == database.WorkspaceTransitionStart {
+			return "Workspace is already started.", nil
+		}
+	case database.WorkspaceBulkOperationActionStop:
+		if succeeded && latest.Transition != database.WorkspaceTransitionStart {
+			return "Workspace is already stopped.", nil
+		}
+	case database.WorkspaceBulkOperationActionUpdate:
+		template, err := tx.GetTemplateByID(ctx, workspace.TemplateID)
+		if err != nil {
+			return "", xerrors.Errorf("get template: %w", err)
+		}
+		if latest.TemplateVersionID == template.ActiveVersionID {
+			return "Workspace is already on the active template version.", nil
+		}
+	}
+	return "", nil
+}
+
+// transition returns the workspace transition of an action. Updating a
+// workspace starts it on the active template version, like "coder update".
+func transition(action database.WorkspaceBulkOperationAction) database.WorkspaceTransition {
+	switch action {
+	case database.WorkspaceBulkOperationActionStop:
+		return database.WorkspaceTransitionStop
+	case database.WorkspaceBulkOperationActionDelete:
+		return database.WorkspaceTransitionDelete
+	default:
+		return database.WorkspaceTransitionStart
+	}
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/coder/coder/v2/coderd/appearance"
 	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/awsidentity"
+	"github.com/coder/coder/v2/coderd/bulkbuild"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbrollup"
@@ -60,6 +61,7 @@ import (
 	"github.com/coder/coder/v2/coderd/healthcheck/derphealth"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/maintenance"
 	"github.com/coder/coder/v2/coderd/metricscache"
 	"github.com/coder/coder/v2/coderd/notifications"
 	"github.com/coder/coder/v2/coderd/portsharing"
@@ -244,6 +246,9 @@ type Options struct {
 	WorkspaceUsageTracker *workspacestats.UsageTracker
 	// NotificationsEnqueuer handles enqueueing notifications for delivery by SMTP, webhook, etc.
 	NotificationsEnqueuer notifications.Enqueuer
+	// WorkspaceBulkOperationOptions limits the rate at which workspace bulk
+	// operations enqueue builds.
+	WorkspaceBulkOperationOptions bulkbuild.Options
 
 	// IDPSync holds all configured values for syncing external IDP users into Coder.
 	IDPSync idpsync.IDPSync
@@ -471,11 +476,15 @@ func New(options *Options) *API {
 			options.Logger.Named("acquirer"),
 			options.Database,
 			options.Pubsub,
+			provisionerdserver.JobLimits(
+				int32(options.DeploymentValues.Provisioner.MaxJobsPerOrganization.Value()),
+				int32(options.DeploymentValues.Provisioner.MaxJobsPerUser.Value()),
+			),
 		),
 		dbRolluper: options.DatabaseRolluper,
 	}
 
-	f := appearance.NewDefaultFetcher(api.DeploymentValues.DocsURL.String())
+	f := maintenance.NewAppearanceFetcher(appearance.NewDefaultFetcher(api.DeploymentValues.DocsURL.String()), options.Database)
 	api.AppearanceFetcher.Store(&f)
 	api.PortSharer.Store(&portsharing.DefaultPortSharer)
 	buildInfo := codersdk.BuildInfoResponse{
@@ -544,6 +553,19 @@ func New(options *Options) *API {
 					Store:                  options.Database,
 					// TimeNow and StaleInterval set to defaults, see healthcheck/provisioner.go
 				},
+				Pubsub: healthcheck.PubsubReportOptions{
+					Pubsub:    options.Pubsub,
+					Threshold: options.DeploymentValues.Healthcheck.ThresholdPubsub.Value(),
+					Logger:    options.Logger.Named("healthcheck.pubsub"),
+				},
+				Notifications: healthcheck.NotificationsReportOptions{
+					Enabled: experiments.Enabled(codersdk.ExperimentNotifications),
+					Config:  options.DeploymentValues.Notifications,
+					Logger:  options.Logger.Named("healthcheck.notifications"),
+				},
+				ExternalAuth: healthcheck.ExternalAuthReportOptions{
+					Configs: options.ExternalAuthConfigs,
+				},
 			})
 		}
 	}
@@ -611,6 +633,24 @@ func New(options *Options) *API {
 		UpdateAgentMetricsFn:  options.UpdateAgentMetrics,
 		AppStatBatchSize:      workspaceapps.DefaultStatsDBReporterBatchSize,
 	})
+	api.bulkBuildRunner = bulkbuild.New(
+		api.ctx,
+		options.Database,
+		options.Pubsub,
+		options.Authorizer,
+		options.Logger,
+		options.DeploymentValues,
+		options.WorkspaceBulkOperationOptions,
+	)
+	if err := api.bulkBuildRunner.Resume(); err != nil {
+		api.Logger.Error(api.ctx, "failed to resume workspace bulk operations", slog.Error(err))
+	}
+	api.healthcheckHistoryDone = make(chan struct{})
+	if options.HealthcheckRefresh > 0 {
+		go api.runHealthcheckHistory(quartz.NewReal())
+	} else {
+		close(api.healthcheckHistoryDone)
+	}
 	workspaceAppsLogger := options.Logger.Named("workspaceapps")
 	if options.WorkspaceAppsStatsCollectorOptions.Logger == nil {
 		named := workspaceAppsLogger.Named("stats_collector")
@@ -841,8 +881,16 @@ func New(options *Options) *API {
 			)
 
 			r.Get("/", api.auditLogs)
+			r.Get("/export", api.exportAuditLogs)
 			r.Post("/testgenerate", api.generateFakeAuditLog)
 		})
+		r.Route("/connectionlogs", func(r chi.Router) {
+			r.Use(
+				apiKeyMiddleware,
+			)
+
+			r.Get("/", api.connectionLogs)
+		})
 		r.Route("/files", func(r chi.Router) {
 			r.Use(
 				apiKeyMiddleware,
@@ -890,6 +938,14 @@ func New(options *Options) *API {
 						})
 					})
 				})
+				r.Route("/maintenance-windows", func(r chi.Router) {
+					r.Get("/", api.maintenanceWindows)
+					r.Post("/", api.postMaintenanceWindow)
+					r.Route("/{maintenancewindow}", func(r chi.Router) {
+						r.Patch("/", api.patchMaintenanceWindow)
+						r.Delete("/", api.deleteMaintenanceWindow)
+					})
+				})
 				r.Route("/members", func(r chi.Router) {
 					r.Get("/", api.listMembers)
 					r.Route("/roles", func(r chi.Router) {
@@ -934,6 +990,12 @@ func New(options *Options) *API {
 				r.Get("/", api.template)
 				r.Delete("/", api.deleteTemplate)
 				r.Patch("/", api.patchTemplateMeta)
+				r.Route("/rollout", func(r chi.Router) {
+					r.Get("/", api.templateVersionRollout)
+					r.Post("/", api.postTemplateVersionRollout)
+					r.Post("/promote", api.postPromoteTemplateVersionRollout)
+					r.Post("/rollback", api.postRollBackTemplateVersionRollout)
+				})
 				r.Route("/versions", func(r chi.Router) {
 					r.Post("/archive", api.postArchiveTemplateVersions)
 					r.Get("/", api.templateVersionsByTemplate)
@@ -965,6 +1027,7 @@ func New(options *Options) *API {
 				r.Post("/", api.postTemplateVersionDryRun)
 				r.Get("/{jobID}", api.templateVersionDryRun)
 				r.Get("/{jobID}/resources", api.templateVersionDryRunResources)
+				r.Get("/{jobID}/resource-changes", api.templateVersionDryRunResourceChanges)
 				r.Get("/{jobID}/logs", api.templateVersionDryRunLogs)
 				r.Patch("/{jobID}/cancel", api.patchTemplateVersionDryRunCancel)
 			})
@@ -1050,6 +1113,7 @@ func New(options *Options) *API {
 						r.Get("/{organizationname}", api.organizationByUserAndName)
 					})
 					r.Post("/workspaces", api.postUserWorkspaces)
+					r.Post("/workspaces/restore", api.postRestoreWorkspaceSnapshot)
 					r.Route("/workspace/{workspacename}", func(r chi.Router) {
 						r.Get("/", api.workspaceByOwnerAndName)
 						r.Get("/builds/{buildnumber}", api.workspaceBuildByBuildNumber)
@@ -1108,6 +1172,11 @@ func New(options *Options) *API {
 				r.Get("/startup-logs", api.workspaceAgentLogsDeprecated)
 				r.Get("/logs", api.workspaceAgentLogs)
 				r.Get("/listening-ports", api.workspaceAgentListeningPorts)
+				r.Route("/files", func(r chi.Router) {
+					r.Get("/", api.workspaceAgentReadFile)
+					r.Put("/", api.workspaceAgentWriteFile)
+					r.Get("/list", api.workspaceAgentListDirectory)
+				})
 				r.Get("/connection", api.workspaceAgentConnection)
 				r.Get("/coordinate", api.workspaceAgentClientCoordinate)
 
@@ -1143,14 +1212,28 @@ func New(options *Options) *API {
 				r.Delete("/favorite", api.deleteFavoriteWorkspace)
 				r.Put("/autoupdates", api.putWorkspaceAutoupdates)
 				r.Get("/resolve-autostart", api.resolveAutostart)
+				r.Route("/acl", func(r chi.Router) {
+					r.Get("/", api.workspaceACL)
+					r.Patch("/", api.patchWorkspaceACL)
+				})
 				r.Route("/port-share", func(r chi.Router) {
 					r.Get("/", api.workspaceAgentPortShares)
 					r.Post("/", api.postWorkspaceAgentPortShare)
 					r.Delete("/", api.deleteWorkspaceAgentPortShare)
 				})
 				r.Get("/timings", api.workspaceTimings)
+				r.Get("/snapshot", api.workspaceSnapshot)
+				r.Route("/recordings", func(r chi.Router) {
+					r.Get("/", api.workspaceSessionRecordings)
+					r.Get("/{recording}", api.workspaceSessionRecordingData)
+				})
 			})
 		})
+		r.Route("/workspacebulkoperations", func(r chi.Router) {
+			r.Use(apiKeyMiddleware)
+			r.Post("/", api.postWorkspaceBulkOperation)
+			r.Get("/{workspacebulkoperation}", api.workspaceBulkOperation)
+		})
 		r.Route("/workspacebuilds/{workspacebuild}", func(r chi.Router) {
 			r.Use(
 				apiKeyMiddleware,
@@ -1210,6 +1293,7 @@ func New(options *Options) *API {
 			r.Get("/tailnet", api.debugTailnet)
 			r.Route("/health", func(r chi.Router) {
 				r.Get("/", api.debugDeploymentHealth)
+				r.Get("/history", api.debugDeploymentHealthHistory)
 				r.Route("/settings", func(r chi.Router) {
 					r.Get("/", api.deploymentHealthSettings)
 					r.Put("/", api.putDeploymentHealthSettings)
@@ -1374,8 +1458,13 @@ type API struct {
 
 	healthCheckGroup *singleflight.Group[string, *healthsdk.HealthcheckReport]
 	healthCheckCache atomic.Pointer[healthsdk.HealthcheckReport]
+	// healthcheckHistoryDone is closed once the background healthcheck that
+	// records the healthcheck history has stopped.
+	healthcheckHistoryDone chan struct{}
 
 	statsReporter *workspacestats.Reporter
+	// bulkBuildRunner enqueues the builds of workspace bulk operations.
+	bulkBuildRunner *bulkbuild.Runner
 
 	Acquirer *provisionerdserver.Acquirer
 	// dbRolluper rolls up template usage stats from raw agent and app
@@ -1425,6 +1514,8 @@ func (api *API) Close() error {
 	}
 	_ = api.agentProvider.Close()
 	_ = api.statsReporter.Close()
+	_ = api.bulkBuildRunner.Close()
+	<-api.healthcheckHistoryDone
 	_ = api.NetworkTelemetryBatcher.Close()
 	return nil
 }
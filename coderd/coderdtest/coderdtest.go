@@ -61,6 +61,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database/dbrollup"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/driftcheck"
 	"github.com/coder/coder/v2/coderd/externalauth"
 	"github.com/coder/coder/v2/coderd/gitsshkey"
 	"github.com/coder/coder/v2/coderd/httpmw"
@@ -110,6 +111,8 @@ type Options struct {
 	SSHKeygenAlgorithm             gitsshkey.Algorithm
 	AutobuildTicker                <-chan time.Time
 	AutobuildStats                 chan<- autobuild.Stats
+	DriftCheckTicker               <-chan time.Time
+	DriftCheckStats                chan<- driftcheck.Stats
 	Auditor                        audit.Auditor
 	TLSCertificates                []tls.Certificate
 	ExternalAuthConfigs            []*externalauth.Config
@@ -227,6 +230,16 @@ func NewOptions(t testing.TB, options *Options) (func(http.Handler), context.Can
 			close(options.AutobuildStats)
 		})
 	}
+	if options.DriftCheckTicker == nil {
+		ticker := make(chan time.Time)
+		options.DriftCheckTicker = ticker
+		t.Cleanup(func() { close(ticker) })
+	}
+	if options.DriftCheckStats != nil {
+		t.Cleanup(func() {
+			close(options.DriftCheckStats)
+		})
+	}
 
 	if options.Authorizer == nil {
 		defAuth := rbac.NewStrictCachingAuthorizer(prometheus.NewRegistry())
@@ -343,6 +356,10 @@ func NewOptions(t testing.TB, options *Options) (func(http.Handler), context.Can
 	hangDetector.Start()
 	t.Cleanup(hangDetector.Close)
 
+	driftChecker := driftcheck.New(ctx, options.Database, options.Pubsub, *options.Logger, options.DeploymentValues, options.DriftCheckTicker).WithStatsChannel(options.DriftCheckStats)
+	driftChecker.Start()
+	t.Cleanup(driftChecker.Close)
+
 	// Did last_used_at not update? Scratching your noggin? Here's why.
 	// Workspace usage tracking must be triggered manually in tests.
 	// The vast majority of existing tests do not depend on last_used_at
@@ -0,0 +1,118 @@
+package coderd
+
+import (
+	"net/http"
+	"net/netip"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/searchquery"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Get connection logs
+// @ID get-connection-logs
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Audit
+// @Param q query string false "Search query"
+// @Param limit query int true "Page limit"
+// @Param offset query int false "Page offset"
+// @Success 200 {object} codersdk.ConnectionLogResponse
+// @Router /connectionlogs [get]
+func (api *API) connectionLogs(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	apiKey := httpmw.APIKey(r)
+
+	page, ok := parsePagination(rw, r)
+	if !ok {
+		return
+	}
+
+	queryStr := r.URL.Query().Get("q")
+	filter, errs := searchquery.ConnectionLogs(ctx, api.Database, queryStr)
+	if len(errs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid connection log search query.",
+			Validations: errs,
+		})
+		return
+	}
+	filter.OffsetOpt = int32(page.Offset)
+	filter.LimitOpt = int32(page.Limit)
+
+	if filter.Username == "me" {
+		filter.UserID = apiKey.UserID
+		filter.Username = ""
+	}
+	if filter.WorkspaceOwner == "me" {
+		filter.WorkspaceOwnerID = apiKey.UserID
+		filter.WorkspaceOwner = ""
+	}
+
+	dblogs, err := api.Database.GetConnectionLogsOffset(ctx, filter)
+	if dbauthz.IsNotAuthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	logs := make([]codersdk.ConnectionLog, 0, len(dblogs))
+	for _, dblog := range dblogs {
+		logs = append(logs, convertConnectionLog(dblog))
+	}
+	var count int64
+	// GetConnectionLogsOffset uses a window function to get the count, so it
+	// is only available on the rows themselves.
+	if len(dblogs) > 0 {
+		count = dblogs[0].Count
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.ConnectionLogResponse{
+		ConnectionLogs: logs,
+		Count:          count,
+	})
+}
+
+func convertConnectionLog(dblog database.GetConnectionLogsOffsetRow) codersdk.ConnectionLog {
+	clog := dblog.ConnectionLog
+	ip, _ := netip.AddrFromSlice(clog.Ip.IPNet.IP)
+
+	sdkLog := codersdk.ConnectionLog{
+		ID:          clog.ID,
+		ConnectTime: clog.ConnectTime,
+		Organization: codersdk.MinimalOrganization{
+			ID:   clog.OrganizationID,
+			Name: dblog.OrganizationName,
+		},
+		WorkspaceOwnerID:       clog.WorkspaceOwnerID,
+		WorkspaceOwnerUsername: dblog.WorkspaceOwnerUsername,
+		WorkspaceID:            clog.WorkspaceID,
+		WorkspaceName:          clog.WorkspaceName,
+		AgentName:              clog.AgentName,
+		Type:                   codersdk.ConnectionType(clog.Type),
+		IP:                     ip.Unmap(),
+		DisconnectReason:       clog.DisconnectReason.String,
+	}
+	if clog.UserID.Valid && dblog.UserUsername.Valid {
+		sdkLog.User = &codersdk.MinimalUser{
+			ID:        clog.UserID.UUID,
+			Username:  dblog.UserUsername.String,
+			AvatarURL: dblog.UserAvatarUrl.String,
+		}
+	}
+	if clog.DisconnectTime.Valid {
+		disconnectTime := clog.DisconnectTime.Time
+		sdkLog.DisconnectTime = &disconnectTime
+	}
+	if clog.Code.Valid {
+		code := clog.Code.Int32
+		sdkLog.Code = &code
+	}
+	return sdkLog
+}
@@ -0,0 +1,148 @@
+package coderd_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestConnectionLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		client, db := coderdtest.NewWithDatabase(t, nil)
+		user := coderdtest.CreateFirstUser(t, client)
+		ws := dbfake.WorkspaceBuild(t, db, database.Workspace{
+			OrganizationID: user.OrganizationID,
+			OwnerID:        user.UserID,
+			Name:           "dev",
+		}).Do().Workspace
+		connectTime := dbtime.Now().Add(-time.Hour)
+		agentID := uuid.New()
+		connectionID := uuid.New()
+
+		_ = dbgen.ConnectionLog(t, db, database.ConnectionLog{
+			ConnectTime:      connectTime,
+			OrganizationID:   user.OrganizationID,
+			WorkspaceOwnerID: user.UserID,
+			WorkspaceID:      ws.ID,
+			WorkspaceName:    "dev",
+			AgentID:          agentID,
+			Type:             database.ConnectionTypeSsh,
+			UserID:           uuid.NullUUID{UUID: user.UserID, Valid: true},
+			ConnectionID:     connectionID,
+		})
+		// The disconnect event completes the existing log.
+		_ = dbgen.ConnectionLog(t, db, database.ConnectionLog{
+			ConnectTime:      connectTime.Add(time.Minute),
+			OrganizationID:   user.OrganizationID,
+			WorkspaceOwnerID: user.UserID,
+			WorkspaceID:      ws.ID,
+			WorkspaceName:    "dev",
+			AgentID:          agentID,
+			Type:             database.ConnectionTypeSsh,
+			ConnectionID:     connectionID,
+			DisconnectTime:   sql.NullTime{Time: connectTime.Add(time.Minute), Valid: true},
+			Code:             sql.NullInt32{Int32: 1, Valid: true},
+		})
+
+		res, err := client.ConnectionLogs(ctx, codersdk.ConnectionLogsRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, res.Count)
+		require.Len(t, res.ConnectionLogs, 1)
+
+		clog := res.ConnectionLogs[0]
+		require.Equal(t, "dev", clog.WorkspaceName)
+		require.Equal(t, codersdk.ConnectionTypeSSH, clog.Type)
+		require.WithinDuration(t, connectTime, clog.ConnectTime, time.Second)
+		require.NotNil(t, clog.User)
+		require.Equal(t, user.UserID, clog.User.ID)
+		require.NotNil(t, clog.DisconnectTime)
+		require.NotNil(t, clog.Code)
+		require.EqualValues(t, 1, *clog.Code)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		client, db := coderdtest.NewWithDatabase(t, nil)
+		user := coderdtest.CreateFirstUser(t, client)
+		dev := dbfake.WorkspaceBuild(t, db, database.Workspace{
+			OrganizationID: user.OrganizationID,
+			OwnerID:        user.UserID,
+			Name:           "dev",
+		}).Do().Workspace
+		prod := dbfake.WorkspaceBuild(t, db, database.Workspace{
+			OrganizationID: user.OrganizationID,
+			OwnerID:        user.UserID,
+			Name:           "prod",
+		}).Do().Workspace
+
+		_ = dbgen.ConnectionLog(t, db, database.ConnectionLog{
+			OrganizationID:   user.OrganizationID,
+			WorkspaceOwnerID: user.UserID,
+			WorkspaceID:      dev.ID,
+			WorkspaceName:    "dev",
+			Type:             database.ConnectionTypeSsh,
+		})
+		_ = dbgen.ConnectionLog(t, db, database.ConnectionLog{
+			OrganizationID:   user.OrganizationID,
+			WorkspaceOwnerID: user.UserID,
+			WorkspaceID:      prod.ID,
+			WorkspaceName:    "prod",
+			Type:             database.ConnectionTypeVscode,
+			DisconnectTime:   sql.NullTime{Time: dbtime.Now(), Valid: true},
+		})
+
+		for _, tc := range []struct {
+			query    string
+			expected []string
+		}{
+			{query: "", expected: []string{"prod", "dev"}},
+			{query: "dev", expected: []string{"dev"}},
+			{query: "me/prod", expected: []string{"prod"}},
+			{query: "type:vscode", expected: []string{"prod"}},
+			{query: "status:ongoing", expected: []string{"dev"}},
+		} {
+			res, err := client.ConnectionLogs(ctx, codersdk.ConnectionLogsRequest{SearchQuery: tc.query})
+			require.NoError(t, err, tc.query)
+			names := make([]string, 0, len(res.ConnectionLogs))
+			for _, clog := range res.ConnectionLogs {
+				names = append(names, clog.WorkspaceName)
+			}
+			require.ElementsMatch(t, tc.expected, names, tc.query)
+		}
+
+		_, err := client.ConnectionLogs(ctx, codersdk.ConnectionLogsRequest{SearchQuery: "status:paused"})
+		require.Error(t, err)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		client := coderdtest.New(t, nil)
+		user := coderdtest.CreateFirstUser(t, client)
+		member, _ := coderdtest.CreateAnotherUser(t, client, user.OrganizationID)
+
+		_, err := member.ConnectionLogs(ctx, codersdk.ConnectionLogsRequest{})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, 403, sdkErr.StatusCode())
+	})
+}
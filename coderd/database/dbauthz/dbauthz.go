@@ -200,6 +200,7 @@ var (
 					rbac.ResourceWorkspaceDormant.Type: {policy.ActionDelete, policy.ActionRead, policy.ActionUpdate, policy.ActionWorkspaceStop},
 					rbac.ResourceWorkspace.Type:        {policy.ActionDelete, policy.ActionRead, policy.ActionUpdate, policy.ActionWorkspaceStart, policy.ActionWorkspaceStop},
 					rbac.ResourceUser.Type:             {policy.ActionRead},
+					rbac.ResourceGroupMember.Type:      {policy.ActionRead},
 				}),
 				Org:  map[string][]rbac.Permission{},
 				User: []rbac.Permission{},
@@ -934,6 +935,19 @@ func (q *querier) AllUserIDs(ctx context.Context) ([]uuid.UUID, error) {
 	return q.db.AllUserIDs(ctx)
 }
 
+func (q *querier) AppendWorkspaceSessionRecordingData(ctx context.Context, arg database.AppendWorkspaceSessionRecordingDataParams) (int64, error) {
+	workspace, err := q.db.GetWorkspaceByAgentID(ctx, arg.AgentID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, workspace); err != nil {
+		return 0, err
+	}
+
+	return q.db.AppendWorkspaceSessionRecordingData(ctx, arg)
+}
+
 func (q *querier) ArchiveUnusedTemplateVersions(ctx context.Context, arg database.ArchiveUnusedTemplateVersionsParams) ([]uuid.UUID, error) {
 	tpl, err := q.db.GetTemplateByID(ctx, arg.TemplateID)
 	if err != nil {
@@ -1097,6 +1111,19 @@ func (q *querier) DeleteLicense(ctx context.Context, id int32) (int32, error) {
 	return id, nil
 }
 
+func (q *querier) DeleteMaintenanceWindowByID(ctx context.Context, id uuid.UUID) error {
+	// Maintenance windows are part of the organization settings, so managing
+	// them requires updating the organization.
+	window, err := q.db.GetMaintenanceWindowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, window); err != nil {
+		return err
+	}
+	return q.db.DeleteMaintenanceWindowByID(ctx, id)
+}
+
 func (q *querier) DeleteOAuth2ProviderAppByID(ctx context.Context, id uuid.UUID) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceOauth2App); err != nil {
 		return err
@@ -1138,6 +1165,13 @@ func (q *querier) DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx context.Contex
 	return q.db.DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx, arg)
 }
 
+func (q *querier) DeleteOldHealthcheckResults(ctx context.Context, beforeTime time.Time) error {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.DeleteOldHealthcheckResults(ctx, beforeTime)
+}
+
 func (q *querier) DeleteOldNotificationMessages(ctx context.Context) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
 		return err
@@ -1166,6 +1200,13 @@ func (q *querier) DeleteOldWorkspaceAgentStats(ctx context.Context) error {
 	return q.db.DeleteOldWorkspaceAgentStats(ctx)
 }
 
+func (q *querier) DeleteOldWorkspaceBuildDriftJobs(ctx context.Context) error {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.DeleteOldWorkspaceBuildDriftJobs(ctx)
+}
+
 func (q *querier) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
 	return deleteQ(q.log, q.auth, q.db.GetOrganizationByID, q.db.DeleteOrganization)(ctx, id)
 }
@@ -1233,6 +1274,13 @@ func (q *querier) DeleteTailnetTunnel(ctx context.Context, arg database.DeleteTa
 	return q.db.DeleteTailnetTunnel(ctx, arg)
 }
 
+func (q *querier) DeleteWorkspaceAgentClientPeer(ctx context.Context, peerID uuid.UUID) error {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.DeleteWorkspaceAgentClientPeer(ctx, peerID)
+}
+
 func (q *querier) DeleteWorkspaceAgentPortShare(ctx context.Context, arg database.DeleteWorkspaceAgentPortShareParams) error {
 	w, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
 	if err != nil {
@@ -1301,6 +1349,14 @@ func (q *querier) GetAPIKeysLastUsedAfter(ctx context.Context, lastUsed time.Tim
 	return fetchWithPostFilter(q.auth, policy.ActionRead, q.db.GetAPIKeysLastUsedAfter)(ctx, lastUsed)
 }
 
+func (q *querier) GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (database.TemplateVersionRollout, error) {
+	// An actor can read the rollouts of templates they can read.
+	if _, err := q.GetTemplateByID(ctx, templateID); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return q.db.GetActiveTemplateVersionRolloutByTemplateID(ctx, templateID)
+}
+
 func (q *querier) GetActiveUserCount(ctx context.Context) (int64, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return 0, err
@@ -1384,6 +1440,20 @@ func (q *querier) GetAuthorizationUserRoles(ctx context.Context, userID uuid.UUI
 	return q.db.GetAuthorizationUserRoles(ctx, userID)
 }
 
+func (q *querier) GetConnectionLogsOffset(ctx context.Context, arg database.GetConnectionLogsOffsetParams) ([]database.GetConnectionLogsOffsetRow, error) {
+	// Connection logs are as sensitive as audit logs, so they share the
+	// audit log permissions. Without an organization filter site-wide access
+	// is required.
+	object := rbac.ResourceAuditLog
+	if arg.OrganizationID != uuid.Nil {
+		object = object.InOrg(arg.OrganizationID)
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, object); err != nil {
+		return nil, err
+	}
+	return q.db.GetConnectionLogsOffset(ctx, arg)
+}
+
 func (q *querier) GetCoordinatorResumeTokenSigningKey(ctx context.Context) (string, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return "", err
@@ -1556,6 +1626,13 @@ func (q *querier) GetHealthSettings(ctx context.Context) (string, error) {
 	return q.db.GetHealthSettings(ctx)
 }
 
+func (q *querier) GetHealthcheckResults(ctx context.Context, arg database.GetHealthcheckResultsParams) ([]database.HealthcheckResult, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceDebugInfo); err != nil {
+		return nil, err
+	}
+	return q.db.GetHealthcheckResults(ctx, arg)
+}
+
 // TODO: We need to create a ProvisionerJob resource type
 func (q *querier) GetHungProvisionerJobs(ctx context.Context, hungSince time.Time) ([]database.ProvisionerJob, error) {
 	// if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
@@ -1564,6 +1641,13 @@ func (q *querier) GetHungProvisionerJobs(ctx context.Context, hungSince time.Tim
 	return q.db.GetHungProvisionerJobs(ctx, hungSince)
 }
 
+func (q *querier) GetIncompleteWorkspaceBulkOperations(ctx context.Context) ([]database.WorkspaceBulkOperation, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetIncompleteWorkspaceBulkOperations(ctx)
+}
+
 func (q *querier) GetJFrogXrayScanByWorkspaceAndAgentID(ctx context.Context, arg database.GetJFrogXrayScanByWorkspaceAndAgentIDParams) (database.JfrogXrayScan, error) {
 	if _, err := fetch(q.log, q.auth, q.db.GetWorkspaceByID)(ctx, arg.WorkspaceID); err != nil {
 		return database.JfrogXrayScan{}, err
@@ -1585,6 +1669,24 @@ func (q *querier) GetLatestCryptoKeyByFeature(ctx context.Context, feature datab
 	return q.db.GetLatestCryptoKeyByFeature(ctx, feature)
 }
 
+func (q *querier) GetLatestHealthcheckResults(ctx context.Context) ([]database.HealthcheckResult, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceDebugInfo); err != nil {
+		return nil, err
+	}
+	return q.db.GetLatestHealthcheckResults(ctx)
+}
+
+func (q *querier) GetLatestTemplateVersionRolloutByTemplateVersionID(ctx context.Context, templateVersionID uuid.UUID) (database.TemplateVersionRollout, error) {
+	rollout, err := q.db.GetLatestTemplateVersionRolloutByTemplateVersionID(ctx, templateVersionID)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	if _, err := q.GetTemplateByID(ctx, rollout.TemplateID); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return rollout, nil
+}
+
 func (q *querier) GetLatestWorkspaceBuildByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (database.WorkspaceBuild, error) {
 	if _, err := q.GetWorkspaceByID(ctx, workspaceID); err != nil {
 		return database.WorkspaceBuild{}, err
@@ -1628,6 +1730,21 @@ func (q *querier) GetLogoURL(ctx context.Context) (string, error) {
 	return q.db.GetLogoURL(ctx)
 }
 
+func (q *querier) GetMaintenanceWindowByID(ctx context.Context, id uuid.UUID) (database.MaintenanceWindow, error) {
+	return fetch(q.log, q.auth, q.db.GetMaintenanceWindowByID)(ctx, id)
+}
+
+func (q *querier) GetMaintenanceWindows(ctx context.Context) ([]database.MaintenanceWindow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetMaintenanceWindows(ctx)
+}
+
+func (q *querier) GetMaintenanceWindowsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.MaintenanceWindow, error) {
+	return fetchWithPostFilter(q.auth, policy.ActionRead, q.db.GetMaintenanceWindowsByOrganizationID)(ctx, organizationID)
+}
+
 func (q *querier) GetNotificationMessagesByStatus(ctx context.Context, arg database.GetNotificationMessagesByStatusParams) ([]database.NotificationMessage, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -1834,6 +1951,21 @@ func (q *querier) GetProvisionerJobByID(ctx context.Context, id uuid.UUID) (data
 	return job, nil
 }
 
+func (q *querier) GetProvisionerJobResourceChangesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	_, err := q.GetProvisionerJobByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return q.db.GetProvisionerJobResourceChangesByJobID(ctx, jobID)
+}
+
+func (q *querier) GetProvisionerJobResourceChangesByJobIDs(ctx context.Context, ids []uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetProvisionerJobResourceChangesByJobIDs(ctx, ids)
+}
+
 func (q *querier) GetProvisionerJobTimingsByJobID(ctx context.Context, jobID uuid.UUID) ([]database.ProvisionerJobTiming, error) {
 	_, err := q.GetProvisionerJobByID(ctx, jobID)
 	if err != nil {
@@ -2106,6 +2238,17 @@ func (q *querier) GetTemplateVersionParameters(ctx context.Context, templateVers
 	return q.db.GetTemplateVersionParameters(ctx, templateVersionID)
 }
 
+func (q *querier) GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (database.TemplateVersionRollout, error) {
+	rollout, err := q.db.GetTemplateVersionRolloutByID(ctx, id)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	if _, err := q.GetTemplateByID(ctx, rollout.TemplateID); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return rollout, nil
+}
+
 func (q *querier) GetTemplateVersionVariables(ctx context.Context, templateVersionID uuid.UUID) ([]database.TemplateVersionVariable, error) {
 	tv, err := q.db.GetTemplateVersionByID(ctx, templateVersionID)
 	if err != nil {
@@ -2359,6 +2502,13 @@ func (q *querier) GetWorkspaceAgentByInstanceID(ctx context.Context, authInstanc
 	return agent, nil
 }
 
+func (q *querier) GetWorkspaceAgentClientPeersByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentClientPeer, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceAgentClientPeersByAgentID(ctx, agentID)
+}
+
 func (q *querier) GetWorkspaceAgentLifecycleStateByID(ctx context.Context, id uuid.UUID) (database.GetWorkspaceAgentLifecycleStateByIDRow, error) {
 	_, err := q.GetWorkspaceAgentByID(ctx, id)
 	if err != nil {
@@ -2513,6 +2663,20 @@ func (q *querier) GetWorkspaceBuildByWorkspaceIDAndBuildNumber(ctx context.Conte
 	return q.db.GetWorkspaceBuildByWorkspaceIDAndBuildNumber(ctx, arg)
 }
 
+func (q *querier) GetWorkspaceBuildDriftByJobID(ctx context.Context, jobID uuid.UUID) (database.WorkspaceBuildDrift, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceBuildDrift{}, err
+	}
+	return q.db.GetWorkspaceBuildDriftByJobID(ctx, jobID)
+}
+
+func (q *querier) GetWorkspaceBuildDriftsByWorkspaceBuildIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceBuildDrift, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceBuildDriftsByWorkspaceBuildIDs(ctx, ids)
+}
+
 func (q *querier) GetWorkspaceBuildParameters(ctx context.Context, workspaceBuildID uuid.UUID) ([]database.WorkspaceBuildParameter, error) {
 	// Authorized call to get the workspace build. If we can read the build,
 	// we can read the params.
@@ -2524,6 +2688,14 @@ func (q *querier) GetWorkspaceBuildParameters(ctx context.Context, workspaceBuil
 	return q.db.GetWorkspaceBuildParameters(ctx, workspaceBuildID)
 }
 
+func (q *querier) GetWorkspaceBuildStatsByTemplateVersionID(ctx context.Context, arg database.GetWorkspaceBuildStatsByTemplateVersionIDParams) (database.GetWorkspaceBuildStatsByTemplateVersionIDRow, error) {
+	// An actor can read the build stats of template versions they can read.
+	if _, err := q.GetTemplateVersionByID(ctx, arg.TemplateVersionID); err != nil {
+		return database.GetWorkspaceBuildStatsByTemplateVersionIDRow{}, err
+	}
+	return q.db.GetWorkspaceBuildStatsByTemplateVersionID(ctx, arg)
+}
+
 func (q *querier) GetWorkspaceBuildStatsByTemplates(ctx context.Context, since time.Time) ([]database.GetWorkspaceBuildStatsByTemplatesRow, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -2548,6 +2720,18 @@ func (q *querier) GetWorkspaceBuildsCreatedAfter(ctx context.Context, createdAt
 	return q.db.GetWorkspaceBuildsCreatedAfter(ctx, createdAt)
 }
 
+func (q *querier) GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (database.WorkspaceBulkOperation, error) {
+	return fetchWithAction(q.log, q.auth, policy.ActionReadPersonal, q.db.GetWorkspaceBulkOperationByID)(ctx, id)
+}
+
+func (q *querier) GetWorkspaceBulkOperationWorkspacesByBulkOperationID(ctx context.Context, bulkOperationID uuid.UUID) ([]database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow, error) {
+	// Authorized by the bulk operation.
+	if _, err := q.GetWorkspaceBulkOperationByID(ctx, bulkOperationID); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceBulkOperationWorkspacesByBulkOperationID(ctx, bulkOperationID)
+}
+
 func (q *querier) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (database.GetWorkspaceByAgentIDRow, error) {
 	return fetch(q.log, q.auth, q.db.GetWorkspaceByAgentID)(ctx, agentID)
 }
@@ -2678,6 +2862,40 @@ func (q *querier) GetWorkspaceResourcesCreatedAfter(ctx context.Context, created
 	return q.db.GetWorkspaceResourcesCreatedAfter(ctx, createdAt)
 }
 
+func (q *querier) GetWorkspaceSessionRecordingByID(ctx context.Context, id uuid.UUID) (database.WorkspaceSessionRecording, error) {
+	recording, err := q.db.GetWorkspaceSessionRecordingByID(ctx, id)
+	if err != nil {
+		return database.WorkspaceSessionRecording{}, err
+	}
+
+	err = q.authorizeWorkspaceSessionRecordings(ctx, recording.WorkspaceID)
+	if err != nil {
+		return database.WorkspaceSessionRecording{}, err
+	}
+
+	return recording, nil
+}
+
+func (q *querier) GetWorkspaceSessionRecordingsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.GetWorkspaceSessionRecordingsByWorkspaceIDRow, error) {
+	err := q.authorizeWorkspaceSessionRecordings(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceSessionRecordingsByWorkspaceID(ctx, workspaceID)
+}
+
+// authorizeWorkspaceSessionRecordings checks that the actor may read the
+// session recordings of a workspace. Recordings contain everything typed into
+// the workspace, so only those who may update the workspace without it being
+// shared with them, like its owner, may read its recordings.
+func (q *querier) authorizeWorkspaceSessionRecordings(ctx context.Context, workspaceID uuid.UUID) error {
+	workspace, err := q.db.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	return q.authorizeContext(ctx, policy.ActionUpdate, workspace.RBACObjectWithoutACL())
+}
+
 func (q *querier) GetWorkspaceUniqueOwnerCountByTemplateIDs(ctx context.Context, templateIds []uuid.UUID) ([]database.GetWorkspaceUniqueOwnerCountByTemplateIDsRow, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -2693,6 +2911,13 @@ func (q *querier) GetWorkspaces(ctx context.Context, arg database.GetWorkspacesP
 	return q.db.GetAuthorizedWorkspaces(ctx, arg, prep)
 }
 
+func (q *querier) GetWorkspacesEligibleForDriftCheck(ctx context.Context, startedBefore time.Time) ([]database.GetWorkspacesEligibleForDriftCheckRow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspacesEligibleForDriftCheck(ctx, startedBefore)
+}
+
 func (q *querier) GetWorkspacesEligibleForTransition(ctx context.Context, now time.Time) ([]database.Workspace, error) {
 	return q.db.GetWorkspacesEligibleForTransition(ctx, now)
 }
@@ -2791,6 +3016,13 @@ func (q *querier) InsertGroupMember(ctx context.Context, arg database.InsertGrou
 	return update(q.log, q.auth, fetch, q.db.InsertGroupMember)(ctx, arg)
 }
 
+func (q *querier) InsertHealthcheckResult(ctx context.Context, arg database.InsertHealthcheckResultParams) (database.HealthcheckResult, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return database.HealthcheckResult{}, err
+	}
+	return q.db.InsertHealthcheckResult(ctx, arg)
+}
+
 func (q *querier) InsertLicense(ctx context.Context, arg database.InsertLicenseParams) (database.License, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceLicense); err != nil {
 		return database.License{}, err
@@ -2798,6 +3030,13 @@ func (q *querier) InsertLicense(ctx context.Context, arg database.InsertLicenseP
 	return q.db.InsertLicense(ctx, arg)
 }
 
+func (q *querier) InsertMaintenanceWindow(ctx context.Context, arg database.InsertMaintenanceWindowParams) (database.MaintenanceWindow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceOrganization.WithID(arg.OrganizationID).InOrg(arg.OrganizationID)); err != nil {
+		return database.MaintenanceWindow{}, err
+	}
+	return q.db.InsertMaintenanceWindow(ctx, arg)
+}
+
 func (q *querier) InsertMissingGroups(ctx context.Context, arg database.InsertMissingGroupsParams) ([]database.Group, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -2875,6 +3114,13 @@ func (q *querier) InsertProvisionerJobLogs(ctx context.Context, arg database.Ins
 	return q.db.InsertProvisionerJobLogs(ctx, arg)
 }
 
+func (q *querier) InsertProvisionerJobResourceChanges(ctx context.Context, arg database.InsertProvisionerJobResourceChangesParams) ([]database.ProvisionerJobResourceChange, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.InsertProvisionerJobResourceChanges(ctx, arg)
+}
+
 // TODO: We need to create a ProvisionerJob resource type
 func (q *querier) InsertProvisionerJobTimings(ctx context.Context, arg database.InsertProvisionerJobTimingsParams) ([]database.ProvisionerJobTiming, error) {
 	// if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
@@ -2932,6 +3178,17 @@ func (q *querier) InsertTemplateVersionParameter(ctx context.Context, arg databa
 	return q.db.InsertTemplateVersionParameter(ctx, arg)
 }
 
+func (q *querier) InsertTemplateVersionRollout(ctx context.Context, arg database.InsertTemplateVersionRolloutParams) (database.TemplateVersionRollout, error) {
+	tpl, err := q.db.GetTemplateByID(ctx, arg.TemplateID)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, tpl); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return q.db.InsertTemplateVersionRollout(ctx, arg)
+}
+
 func (q *querier) InsertTemplateVersionVariable(ctx context.Context, arg database.InsertTemplateVersionVariableParams) (database.TemplateVersionVariable, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return database.TemplateVersionVariable{}, err
@@ -3085,6 +3342,13 @@ func (q *querier) InsertWorkspaceBuild(ctx context.Context, arg database.InsertW
 	return q.db.InsertWorkspaceBuild(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceBuildDriftCheck(ctx context.Context, arg database.InsertWorkspaceBuildDriftCheckParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.InsertWorkspaceBuildDriftCheck(ctx, arg)
+}
+
 func (q *querier) InsertWorkspaceBuildParameters(ctx context.Context, arg database.InsertWorkspaceBuildParametersParams) error {
 	// TODO: Optimize this. We always have the workspace and build already fetched.
 	build, err := q.db.GetWorkspaceBuildByID(ctx, arg.WorkspaceBuildID)
@@ -3105,6 +3369,17 @@ func (q *querier) InsertWorkspaceBuildParameters(ctx context.Context, arg databa
 	return q.db.InsertWorkspaceBuildParameters(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceBulkOperation(ctx context.Context, arg database.InsertWorkspaceBulkOperationParams) (database.WorkspaceBulkOperation, error) {
+	return insertWithAction(q.log, q.auth, rbac.ResourceUserObject(arg.InitiatorID), policy.ActionUpdatePersonal, q.db.InsertWorkspaceBulkOperation)(ctx, arg)
+}
+
+func (q *querier) InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.InsertWorkspaceBulkOperationWorkspacesParams) error {
+	fetch := func(ctx context.Context, arg database.InsertWorkspaceBulkOperationWorkspacesParams) (database.WorkspaceBulkOperation, error) {
+		return q.db.GetWorkspaceBulkOperationByID(ctx, arg.BulkOperationID)
+	}
+	return fetchAndExec(q.log, q.auth, policy.ActionUpdatePersonal, fetch, q.db.InsertWorkspaceBulkOperationWorkspaces)(ctx, arg)
+}
+
 func (q *querier) InsertWorkspaceProxy(ctx context.Context, arg database.InsertWorkspaceProxyParams) (database.WorkspaceProxy, error) {
 	return insert(q.log, q.auth, rbac.ResourceWorkspaceProxy, q.db.InsertWorkspaceProxy)(ctx, arg)
 }
@@ -3123,6 +3398,19 @@ func (q *querier) InsertWorkspaceResourceMetadata(ctx context.Context, arg datab
 	return q.db.InsertWorkspaceResourceMetadata(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceSessionRecording(ctx context.Context, arg database.InsertWorkspaceSessionRecordingParams) (database.WorkspaceSessionRecording, error) {
+	workspace, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
+	if err != nil {
+		return database.WorkspaceSessionRecording{}, err
+	}
+
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, workspace); err != nil {
+		return database.WorkspaceSessionRecording{}, err
+	}
+
+	return q.db.InsertWorkspaceSessionRecording(ctx, arg)
+}
+
 func (q *querier) ListProvisionerKeysByOrganization(ctx context.Context, organizationID uuid.UUID) ([]database.ProvisionerKey, error) {
 	return fetchWithPostFilter(q.auth, policy.ActionRead, q.db.ListProvisionerKeysByOrganization)(ctx, organizationID)
 }
@@ -3288,6 +3576,20 @@ func (q *querier) UpdateInactiveUsersToDormant(ctx context.Context, lastSeenAfte
 	return q.db.UpdateInactiveUsersToDormant(ctx, lastSeenAfter)
 }
 
+func (q *querier) UpdateMaintenanceWindowByID(ctx context.Context, arg database.UpdateMaintenanceWindowByIDParams) (database.MaintenanceWindow, error) {
+	fetch := func(ctx context.Context, arg database.UpdateMaintenanceWindowByIDParams) (database.MaintenanceWindow, error) {
+		return q.db.GetMaintenanceWindowByID(ctx, arg.ID)
+	}
+	return updateWithReturn(q.log, q.auth, fetch, q.db.UpdateMaintenanceWindowByID)(ctx, arg)
+}
+
+func (q *querier) UpdateMaintenanceWindowLastNotifiedStart(ctx context.Context, arg database.UpdateMaintenanceWindowLastNotifiedStartParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.UpdateMaintenanceWindowLastNotifiedStart(ctx, arg)
+}
+
 func (q *querier) UpdateMemberRoles(ctx context.Context, arg database.UpdateMemberRolesParams) (database.OrganizationMember, error) {
 	// Authorized fetch will check that the actor has read access to the org member since the org member is returned.
 	member, err := database.ExpectOne(q.OrganizationMembers(ctx, database.OrganizationMembersParams{
@@ -3562,6 +3864,21 @@ func (q *querier) UpdateTemplateVersionExternalAuthProvidersByJobID(ctx context.
 	return q.db.UpdateTemplateVersionExternalAuthProvidersByJobID(ctx, arg)
 }
 
+func (q *querier) UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutStatusByIDParams) (database.TemplateVersionRollout, error) {
+	rollout, err := q.db.GetTemplateVersionRolloutByID(ctx, arg.ID)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	tpl, err := q.db.GetTemplateByID(ctx, rollout.TemplateID)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, tpl); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return q.db.UpdateTemplateVersionRolloutStatusByID(ctx, arg)
+}
+
 func (q *querier) UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) error {
 	fetch := func(ctx context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) (database.Template, error) {
 		return q.db.GetTemplateByID(ctx, arg.TemplateID)
@@ -3717,6 +4034,18 @@ func (q *querier) UpdateWorkspace(ctx context.Context, arg database.UpdateWorksp
 	return updateWithReturn(q.log, q.auth, fetch, q.db.UpdateWorkspace)(ctx, arg)
 }
 
+func (q *querier) UpdateWorkspaceACLByID(ctx context.Context, arg database.UpdateWorkspaceACLByIDParams) error {
+	workspace, err := q.db.GetWorkspaceByID(ctx, arg.ID)
+	if err != nil {
+		return err
+	}
+	// Users the workspace is shared with may not share it further.
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, workspace.RBACObjectWithoutACL()); err != nil {
+		return err
+	}
+	return q.db.UpdateWorkspaceACLByID(ctx, arg)
+}
+
 func (q *querier) UpdateWorkspaceAgentConnectionByID(ctx context.Context, arg database.UpdateWorkspaceAgentConnectionByIDParams) error {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
 		return err
@@ -3847,6 +4176,13 @@ func (q *querier) UpdateWorkspaceBuildDeadlineByID(ctx context.Context, arg data
 	return q.db.UpdateWorkspaceBuildDeadlineByID(ctx, arg)
 }
 
+func (q *querier) UpdateWorkspaceBuildDriftByJobID(ctx context.Context, arg database.UpdateWorkspaceBuildDriftByJobIDParams) (database.WorkspaceBuildDrift, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceBuildDrift{}, err
+	}
+	return q.db.UpdateWorkspaceBuildDriftByJobID(ctx, arg)
+}
+
 func (q *querier) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Context, arg database.UpdateWorkspaceBuildProvisionerStateByIDParams) error {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
 		return err
@@ -3854,6 +4190,24 @@ func (q *querier) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Context,
 	return q.db.UpdateWorkspaceBuildProvisionerStateByID(ctx, arg)
 }
 
+func (q *querier) UpdateWorkspaceBulkOperationCompletedAt(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCompletedAtParams) error {
+	fetch := func(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCompletedAtParams) (database.WorkspaceBulkOperation, error) {
+		return q.db.GetWorkspaceBulkOperationByID(ctx, arg.ID)
+	}
+	return fetchAndExec(q.log, q.auth, policy.ActionUpdatePersonal, fetch, q.db.UpdateWorkspaceBulkOperationCompletedAt)(ctx, arg)
+}
+
+func (q *querier) UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg database.UpdateWorkspaceBulkOperationWorkspaceParams) (database.WorkspaceBulkOperationWorkspace, error) {
+	op, err := q.db.GetWorkspaceBulkOperationByID(ctx, arg.BulkOperationID)
+	if err != nil {
+		return database.WorkspaceBulkOperationWorkspace{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, op); err != nil {
+		return database.WorkspaceBulkOperationWorkspace{}, err
+	}
+	return q.db.UpdateWorkspaceBulkOperationWorkspace(ctx, arg)
+}
+
 // Deprecated: Use SoftDeleteWorkspaceByID
 func (q *querier) UpdateWorkspaceDeletedByID(ctx context.Context, arg database.UpdateWorkspaceDeletedByIDParams) error {
 	// TODO deleteQ me, placeholder for database.Store
@@ -3931,6 +4285,19 @@ func (q *querier) UpsertApplicationName(ctx context.Context, value string) error
 	return q.db.UpsertApplicationName(ctx, value)
 }
 
+func (q *querier) UpsertConnectionLog(ctx context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
+	workspace, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
+	if err != nil {
+		return database.ConnectionLog{}, err
+	}
+
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, workspace); err != nil {
+		return database.ConnectionLog{}, err
+	}
+
+	return q.db.UpsertConnectionLog(ctx, arg)
+}
+
 func (q *querier) UpsertCoordinatorResumeTokenSigningKey(ctx context.Context, value string) error {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
 		return err
@@ -4075,6 +4442,13 @@ func (q *querier) UpsertTemplateUsageStats(ctx context.Context) error {
 	return q.db.UpsertTemplateUsageStats(ctx)
 }
 
+func (q *querier) UpsertWorkspaceAgentClientPeer(ctx context.Context, arg database.UpsertWorkspaceAgentClientPeerParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.UpsertWorkspaceAgentClientPeer(ctx, arg)
+}
+
 func (q *querier) UpsertWorkspaceAgentPortShare(ctx context.Context, arg database.UpsertWorkspaceAgentPortShareParams) (database.WorkspaceAgentPortShare, error) {
 	workspace, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
 	if err != nil {
@@ -278,6 +278,48 @@ func (s *MethodTestSuite) TestAuditLogs() {
 	}))
 }
 
+func (s *MethodTestSuite) TestConnectionLogs() {
+	s.Run("UpsertConnectionLog", s.Subtest(func(db database.Store, check *expects) {
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{})
+		check.Args(database.UpsertConnectionLogParams{
+			ID:               uuid.New(),
+			ConnectTime:      dbtime.Now(),
+			OrganizationID:   ws.OrganizationID,
+			WorkspaceOwnerID: ws.OwnerID,
+			WorkspaceID:      ws.ID,
+			WorkspaceName:    ws.Name,
+			AgentID:          uuid.New(),
+			AgentName:        "main",
+			Type:             database.ConnectionTypeSsh,
+			ConnectionID:     uuid.New(),
+		}).Asserts(ws, policy.ActionUpdate)
+	}))
+	s.Run("GetConnectionLogsOffset", s.Subtest(func(db database.Store, check *expects) {
+		_ = dbgen.ConnectionLog(s.T(), db, database.ConnectionLog{})
+		_ = dbgen.ConnectionLog(s.T(), db, database.ConnectionLog{})
+		check.Args(database.GetConnectionLogsOffsetParams{
+			LimitOpt: 10,
+		}).Asserts(rbac.ResourceAuditLog, policy.ActionRead)
+	}))
+	s.Run("UpsertWorkspaceAgentClientPeer", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		agt := dbgen.WorkspaceAgent(s.T(), db, database.WorkspaceAgent{})
+		check.Args(database.UpsertWorkspaceAgentClientPeerParams{
+			PeerID:    uuid.New(),
+			AgentID:   agt.ID,
+			UserID:    u.ID,
+			CreatedAt: dbtime.Now(),
+		}).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
+	s.Run("GetWorkspaceAgentClientPeersByAgentID", s.Subtest(func(db database.Store, check *expects) {
+		agt := dbgen.WorkspaceAgent(s.T(), db, database.WorkspaceAgent{})
+		check.Args(agt.ID).Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+	s.Run("DeleteWorkspaceAgentClientPeer", s.Subtest(func(db database.Store, check *expects) {
+		check.Args(uuid.New()).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+}
+
 func (s *MethodTestSuite) TestFile() {
 	s.Run("GetFileByHashAndCreator", s.Subtest(func(db database.Store, check *expects) {
 		f := dbgen.File(s.T(), db, database.File{})
@@ -551,6 +593,22 @@ func (s *MethodTestSuite) TestProvisionerJob() {
 		check.Args(database.UpdateProvisionerJobWithCancelByIDParams{ID: j.ID}).
 			Asserts(v.RBACObject(tpl), []policy.Action{policy.ActionRead, policy.ActionUpdate}).Returns()
 	}))
+	s.Run("GetProvisionerJobResourceChangesByJobID", s.Subtest(func(db database.Store, check *expects) {
+		w := dbgen.Workspace(s.T(), db, database.Workspace{})
+		j := dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{
+			Type: database.ProvisionerJobTypeWorkspaceBuild,
+		})
+		_ = dbgen.WorkspaceBuild(s.T(), db, database.WorkspaceBuild{JobID: j.ID, WorkspaceID: w.ID})
+		changes, err := db.InsertProvisionerJobResourceChanges(context.Background(), database.InsertProvisionerJobResourceChangesParams{
+			JobID:   j.ID,
+			Address: []string{"docker_container.workspace"},
+			Type:    []string{"docker_container"},
+			Name:    []string{"workspace"},
+			Action:  []database.ProvisionerJobResourceChangeAction{database.ProvisionerJobResourceChangeActionReplace},
+		})
+		require.NoError(s.T(), err)
+		check.Args(j.ID).Asserts(w, policy.ActionRead).Returns(changes)
+	}))
 	s.Run("GetProvisionerJobTimingsByJobID", s.Subtest(func(db database.Store, check *expects) {
 		w := dbgen.Workspace(s.T(), db, database.Workspace{})
 		j := dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{
@@ -785,6 +843,223 @@ func (s *MethodTestSuite) TestOrganization() {
 	}))
 }
 
+func (s *MethodTestSuite) TestMaintenanceWindows() {
+	s.Run("InsertMaintenanceWindow", s.Subtest(func(db database.Store, check *expects) {
+		o := dbgen.Organization(s.T(), db, database.Organization{})
+		check.Args(database.InsertMaintenanceWindowParams{
+			ID:             uuid.New(),
+			OrganizationID: o.ID,
+			Name:           "weekly",
+			Schedule:       "CRON_TZ=UTC 0 2 * * 6",
+		}).Asserts(rbac.ResourceOrganization.WithID(o.ID).InOrg(o.ID), policy.ActionUpdate)
+	}))
+	s.Run("GetMaintenanceWindowByID", s.Subtest(func(db database.Store, check *expects) {
+		o := dbgen.Organization(s.T(), db, database.Organization{})
+		w := dbgen.MaintenanceWindow(s.T(), db, database.MaintenanceWindow{OrganizationID: o.ID})
+		check.Args(w.ID).Asserts(w, policy.ActionRead).Returns(w)
+	}))
+	s.Run("GetMaintenanceWindowsByOrganizationID", s.Subtest(func(db database.Store, check *expects) {
+		o := dbgen.Organization(s.T(), db, database.Organization{})
+		a := dbgen.MaintenanceWindow(s.T(), db, database.MaintenanceWindow{OrganizationID: o.ID, Name: "a"})
+		b := dbgen.MaintenanceWindow(s.T(), db, database.MaintenanceWindow{OrganizationID: o.ID, Name: "b"})
+		check.Args(o.ID).Asserts(a, policy.ActionRead, b, policy.ActionRead).
+			Returns([]database.MaintenanceWindow{a, b})
+	}))
+	s.Run("GetMaintenanceWindows", s.Subtest(func(db database.Store, check *expects) {
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+	s.Run("UpdateMaintenanceWindowByID", s.Subtest(func(db database.Store, check *expects) {
+		o := dbgen.Organization(s.T(), db, database.Organization{})
+		w := dbgen.MaintenanceWindow(s.T(), db, database.MaintenanceWindow{OrganizationID: o.ID})
+		check.Args(database.UpdateMaintenanceWindowByIDParams{
+			ID:       w.ID,
+			Name:     w.Name,
+			Schedule: w.Schedule,
+		}).Asserts(w, policy.ActionUpdate)
+	}))
+	s.Run("UpdateMaintenanceWindowLastNotifiedStart", s.Subtest(func(db database.Store, check *expects) {
+		o := dbgen.Organization(s.T(), db, database.Organization{})
+		w := dbgen.MaintenanceWindow(s.T(), db, database.MaintenanceWindow{OrganizationID: o.ID})
+		check.Args(database.UpdateMaintenanceWindowLastNotifiedStartParams{
+			ID: w.ID,
+		}).Asserts(rbac.ResourceSystem, policy.ActionUpdate)
+	}))
+	s.Run("DeleteMaintenanceWindowByID", s.Subtest(func(db database.Store, check *expects) {
+		o := dbgen.Organization(s.T(), db, database.Organization{})
+		w := dbgen.MaintenanceWindow(s.T(), db, database.MaintenanceWindow{OrganizationID: o.ID})
+		check.Args(w.ID).Asserts(w, policy.ActionUpdate)
+	}))
+}
+
+func (s *MethodTestSuite) TestTemplateVersionRollouts() {
+	setup := func(db database.Store) (database.Template, database.TemplateVersion) {
+		o := dbgen.Organization(s.T(), db, database.Organization{})
+		u := dbgen.User(s.T(), db, database.User{})
+		tpl := dbgen.Template(s.T(), db, database.Template{OrganizationID: o.ID, CreatedBy: u.ID})
+		tv := dbgen.TemplateVersion(s.T(), db, database.TemplateVersion{
+			OrganizationID: o.ID,
+			TemplateID:     uuid.NullUUID{UUID: tpl.ID, Valid: true},
+			CreatedBy:      u.ID,
+		})
+		return tpl, tv
+	}
+	s.Run("InsertTemplateVersionRollout", s.Subtest(func(db database.Store, check *expects) {
+		tpl, tv := setup(db)
+		check.Args(database.InsertTemplateVersionRolloutParams{
+			ID:                uuid.New(),
+			TemplateID:        tpl.ID,
+			TemplateVersionID: tv.ID,
+			Percent:           10,
+			CreatedBy:         tpl.CreatedBy,
+		}).Asserts(tpl, policy.ActionUpdate)
+	}))
+	s.Run("GetActiveTemplateVersionRolloutByTemplateID", s.Subtest(func(db database.Store, check *expects) {
+		tpl, tv := setup(db)
+		r := dbgen.TemplateVersionRollout(s.T(), db, database.TemplateVersionRollout{TemplateID: tpl.ID, TemplateVersionID: tv.ID, CreatedBy: tpl.CreatedBy})
+		check.Args(tpl.ID).Asserts(tpl, policy.ActionRead).Returns(r)
+	}))
+	s.Run("GetTemplateVersionRolloutByID", s.Subtest(func(db database.Store, check *expects) {
+		tpl, tv := setup(db)
+		r := dbgen.TemplateVersionRollout(s.T(), db, database.TemplateVersionRollout{TemplateID: tpl.ID, TemplateVersionID: tv.ID, CreatedBy: tpl.CreatedBy})
+		check.Args(r.ID).Asserts(tpl, policy.ActionRead).Returns(r)
+	}))
+	s.Run("GetLatestTemplateVersionRolloutByTemplateVersionID", s.Subtest(func(db database.Store, check *expects) {
+		tpl, tv := setup(db)
+		r := dbgen.TemplateVersionRollout(s.T(), db, database.TemplateVersionRollout{TemplateID: tpl.ID, TemplateVersionID: tv.ID, CreatedBy: tpl.CreatedBy})
+		check.Args(tv.ID).Asserts(tpl, policy.ActionRead).Returns(r)
+	}))
+	s.Run("UpdateTemplateVersionRolloutStatusByID", s.Subtest(func(db database.Store, check *expects) {
+		tpl, tv := setup(db)
+		r := dbgen.TemplateVersionRollout(s.T(), db, database.TemplateVersionRollout{TemplateID: tpl.ID, TemplateVersionID: tv.ID, CreatedBy: tpl.CreatedBy})
+		check.Args(database.UpdateTemplateVersionRolloutStatusByIDParams{
+			ID:     r.ID,
+			Status: database.TemplateVersionRolloutStatusPromoted,
+		}).Asserts(tpl, policy.ActionUpdate)
+	}))
+	s.Run("GetWorkspaceBuildStatsByTemplateVersionID", s.Subtest(func(db database.Store, check *expects) {
+		tpl, tv := setup(db)
+		check.Args(database.GetWorkspaceBuildStatsByTemplateVersionIDParams{
+			TemplateVersionID: tv.ID,
+			Since:             dbtime.Now(),
+		}).Asserts(tpl, policy.ActionRead).Returns(database.GetWorkspaceBuildStatsByTemplateVersionIDRow{})
+	}))
+}
+
+func (s *MethodTestSuite) TestWorkspaceBulkOperations() {
+	s.Run("InsertWorkspaceBulkOperation", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		check.Args(database.InsertWorkspaceBulkOperationParams{
+			ID:             uuid.New(),
+			InitiatorID:    u.ID,
+			InitiatorScope: database.APIKeyScopeAll,
+			Action:         database.WorkspaceBulkOperationActionStop,
+		}).Asserts(rbac.ResourceUserObject(u.ID), policy.ActionUpdatePersonal)
+	}))
+	s.Run("GetWorkspaceBulkOperationByID", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		op := dbgen.WorkspaceBulkOperation(s.T(), db, database.WorkspaceBulkOperation{InitiatorID: u.ID})
+		check.Args(op.ID).Asserts(op, policy.ActionReadPersonal).Returns(op)
+	}))
+	s.Run("GetIncompleteWorkspaceBulkOperations", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		op := dbgen.WorkspaceBulkOperation(s.T(), db, database.WorkspaceBulkOperation{InitiatorID: u.ID})
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.WorkspaceBulkOperation{op})
+	}))
+	s.Run("GetWorkspaceBulkOperationWorkspacesByBulkOperationID", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		op := dbgen.WorkspaceBulkOperation(s.T(), db, database.WorkspaceBulkOperation{InitiatorID: u.ID})
+		check.Args(op.ID).Asserts(op, policy.ActionReadPersonal).Returns([]database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow(nil))
+	}))
+	s.Run("InsertWorkspaceBulkOperationWorkspaces", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		op := dbgen.WorkspaceBulkOperation(s.T(), db, database.WorkspaceBulkOperation{InitiatorID: u.ID})
+		check.Args(database.InsertWorkspaceBulkOperationWorkspacesParams{
+			BulkOperationID: op.ID,
+			WorkspaceIds:    []uuid.UUID{uuid.New()},
+		}).Asserts(op, policy.ActionUpdatePersonal)
+	}))
+	s.Run("UpdateWorkspaceBulkOperationWorkspace", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{OwnerID: u.ID})
+		op := dbgen.WorkspaceBulkOperation(s.T(), db, database.WorkspaceBulkOperation{InitiatorID: u.ID})
+		require.NoError(s.T(), db.InsertWorkspaceBulkOperationWorkspaces(context.Background(), database.InsertWorkspaceBulkOperationWorkspacesParams{
+			BulkOperationID: op.ID,
+			WorkspaceIds:    []uuid.UUID{ws.ID},
+		}))
+		check.Args(database.UpdateWorkspaceBulkOperationWorkspaceParams{
+			BulkOperationID: op.ID,
+			WorkspaceID:     ws.ID,
+			Status:          database.WorkspaceBulkOperationWorkspaceStatusSkipped,
+		}).Asserts(op, policy.ActionUpdatePersonal)
+	}))
+	s.Run("UpdateWorkspaceBulkOperationCompletedAt", s.Subtest(func(db database.Store, check *expects) {
+		u := dbgen.User(s.T(), db, database.User{})
+		op := dbgen.WorkspaceBulkOperation(s.T(), db, database.WorkspaceBulkOperation{InitiatorID: u.ID})
+		check.Args(database.UpdateWorkspaceBulkOperationCompletedAtParams{
+			ID:          op.ID,
+			CompletedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+		}).Asserts(op, policy.ActionUpdatePersonal)
+	}))
+}
+
+func (s *MethodTestSuite) TestWorkspaceBuildDrift() {
+	insertDrift := func(db database.Store) database.WorkspaceBuildDrift {
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{})
+		build := dbgen.WorkspaceBuild(s.T(), db, database.WorkspaceBuild{WorkspaceID: ws.ID, JobID: uuid.New()})
+		j := dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{Type: database.ProvisionerJobTypeTemplateVersionDryRun})
+		err := db.InsertWorkspaceBuildDriftCheck(context.Background(), database.InsertWorkspaceBuildDriftCheckParams{
+			WorkspaceBuildID: build.ID,
+			JobID:            j.ID,
+			StartedAt:        dbtime.Now(),
+		})
+		require.NoError(s.T(), err)
+		drift, err := db.GetWorkspaceBuildDriftByJobID(context.Background(), j.ID)
+		require.NoError(s.T(), err)
+		return drift
+	}
+	s.Run("InsertWorkspaceBuildDriftCheck", s.Subtest(func(db database.Store, check *expects) {
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{})
+		build := dbgen.WorkspaceBuild(s.T(), db, database.WorkspaceBuild{WorkspaceID: ws.ID, JobID: uuid.New()})
+		j := dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{Type: database.ProvisionerJobTypeTemplateVersionDryRun})
+		check.Args(database.InsertWorkspaceBuildDriftCheckParams{
+			WorkspaceBuildID: build.ID,
+			JobID:            j.ID,
+			StartedAt:        dbtime.Now(),
+		}).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
+	s.Run("GetWorkspaceBuildDriftByJobID", s.Subtest(func(db database.Store, check *expects) {
+		drift := insertDrift(db)
+		check.Args(drift.JobID).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns(drift)
+	}))
+	s.Run("GetWorkspaceBuildDriftsByWorkspaceBuildIDs", s.Subtest(func(db database.Store, check *expects) {
+		drift := insertDrift(db)
+		check.Args([]uuid.UUID{drift.WorkspaceBuildID}).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.WorkspaceBuildDrift{drift})
+	}))
+	s.Run("UpdateWorkspaceBuildDriftByJobID", s.Subtest(func(db database.Store, check *expects) {
+		drift := insertDrift(db)
+		check.Args(database.UpdateWorkspaceBuildDriftByJobIDParams{
+			CheckedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+			Drifted:   true,
+			JobID:     drift.JobID,
+		}).Asserts(rbac.ResourceSystem, policy.ActionUpdate)
+	}))
+	s.Run("GetWorkspacesEligibleForDriftCheck", s.Subtest(func(db database.Store, check *expects) {
+		check.Args(dbtime.Now()).Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+	s.Run("GetProvisionerJobResourceChangesByJobIDs", s.Subtest(func(db database.Store, check *expects) {
+		j := dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{Type: database.ProvisionerJobTypeTemplateVersionDryRun})
+		changes, err := db.InsertProvisionerJobResourceChanges(context.Background(), database.InsertProvisionerJobResourceChangesParams{
+			JobID:   j.ID,
+			Address: []string{"docker_container.workspace"},
+			Type:    []string{"docker_container"},
+			Name:    []string{"workspace"},
+			Action:  []database.ProvisionerJobResourceChangeAction{database.ProvisionerJobResourceChangeActionDestroy},
+		})
+		require.NoError(s.T(), err)
+		check.Args([]uuid.UUID{j.ID}).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns(changes)
+	}))
+}
+
 func (s *MethodTestSuite) TestWorkspaceProxy() {
 	s.Run("InsertWorkspaceProxy", s.Subtest(func(db database.Store, check *expects) {
 		check.Args(database.InsertWorkspaceProxyParams{
@@ -1537,6 +1812,45 @@ func (s *MethodTestSuite) TestWorkspace() {
 		agt := dbgen.WorkspaceAgent(s.T(), db, database.WorkspaceAgent{ResourceID: res.ID})
 		check.Args(agt.AuthInstanceID.String).Asserts(ws, policy.ActionRead).Returns(agt)
 	}))
+	s.Run("InsertWorkspaceSessionRecording", s.Subtest(func(db database.Store, check *expects) {
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{})
+		check.Args(database.InsertWorkspaceSessionRecordingParams{
+			ID:          uuid.New(),
+			WorkspaceID: ws.ID,
+			AgentID:     uuid.New(),
+			Type:        database.WorkspaceSessionRecordingTypeSsh,
+		}).Asserts(ws, policy.ActionUpdate)
+	}))
+	s.Run("AppendWorkspaceSessionRecordingData", s.Subtest(func(db database.Store, check *expects) {
+		tpl := dbgen.Template(s.T(), db, database.Template{})
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{
+			TemplateID: tpl.ID,
+		})
+		build := dbgen.WorkspaceBuild(s.T(), db, database.WorkspaceBuild{WorkspaceID: ws.ID, JobID: uuid.New()})
+		res := dbgen.WorkspaceResource(s.T(), db, database.WorkspaceResource{JobID: build.JobID})
+		agt := dbgen.WorkspaceAgent(s.T(), db, database.WorkspaceAgent{ResourceID: res.ID})
+		rec := dbgen.WorkspaceSessionRecording(s.T(), db, database.WorkspaceSessionRecording{
+			WorkspaceID: ws.ID,
+			AgentID:     agt.ID,
+			Data:        []byte("header\n"),
+		})
+		check.Args(database.AppendWorkspaceSessionRecordingDataParams{
+			ID:      rec.ID,
+			AgentID: agt.ID,
+			Offset:  int64(len(rec.Data)),
+			Data:    []byte("event\n"),
+		}).Asserts(ws, policy.ActionUpdate).Returns(int64(len("header\nevent\n")))
+	}))
+	s.Run("GetWorkspaceSessionRecordingByID", s.Subtest(func(db database.Store, check *expects) {
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{})
+		rec := dbgen.WorkspaceSessionRecording(s.T(), db, database.WorkspaceSessionRecording{WorkspaceID: ws.ID})
+		check.Args(rec.ID).Asserts(ws.RBACObjectWithoutACL(), policy.ActionUpdate).Returns(rec)
+	}))
+	s.Run("GetWorkspaceSessionRecordingsByWorkspaceID", s.Subtest(func(db database.Store, check *expects) {
+		ws := dbgen.Workspace(s.T(), db, database.Workspace{})
+		_ = dbgen.WorkspaceSessionRecording(s.T(), db, database.WorkspaceSessionRecording{WorkspaceID: ws.ID})
+		check.Args(ws.ID).Asserts(ws.RBACObjectWithoutACL(), policy.ActionUpdate)
+	}))
 	s.Run("UpdateWorkspaceAgentLifecycleStateByID", s.Subtest(func(db database.Store, check *expects) {
 		tpl := dbgen.Template(s.T(), db, database.Template{})
 		ws := dbgen.Workspace(s.T(), db, database.Workspace{
@@ -1825,6 +2139,12 @@ func (s *MethodTestSuite) TestWorkspace() {
 			ID: w.ID,
 		}).Asserts(w, policy.ActionUpdate)
 	}))
+	s.Run("UpdateWorkspaceACLByID", s.Subtest(func(db database.Store, check *expects) {
+		w := dbgen.Workspace(s.T(), db, database.Workspace{})
+		check.Args(database.UpdateWorkspaceACLByIDParams{
+			ID: w.ID,
+		}).Asserts(w.RBACObjectWithoutACL(), policy.ActionUpdate).Returns()
+	}))
 	s.Run("UpdateWorkspaceAutomaticUpdates", s.Subtest(func(db database.Store, check *expects) {
 		w := dbgen.Workspace(s.T(), db, database.Workspace{})
 		check.Args(database.UpdateWorkspaceAutomaticUpdatesParams{
@@ -2436,6 +2756,9 @@ func (s *MethodTestSuite) TestSystemFunctions() {
 	s.Run("DeleteOldWorkspaceAgentStats", s.Subtest(func(db database.Store, check *expects) {
 		check.Args().Asserts(rbac.ResourceSystem, policy.ActionDelete)
 	}))
+	s.Run("DeleteOldWorkspaceBuildDriftJobs", s.Subtest(func(db database.Store, check *expects) {
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
 	s.Run("GetProvisionerJobsCreatedAfter", s.Subtest(func(db database.Store, check *expects) {
 		// TODO: add provisioner job resource type
 		_ = dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{CreatedAt: time.Now().Add(-time.Hour)})
@@ -2586,6 +2909,12 @@ func (s *MethodTestSuite) TestSystemFunctions() {
 			JobID: j.ID,
 		}).Asserts( /*rbac.ResourceSystem, policy.ActionCreate*/ )
 	}))
+	s.Run("InsertProvisionerJobResourceChanges", s.Subtest(func(db database.Store, check *expects) {
+		j := dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{})
+		check.Args(database.InsertProvisionerJobResourceChangesParams{
+			JobID: j.ID,
+		}).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
 	s.Run("InsertProvisionerJobTimings", s.Subtest(func(db database.Store, check *expects) {
 		// TODO: we need to create a ProvisionerJob resource
 		j := dbgen.ProvisionerJob(s.T(), db, nil, database.ProvisionerJob{})
@@ -2672,6 +3001,23 @@ func (s *MethodTestSuite) TestSystemFunctions() {
 	s.Run("UpsertHealthSettings", s.Subtest(func(db database.Store, check *expects) {
 		check.Args("foo").Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate)
 	}))
+	s.Run("InsertHealthcheckResult", s.Subtest(func(db database.Store, check *expects) {
+		check.Args(database.InsertHealthcheckResultParams{
+			ID:       uuid.New(),
+			Section:  "Database",
+			Severity: "ok",
+			Warnings: json.RawMessage("[]"),
+		}).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
+	s.Run("GetHealthcheckResults", s.Subtest(func(db database.Store, check *expects) {
+		check.Args(database.GetHealthcheckResultsParams{}).Asserts(rbac.ResourceDebugInfo, policy.ActionRead)
+	}))
+	s.Run("GetLatestHealthcheckResults", s.Subtest(func(db database.Store, check *expects) {
+		check.Args().Asserts(rbac.ResourceDebugInfo, policy.ActionRead)
+	}))
+	s.Run("DeleteOldHealthcheckResults", s.Subtest(func(db database.Store, check *expects) {
+		check.Args(time.Time{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
 	s.Run("GetNotificationsSettings", s.Subtest(func(db database.Store, check *expects) {
 		check.Args().Asserts()
 	}))
@@ -70,6 +70,31 @@ func AuditLog(t testing.TB, db database.Store, seed database.AuditLog) database.
 	return log
 }
 
+func ConnectionLog(t testing.TB, db database.Store, seed database.ConnectionLog) database.ConnectionLog {
+	log, err := db.UpsertConnectionLog(genCtx, database.UpsertConnectionLogParams{
+		ID:               takeFirst(seed.ID, uuid.New()),
+		ConnectTime:      takeFirst(seed.ConnectTime, dbtime.Now()),
+		OrganizationID:   takeFirst(seed.OrganizationID, uuid.New()),
+		WorkspaceOwnerID: takeFirst(seed.WorkspaceOwnerID, uuid.New()),
+		WorkspaceID:      takeFirst(seed.WorkspaceID, uuid.New()),
+		WorkspaceName:    takeFirst(seed.WorkspaceName, testutil.GetRandomName(t)),
+		AgentID:          takeFirst(seed.AgentID, uuid.New()),
+		AgentName:        takeFirst(seed.AgentName, "main"),
+		Type:             takeFirst(seed.Type, database.ConnectionTypeSsh),
+		Ip: pqtype.Inet{
+			IPNet: takeFirstIP(seed.Ip.IPNet, net.IPNet{}),
+			Valid: takeFirst(seed.Ip.Valid, false),
+		},
+		UserID:           seed.UserID,
+		ConnectionID:     takeFirst(seed.ConnectionID, uuid.New()),
+		DisconnectTime:   seed.DisconnectTime,
+		Code:             seed.Code,
+		DisconnectReason: seed.DisconnectReason,
+	})
+	require.NoError(t, err, "insert connection log")
+	return log
+}
+
 func Template(t testing.TB, db database.Store, seed database.Template) database.Template {
 	id := takeFirst(seed.ID, uuid.New())
 	if seed.GroupACL == nil {
@@ -468,6 +493,7 @@ func ProvisionerJob(t testing.TB, db database.Store, ps pubsub.Pubsub, orig data
 		Input:          takeFirstSlice(orig.Input, []byte("{}")),
 		Tags:           orig.Tags,
 		TraceMetadata:  pqtype.NullRawMessage{},
+		Priority:       orig.Priority,
 	})
 	require.NoError(t, err, "insert job")
 	if ps != nil {
@@ -525,6 +551,52 @@ func ProvisionerKey(t testing.TB, db database.Store, orig database.ProvisionerKe
 	return key
 }
 
+func MaintenanceWindow(t testing.TB, db database.Store, orig database.MaintenanceWindow) database.MaintenanceWindow {
+	window, err := db.InsertMaintenanceWindow(genCtx, database.InsertMaintenanceWindowParams{
+		ID:             takeFirst(orig.ID, uuid.New()),
+		OrganizationID: takeFirst(orig.OrganizationID, uuid.New()),
+		TemplateID:     orig.TemplateID,
+		Name:           takeFirst(orig.Name, testutil.GetRandomName(t)),
+		Message:        orig.Message,
+		Schedule:       takeFirst(orig.Schedule, "CRON_TZ=UTC 0 2 * * 6"),
+		Duration:       takeFirst(orig.Duration, int64(time.Hour)),
+		NoticePeriod:   takeFirst(orig.NoticePeriod, int64(24*time.Hour)),
+		BlockBuilds:    orig.BlockBuilds,
+		CreatedAt:      takeFirst(orig.CreatedAt, dbtime.Now()),
+		UpdatedAt:      takeFirst(orig.UpdatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert maintenance window")
+	return window
+}
+
+func TemplateVersionRollout(t testing.TB, db database.Store, orig database.TemplateVersionRollout) database.TemplateVersionRollout {
+	rollout, err := db.InsertTemplateVersionRollout(genCtx, database.InsertTemplateVersionRolloutParams{
+		ID:                takeFirst(orig.ID, uuid.New()),
+		TemplateID:        takeFirst(orig.TemplateID, uuid.New()),
+		TemplateVersionID: takeFirst(orig.TemplateVersionID, uuid.New()),
+		Percent:           orig.Percent,
+		GroupID:           orig.GroupID,
+		CreatedBy:         takeFirst(orig.CreatedBy, uuid.New()),
+		CreatedAt:         takeFirst(orig.CreatedAt, dbtime.Now()),
+		UpdatedAt:         takeFirst(orig.UpdatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert template version rollout")
+	return rollout
+}
+
+func WorkspaceBulkOperation(t testing.TB, db database.Store, orig database.WorkspaceBulkOperation) database.WorkspaceBulkOperation {
+	op, err := db.InsertWorkspaceBulkOperation(genCtx, database.InsertWorkspaceBulkOperationParams{
+		ID:             takeFirst(orig.ID, uuid.New()),
+		InitiatorID:    takeFirst(orig.InitiatorID, uuid.New()),
+		InitiatorScope: takeFirst(orig.InitiatorScope, database.APIKeyScopeAll),
+		Action:         takeFirst(orig.Action, database.WorkspaceBulkOperationActionStart),
+		Search:         takeFirst(orig.Search, "owner:me"),
+		CreatedAt:      takeFirst(orig.CreatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert workspace bulk operation")
+	return op
+}
+
 func WorkspaceApp(t testing.TB, db database.Store, orig database.WorkspaceApp) database.WorkspaceApp {
 	resource, err := db.InsertWorkspaceApp(genCtx, database.InsertWorkspaceAppParams{
 		ID:          takeFirst(orig.ID, uuid.New()),
@@ -618,6 +690,25 @@ func WorkspaceResourceMetadatums(t testing.TB, db database.Store, seed database.
 	return meta
 }
 
+func WorkspaceSessionRecording(t testing.TB, db database.Store, orig database.WorkspaceSessionRecording) database.WorkspaceSessionRecording {
+	startedAt := takeFirst(orig.StartedAt, dbtime.Now())
+	recording, err := db.InsertWorkspaceSessionRecording(genCtx, database.InsertWorkspaceSessionRecordingParams{
+		ID:            takeFirst(orig.ID, uuid.New()),
+		WorkspaceID:   takeFirst(orig.WorkspaceID, uuid.New()),
+		AgentID:       takeFirst(orig.AgentID, uuid.New()),
+		Type:          takeFirst(orig.Type, database.WorkspaceSessionRecordingTypeSsh),
+		Command:       takeFirst(orig.Command, ""),
+		StartedAt:     startedAt,
+		EndedAt:       takeFirst(orig.EndedAt, startedAt.Add(time.Minute)),
+		InputRecorded: takeFirst(orig.InputRecorded, false),
+		Truncated:     takeFirst(orig.Truncated, false),
+		CreatedAt:     takeFirst(orig.CreatedAt, dbtime.Now()),
+		Data:          takeFirstSlice(orig.Data, []byte(`{"version":2,"width":80,"height":24}`+"\n")),
+	})
+	require.NoError(t, err, "insert workspace session recording")
+	return recording
+}
+
 func WorkspaceProxy(t testing.TB, db database.Store, orig database.WorkspaceProxy) (database.WorkspaceProxy, string) {
 	secret, err := cryptorand.HexString(64)
 	require.NoError(t, err, "generate secret")
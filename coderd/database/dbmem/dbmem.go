@@ -187,54 +187,64 @@ type data struct {
 	userLinks           []database.UserLink
 
 	// New tables
-	auditLogs                       []database.AuditLog
-	cryptoKeys                      []database.CryptoKey
-	dbcryptKeys                     []database.DBCryptKey
-	files                           []database.File
-	externalAuthLinks               []database.ExternalAuthLink
-	gitSSHKey                       []database.GitSSHKey
-	groupMembers                    []database.GroupMemberTable
-	groups                          []database.Group
-	jfrogXRayScans                  []database.JfrogXrayScan
-	licenses                        []database.License
-	notificationMessages            []database.NotificationMessage
-	notificationPreferences         []database.NotificationPreference
-	notificationReportGeneratorLogs []database.NotificationReportGeneratorLog
-	oauth2ProviderApps              []database.OAuth2ProviderApp
-	oauth2ProviderAppSecrets        []database.OAuth2ProviderAppSecret
-	oauth2ProviderAppCodes          []database.OAuth2ProviderAppCode
-	oauth2ProviderAppTokens         []database.OAuth2ProviderAppToken
-	parameterSchemas                []database.ParameterSchema
-	provisionerDaemons              []database.ProvisionerDaemon
-	provisionerJobLogs              []database.ProvisionerJobLog
-	provisionerJobs                 []database.ProvisionerJob
-	provisionerKeys                 []database.ProvisionerKey
-	replicas                        []database.Replica
-	templateVersions                []database.TemplateVersionTable
-	templateVersionParameters       []database.TemplateVersionParameter
-	templateVersionVariables        []database.TemplateVersionVariable
-	templateVersionWorkspaceTags    []database.TemplateVersionWorkspaceTag
-	templates                       []database.TemplateTable
-	templateUsageStats              []database.TemplateUsageStat
-	workspaceAgents                 []database.WorkspaceAgent
-	workspaceAgentMetadata          []database.WorkspaceAgentMetadatum
-	workspaceAgentLogs              []database.WorkspaceAgentLog
-	workspaceAgentLogSources        []database.WorkspaceAgentLogSource
-	workspaceAgentPortShares        []database.WorkspaceAgentPortShare
-	workspaceAgentScripts           []database.WorkspaceAgentScript
-	workspaceAgentStats             []database.WorkspaceAgentStat
-	workspaceApps                   []database.WorkspaceApp
-	workspaceAppStatsLastInsertID   int64
-	workspaceAppStats               []database.WorkspaceAppStat
-	workspaceBuilds                 []database.WorkspaceBuild
-	workspaceBuildParameters        []database.WorkspaceBuildParameter
-	workspaceResourceMetadata       []database.WorkspaceResourceMetadatum
-	workspaceResources              []database.WorkspaceResource
-	workspaces                      []database.Workspace
-	workspaceProxies                []database.WorkspaceProxy
-	customRoles                     []database.CustomRole
-	provisionerJobTimings           []database.ProvisionerJobTiming
-	runtimeConfig                   map[string]string
+	auditLogs                        []database.AuditLog
+	connectionLogs                   []database.ConnectionLog
+	cryptoKeys                       []database.CryptoKey
+	dbcryptKeys                      []database.DBCryptKey
+	files                            []database.File
+	externalAuthLinks                []database.ExternalAuthLink
+	gitSSHKey                        []database.GitSSHKey
+	groupMembers                     []database.GroupMemberTable
+	groups                           []database.Group
+	healthcheckResults               []database.HealthcheckResult
+	jfrogXRayScans                   []database.JfrogXrayScan
+	licenses                         []database.License
+	maintenanceWindows               []database.MaintenanceWindow
+	notificationMessages             []database.NotificationMessage
+	notificationPreferences          []database.NotificationPreference
+	notificationReportGeneratorLogs  []database.NotificationReportGeneratorLog
+	oauth2ProviderApps               []database.OAuth2ProviderApp
+	oauth2ProviderAppSecrets         []database.OAuth2ProviderAppSecret
+	oauth2ProviderAppCodes           []database.OAuth2ProviderAppCode
+	oauth2ProviderAppTokens          []database.OAuth2ProviderAppToken
+	parameterSchemas                 []database.ParameterSchema
+	provisionerDaemons               []database.ProvisionerDaemon
+	provisionerJobLogs               []database.ProvisionerJobLog
+	provisionerJobs                  []database.ProvisionerJob
+	provisionerKeys                  []database.ProvisionerKey
+	replicas                         []database.Replica
+	templateVersions                 []database.TemplateVersionTable
+	templateVersionParameters        []database.TemplateVersionParameter
+	templateVersionRollouts          []database.TemplateVersionRollout
+	templateVersionVariables         []database.TemplateVersionVariable
+	templateVersionWorkspaceTags     []database.TemplateVersionWorkspaceTag
+	templates                        []database.TemplateTable
+	templateUsageStats               []database.TemplateUsageStat
+	workspaceAgents                  []database.WorkspaceAgent
+	workspaceAgentMetadata           []database.WorkspaceAgentMetadatum
+	workspaceAgentLogs               []database.WorkspaceAgentLog
+	workspaceAgentClientPeers        []database.WorkspaceAgentClientPeer
+	workspaceAgentLogSources         []database.WorkspaceAgentLogSource
+	workspaceAgentPortShares         []database.WorkspaceAgentPortShare
+	workspaceAgentScripts            []database.WorkspaceAgentScript
+	workspaceAgentStats              []database.WorkspaceAgentStat
+	workspaceApps                    []database.WorkspaceApp
+	workspaceAppStatsLastInsertID    int64
+	workspaceAppStats                []database.WorkspaceAppStat
+	workspaceBuilds                  []database.WorkspaceBuild
+	workspaceBuildParameters         []database.WorkspaceBuildParameter
+	workspaceBulkOperations          []database.WorkspaceBulkOperation
+	workspaceBulkOperationWorkspaces []database.WorkspaceBulkOperationWorkspace
+	workspaceResourceMetadata        []database.WorkspaceResourceMetadatum
+	workspaceResources               []database.WorkspaceResource
+	workspaceSessionRecordings       []database.WorkspaceSessionRecording
+	workspaces                       []database.Workspace
+	workspaceProxies                 []database.WorkspaceProxy
+	customRoles                      []database.CustomRole
+	provisionerJobTimings            []database.ProvisionerJobTiming
+	provisionerJobResourceChanges    []database.ProvisionerJobResourceChange
+	workspaceBuildDrift              []database.WorkspaceBuildDrift
+	runtimeConfig                    map[string]string
 	// Locks is a map of lock names. Any keys within the map are currently
 	// locked.
 	locks                            map[int64]struct{}
@@ -450,6 +460,8 @@ func (q *FakeQuerier) convertToWorkspaceRowsNoLock(ctx context.Context, workspac
 			Count:             count,
 			AutomaticUpdates:  w.AutomaticUpdates,
 			Favorite:          w.Favorite,
+			UserACL:           w.UserACL,
+			GroupACL:          w.GroupACL,
 		}
 
 		for _, t := range q.templates {
@@ -1023,6 +1035,14 @@ func (q *FakeQuerier) getLatestWorkspaceAppByTemplateIDUserIDSlugNoLock(ctx cont
 }
 
 // getOrganizationByIDNoLock is used by other functions in the database fake.
+// sortConnectionLogsNoLock keeps connection logs newest first, matching the
+// order of GetConnectionLogsOffset.
+func (q *FakeQuerier) sortConnectionLogsNoLock() {
+	slices.SortStableFunc(q.connectionLogs, func(a, b database.ConnectionLog) int {
+		return b.ConnectTime.Compare(a.ConnectTime)
+	})
+}
+
 func (q *FakeQuerier) getOrganizationByIDNoLock(id uuid.UUID) (database.Organization, error) {
 	for _, organization := range q.organizations {
 		if organization.ID == id {
@@ -1047,14 +1067,32 @@ func (q *FakeQuerier) AcquireNotificationMessages(_ context.Context, arg databas
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
-	// Shift the first "Count" notifications off the slice (FIFO).
-	sz := len(q.notificationMessages)
-	if sz > int(arg.Count) {
-		sz = int(arg.Count)
+	// Messages are held back until the oldest message of the same template and method for the same user has exceeded
+	// the digest window.
+	digestCutoff := dbtime.Now().Add(-time.Second * time.Duration(arg.DigestWindowSeconds))
+	held := func(nm database.NotificationMessage) bool {
+		if arg.DigestWindowSeconds == 0 {
+			return false
+		}
+		for _, other := range q.notificationMessages {
+			if other.UserID == nm.UserID && other.NotificationTemplateID == nm.NotificationTemplateID &&
+				other.Method == nm.Method && !other.CreatedAt.After(digestCutoff) {
+				return false
+			}
+		}
+		return true
 	}
 
-	list := q.notificationMessages[:sz]
-	q.notificationMessages = q.notificationMessages[sz:]
+	// Shift the first "Count" eligible notifications off the slice (FIFO).
+	var list, remaining []database.NotificationMessage
+	for _, nm := range q.notificationMessages {
+		if len(list) >= int(arg.Count) || held(nm) {
+			remaining = append(remaining, nm)
+			continue
+		}
+		list = append(list, nm)
+	}
+	q.notificationMessages = remaining
 
 	var out []database.AcquireNotificationMessagesRow
 	for _, nm := range list {
@@ -1073,6 +1111,8 @@ func (q *FakeQuerier) AcquireNotificationMessages(_ context.Context, arg databas
 			ID:            nm.ID,
 			Payload:       nm.Payload,
 			Method:        nm.Method,
+			UserID:        nm.UserID,
+			CreatedAt:     nm.CreatedAt,
 			TitleTemplate: "This is a title with {{.Labels.variable}}",
 			BodyTemplate:  "This is a body with {{.Labels.variable}}",
 			TemplateID:    nm.NotificationTemplateID,
@@ -1090,6 +1130,16 @@ func (q *FakeQuerier) AcquireProvisionerJob(_ context.Context, arg database.Acqu
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
+	runningByOrganization := map[uuid.UUID]int32{}
+	runningByInitiator := map[uuid.UUID]int32{}
+	for _, provisionerJob := range q.provisionerJobs {
+		if provisionerJob.StartedAt.Valid && !provisionerJob.CompletedAt.Valid {
+			runningByOrganization[provisionerJob.OrganizationID]++
+			runningByInitiator[provisionerJob.InitiatorID]++
+		}
+	}
+
+	candidates := make([]int, 0)
 	for index, provisionerJob := range q.provisionerJobs {
 		if provisionerJob.OrganizationID != arg.OrganizationID {
 			continue
@@ -1127,6 +1177,28 @@ func (q *FakeQuerier) AcquireProvisionerJob(_ context.Context, arg database.Acqu
 		if !tagsSubset(provisionerJob.Tags, tags) {
 			continue
 		}
+		if arg.MaxRunningJobsPerOrganization > 0 && runningByOrganization[provisionerJob.OrganizationID] >= arg.MaxRunningJobsPerOrganization {
+			continue
+		}
+		if arg.MaxRunningJobsPerUser > 0 && runningByInitiator[provisionerJob.InitiatorID] >= arg.MaxRunningJobsPerUser {
+			continue
+		}
+		candidates = append(candidates, index)
+	}
+
+	// ORDER BY priority DESC, running jobs of the initiator ASC, created_at
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := q.provisionerJobs[candidates[i]], q.provisionerJobs[candidates[j]]
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if runningByInitiator[a.InitiatorID] != runningByInitiator[b.InitiatorID] {
+			return runningByInitiator[a.InitiatorID] < runningByInitiator[b.InitiatorID]
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+	for _, index := range candidates {
+		provisionerJob := q.provisionerJobs[index]
 		provisionerJob.StartedAt = arg.StartedAt
 		provisionerJob.UpdatedAt = arg.StartedAt.Time
 		provisionerJob.WorkerID = arg.WorkerID
@@ -1239,6 +1311,32 @@ func (q *FakeQuerier) AllUserIDs(_ context.Context) ([]uuid.UUID, error) {
 	return userIDs, nil
 }
 
+func (q *FakeQuerier) AppendWorkspaceSessionRecordingData(_ context.Context, arg database.AppendWorkspaceSessionRecordingDataParams) (int64, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return 0, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, recording := range q.workspaceSessionRecordings {
+		if recording.ID != arg.ID || recording.AgentID != arg.AgentID {
+			continue
+		}
+		if int64(len(recording.Data)) != arg.Offset {
+			return 0, sql.ErrNoRows
+		}
+		data := make([]byte, 0, len(recording.Data)+len(arg.Data))
+		data = append(data, recording.Data...)
+		data = append(data, arg.Data...)
+		recording.Data = data
+		q.workspaceSessionRecordings[i] = recording
+		return int64(len(data)), nil
+	}
+	return 0, sql.ErrNoRows
+}
+
 func (q *FakeQuerier) ArchiveUnusedTemplateVersions(_ context.Context, arg database.ArchiveUnusedTemplateVersionsParams) ([]uuid.UUID, error) {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -1601,6 +1699,16 @@ func (q *FakeQuerier) DeleteLicense(_ context.Context, id int32) (int32, error)
 	return 0, sql.ErrNoRows
 }
 
+func (q *FakeQuerier) DeleteMaintenanceWindowByID(_ context.Context, id uuid.UUID) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.maintenanceWindows = slices.DeleteFunc(q.maintenanceWindows, func(window database.MaintenanceWindow) bool {
+		return window.ID == id
+	})
+	return nil
+}
+
 func (q *FakeQuerier) DeleteOAuth2ProviderAppByID(_ context.Context, id uuid.UUID) error {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
@@ -1745,6 +1853,20 @@ func (q *FakeQuerier) DeleteOAuth2ProviderAppTokensByAppAndUserID(_ context.Cont
 	return nil
 }
 
+func (q *FakeQuerier) DeleteOldHealthcheckResults(ctx context.Context, beforeTime time.Time) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	results := q.healthcheckResults[:0]
+	for _, result := range q.healthcheckResults {
+		if !result.CreatedAt.Before(beforeTime) {
+			results = append(results, result)
+		}
+	}
+	q.healthcheckResults = results
+	return nil
+}
+
 func (*FakeQuerier) DeleteOldNotificationMessages(_ context.Context) error {
 	return nil
 }
@@ -1932,6 +2054,65 @@ func (q *FakeQuerier) DeleteOldWorkspaceAgentStats(_ context.Context) error {
 	return nil
 }
 
+func (q *FakeQuerier) DeleteOldWorkspaceBuildDriftJobs(_ context.Context) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	referenced := map[uuid.UUID]bool{}
+	for _, drift := range q.workspaceBuildDrift {
+		referenced[drift.JobID] = true
+		if drift.CheckedJobID.Valid {
+			referenced[drift.CheckedJobID.UUID] = true
+		}
+	}
+
+	deleted := map[uuid.UUID]bool{}
+	jobs := q.provisionerJobs[:0]
+	for _, job := range q.provisionerJobs {
+		if job.Type == database.ProvisionerJobTypeTemplateVersionDryRun && job.CompletedAt.Valid && !referenced[job.ID] {
+			var input struct {
+				RefreshOnly bool `json:"refresh_only"`
+			}
+			if err := json.Unmarshal(job.Input, &input); err == nil && input.RefreshOnly {
+				deleted[job.ID] = true
+				continue
+			}
+		}
+		jobs = append(jobs, job)
+	}
+	q.provisionerJobs = jobs
+
+	logs := q.provisionerJobLogs[:0]
+	for _, log := range q.provisionerJobLogs {
+		if !deleted[log.JobID] {
+			logs = append(logs, log)
+		}
+	}
+	q.provisionerJobLogs = logs
+	timings := q.provisionerJobTimings[:0]
+	for _, timing := range q.provisionerJobTimings {
+		if !deleted[timing.JobID] {
+			timings = append(timings, timing)
+		}
+	}
+	q.provisionerJobTimings = timings
+	changes := q.provisionerJobResourceChanges[:0]
+	for _, change := range q.provisionerJobResourceChanges {
+		if !deleted[change.JobID] {
+			changes = append(changes, change)
+		}
+	}
+	q.provisionerJobResourceChanges = changes
+	resources := q.workspaceResources[:0]
+	for _, resource := range q.workspaceResources {
+		if !deleted[resource.JobID] {
+			resources = append(resources, resource)
+		}
+	}
+	q.workspaceResources = resources
+	return nil
+}
+
 func (q *FakeQuerier) DeleteOrganization(_ context.Context, id uuid.UUID) error {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
@@ -2038,6 +2219,20 @@ func (*FakeQuerier) DeleteTailnetTunnel(_ context.Context, arg database.DeleteTa
 	return database.DeleteTailnetTunnelRow{}, ErrUnimplemented
 }
 
+func (q *FakeQuerier) DeleteWorkspaceAgentClientPeer(_ context.Context, peerID uuid.UUID) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, peer := range q.workspaceAgentClientPeers {
+		if peer.PeerID == peerID {
+			q.workspaceAgentClientPeers = append(q.workspaceAgentClientPeers[:i], q.workspaceAgentClientPeers[i+1:]...)
+			return nil
+		}
+	}
+
+	return nil
+}
+
 func (q *FakeQuerier) DeleteWorkspaceAgentPortShare(_ context.Context, arg database.DeleteWorkspaceAgentPortShareParams) error {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -2235,6 +2430,18 @@ func (q *FakeQuerier) GetAPIKeysLastUsedAfter(_ context.Context, after time.Time
 	return apiKeys, nil
 }
 
+func (q *FakeQuerier) GetActiveTemplateVersionRolloutByTemplateID(_ context.Context, templateID uuid.UUID) (database.TemplateVersionRollout, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	for _, rollout := range q.templateVersionRollouts {
+		if rollout.TemplateID == templateID && rollout.Status == database.TemplateVersionRolloutStatusCanary {
+			return rollout, nil
+		}
+	}
+	return database.TemplateVersionRollout{}, sql.ErrNoRows
+}
+
 func (q *FakeQuerier) GetActiveUserCount(_ context.Context) (int64, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -2370,6 +2577,110 @@ func (q *FakeQuerier) GetAuthorizationUserRoles(_ context.Context, userID uuid.U
 	}, nil
 }
 
+func (q *FakeQuerier) GetConnectionLogsOffset(_ context.Context, arg database.GetConnectionLogsOffsetParams) ([]database.GetConnectionLogsOffsetRow, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	if arg.LimitOpt == 0 {
+		// Default to 100 is set in the SQL query.
+		arg.LimitOpt = 100
+	}
+
+	logs := make([]database.GetConnectionLogsOffsetRow, 0, arg.LimitOpt)
+
+	// q.connectionLogs are already sorted by connect time DESC.
+	for _, clog := range q.connectionLogs {
+		if arg.OrganizationID != uuid.Nil && clog.OrganizationID != arg.OrganizationID {
+			continue
+		}
+		if arg.WorkspaceOwnerID != uuid.Nil && clog.WorkspaceOwnerID != arg.WorkspaceOwnerID {
+			continue
+		}
+		if arg.WorkspaceOwner != "" {
+			owner, err := q.getUserByIDNoLock(clog.WorkspaceOwnerID)
+			if err != nil || !strings.EqualFold(arg.WorkspaceOwner, owner.Username) {
+				continue
+			}
+		}
+		if arg.WorkspaceID != uuid.Nil && clog.WorkspaceID != arg.WorkspaceID {
+			continue
+		}
+		if arg.WorkspaceName != "" && !strings.EqualFold(arg.WorkspaceName, clog.WorkspaceName) {
+			continue
+		}
+		if arg.Type != "" && string(clog.Type) != arg.Type {
+			continue
+		}
+		if arg.UserID != uuid.Nil && (!clog.UserID.Valid || clog.UserID.UUID != arg.UserID) {
+			continue
+		}
+		if arg.Username != "" {
+			if !clog.UserID.Valid {
+				continue
+			}
+			user, err := q.getUserByIDNoLock(clog.UserID.UUID)
+			if err != nil || !strings.EqualFold(arg.Username, user.Username) {
+				continue
+			}
+		}
+		if !arg.ConnectedAfter.IsZero() && clog.ConnectTime.Before(arg.ConnectedAfter) {
+			continue
+		}
+		if !arg.ConnectedBefore.IsZero() && clog.ConnectTime.After(arg.ConnectedBefore) {
+			continue
+		}
+		switch arg.Status {
+		case "ongoing":
+			if clog.DisconnectTime.Valid {
+				continue
+			}
+		case "completed":
+			if !clog.DisconnectTime.Valid {
+				continue
+			}
+		}
+		if arg.OffsetOpt > 0 {
+			arg.OffsetOpt--
+			continue
+		}
+
+		row := database.GetConnectionLogsOffsetRow{
+			ConnectionLog: clog,
+		}
+		if clog.UserID.Valid {
+			user, err := q.getUserByIDNoLock(clog.UserID.UUID)
+			if err == nil {
+				row.UserUsername = sql.NullString{String: user.Username, Valid: true}
+				row.UserName = sql.NullString{String: user.Name, Valid: true}
+				row.UserAvatarUrl = sql.NullString{String: user.AvatarURL, Valid: true}
+			}
+		}
+		if owner, err := q.getUserByIDNoLock(clog.WorkspaceOwnerID); err == nil {
+			row.WorkspaceOwnerUsername = owner.Username
+		}
+		if org, err := q.getOrganizationByIDNoLock(clog.OrganizationID); err == nil {
+			row.OrganizationName = org.Name
+		}
+		logs = append(logs, row)
+
+		if len(logs) >= int(arg.LimitOpt) {
+			break
+		}
+	}
+
+	count := int64(len(logs))
+	for i := range logs {
+		logs[i].Count = count
+	}
+
+	return logs, nil
+}
+
 func (q *FakeQuerier) GetCoordinatorResumeTokenSigningKey(_ context.Context) (string, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -2941,6 +3252,37 @@ func (q *FakeQuerier) GetHealthSettings(_ context.Context) (string, error) {
 	return string(q.healthSettings), nil
 }
 
+func (q *FakeQuerier) GetHealthcheckResults(ctx context.Context, arg database.GetHealthcheckResultsParams) ([]database.HealthcheckResult, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	results := []database.HealthcheckResult{}
+	for _, result := range q.healthcheckResults {
+		if arg.Section != "" && result.Section != arg.Section {
+			continue
+		}
+		if !result.CreatedAt.After(arg.CreatedAfter) {
+			continue
+		}
+		results = append(results, result)
+	}
+	slices.SortFunc(results, func(a, b database.HealthcheckResult) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Section, b.Section)
+	})
+	if arg.LimitOpt > 0 && len(results) > int(arg.LimitOpt) {
+		results = results[:arg.LimitOpt]
+	}
+	return results, nil
+}
+
 func (q *FakeQuerier) GetHungProvisionerJobs(_ context.Context, hungSince time.Time) ([]database.ProvisionerJob, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -2958,6 +3300,22 @@ func (q *FakeQuerier) GetHungProvisionerJobs(_ context.Context, hungSince time.T
 	return hungJobs, nil
 }
 
+func (q *FakeQuerier) GetIncompleteWorkspaceBulkOperations(_ context.Context) ([]database.WorkspaceBulkOperation, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var ops []database.WorkspaceBulkOperation
+	for _, op := range q.workspaceBulkOperations {
+		if !op.CompletedAt.Valid {
+			ops = append(ops, op)
+		}
+	}
+	slices.SortFunc(ops, func(a, b database.WorkspaceBulkOperation) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return ops, nil
+}
+
 func (q *FakeQuerier) GetJFrogXrayScanByWorkspaceAndAgentID(_ context.Context, arg database.GetJFrogXrayScanByWorkspaceAndAgentIDParams) (database.JfrogXrayScan, error) {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -3002,6 +3360,46 @@ func (q *FakeQuerier) GetLatestCryptoKeyByFeature(_ context.Context, feature dat
 	return latestKey, nil
 }
 
+func (q *FakeQuerier) GetLatestHealthcheckResults(ctx context.Context) ([]database.HealthcheckResult, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	latest := map[string]database.HealthcheckResult{}
+	for _, result := range q.healthcheckResults {
+		if l, ok := latest[result.Section]; !ok || result.CreatedAt.After(l.CreatedAt) {
+			latest[result.Section] = result
+		}
+	}
+	results := maps.Values(latest)
+	slices.SortFunc(results, func(a, b database.HealthcheckResult) int {
+		return strings.Compare(a.Section, b.Section)
+	})
+	return results, nil
+}
+
+func (q *FakeQuerier) GetLatestTemplateVersionRolloutByTemplateVersionID(_ context.Context, templateVersionID uuid.UUID) (database.TemplateVersionRollout, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var (
+		latest database.TemplateVersionRollout
+		found  bool
+	)
+	for _, rollout := range q.templateVersionRollouts {
+		if rollout.TemplateVersionID != templateVersionID {
+			continue
+		}
+		if !found || rollout.CreatedAt.After(latest.CreatedAt) {
+			latest = rollout
+			found = true
+		}
+	}
+	if !found {
+		return database.TemplateVersionRollout{}, sql.ErrNoRows
+	}
+	return latest, nil
+}
+
 func (q *FakeQuerier) GetLatestWorkspaceBuildByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (database.WorkspaceBuild, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -3094,6 +3492,48 @@ func (q *FakeQuerier) GetLogoURL(_ context.Context) (string, error) {
 	return q.logoURL, nil
 }
 
+func (q *FakeQuerier) GetMaintenanceWindowByID(_ context.Context, id uuid.UUID) (database.MaintenanceWindow, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	for _, window := range q.maintenanceWindows {
+		if window.ID == id {
+			return window, nil
+		}
+	}
+	return database.MaintenanceWindow{}, sql.ErrNoRows
+}
+
+func (q *FakeQuerier) GetMaintenanceWindows(_ context.Context) ([]database.MaintenanceWindow, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	windows := slices.Clone(q.maintenanceWindows)
+	slices.SortFunc(windows, func(a, b database.MaintenanceWindow) int {
+		if c := slice.Ascending(a.OrganizationID.String(), b.OrganizationID.String()); c != 0 {
+			return c
+		}
+		return slice.Ascending(a.Name, b.Name)
+	})
+	return windows, nil
+}
+
+func (q *FakeQuerier) GetMaintenanceWindowsByOrganizationID(_ context.Context, organizationID uuid.UUID) ([]database.MaintenanceWindow, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	windows := make([]database.MaintenanceWindow, 0)
+	for _, window := range q.maintenanceWindows {
+		if window.OrganizationID == organizationID {
+			windows = append(windows, window)
+		}
+	}
+	slices.SortFunc(windows, func(a, b database.MaintenanceWindow) int {
+		return slice.Ascending(a.Name, b.Name)
+	})
+	return windows, nil
+}
+
 func (q *FakeQuerier) GetNotificationMessagesByStatus(_ context.Context, arg database.GetNotificationMessagesByStatusParams) ([]database.NotificationMessage, error) {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -3497,6 +3937,41 @@ func (q *FakeQuerier) GetProvisionerJobByID(ctx context.Context, id uuid.UUID) (
 	return q.getProvisionerJobByIDNoLock(ctx, id)
 }
 
+func (q *FakeQuerier) GetProvisionerJobResourceChangesByJobID(_ context.Context, jobID uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var changes []database.ProvisionerJobResourceChange
+	for _, change := range q.provisionerJobResourceChanges {
+		if change.JobID == jobID {
+			changes = append(changes, change)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Address < changes[j].Address
+	})
+	return changes, nil
+}
+
+func (q *FakeQuerier) GetProvisionerJobResourceChangesByJobIDs(_ context.Context, ids []uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var changes []database.ProvisionerJobResourceChange
+	for _, change := range q.provisionerJobResourceChanges {
+		if slices.Contains(ids, change.JobID) {
+			changes = append(changes, change)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].JobID != changes[j].JobID {
+			return changes[i].JobID.String() < changes[j].JobID.String()
+		}
+		return changes[i].Address < changes[j].Address
+	})
+	return changes, nil
+}
+
 func (q *FakeQuerier) GetProvisionerJobTimingsByJobID(_ context.Context, jobID uuid.UUID) ([]database.ProvisionerJobTiming, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -3545,34 +4020,63 @@ func (q *FakeQuerier) GetProvisionerJobsByIDsWithQueuePosition(_ context.Context
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
 
-	jobs := make([]database.GetProvisionerJobsByIDsWithQueuePositionRow, 0)
-	queuePosition := int64(1)
+	var (
+		unstarted   []database.ProvisionerJob
+		completed   []database.ProvisionerJob
+		runningJobs int64
+	)
 	for _, job := range q.provisionerJobs {
-		for _, id := range ids {
-			if id == job.ID {
-				// clone the Tags before appending, since maps are reference types and
-				// we don't want the caller to be able to mutate the map we have inside
-				// dbmem!
-				job.Tags = maps.Clone(job.Tags)
-				job := database.GetProvisionerJobsByIDsWithQueuePositionRow{
-					ProvisionerJob: job,
-				}
-				if !job.ProvisionerJob.StartedAt.Valid {
-					job.QueuePosition = queuePosition
-				}
-				jobs = append(jobs, job)
-				break
-			}
+		switch {
+		case !job.StartedAt.Valid:
+			unstarted = append(unstarted, job)
+		case !job.CompletedAt.Valid:
+			runningJobs++
+		default:
+			completed = append(completed, job)
 		}
-		if !job.StartedAt.Valid {
-			queuePosition++
+	}
+	sort.SliceStable(unstarted, func(i, j int) bool {
+		if unstarted[i].Priority != unstarted[j].Priority {
+			return unstarted[i].Priority > unstarted[j].Priority
 		}
+		return unstarted[i].CreatedAt.Before(unstarted[j].CreatedAt)
+	})
+	queuePositions := make(map[uuid.UUID]int64, len(unstarted))
+	for i, job := range unstarted {
+		queuePositions[job.ID] = int64(i + 1)
+	}
+
+	// Average the duration of the 100 most recently completed jobs.
+	sort.Slice(completed, func(i, j int) bool {
+		return completed[i].CompletedAt.Time.After(completed[j].CompletedAt.Time)
+	})
+	if len(completed) > 100 {
+		completed = completed[:100]
+	}
+	var averageDuration float64
+	for _, job := range completed {
+		averageDuration += job.CompletedAt.Time.Sub(job.StartedAt.Time).Seconds()
 	}
-	for _, job := range jobs {
-		if !job.ProvisionerJob.StartedAt.Valid {
-			// Set it to the max position!
-			job.QueueSize = queuePosition
+	if len(completed) > 0 {
+		averageDuration /= float64(len(completed))
+	}
+
+	jobs := make([]database.GetProvisionerJobsByIDsWithQueuePositionRow, 0)
+	for _, job := range q.provisionerJobs {
+		if !slices.Contains(ids, job.ID) {
+			continue
 		}
+		// clone the Tags before appending, since maps are reference types and
+		// we don't want the caller to be able to mutate the map we have inside
+		// dbmem!
+		job.Tags = maps.Clone(job.Tags)
+		jobs = append(jobs, database.GetProvisionerJobsByIDsWithQueuePositionRow{
+			ProvisionerJob:         job,
+			QueuePosition:          queuePositions[job.ID],
+			QueueSize:              int64(len(unstarted)),
+			RunningJobs:            runningJobs,
+			AverageDurationSeconds: averageDuration,
+		})
 	}
 	return jobs, nil
 }
@@ -4870,6 +5374,18 @@ func (q *FakeQuerier) GetTemplateVersionParameters(_ context.Context, templateVe
 	return parameters, nil
 }
 
+func (q *FakeQuerier) GetTemplateVersionRolloutByID(_ context.Context, id uuid.UUID) (database.TemplateVersionRollout, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	for _, rollout := range q.templateVersionRollouts {
+		if rollout.ID == id {
+			return rollout, nil
+		}
+	}
+	return database.TemplateVersionRollout{}, sql.ErrNoRows
+}
+
 func (q *FakeQuerier) GetTemplateVersionVariables(_ context.Context, templateVersionID uuid.UUID) ([]database.TemplateVersionVariable, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -5639,6 +6155,23 @@ func (q *FakeQuerier) GetWorkspaceAgentByInstanceID(_ context.Context, instanceI
 	return database.WorkspaceAgent{}, sql.ErrNoRows
 }
 
+func (q *FakeQuerier) GetWorkspaceAgentClientPeersByAgentID(_ context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentClientPeer, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	peers := make([]database.WorkspaceAgentClientPeer, 0)
+	for _, peer := range q.workspaceAgentClientPeers {
+		if peer.AgentID == agentID {
+			peers = append(peers, peer)
+		}
+	}
+	slices.SortStableFunc(peers, func(a, b database.WorkspaceAgentClientPeer) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return peers, nil
+}
+
 func (q *FakeQuerier) GetWorkspaceAgentLifecycleStateByID(ctx context.Context, id uuid.UUID) (database.GetWorkspaceAgentLifecycleStateByIDRow, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -6037,6 +6570,31 @@ func (q *FakeQuerier) GetWorkspaceBuildByWorkspaceIDAndBuildNumber(_ context.Con
 	return database.WorkspaceBuild{}, sql.ErrNoRows
 }
 
+func (q *FakeQuerier) GetWorkspaceBuildDriftByJobID(_ context.Context, jobID uuid.UUID) (database.WorkspaceBuildDrift, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	for _, drift := range q.workspaceBuildDrift {
+		if drift.JobID == jobID {
+			return drift, nil
+		}
+	}
+	return database.WorkspaceBuildDrift{}, sql.ErrNoRows
+}
+
+func (q *FakeQuerier) GetWorkspaceBuildDriftsByWorkspaceBuildIDs(_ context.Context, ids []uuid.UUID) ([]database.WorkspaceBuildDrift, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var drifts []database.WorkspaceBuildDrift
+	for _, drift := range q.workspaceBuildDrift {
+		if slices.Contains(ids, drift.WorkspaceBuildID) {
+			drifts = append(drifts, drift)
+		}
+	}
+	return drifts, nil
+}
+
 func (q *FakeQuerier) GetWorkspaceBuildParameters(_ context.Context, workspaceBuildID uuid.UUID) ([]database.WorkspaceBuildParameter, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -6051,6 +6609,35 @@ func (q *FakeQuerier) GetWorkspaceBuildParameters(_ context.Context, workspaceBu
 	return params, nil
 }
 
+func (q *FakeQuerier) GetWorkspaceBuildStatsByTemplateVersionID(ctx context.Context, arg database.GetWorkspaceBuildStatsByTemplateVersionIDParams) (database.GetWorkspaceBuildStatsByTemplateVersionIDRow, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.GetWorkspaceBuildStatsByTemplateVersionIDRow{}, err
+	}
+
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var row database.GetWorkspaceBuildStatsByTemplateVersionIDRow
+	for _, wb := range q.workspaceBuilds {
+		if wb.TemplateVersionID != arg.TemplateVersionID || wb.CreatedAt.Before(arg.Since) {
+			continue
+		}
+		job, err := q.getProvisionerJobByIDNoLock(ctx, wb.JobID)
+		if err != nil {
+			return database.GetWorkspaceBuildStatsByTemplateVersionIDRow{}, xerrors.Errorf("get provisioner job by ID: %w", err)
+		}
+		if !job.CompletedAt.Valid {
+			continue
+		}
+		row.TotalBuilds++
+		if job.JobStatus == database.ProvisionerJobStatusFailed {
+			row.FailedBuilds++
+		}
+	}
+	return row, nil
+}
+
 func (q *FakeQuerier) GetWorkspaceBuildStatsByTemplates(ctx context.Context, since time.Time) ([]database.GetWorkspaceBuildStatsByTemplatesRow, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -6183,6 +6770,65 @@ func (q *FakeQuerier) GetWorkspaceBuildsCreatedAfter(_ context.Context, after ti
 	return workspaceBuilds, nil
 }
 
+func (q *FakeQuerier) GetWorkspaceBulkOperationByID(_ context.Context, id uuid.UUID) (database.WorkspaceBulkOperation, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	for _, op := range q.workspaceBulkOperations {
+		if op.ID == id {
+			return op, nil
+		}
+	}
+	return database.WorkspaceBulkOperation{}, sql.ErrNoRows
+}
+
+func (q *FakeQuerier) GetWorkspaceBulkOperationWorkspacesByBulkOperationID(ctx context.Context, bulkOperationID uuid.UUID) ([]database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var rows []database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow
+	for _, item := range q.workspaceBulkOperationWorkspaces {
+		if item.BulkOperationID != bulkOperationID {
+			continue
+		}
+		workspace, err := q.getWorkspaceByIDNoLock(ctx, item.WorkspaceID)
+		if err != nil {
+			continue
+		}
+		owner, err := q.getUserByIDNoLock(workspace.OwnerID)
+		if err != nil {
+			continue
+		}
+		row := database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow{
+			BulkOperationID:        item.BulkOperationID,
+			WorkspaceID:            item.WorkspaceID,
+			Status:                 item.Status,
+			WorkspaceBuildID:       item.WorkspaceBuildID,
+			Message:                item.Message,
+			UpdatedAt:              item.UpdatedAt,
+			WorkspaceName:          workspace.Name,
+			WorkspaceOwnerUsername: owner.Username,
+		}
+		if item.WorkspaceBuildID.Valid {
+			build, err := q.getWorkspaceBuildByIDNoLock(ctx, item.WorkspaceBuildID.UUID)
+			if err == nil {
+				job, err := q.getProvisionerJobByIDNoLock(ctx, build.JobID)
+				if err == nil {
+					row.JobStatus = database.NullProvisionerJobStatus{ProvisionerJobStatus: job.JobStatus, Valid: true}
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	slices.SortFunc(rows, func(a, b database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow) int {
+		if a.WorkspaceOwnerUsername != b.WorkspaceOwnerUsername {
+			return strings.Compare(a.WorkspaceOwnerUsername, b.WorkspaceOwnerUsername)
+		}
+		return strings.Compare(a.WorkspaceName, b.WorkspaceName)
+	})
+	return rows, nil
+}
+
 func (q *FakeQuerier) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (database.GetWorkspaceByAgentIDRow, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -6198,8 +6844,9 @@ func (q *FakeQuerier) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UU
 	}
 
 	return database.GetWorkspaceByAgentIDRow{
-		Workspace:    w,
-		TemplateName: tpl.Name,
+		Workspace:                    w,
+		TemplateName:                 tpl.Name,
+		TemplateIdleDetectionEnabled: tpl.IdleDetectionEnabled,
 	}, nil
 }
 
@@ -6423,6 +7070,48 @@ func (q *FakeQuerier) GetWorkspaceResourcesCreatedAfter(_ context.Context, after
 	return resources, nil
 }
 
+func (q *FakeQuerier) GetWorkspaceSessionRecordingByID(_ context.Context, id uuid.UUID) (database.WorkspaceSessionRecording, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	for _, recording := range q.workspaceSessionRecordings {
+		if recording.ID == id {
+			recording.Data = slices.Clone(recording.Data)
+			return recording, nil
+		}
+	}
+	return database.WorkspaceSessionRecording{}, sql.ErrNoRows
+}
+
+func (q *FakeQuerier) GetWorkspaceSessionRecordingsByWorkspaceID(_ context.Context, workspaceID uuid.UUID) ([]database.GetWorkspaceSessionRecordingsByWorkspaceIDRow, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	rows := []database.GetWorkspaceSessionRecordingsByWorkspaceIDRow{}
+	for _, recording := range q.workspaceSessionRecordings {
+		if recording.WorkspaceID != workspaceID {
+			continue
+		}
+		rows = append(rows, database.GetWorkspaceSessionRecordingsByWorkspaceIDRow{
+			ID:            recording.ID,
+			WorkspaceID:   recording.WorkspaceID,
+			AgentID:       recording.AgentID,
+			Type:          recording.Type,
+			Command:       recording.Command,
+			StartedAt:     recording.StartedAt,
+			EndedAt:       recording.EndedAt,
+			InputRecorded: recording.InputRecorded,
+			Truncated:     recording.Truncated,
+			CreatedAt:     recording.CreatedAt,
+			Size:          int64(len(recording.Data)),
+		})
+	}
+	slices.SortFunc(rows, func(a, b database.GetWorkspaceSessionRecordingsByWorkspaceIDRow) int {
+		return b.StartedAt.Compare(a.StartedAt)
+	})
+	return rows, nil
+}
+
 func (q *FakeQuerier) GetWorkspaceUniqueOwnerCountByTemplateIDs(_ context.Context, templateIds []uuid.UUID) ([]database.GetWorkspaceUniqueOwnerCountByTemplateIDsRow, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -6463,6 +7152,57 @@ func (q *FakeQuerier) GetWorkspaces(ctx context.Context, arg database.GetWorkspa
 	return workspaceRows, err
 }
 
+func (q *FakeQuerier) GetWorkspacesEligibleForDriftCheck(ctx context.Context, startedBefore time.Time) ([]database.GetWorkspacesEligibleForDriftCheckRow, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	var rows []database.GetWorkspacesEligibleForDriftCheckRow
+	for _, workspace := range q.workspaces {
+		if workspace.Deleted || workspace.DormantAt.Valid {
+			continue
+		}
+		build, err := q.getLatestWorkspaceBuildByWorkspaceIDNoLock(ctx, workspace.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if build.Transition != database.WorkspaceTransitionStart {
+			continue
+		}
+		job, err := q.getProvisionerJobByIDNoLock(ctx, build.JobID)
+		if err != nil {
+			return nil, xerrors.Errorf("get provisioner job by ID: %w", err)
+		}
+		if job.JobStatus != database.ProvisionerJobStatusSucceeded {
+			continue
+		}
+
+		row := database.GetWorkspacesEligibleForDriftCheckRow{
+			WorkspaceID:       workspace.ID,
+			WorkspaceBuildID:  build.ID,
+			TemplateVersionID: build.TemplateVersionID,
+			CheckDue:          true,
+		}
+		for _, drift := range q.workspaceBuildDrift {
+			if drift.WorkspaceBuildID != build.ID {
+				continue
+			}
+			row.Drifted = drift.Drifted
+			driftJob, err := q.getProvisionerJobByIDNoLock(ctx, drift.JobID)
+			if err != nil {
+				return nil, xerrors.Errorf("get drift check job by ID: %w", err)
+			}
+			row.CheckDue = drift.StartedAt.Before(startedBefore) && driftJob.CompletedAt.Valid
+		}
+		if row.Drifted || row.CheckDue {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
 func (q *FakeQuerier) GetWorkspacesEligibleForTransition(ctx context.Context, now time.Time) ([]database.Workspace, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -6804,6 +7544,27 @@ func (q *FakeQuerier) InsertGroupMember(_ context.Context, arg database.InsertGr
 	return nil
 }
 
+func (q *FakeQuerier) InsertHealthcheckResult(ctx context.Context, arg database.InsertHealthcheckResultParams) (database.HealthcheckResult, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.HealthcheckResult{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	result := database.HealthcheckResult{
+		ID:        arg.ID,
+		CreatedAt: arg.CreatedAt,
+		Section:   arg.Section,
+		Severity:  arg.Severity,
+		Warnings:  arg.Warnings,
+		Error:     arg.Error,
+	}
+	q.healthcheckResults = append(q.healthcheckResults, result)
+	return result, nil
+}
+
 func (q *FakeQuerier) InsertLicense(
 	_ context.Context, arg database.InsertLicenseParams,
 ) (database.License, error) {
@@ -6825,6 +7586,38 @@ func (q *FakeQuerier) InsertLicense(
 	return l, nil
 }
 
+func (q *FakeQuerier) InsertMaintenanceWindow(_ context.Context, arg database.InsertMaintenanceWindowParams) (database.MaintenanceWindow, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.MaintenanceWindow{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for _, window := range q.maintenanceWindows {
+		if window.OrganizationID == arg.OrganizationID && window.Name == arg.Name {
+			return database.MaintenanceWindow{}, newUniqueConstraintError(database.UniqueMaintenanceWindowsOrganizationIDNameKey)
+		}
+	}
+
+	window := database.MaintenanceWindow{
+		ID:             arg.ID,
+		OrganizationID: arg.OrganizationID,
+		TemplateID:     arg.TemplateID,
+		Name:           arg.Name,
+		Message:        arg.Message,
+		Schedule:       arg.Schedule,
+		Duration:       arg.Duration,
+		NoticePeriod:   arg.NoticePeriod,
+		BlockBuilds:    arg.BlockBuilds,
+		CreatedAt:      arg.CreatedAt,
+		UpdatedAt:      arg.UpdatedAt,
+	}
+	q.maintenanceWindows = append(q.maintenanceWindows, window)
+	return window, nil
+}
+
 func (q *FakeQuerier) InsertMissingGroups(_ context.Context, arg database.InsertMissingGroupsParams) ([]database.Group, error) {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -7054,6 +7847,7 @@ func (q *FakeQuerier) InsertProvisionerJob(_ context.Context, arg database.Inser
 		Input:          arg.Input,
 		Tags:           maps.Clone(arg.Tags),
 		TraceMetadata:  arg.TraceMetadata,
+		Priority:       arg.Priority,
 	}
 	job.JobStatus = provisonerJobStatus(job)
 	q.provisionerJobs = append(q.provisionerJobs, job)
@@ -7089,6 +7883,30 @@ func (q *FakeQuerier) InsertProvisionerJobLogs(_ context.Context, arg database.I
 	return logs, nil
 }
 
+func (q *FakeQuerier) InsertProvisionerJobResourceChanges(_ context.Context, arg database.InsertProvisionerJobResourceChangesParams) ([]database.ProvisionerJobResourceChange, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	inserted := make([]database.ProvisionerJobResourceChange, 0, len(arg.Address))
+	for i := range arg.Address {
+		change := database.ProvisionerJobResourceChange{
+			JobID:   arg.JobID,
+			Address: arg.Address[i],
+			Type:    arg.Type[i],
+			Name:    arg.Name[i],
+			Action:  arg.Action[i],
+		}
+		q.provisionerJobResourceChanges = append(q.provisionerJobResourceChanges, change)
+		inserted = append(inserted, change)
+	}
+	return inserted, nil
+}
+
 func (q *FakeQuerier) InsertProvisionerJobTimings(_ context.Context, arg database.InsertProvisionerJobTimingsParams) ([]database.ProvisionerJobTiming, error) {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -7262,6 +8080,36 @@ func (q *FakeQuerier) InsertTemplateVersionParameter(_ context.Context, arg data
 	return param, nil
 }
 
+func (q *FakeQuerier) InsertTemplateVersionRollout(_ context.Context, arg database.InsertTemplateVersionRolloutParams) (database.TemplateVersionRollout, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for _, rollout := range q.templateVersionRollouts {
+		if rollout.TemplateID == arg.TemplateID && rollout.Status == database.TemplateVersionRolloutStatusCanary {
+			return database.TemplateVersionRollout{}, newUniqueConstraintError(database.UniqueTemplateVersionRolloutsTemplateIDCanaryIndex)
+		}
+	}
+
+	rollout := database.TemplateVersionRollout{
+		ID:                arg.ID,
+		TemplateID:        arg.TemplateID,
+		TemplateVersionID: arg.TemplateVersionID,
+		Status:            database.TemplateVersionRolloutStatusCanary,
+		Percent:           arg.Percent,
+		GroupID:           arg.GroupID,
+		CreatedBy:         arg.CreatedBy,
+		CreatedAt:         arg.CreatedAt,
+		UpdatedAt:         arg.UpdatedAt,
+	}
+	q.templateVersionRollouts = append(q.templateVersionRollouts, rollout)
+	return rollout, nil
+}
+
 func (q *FakeQuerier) InsertTemplateVersionVariable(_ context.Context, arg database.InsertTemplateVersionVariableParams) (database.TemplateVersionVariable, error) {
 	if err := validateDatabaseType(arg); err != nil {
 		return database.TemplateVersionVariable{}, err
@@ -7436,7 +8284,6 @@ func (q *FakeQuerier) InsertWorkspace(_ context.Context, arg database.InsertWork
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
-	//nolint:gosimple
 	workspace := database.Workspace{
 		ID:                arg.ID,
 		CreatedAt:         arg.CreatedAt,
@@ -7449,6 +8296,8 @@ func (q *FakeQuerier) InsertWorkspace(_ context.Context, arg database.InsertWork
 		Ttl:               arg.Ttl,
 		LastUsedAt:        arg.LastUsedAt,
 		AutomaticUpdates:  arg.AutomaticUpdates,
+		UserACL:           database.WorkspaceACL{},
+		GroupACL:          database.WorkspaceACL{},
 	}
 	q.workspaces = append(q.workspaces, workspace)
 	return workspace, nil
@@ -7749,6 +8598,31 @@ func (q *FakeQuerier) InsertWorkspaceBuild(_ context.Context, arg database.Inser
 	return nil
 }
 
+func (q *FakeQuerier) InsertWorkspaceBuildDriftCheck(_ context.Context, arg database.InsertWorkspaceBuildDriftCheckParams) error {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, drift := range q.workspaceBuildDrift {
+		if drift.WorkspaceBuildID == arg.WorkspaceBuildID {
+			drift.JobID = arg.JobID
+			drift.StartedAt = arg.StartedAt
+			q.workspaceBuildDrift[i] = drift
+			return nil
+		}
+	}
+	q.workspaceBuildDrift = append(q.workspaceBuildDrift, database.WorkspaceBuildDrift{
+		WorkspaceBuildID: arg.WorkspaceBuildID,
+		JobID:            arg.JobID,
+		StartedAt:        arg.StartedAt,
+	})
+	return nil
+}
+
 func (q *FakeQuerier) InsertWorkspaceBuildParameters(_ context.Context, arg database.InsertWorkspaceBuildParametersParams) error {
 	if err := validateDatabaseType(arg); err != nil {
 		return err
@@ -7767,6 +8641,47 @@ func (q *FakeQuerier) InsertWorkspaceBuildParameters(_ context.Context, arg data
 	return nil
 }
 
+func (q *FakeQuerier) InsertWorkspaceBulkOperation(_ context.Context, arg database.InsertWorkspaceBulkOperationParams) (database.WorkspaceBulkOperation, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.WorkspaceBulkOperation{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	op := database.WorkspaceBulkOperation{
+		ID:             arg.ID,
+		InitiatorID:    arg.InitiatorID,
+		InitiatorScope: arg.InitiatorScope,
+		Action:         arg.Action,
+		Search:         arg.Search,
+		CreatedAt:      arg.CreatedAt,
+	}
+	q.workspaceBulkOperations = append(q.workspaceBulkOperations, op)
+	return op, nil
+}
+
+func (q *FakeQuerier) InsertWorkspaceBulkOperationWorkspaces(_ context.Context, arg database.InsertWorkspaceBulkOperationWorkspacesParams) error {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for _, workspaceID := range arg.WorkspaceIds {
+		q.workspaceBulkOperationWorkspaces = append(q.workspaceBulkOperationWorkspaces, database.WorkspaceBulkOperationWorkspace{
+			BulkOperationID: arg.BulkOperationID,
+			WorkspaceID:     workspaceID,
+			Status:          database.WorkspaceBulkOperationWorkspaceStatusPending,
+			UpdatedAt:       arg.UpdatedAt,
+		})
+	}
+	return nil
+}
+
 func (q *FakeQuerier) InsertWorkspaceProxy(_ context.Context, arg database.InsertWorkspaceProxyParams) (database.WorkspaceProxy, error) {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
@@ -7853,6 +8768,47 @@ func (q *FakeQuerier) InsertWorkspaceResourceMetadata(_ context.Context, arg dat
 	return metadata, nil
 }
 
+func (q *FakeQuerier) InsertWorkspaceSessionRecording(_ context.Context, arg database.InsertWorkspaceSessionRecordingParams) (database.WorkspaceSessionRecording, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.WorkspaceSessionRecording{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, recording := range q.workspaceSessionRecordings {
+		if recording.ID != arg.ID {
+			continue
+		}
+		if recording.AgentID != arg.AgentID {
+			return database.WorkspaceSessionRecording{}, sql.ErrNoRows
+		}
+		recording.EndedAt = arg.EndedAt
+		recording.Truncated = arg.Truncated
+		recording.Data = slices.Clone(arg.Data)
+		q.workspaceSessionRecordings[i] = recording
+		return recording, nil
+	}
+
+	//nolint:gosimple
+	recording := database.WorkspaceSessionRecording{
+		ID:            arg.ID,
+		WorkspaceID:   arg.WorkspaceID,
+		AgentID:       arg.AgentID,
+		Type:          arg.Type,
+		Command:       arg.Command,
+		StartedAt:     arg.StartedAt,
+		EndedAt:       arg.EndedAt,
+		InputRecorded: arg.InputRecorded,
+		Truncated:     arg.Truncated,
+		CreatedAt:     arg.CreatedAt,
+		Data:          slices.Clone(arg.Data),
+	}
+	q.workspaceSessionRecordings = append(q.workspaceSessionRecordings, recording)
+	return recording, nil
+}
+
 func (q *FakeQuerier) ListProvisionerKeysByOrganization(_ context.Context, organizationID uuid.UUID) ([]database.ProvisionerKey, error) {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
@@ -8258,6 +9214,62 @@ func (q *FakeQuerier) UpdateInactiveUsersToDormant(_ context.Context, params dat
 	return updated, nil
 }
 
+func (q *FakeQuerier) UpdateMaintenanceWindowByID(_ context.Context, arg database.UpdateMaintenanceWindowByIDParams) (database.MaintenanceWindow, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.MaintenanceWindow{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, window := range q.maintenanceWindows {
+		if window.ID != arg.ID {
+			continue
+		}
+		for _, other := range q.maintenanceWindows {
+			if other.ID != window.ID && other.OrganizationID == window.OrganizationID && other.Name == arg.Name {
+				return database.MaintenanceWindow{}, newUniqueConstraintError(database.UniqueMaintenanceWindowsOrganizationIDNameKey)
+			}
+		}
+		if window.Schedule != arg.Schedule {
+			window.LastNotifiedStart = sql.NullTime{}
+		}
+		window.Name = arg.Name
+		window.Message = arg.Message
+		window.Schedule = arg.Schedule
+		window.Duration = arg.Duration
+		window.NoticePeriod = arg.NoticePeriod
+		window.BlockBuilds = arg.BlockBuilds
+		window.UpdatedAt = arg.UpdatedAt
+		q.maintenanceWindows[i] = window
+		return window, nil
+	}
+	return database.MaintenanceWindow{}, sql.ErrNoRows
+}
+
+func (q *FakeQuerier) UpdateMaintenanceWindowLastNotifiedStart(_ context.Context, arg database.UpdateMaintenanceWindowLastNotifiedStartParams) (int64, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return 0, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, window := range q.maintenanceWindows {
+		if window.ID != arg.ID {
+			continue
+		}
+		if window.LastNotifiedStart.Valid == arg.LastNotifiedStart.Valid && window.LastNotifiedStart.Time.Equal(arg.LastNotifiedStart.Time) {
+			return 0, nil
+		}
+		q.maintenanceWindows[i].LastNotifiedStart = arg.LastNotifiedStart
+		return 1, nil
+	}
+	return 0, nil
+}
+
 func (q *FakeQuerier) UpdateMemberRoles(_ context.Context, arg database.UpdateMemberRolesParams) (database.OrganizationMember, error) {
 	if err := validateDatabaseType(arg); err != nil {
 		return database.OrganizationMember{}, err
@@ -8632,6 +9644,8 @@ func (q *FakeQuerier) UpdateTemplateScheduleByID(_ context.Context, arg database
 		tpl.FailureTTL = arg.FailureTTL
 		tpl.TimeTilDormant = arg.TimeTilDormant
 		tpl.TimeTilDormantAutoDelete = arg.TimeTilDormantAutoDelete
+		tpl.IdleDetectionEnabled = arg.IdleDetectionEnabled
+		tpl.IdleCPUThresholdPercent = arg.IdleCPUThresholdPercent
 		q.templates[idx] = tpl
 		return nil
 	}
@@ -8701,6 +9715,27 @@ func (q *FakeQuerier) UpdateTemplateVersionExternalAuthProvidersByJobID(_ contex
 	return sql.ErrNoRows
 }
 
+func (q *FakeQuerier) UpdateTemplateVersionRolloutStatusByID(_ context.Context, arg database.UpdateTemplateVersionRolloutStatusByIDParams) (database.TemplateVersionRollout, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, rollout := range q.templateVersionRollouts {
+		if rollout.ID != arg.ID {
+			continue
+		}
+		rollout.Status = arg.Status
+		rollout.UpdatedAt = arg.UpdatedAt
+		q.templateVersionRollouts[i] = rollout
+		return rollout, nil
+	}
+	return database.TemplateVersionRollout{}, sql.ErrNoRows
+}
+
 func (q *FakeQuerier) UpdateTemplateWorkspacesLastUsedAt(_ context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) error {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -9068,6 +10103,27 @@ func (q *FakeQuerier) UpdateWorkspace(_ context.Context, arg database.UpdateWork
 	return database.Workspace{}, sql.ErrNoRows
 }
 
+func (q *FakeQuerier) UpdateWorkspaceACLByID(_ context.Context, arg database.UpdateWorkspaceACLByIDParams) error {
+	if err := validateDatabaseType(arg); err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, workspace := range q.workspaces {
+		if workspace.ID == arg.ID {
+			workspace.GroupACL = arg.GroupACL
+			workspace.UserACL = arg.UserACL
+
+			q.workspaces[i] = workspace
+			return nil
+		}
+	}
+
+	return sql.ErrNoRows
+}
+
 func (q *FakeQuerier) UpdateWorkspaceAgentConnectionByID(_ context.Context, arg database.UpdateWorkspaceAgentConnectionByIDParams) error {
 	if err := validateDatabaseType(arg); err != nil {
 		return err
@@ -9289,6 +10345,28 @@ func (q *FakeQuerier) UpdateWorkspaceBuildDeadlineByID(_ context.Context, arg da
 	return sql.ErrNoRows
 }
 
+func (q *FakeQuerier) UpdateWorkspaceBuildDriftByJobID(_ context.Context, arg database.UpdateWorkspaceBuildDriftByJobIDParams) (database.WorkspaceBuildDrift, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.WorkspaceBuildDrift{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, drift := range q.workspaceBuildDrift {
+		if drift.JobID != arg.JobID {
+			continue
+		}
+		drift.CheckedJobID = uuid.NullUUID{UUID: drift.JobID, Valid: true}
+		drift.CheckedAt = arg.CheckedAt
+		drift.Drifted = arg.Drifted
+		q.workspaceBuildDrift[i] = drift
+		return drift, nil
+	}
+	return database.WorkspaceBuildDrift{}, sql.ErrNoRows
+}
+
 func (q *FakeQuerier) UpdateWorkspaceBuildProvisionerStateByID(_ context.Context, arg database.UpdateWorkspaceBuildProvisionerStateByIDParams) error {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -9311,6 +10389,51 @@ func (q *FakeQuerier) UpdateWorkspaceBuildProvisionerStateByID(_ context.Context
 	return sql.ErrNoRows
 }
 
+func (q *FakeQuerier) UpdateWorkspaceBulkOperationCompletedAt(_ context.Context, arg database.UpdateWorkspaceBulkOperationCompletedAtParams) error {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, op := range q.workspaceBulkOperations {
+		if op.ID == arg.ID {
+			op.CompletedAt = arg.CompletedAt
+			q.workspaceBulkOperations[i] = op
+			return nil
+		}
+	}
+	return nil
+}
+
+func (q *FakeQuerier) UpdateWorkspaceBulkOperationWorkspace(_ context.Context, arg database.UpdateWorkspaceBulkOperationWorkspaceParams) (database.WorkspaceBulkOperationWorkspace, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.WorkspaceBulkOperationWorkspace{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, item := range q.workspaceBulkOperationWorkspaces {
+		if item.BulkOperationID != arg.BulkOperationID || item.WorkspaceID != arg.WorkspaceID {
+			continue
+		}
+		if item.Status != database.WorkspaceBulkOperationWorkspaceStatusPending {
+			break
+		}
+		item.Status = arg.Status
+		item.WorkspaceBuildID = arg.WorkspaceBuildID
+		item.Message = arg.Message
+		item.UpdatedAt = arg.UpdatedAt
+		q.workspaceBulkOperationWorkspaces[i] = item
+		return item, nil
+	}
+	return database.WorkspaceBulkOperationWorkspace{}, sql.ErrNoRows
+}
+
 func (q *FakeQuerier) UpdateWorkspaceDeletedByID(_ context.Context, arg database.UpdateWorkspaceDeletedByIDParams) error {
 	if err := validateDatabaseType(arg); err != nil {
 		return err
@@ -9513,6 +10636,47 @@ func (q *FakeQuerier) UpsertApplicationName(_ context.Context, data string) erro
 	return nil
 }
 
+func (q *FakeQuerier) UpsertConnectionLog(_ context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return database.ConnectionLog{}, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, clog := range q.connectionLogs {
+		if clog.ConnectionID != arg.ConnectionID || clog.AgentID != arg.AgentID {
+			continue
+		}
+		// A disconnect event only carries a placeholder connect time.
+		if !arg.DisconnectTime.Valid {
+			clog.ConnectTime = arg.ConnectTime
+		}
+		if !clog.UserID.Valid {
+			clog.UserID = arg.UserID
+		}
+		if arg.DisconnectTime.Valid {
+			clog.DisconnectTime = arg.DisconnectTime
+		}
+		if arg.Code.Valid {
+			clog.Code = arg.Code
+		}
+		if arg.DisconnectReason.Valid {
+			clog.DisconnectReason = arg.DisconnectReason
+		}
+		q.connectionLogs[i] = clog
+		q.sortConnectionLogsNoLock()
+		return clog, nil
+	}
+
+	clog := database.ConnectionLog(arg)
+	q.connectionLogs = append(q.connectionLogs, clog)
+	q.sortConnectionLogsNoLock()
+
+	return clog, nil
+}
+
 func (q *FakeQuerier) UpsertCoordinatorResumeTokenSigningKey(_ context.Context, value string) error {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
@@ -10299,6 +11463,27 @@ TemplateUsageStatsInsertLoop:
 	return nil
 }
 
+func (q *FakeQuerier) UpsertWorkspaceAgentClientPeer(_ context.Context, arg database.UpsertWorkspaceAgentClientPeerParams) error {
+	err := validateDatabaseType(arg)
+	if err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	peer := database.WorkspaceAgentClientPeer(arg)
+	for i, p := range q.workspaceAgentClientPeers {
+		if p.PeerID == arg.PeerID {
+			q.workspaceAgentClientPeers[i] = peer
+			return nil
+		}
+	}
+	q.workspaceAgentClientPeers = append(q.workspaceAgentClientPeers, peer)
+
+	return nil
+}
+
 func (q *FakeQuerier) UpsertWorkspaceAgentPortShare(_ context.Context, arg database.UpsertWorkspaceAgentPortShareParams) (database.WorkspaceAgentPortShare, error) {
 	err := validateDatabaseType(arg)
 	if err != nil {
@@ -10487,7 +11672,7 @@ func (q *FakeQuerier) GetAuthorizedWorkspaces(ctx context.Context, arg database.
 
 	if prepared != nil {
 		// Call this to match the same function calls as the SQL implementation.
-		_, err := prepared.CompileToSQL(ctx, rbac.ConfigWithoutACL())
+		_, err := prepared.CompileToSQL(ctx, rbac.ConfigWorkspaces())
 		if err != nil {
 			return nil, err
 		}
@@ -10864,8 +12049,13 @@ func (q *FakeQuerier) GetAuthorizedAuditLogsOffset(ctx context.Context, arg data
 
 	logs := make([]database.GetAuditLogsOffsetRow, 0, arg.LimitOpt)
 
-	// q.auditLogs are already sorted by time DESC, so no need to sort after the fact.
-	for _, alog := range q.auditLogs {
+	// q.auditLogs are sorted by time ASC, so sort a copy by time DESC like
+	// the SQL query.
+	auditLogs := slices.Clone(q.auditLogs)
+	slices.SortStableFunc(auditLogs, func(a, b database.AuditLog) int {
+		return b.Time.Compare(a.Time)
+	})
+	for _, alog := range auditLogs {
 		if arg.OffsetOpt > 0 {
 			arg.OffsetOpt--
 			continue
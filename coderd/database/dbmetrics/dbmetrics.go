@@ -116,6 +116,13 @@ func (m metricsStore) AllUserIDs(ctx context.Context) ([]uuid.UUID, error) {
 	return r0, r1
 }
 
+func (m metricsStore) AppendWorkspaceSessionRecordingData(ctx context.Context, arg database.AppendWorkspaceSessionRecordingDataParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.AppendWorkspaceSessionRecordingData(ctx, arg)
+	m.queryLatencies.WithLabelValues("AppendWorkspaceSessionRecordingData").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) ArchiveUnusedTemplateVersions(ctx context.Context, arg database.ArchiveUnusedTemplateVersionsParams) ([]uuid.UUID, error) {
 	start := time.Now()
 	r0, r1 := m.s.ArchiveUnusedTemplateVersions(ctx, arg)
@@ -263,6 +270,13 @@ func (m metricsStore) DeleteLicense(ctx context.Context, id int32) (int32, error
 	return licenseID, err
 }
 
+func (m metricsStore) DeleteMaintenanceWindowByID(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteMaintenanceWindowByID(ctx, id)
+	m.queryLatencies.WithLabelValues("DeleteMaintenanceWindowByID").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) DeleteOAuth2ProviderAppByID(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteOAuth2ProviderAppByID(ctx, id)
@@ -298,6 +312,13 @@ func (m metricsStore) DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx context.Co
 	return r0
 }
 
+func (m metricsStore) DeleteOldHealthcheckResults(ctx context.Context, beforeTime time.Time) error {
+	start := time.Now()
+	r0 := m.s.DeleteOldHealthcheckResults(ctx, beforeTime)
+	m.queryLatencies.WithLabelValues("DeleteOldHealthcheckResults").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) DeleteOldNotificationMessages(ctx context.Context) error {
 	start := time.Now()
 	r0 := m.s.DeleteOldNotificationMessages(ctx)
@@ -326,6 +347,13 @@ func (m metricsStore) DeleteOldWorkspaceAgentStats(ctx context.Context) error {
 	return err
 }
 
+func (m metricsStore) DeleteOldWorkspaceBuildDriftJobs(ctx context.Context) error {
+	start := time.Now()
+	r0 := m.s.DeleteOldWorkspaceBuildDriftJobs(ctx)
+	m.queryLatencies.WithLabelValues("DeleteOldWorkspaceBuildDriftJobs").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteOrganization(ctx, id)
@@ -396,6 +424,13 @@ func (m metricsStore) DeleteTailnetTunnel(ctx context.Context, arg database.Dele
 	return r0, r1
 }
 
+func (m metricsStore) DeleteWorkspaceAgentClientPeer(ctx context.Context, peerID uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteWorkspaceAgentClientPeer(ctx, peerID)
+	m.queryLatencies.WithLabelValues("DeleteWorkspaceAgentClientPeer").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) DeleteWorkspaceAgentPortShare(ctx context.Context, arg database.DeleteWorkspaceAgentPortShareParams) error {
 	start := time.Now()
 	r0 := m.s.DeleteWorkspaceAgentPortShare(ctx, arg)
@@ -466,6 +501,13 @@ func (m metricsStore) GetAPIKeysLastUsedAfter(ctx context.Context, lastUsed time
 	return apiKeys, err
 }
 
+func (m metricsStore) GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetActiveTemplateVersionRolloutByTemplateID(ctx, templateID)
+	m.queryLatencies.WithLabelValues("GetActiveTemplateVersionRolloutByTemplateID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetActiveUserCount(ctx context.Context) (int64, error) {
 	start := time.Now()
 	count, err := m.s.GetActiveUserCount(ctx)
@@ -543,6 +585,13 @@ func (m metricsStore) GetAuthorizationUserRoles(ctx context.Context, userID uuid
 	return row, err
 }
 
+func (m metricsStore) GetConnectionLogsOffset(ctx context.Context, arg database.GetConnectionLogsOffsetParams) ([]database.GetConnectionLogsOffsetRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetConnectionLogsOffset(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetConnectionLogsOffset").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetCoordinatorResumeTokenSigningKey(ctx context.Context) (string, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetCoordinatorResumeTokenSigningKey(ctx)
@@ -718,6 +767,13 @@ func (m metricsStore) GetHealthSettings(ctx context.Context) (string, error) {
 	return r0, r1
 }
 
+func (m metricsStore) GetHealthcheckResults(ctx context.Context, arg database.GetHealthcheckResultsParams) ([]database.HealthcheckResult, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetHealthcheckResults(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetHealthcheckResults").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetHungProvisionerJobs(ctx context.Context, hungSince time.Time) ([]database.ProvisionerJob, error) {
 	start := time.Now()
 	jobs, err := m.s.GetHungProvisionerJobs(ctx, hungSince)
@@ -725,6 +781,13 @@ func (m metricsStore) GetHungProvisionerJobs(ctx context.Context, hungSince time
 	return jobs, err
 }
 
+func (m metricsStore) GetIncompleteWorkspaceBulkOperations(ctx context.Context) ([]database.WorkspaceBulkOperation, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetIncompleteWorkspaceBulkOperations(ctx)
+	m.queryLatencies.WithLabelValues("GetIncompleteWorkspaceBulkOperations").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetJFrogXrayScanByWorkspaceAndAgentID(ctx context.Context, arg database.GetJFrogXrayScanByWorkspaceAndAgentIDParams) (database.JfrogXrayScan, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetJFrogXrayScanByWorkspaceAndAgentID(ctx, arg)
@@ -746,6 +809,20 @@ func (m metricsStore) GetLatestCryptoKeyByFeature(ctx context.Context, feature d
 	return r0, r1
 }
 
+func (m metricsStore) GetLatestHealthcheckResults(ctx context.Context) ([]database.HealthcheckResult, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetLatestHealthcheckResults(ctx)
+	m.queryLatencies.WithLabelValues("GetLatestHealthcheckResults").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) GetLatestTemplateVersionRolloutByTemplateVersionID(ctx context.Context, templateVersionID uuid.UUID) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetLatestTemplateVersionRolloutByTemplateVersionID(ctx, templateVersionID)
+	m.queryLatencies.WithLabelValues("GetLatestTemplateVersionRolloutByTemplateVersionID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetLatestWorkspaceBuildByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (database.WorkspaceBuild, error) {
 	start := time.Now()
 	build, err := m.s.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspaceID)
@@ -788,6 +865,27 @@ func (m metricsStore) GetLogoURL(ctx context.Context) (string, error) {
 	return url, err
 }
 
+func (m metricsStore) GetMaintenanceWindowByID(ctx context.Context, id uuid.UUID) (database.MaintenanceWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetMaintenanceWindowByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetMaintenanceWindowByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) GetMaintenanceWindows(ctx context.Context) ([]database.MaintenanceWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetMaintenanceWindows(ctx)
+	m.queryLatencies.WithLabelValues("GetMaintenanceWindows").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) GetMaintenanceWindowsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.MaintenanceWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetMaintenanceWindowsByOrganizationID(ctx, organizationID)
+	m.queryLatencies.WithLabelValues("GetMaintenanceWindowsByOrganizationID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetNotificationMessagesByStatus(ctx context.Context, arg database.GetNotificationMessagesByStatusParams) ([]database.NotificationMessage, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetNotificationMessagesByStatus(ctx, arg)
@@ -963,6 +1061,20 @@ func (m metricsStore) GetProvisionerJobByID(ctx context.Context, id uuid.UUID) (
 	return job, err
 }
 
+func (m metricsStore) GetProvisionerJobResourceChangesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetProvisionerJobResourceChangesByJobID(ctx, jobID)
+	m.queryLatencies.WithLabelValues("GetProvisionerJobResourceChangesByJobID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) GetProvisionerJobResourceChangesByJobIDs(ctx context.Context, ids []uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetProvisionerJobResourceChangesByJobIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("GetProvisionerJobResourceChangesByJobIDs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetProvisionerJobTimingsByJobID(ctx context.Context, jobID uuid.UUID) ([]database.ProvisionerJobTiming, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetProvisionerJobTimingsByJobID(ctx, jobID)
@@ -1194,6 +1306,13 @@ func (m metricsStore) GetTemplateVersionParameters(ctx context.Context, template
 	return parameters, err
 }
 
+func (m metricsStore) GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTemplateVersionRolloutByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetTemplateVersionRolloutByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetTemplateVersionVariables(ctx context.Context, templateVersionID uuid.UUID) ([]database.TemplateVersionVariable, error) {
 	start := time.Now()
 	variables, err := m.s.GetTemplateVersionVariables(ctx, templateVersionID)
@@ -1355,6 +1474,13 @@ func (m metricsStore) GetWorkspaceAgentByInstanceID(ctx context.Context, authIns
 	return agent, err
 }
 
+func (m metricsStore) GetWorkspaceAgentClientPeersByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentClientPeer, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAgentClientPeersByAgentID(ctx, agentID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAgentClientPeersByAgentID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetWorkspaceAgentLifecycleStateByID(ctx context.Context, id uuid.UUID) (database.GetWorkspaceAgentLifecycleStateByIDRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceAgentLifecycleStateByID(ctx, id)
@@ -1481,6 +1607,20 @@ func (m metricsStore) GetWorkspaceBuildByWorkspaceIDAndBuildNumber(ctx context.C
 	return build, err
 }
 
+func (m metricsStore) GetWorkspaceBuildDriftByJobID(ctx context.Context, jobID uuid.UUID) (database.WorkspaceBuildDrift, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBuildDriftByJobID(ctx, jobID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBuildDriftByJobID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) GetWorkspaceBuildDriftsByWorkspaceBuildIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceBuildDrift, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBuildDriftsByWorkspaceBuildIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBuildDriftsByWorkspaceBuildIDs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetWorkspaceBuildParameters(ctx context.Context, workspaceBuildID uuid.UUID) ([]database.WorkspaceBuildParameter, error) {
 	start := time.Now()
 	params, err := m.s.GetWorkspaceBuildParameters(ctx, workspaceBuildID)
@@ -1488,6 +1628,13 @@ func (m metricsStore) GetWorkspaceBuildParameters(ctx context.Context, workspace
 	return params, err
 }
 
+func (m metricsStore) GetWorkspaceBuildStatsByTemplateVersionID(ctx context.Context, arg database.GetWorkspaceBuildStatsByTemplateVersionIDParams) (database.GetWorkspaceBuildStatsByTemplateVersionIDRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBuildStatsByTemplateVersionID(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBuildStatsByTemplateVersionID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetWorkspaceBuildStatsByTemplates(ctx context.Context, since time.Time) ([]database.GetWorkspaceBuildStatsByTemplatesRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceBuildStatsByTemplates(ctx, since)
@@ -1509,6 +1656,20 @@ func (m metricsStore) GetWorkspaceBuildsCreatedAfter(ctx context.Context, create
 	return builds, err
 }
 
+func (m metricsStore) GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (database.WorkspaceBulkOperation, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBulkOperationByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBulkOperationByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) GetWorkspaceBulkOperationWorkspacesByBulkOperationID(ctx context.Context, bulkOperationID uuid.UUID) ([]database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBulkOperationWorkspacesByBulkOperationID(ctx, bulkOperationID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBulkOperationWorkspacesByBulkOperationID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (database.GetWorkspaceByAgentIDRow, error) {
 	start := time.Now()
 	workspace, err := m.s.GetWorkspaceByAgentID(ctx, agentID)
@@ -1607,6 +1768,20 @@ func (m metricsStore) GetWorkspaceResourcesCreatedAfter(ctx context.Context, cre
 	return resources, err
 }
 
+func (m metricsStore) GetWorkspaceSessionRecordingByID(ctx context.Context, id uuid.UUID) (database.WorkspaceSessionRecording, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceSessionRecordingByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetWorkspaceSessionRecordingByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) GetWorkspaceSessionRecordingsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.GetWorkspaceSessionRecordingsByWorkspaceIDRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceSessionRecordingsByWorkspaceID(ctx, workspaceID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceSessionRecordingsByWorkspaceID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetWorkspaceUniqueOwnerCountByTemplateIDs(ctx context.Context, templateIds []uuid.UUID) ([]database.GetWorkspaceUniqueOwnerCountByTemplateIDsRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceUniqueOwnerCountByTemplateIDs(ctx, templateIds)
@@ -1621,6 +1796,13 @@ func (m metricsStore) GetWorkspaces(ctx context.Context, arg database.GetWorkspa
 	return workspaces, err
 }
 
+func (m metricsStore) GetWorkspacesEligibleForDriftCheck(ctx context.Context, startedBefore time.Time) ([]database.GetWorkspacesEligibleForDriftCheckRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspacesEligibleForDriftCheck(ctx, startedBefore)
+	m.queryLatencies.WithLabelValues("GetWorkspacesEligibleForDriftCheck").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) GetWorkspacesEligibleForTransition(ctx context.Context, now time.Time) ([]database.Workspace, error) {
 	start := time.Now()
 	workspaces, err := m.s.GetWorkspacesEligibleForTransition(ctx, now)
@@ -1719,6 +1901,13 @@ func (m metricsStore) InsertGroupMember(ctx context.Context, arg database.Insert
 	return err
 }
 
+func (m metricsStore) InsertHealthcheckResult(ctx context.Context, arg database.InsertHealthcheckResultParams) (database.HealthcheckResult, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertHealthcheckResult(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertHealthcheckResult").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) InsertLicense(ctx context.Context, arg database.InsertLicenseParams) (database.License, error) {
 	start := time.Now()
 	license, err := m.s.InsertLicense(ctx, arg)
@@ -1726,6 +1915,13 @@ func (m metricsStore) InsertLicense(ctx context.Context, arg database.InsertLice
 	return license, err
 }
 
+func (m metricsStore) InsertMaintenanceWindow(ctx context.Context, arg database.InsertMaintenanceWindowParams) (database.MaintenanceWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertMaintenanceWindow(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertMaintenanceWindow").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) InsertMissingGroups(ctx context.Context, arg database.InsertMissingGroupsParams) ([]database.Group, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertMissingGroups(ctx, arg)
@@ -1789,6 +1985,13 @@ func (m metricsStore) InsertProvisionerJobLogs(ctx context.Context, arg database
 	return logs, err
 }
 
+func (m metricsStore) InsertProvisionerJobResourceChanges(ctx context.Context, arg database.InsertProvisionerJobResourceChangesParams) ([]database.ProvisionerJobResourceChange, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertProvisionerJobResourceChanges(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertProvisionerJobResourceChanges").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) InsertProvisionerJobTimings(ctx context.Context, arg database.InsertProvisionerJobTimingsParams) ([]database.ProvisionerJobTiming, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertProvisionerJobTimings(ctx, arg)
@@ -1831,6 +2034,13 @@ func (m metricsStore) InsertTemplateVersionParameter(ctx context.Context, arg da
 	return parameter, err
 }
 
+func (m metricsStore) InsertTemplateVersionRollout(ctx context.Context, arg database.InsertTemplateVersionRolloutParams) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertTemplateVersionRollout(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertTemplateVersionRollout").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) InsertTemplateVersionVariable(ctx context.Context, arg database.InsertTemplateVersionVariableParams) (database.TemplateVersionVariable, error) {
 	start := time.Now()
 	variable, err := m.s.InsertTemplateVersionVariable(ctx, arg)
@@ -1943,6 +2153,13 @@ func (m metricsStore) InsertWorkspaceBuild(ctx context.Context, arg database.Ins
 	return err
 }
 
+func (m metricsStore) InsertWorkspaceBuildDriftCheck(ctx context.Context, arg database.InsertWorkspaceBuildDriftCheckParams) error {
+	start := time.Now()
+	r0 := m.s.InsertWorkspaceBuildDriftCheck(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceBuildDriftCheck").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) InsertWorkspaceBuildParameters(ctx context.Context, arg database.InsertWorkspaceBuildParametersParams) error {
 	start := time.Now()
 	err := m.s.InsertWorkspaceBuildParameters(ctx, arg)
@@ -1950,6 +2167,20 @@ func (m metricsStore) InsertWorkspaceBuildParameters(ctx context.Context, arg da
 	return err
 }
 
+func (m metricsStore) InsertWorkspaceBulkOperation(ctx context.Context, arg database.InsertWorkspaceBulkOperationParams) (database.WorkspaceBulkOperation, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceBulkOperation(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceBulkOperation").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.InsertWorkspaceBulkOperationWorkspacesParams) error {
+	start := time.Now()
+	r0 := m.s.InsertWorkspaceBulkOperationWorkspaces(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceBulkOperationWorkspaces").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) InsertWorkspaceProxy(ctx context.Context, arg database.InsertWorkspaceProxyParams) (database.WorkspaceProxy, error) {
 	start := time.Now()
 	proxy, err := m.s.InsertWorkspaceProxy(ctx, arg)
@@ -1971,6 +2202,13 @@ func (m metricsStore) InsertWorkspaceResourceMetadata(ctx context.Context, arg d
 	return metadata, err
 }
 
+func (m metricsStore) InsertWorkspaceSessionRecording(ctx context.Context, arg database.InsertWorkspaceSessionRecordingParams) (database.WorkspaceSessionRecording, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceSessionRecording(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceSessionRecording").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) ListProvisionerKeysByOrganization(ctx context.Context, organizationID uuid.UUID) ([]database.ProvisionerKey, error) {
 	start := time.Now()
 	r0, r1 := m.s.ListProvisionerKeysByOrganization(ctx, organizationID)
@@ -2104,6 +2342,20 @@ func (m metricsStore) UpdateInactiveUsersToDormant(ctx context.Context, lastSeen
 	return r0, r1
 }
 
+func (m metricsStore) UpdateMaintenanceWindowByID(ctx context.Context, arg database.UpdateMaintenanceWindowByIDParams) (database.MaintenanceWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateMaintenanceWindowByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateMaintenanceWindowByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m metricsStore) UpdateMaintenanceWindowLastNotifiedStart(ctx context.Context, arg database.UpdateMaintenanceWindowLastNotifiedStartParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateMaintenanceWindowLastNotifiedStart(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateMaintenanceWindowLastNotifiedStart").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) UpdateMemberRoles(ctx context.Context, arg database.UpdateMemberRolesParams) (database.OrganizationMember, error) {
 	start := time.Now()
 	member, err := m.s.UpdateMemberRoles(ctx, arg)
@@ -2244,6 +2496,13 @@ func (m metricsStore) UpdateTemplateVersionExternalAuthProvidersByJobID(ctx cont
 	return err
 }
 
+func (m metricsStore) UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutStatusByIDParams) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateTemplateVersionRolloutStatusByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateTemplateVersionRolloutStatusByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) error {
 	start := time.Now()
 	r0 := m.s.UpdateTemplateWorkspacesLastUsedAt(ctx, arg)
@@ -2349,6 +2608,13 @@ func (m metricsStore) UpdateWorkspace(ctx context.Context, arg database.UpdateWo
 	return workspace, err
 }
 
+func (m metricsStore) UpdateWorkspaceACLByID(ctx context.Context, arg database.UpdateWorkspaceACLByIDParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateWorkspaceACLByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceACLByID").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) UpdateWorkspaceAgentConnectionByID(ctx context.Context, arg database.UpdateWorkspaceAgentConnectionByIDParams) error {
 	start := time.Now()
 	err := m.s.UpdateWorkspaceAgentConnectionByID(ctx, arg)
@@ -2419,6 +2685,13 @@ func (m metricsStore) UpdateWorkspaceBuildDeadlineByID(ctx context.Context, arg
 	return r0
 }
 
+func (m metricsStore) UpdateWorkspaceBuildDriftByJobID(ctx context.Context, arg database.UpdateWorkspaceBuildDriftByJobIDParams) (database.WorkspaceBuildDrift, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateWorkspaceBuildDriftByJobID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceBuildDriftByJobID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Context, arg database.UpdateWorkspaceBuildProvisionerStateByIDParams) error {
 	start := time.Now()
 	r0 := m.s.UpdateWorkspaceBuildProvisionerStateByID(ctx, arg)
@@ -2426,6 +2699,20 @@ func (m metricsStore) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Conte
 	return r0
 }
 
+func (m metricsStore) UpdateWorkspaceBulkOperationCompletedAt(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCompletedAtParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateWorkspaceBulkOperationCompletedAt(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceBulkOperationCompletedAt").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m metricsStore) UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg database.UpdateWorkspaceBulkOperationWorkspaceParams) (database.WorkspaceBulkOperationWorkspace, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateWorkspaceBulkOperationWorkspace(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceBulkOperationWorkspace").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) UpdateWorkspaceDeletedByID(ctx context.Context, arg database.UpdateWorkspaceDeletedByIDParams) error {
 	start := time.Now()
 	err := m.s.UpdateWorkspaceDeletedByID(ctx, arg)
@@ -2496,6 +2783,13 @@ func (m metricsStore) UpsertApplicationName(ctx context.Context, value string) e
 	return r0
 }
 
+func (m metricsStore) UpsertConnectionLog(ctx context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpsertConnectionLog(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpsertConnectionLog").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m metricsStore) UpsertCoordinatorResumeTokenSigningKey(ctx context.Context, value string) error {
 	start := time.Now()
 	r0 := m.s.UpsertCoordinatorResumeTokenSigningKey(ctx, value)
@@ -2622,6 +2916,13 @@ func (m metricsStore) UpsertTemplateUsageStats(ctx context.Context) error {
 	return r0
 }
 
+func (m metricsStore) UpsertWorkspaceAgentClientPeer(ctx context.Context, arg database.UpsertWorkspaceAgentClientPeerParams) error {
+	start := time.Now()
+	r0 := m.s.UpsertWorkspaceAgentClientPeer(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpsertWorkspaceAgentClientPeer").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m metricsStore) UpsertWorkspaceAgentPortShare(ctx context.Context, arg database.UpsertWorkspaceAgentPortShareParams) (database.WorkspaceAgentPortShare, error) {
 	start := time.Now()
 	r0, r1 := m.s.UpsertWorkspaceAgentPortShare(ctx, arg)
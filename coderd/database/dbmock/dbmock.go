@@ -117,6 +117,21 @@ func (mr *MockStoreMockRecorder) AllUserIDs(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllUserIDs", reflect.TypeOf((*MockStore)(nil).AllUserIDs), arg0)
 }
 
+// AppendWorkspaceSessionRecordingData mocks base method.
+func (m *MockStore) AppendWorkspaceSessionRecordingData(arg0 context.Context, arg1 database.AppendWorkspaceSessionRecordingDataParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendWorkspaceSessionRecordingData", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendWorkspaceSessionRecordingData indicates an expected call of AppendWorkspaceSessionRecordingData.
+func (mr *MockStoreMockRecorder) AppendWorkspaceSessionRecordingData(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendWorkspaceSessionRecordingData", reflect.TypeOf((*MockStore)(nil).AppendWorkspaceSessionRecordingData), arg0, arg1)
+}
+
 // ArchiveUnusedTemplateVersions mocks base method.
 func (m *MockStore) ArchiveUnusedTemplateVersions(arg0 context.Context, arg1 database.ArchiveUnusedTemplateVersionsParams) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -417,6 +432,20 @@ func (mr *MockStoreMockRecorder) DeleteLicense(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLicense", reflect.TypeOf((*MockStore)(nil).DeleteLicense), arg0, arg1)
 }
 
+// DeleteMaintenanceWindowByID mocks base method.
+func (m *MockStore) DeleteMaintenanceWindowByID(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaintenanceWindowByID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaintenanceWindowByID indicates an expected call of DeleteMaintenanceWindowByID.
+func (mr *MockStoreMockRecorder) DeleteMaintenanceWindowByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaintenanceWindowByID", reflect.TypeOf((*MockStore)(nil).DeleteMaintenanceWindowByID), arg0, arg1)
+}
+
 // DeleteOAuth2ProviderAppByID mocks base method.
 func (m *MockStore) DeleteOAuth2ProviderAppByID(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -487,6 +516,20 @@ func (mr *MockStoreMockRecorder) DeleteOAuth2ProviderAppTokensByAppAndUserID(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOAuth2ProviderAppTokensByAppAndUserID", reflect.TypeOf((*MockStore)(nil).DeleteOAuth2ProviderAppTokensByAppAndUserID), arg0, arg1)
 }
 
+// DeleteOldHealthcheckResults mocks base method.
+func (m *MockStore) DeleteOldHealthcheckResults(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldHealthcheckResults", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOldHealthcheckResults indicates an expected call of DeleteOldHealthcheckResults.
+func (mr *MockStoreMockRecorder) DeleteOldHealthcheckResults(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldHealthcheckResults", reflect.TypeOf((*MockStore)(nil).DeleteOldHealthcheckResults), arg0, arg1)
+}
+
 // DeleteOldNotificationMessages mocks base method.
 func (m *MockStore) DeleteOldNotificationMessages(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -543,6 +586,20 @@ func (mr *MockStoreMockRecorder) DeleteOldWorkspaceAgentStats(arg0 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldWorkspaceAgentStats", reflect.TypeOf((*MockStore)(nil).DeleteOldWorkspaceAgentStats), arg0)
 }
 
+// DeleteOldWorkspaceBuildDriftJobs mocks base method.
+func (m *MockStore) DeleteOldWorkspaceBuildDriftJobs(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldWorkspaceBuildDriftJobs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOldWorkspaceBuildDriftJobs indicates an expected call of DeleteOldWorkspaceBuildDriftJobs.
+func (mr *MockStoreMockRecorder) DeleteOldWorkspaceBuildDriftJobs(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldWorkspaceBuildDriftJobs", reflect.TypeOf((*MockStore)(nil).DeleteOldWorkspaceBuildDriftJobs), arg0)
+}
+
 // DeleteOrganization mocks base method.
 func (m *MockStore) DeleteOrganization(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -687,6 +744,20 @@ func (mr *MockStoreMockRecorder) DeleteTailnetTunnel(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTailnetTunnel", reflect.TypeOf((*MockStore)(nil).DeleteTailnetTunnel), arg0, arg1)
 }
 
+// DeleteWorkspaceAgentClientPeer mocks base method.
+func (m *MockStore) DeleteWorkspaceAgentClientPeer(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceAgentClientPeer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceAgentClientPeer indicates an expected call of DeleteWorkspaceAgentClientPeer.
+func (mr *MockStoreMockRecorder) DeleteWorkspaceAgentClientPeer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceAgentClientPeer", reflect.TypeOf((*MockStore)(nil).DeleteWorkspaceAgentClientPeer), arg0, arg1)
+}
+
 // DeleteWorkspaceAgentPortShare mocks base method.
 func (m *MockStore) DeleteWorkspaceAgentPortShare(arg0 context.Context, arg1 database.DeleteWorkspaceAgentPortShareParams) error {
 	m.ctrl.T.Helper()
@@ -833,6 +904,21 @@ func (mr *MockStoreMockRecorder) GetAPIKeysLastUsedAfter(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeysLastUsedAfter", reflect.TypeOf((*MockStore)(nil).GetAPIKeysLastUsedAfter), arg0, arg1)
 }
 
+// GetActiveTemplateVersionRolloutByTemplateID mocks base method.
+func (m *MockStore) GetActiveTemplateVersionRolloutByTemplateID(arg0 context.Context, arg1 uuid.UUID) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTemplateVersionRolloutByTemplateID", arg0, arg1)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveTemplateVersionRolloutByTemplateID indicates an expected call of GetActiveTemplateVersionRolloutByTemplateID.
+func (mr *MockStoreMockRecorder) GetActiveTemplateVersionRolloutByTemplateID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTemplateVersionRolloutByTemplateID", reflect.TypeOf((*MockStore)(nil).GetActiveTemplateVersionRolloutByTemplateID), arg0, arg1)
+}
+
 // GetActiveUserCount mocks base method.
 func (m *MockStore) GetActiveUserCount(arg0 context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1058,6 +1144,21 @@ func (mr *MockStoreMockRecorder) GetAuthorizedWorkspaces(arg0, arg1, arg2 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizedWorkspaces", reflect.TypeOf((*MockStore)(nil).GetAuthorizedWorkspaces), arg0, arg1, arg2)
 }
 
+// GetConnectionLogsOffset mocks base method.
+func (m *MockStore) GetConnectionLogsOffset(arg0 context.Context, arg1 database.GetConnectionLogsOffsetParams) ([]database.GetConnectionLogsOffsetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectionLogsOffset", arg0, arg1)
+	ret0, _ := ret[0].([]database.GetConnectionLogsOffsetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectionLogsOffset indicates an expected call of GetConnectionLogsOffset.
+func (mr *MockStoreMockRecorder) GetConnectionLogsOffset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionLogsOffset", reflect.TypeOf((*MockStore)(nil).GetConnectionLogsOffset), arg0, arg1)
+}
+
 // GetCoordinatorResumeTokenSigningKey mocks base method.
 func (m *MockStore) GetCoordinatorResumeTokenSigningKey(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -1433,6 +1534,21 @@ func (mr *MockStoreMockRecorder) GetHealthSettings(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthSettings", reflect.TypeOf((*MockStore)(nil).GetHealthSettings), arg0)
 }
 
+// GetHealthcheckResults mocks base method.
+func (m *MockStore) GetHealthcheckResults(arg0 context.Context, arg1 database.GetHealthcheckResultsParams) ([]database.HealthcheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthcheckResults", arg0, arg1)
+	ret0, _ := ret[0].([]database.HealthcheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthcheckResults indicates an expected call of GetHealthcheckResults.
+func (mr *MockStoreMockRecorder) GetHealthcheckResults(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthcheckResults", reflect.TypeOf((*MockStore)(nil).GetHealthcheckResults), arg0, arg1)
+}
+
 // GetHungProvisionerJobs mocks base method.
 func (m *MockStore) GetHungProvisionerJobs(arg0 context.Context, arg1 time.Time) ([]database.ProvisionerJob, error) {
 	m.ctrl.T.Helper()
@@ -1448,6 +1564,21 @@ func (mr *MockStoreMockRecorder) GetHungProvisionerJobs(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHungProvisionerJobs", reflect.TypeOf((*MockStore)(nil).GetHungProvisionerJobs), arg0, arg1)
 }
 
+// GetIncompleteWorkspaceBulkOperations mocks base method.
+func (m *MockStore) GetIncompleteWorkspaceBulkOperations(arg0 context.Context) ([]database.WorkspaceBulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncompleteWorkspaceBulkOperations", arg0)
+	ret0, _ := ret[0].([]database.WorkspaceBulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncompleteWorkspaceBulkOperations indicates an expected call of GetIncompleteWorkspaceBulkOperations.
+func (mr *MockStoreMockRecorder) GetIncompleteWorkspaceBulkOperations(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncompleteWorkspaceBulkOperations", reflect.TypeOf((*MockStore)(nil).GetIncompleteWorkspaceBulkOperations), arg0)
+}
+
 // GetJFrogXrayScanByWorkspaceAndAgentID mocks base method.
 func (m *MockStore) GetJFrogXrayScanByWorkspaceAndAgentID(arg0 context.Context, arg1 database.GetJFrogXrayScanByWorkspaceAndAgentIDParams) (database.JfrogXrayScan, error) {
 	m.ctrl.T.Helper()
@@ -1493,6 +1624,36 @@ func (mr *MockStoreMockRecorder) GetLatestCryptoKeyByFeature(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestCryptoKeyByFeature", reflect.TypeOf((*MockStore)(nil).GetLatestCryptoKeyByFeature), arg0, arg1)
 }
 
+// GetLatestHealthcheckResults mocks base method.
+func (m *MockStore) GetLatestHealthcheckResults(arg0 context.Context) ([]database.HealthcheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestHealthcheckResults", arg0)
+	ret0, _ := ret[0].([]database.HealthcheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestHealthcheckResults indicates an expected call of GetLatestHealthcheckResults.
+func (mr *MockStoreMockRecorder) GetLatestHealthcheckResults(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestHealthcheckResults", reflect.TypeOf((*MockStore)(nil).GetLatestHealthcheckResults), arg0)
+}
+
+// GetLatestTemplateVersionRolloutByTemplateVersionID mocks base method.
+func (m *MockStore) GetLatestTemplateVersionRolloutByTemplateVersionID(arg0 context.Context, arg1 uuid.UUID) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestTemplateVersionRolloutByTemplateVersionID", arg0, arg1)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestTemplateVersionRolloutByTemplateVersionID indicates an expected call of GetLatestTemplateVersionRolloutByTemplateVersionID.
+func (mr *MockStoreMockRecorder) GetLatestTemplateVersionRolloutByTemplateVersionID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestTemplateVersionRolloutByTemplateVersionID", reflect.TypeOf((*MockStore)(nil).GetLatestTemplateVersionRolloutByTemplateVersionID), arg0, arg1)
+}
+
 // GetLatestWorkspaceBuildByWorkspaceID mocks base method.
 func (m *MockStore) GetLatestWorkspaceBuildByWorkspaceID(arg0 context.Context, arg1 uuid.UUID) (database.WorkspaceBuild, error) {
 	m.ctrl.T.Helper()
@@ -1583,6 +1744,51 @@ func (mr *MockStoreMockRecorder) GetLogoURL(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogoURL", reflect.TypeOf((*MockStore)(nil).GetLogoURL), arg0)
 }
 
+// GetMaintenanceWindowByID mocks base method.
+func (m *MockStore) GetMaintenanceWindowByID(arg0 context.Context, arg1 uuid.UUID) (database.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowByID", arg0, arg1)
+	ret0, _ := ret[0].(database.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowByID indicates an expected call of GetMaintenanceWindowByID.
+func (mr *MockStoreMockRecorder) GetMaintenanceWindowByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowByID", reflect.TypeOf((*MockStore)(nil).GetMaintenanceWindowByID), arg0, arg1)
+}
+
+// GetMaintenanceWindows mocks base method.
+func (m *MockStore) GetMaintenanceWindows(arg0 context.Context) ([]database.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindows", arg0)
+	ret0, _ := ret[0].([]database.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindows indicates an expected call of GetMaintenanceWindows.
+func (mr *MockStoreMockRecorder) GetMaintenanceWindows(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindows", reflect.TypeOf((*MockStore)(nil).GetMaintenanceWindows), arg0)
+}
+
+// GetMaintenanceWindowsByOrganizationID mocks base method.
+func (m *MockStore) GetMaintenanceWindowsByOrganizationID(arg0 context.Context, arg1 uuid.UUID) ([]database.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowsByOrganizationID", arg0, arg1)
+	ret0, _ := ret[0].([]database.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowsByOrganizationID indicates an expected call of GetMaintenanceWindowsByOrganizationID.
+func (mr *MockStoreMockRecorder) GetMaintenanceWindowsByOrganizationID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowsByOrganizationID", reflect.TypeOf((*MockStore)(nil).GetMaintenanceWindowsByOrganizationID), arg0, arg1)
+}
+
 // GetNotificationMessagesByStatus mocks base method.
 func (m *MockStore) GetNotificationMessagesByStatus(arg0 context.Context, arg1 database.GetNotificationMessagesByStatusParams) ([]database.NotificationMessage, error) {
 	m.ctrl.T.Helper()
@@ -1958,6 +2164,36 @@ func (mr *MockStoreMockRecorder) GetProvisionerJobByID(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisionerJobByID", reflect.TypeOf((*MockStore)(nil).GetProvisionerJobByID), arg0, arg1)
 }
 
+// GetProvisionerJobResourceChangesByJobID mocks base method.
+func (m *MockStore) GetProvisionerJobResourceChangesByJobID(arg0 context.Context, arg1 uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvisionerJobResourceChangesByJobID", arg0, arg1)
+	ret0, _ := ret[0].([]database.ProvisionerJobResourceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisionerJobResourceChangesByJobID indicates an expected call of GetProvisionerJobResourceChangesByJobID.
+func (mr *MockStoreMockRecorder) GetProvisionerJobResourceChangesByJobID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisionerJobResourceChangesByJobID", reflect.TypeOf((*MockStore)(nil).GetProvisionerJobResourceChangesByJobID), arg0, arg1)
+}
+
+// GetProvisionerJobResourceChangesByJobIDs mocks base method.
+func (m *MockStore) GetProvisionerJobResourceChangesByJobIDs(arg0 context.Context, arg1 []uuid.UUID) ([]database.ProvisionerJobResourceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvisionerJobResourceChangesByJobIDs", arg0, arg1)
+	ret0, _ := ret[0].([]database.ProvisionerJobResourceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisionerJobResourceChangesByJobIDs indicates an expected call of GetProvisionerJobResourceChangesByJobIDs.
+func (mr *MockStoreMockRecorder) GetProvisionerJobResourceChangesByJobIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisionerJobResourceChangesByJobIDs", reflect.TypeOf((*MockStore)(nil).GetProvisionerJobResourceChangesByJobIDs), arg0, arg1)
+}
+
 // GetProvisionerJobTimingsByJobID mocks base method.
 func (m *MockStore) GetProvisionerJobTimingsByJobID(arg0 context.Context, arg1 uuid.UUID) ([]database.ProvisionerJobTiming, error) {
 	m.ctrl.T.Helper()
@@ -2483,6 +2719,21 @@ func (mr *MockStoreMockRecorder) GetTemplateVersionParameters(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionParameters", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionParameters), arg0, arg1)
 }
 
+// GetTemplateVersionRolloutByID mocks base method.
+func (m *MockStore) GetTemplateVersionRolloutByID(arg0 context.Context, arg1 uuid.UUID) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersionRolloutByID", arg0, arg1)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersionRolloutByID indicates an expected call of GetTemplateVersionRolloutByID.
+func (mr *MockStoreMockRecorder) GetTemplateVersionRolloutByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionRolloutByID", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionRolloutByID), arg0, arg1)
+}
+
 // GetTemplateVersionVariables mocks base method.
 func (m *MockStore) GetTemplateVersionVariables(arg0 context.Context, arg1 uuid.UUID) ([]database.TemplateVersionVariable, error) {
 	m.ctrl.T.Helper()
@@ -2828,6 +3079,21 @@ func (mr *MockStoreMockRecorder) GetWorkspaceAgentByInstanceID(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentByInstanceID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentByInstanceID), arg0, arg1)
 }
 
+// GetWorkspaceAgentClientPeersByAgentID mocks base method.
+func (m *MockStore) GetWorkspaceAgentClientPeersByAgentID(arg0 context.Context, arg1 uuid.UUID) ([]database.WorkspaceAgentClientPeer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAgentClientPeersByAgentID", arg0, arg1)
+	ret0, _ := ret[0].([]database.WorkspaceAgentClientPeer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAgentClientPeersByAgentID indicates an expected call of GetWorkspaceAgentClientPeersByAgentID.
+func (mr *MockStoreMockRecorder) GetWorkspaceAgentClientPeersByAgentID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentClientPeersByAgentID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentClientPeersByAgentID), arg0, arg1)
+}
+
 // GetWorkspaceAgentLifecycleStateByID mocks base method.
 func (m *MockStore) GetWorkspaceAgentLifecycleStateByID(arg0 context.Context, arg1 uuid.UUID) (database.GetWorkspaceAgentLifecycleStateByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -3098,6 +3364,36 @@ func (mr *MockStoreMockRecorder) GetWorkspaceBuildByWorkspaceIDAndBuildNumber(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBuildByWorkspaceIDAndBuildNumber", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBuildByWorkspaceIDAndBuildNumber), arg0, arg1)
 }
 
+// GetWorkspaceBuildDriftByJobID mocks base method.
+func (m *MockStore) GetWorkspaceBuildDriftByJobID(arg0 context.Context, arg1 uuid.UUID) (database.WorkspaceBuildDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBuildDriftByJobID", arg0, arg1)
+	ret0, _ := ret[0].(database.WorkspaceBuildDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBuildDriftByJobID indicates an expected call of GetWorkspaceBuildDriftByJobID.
+func (mr *MockStoreMockRecorder) GetWorkspaceBuildDriftByJobID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBuildDriftByJobID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBuildDriftByJobID), arg0, arg1)
+}
+
+// GetWorkspaceBuildDriftsByWorkspaceBuildIDs mocks base method.
+func (m *MockStore) GetWorkspaceBuildDriftsByWorkspaceBuildIDs(arg0 context.Context, arg1 []uuid.UUID) ([]database.WorkspaceBuildDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBuildDriftsByWorkspaceBuildIDs", arg0, arg1)
+	ret0, _ := ret[0].([]database.WorkspaceBuildDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBuildDriftsByWorkspaceBuildIDs indicates an expected call of GetWorkspaceBuildDriftsByWorkspaceBuildIDs.
+func (mr *MockStoreMockRecorder) GetWorkspaceBuildDriftsByWorkspaceBuildIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBuildDriftsByWorkspaceBuildIDs", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBuildDriftsByWorkspaceBuildIDs), arg0, arg1)
+}
+
 // GetWorkspaceBuildParameters mocks base method.
 func (m *MockStore) GetWorkspaceBuildParameters(arg0 context.Context, arg1 uuid.UUID) ([]database.WorkspaceBuildParameter, error) {
 	m.ctrl.T.Helper()
@@ -3113,6 +3409,21 @@ func (mr *MockStoreMockRecorder) GetWorkspaceBuildParameters(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBuildParameters", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBuildParameters), arg0, arg1)
 }
 
+// GetWorkspaceBuildStatsByTemplateVersionID mocks base method.
+func (m *MockStore) GetWorkspaceBuildStatsByTemplateVersionID(arg0 context.Context, arg1 database.GetWorkspaceBuildStatsByTemplateVersionIDParams) (database.GetWorkspaceBuildStatsByTemplateVersionIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBuildStatsByTemplateVersionID", arg0, arg1)
+	ret0, _ := ret[0].(database.GetWorkspaceBuildStatsByTemplateVersionIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBuildStatsByTemplateVersionID indicates an expected call of GetWorkspaceBuildStatsByTemplateVersionID.
+func (mr *MockStoreMockRecorder) GetWorkspaceBuildStatsByTemplateVersionID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBuildStatsByTemplateVersionID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBuildStatsByTemplateVersionID), arg0, arg1)
+}
+
 // GetWorkspaceBuildStatsByTemplates mocks base method.
 func (m *MockStore) GetWorkspaceBuildStatsByTemplates(arg0 context.Context, arg1 time.Time) ([]database.GetWorkspaceBuildStatsByTemplatesRow, error) {
 	m.ctrl.T.Helper()
@@ -3158,6 +3469,36 @@ func (mr *MockStoreMockRecorder) GetWorkspaceBuildsCreatedAfter(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBuildsCreatedAfter", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBuildsCreatedAfter), arg0, arg1)
 }
 
+// GetWorkspaceBulkOperationByID mocks base method.
+func (m *MockStore) GetWorkspaceBulkOperationByID(arg0 context.Context, arg1 uuid.UUID) (database.WorkspaceBulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBulkOperationByID", arg0, arg1)
+	ret0, _ := ret[0].(database.WorkspaceBulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBulkOperationByID indicates an expected call of GetWorkspaceBulkOperationByID.
+func (mr *MockStoreMockRecorder) GetWorkspaceBulkOperationByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBulkOperationByID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBulkOperationByID), arg0, arg1)
+}
+
+// GetWorkspaceBulkOperationWorkspacesByBulkOperationID mocks base method.
+func (m *MockStore) GetWorkspaceBulkOperationWorkspacesByBulkOperationID(arg0 context.Context, arg1 uuid.UUID) ([]database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBulkOperationWorkspacesByBulkOperationID", arg0, arg1)
+	ret0, _ := ret[0].([]database.GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBulkOperationWorkspacesByBulkOperationID indicates an expected call of GetWorkspaceBulkOperationWorkspacesByBulkOperationID.
+func (mr *MockStoreMockRecorder) GetWorkspaceBulkOperationWorkspacesByBulkOperationID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBulkOperationWorkspacesByBulkOperationID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBulkOperationWorkspacesByBulkOperationID), arg0, arg1)
+}
+
 // GetWorkspaceByAgentID mocks base method.
 func (m *MockStore) GetWorkspaceByAgentID(arg0 context.Context, arg1 uuid.UUID) (database.GetWorkspaceByAgentIDRow, error) {
 	m.ctrl.T.Helper()
@@ -3368,6 +3709,36 @@ func (mr *MockStoreMockRecorder) GetWorkspaceResourcesCreatedAfter(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceResourcesCreatedAfter", reflect.TypeOf((*MockStore)(nil).GetWorkspaceResourcesCreatedAfter), arg0, arg1)
 }
 
+// GetWorkspaceSessionRecordingByID mocks base method.
+func (m *MockStore) GetWorkspaceSessionRecordingByID(arg0 context.Context, arg1 uuid.UUID) (database.WorkspaceSessionRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceSessionRecordingByID", arg0, arg1)
+	ret0, _ := ret[0].(database.WorkspaceSessionRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceSessionRecordingByID indicates an expected call of GetWorkspaceSessionRecordingByID.
+func (mr *MockStoreMockRecorder) GetWorkspaceSessionRecordingByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceSessionRecordingByID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceSessionRecordingByID), arg0, arg1)
+}
+
+// GetWorkspaceSessionRecordingsByWorkspaceID mocks base method.
+func (m *MockStore) GetWorkspaceSessionRecordingsByWorkspaceID(arg0 context.Context, arg1 uuid.UUID) ([]database.GetWorkspaceSessionRecordingsByWorkspaceIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceSessionRecordingsByWorkspaceID", arg0, arg1)
+	ret0, _ := ret[0].([]database.GetWorkspaceSessionRecordingsByWorkspaceIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceSessionRecordingsByWorkspaceID indicates an expected call of GetWorkspaceSessionRecordingsByWorkspaceID.
+func (mr *MockStoreMockRecorder) GetWorkspaceSessionRecordingsByWorkspaceID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceSessionRecordingsByWorkspaceID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceSessionRecordingsByWorkspaceID), arg0, arg1)
+}
+
 // GetWorkspaceUniqueOwnerCountByTemplateIDs mocks base method.
 func (m *MockStore) GetWorkspaceUniqueOwnerCountByTemplateIDs(arg0 context.Context, arg1 []uuid.UUID) ([]database.GetWorkspaceUniqueOwnerCountByTemplateIDsRow, error) {
 	m.ctrl.T.Helper()
@@ -3398,6 +3769,21 @@ func (mr *MockStoreMockRecorder) GetWorkspaces(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaces", reflect.TypeOf((*MockStore)(nil).GetWorkspaces), arg0, arg1)
 }
 
+// GetWorkspacesEligibleForDriftCheck mocks base method.
+func (m *MockStore) GetWorkspacesEligibleForDriftCheck(arg0 context.Context, arg1 time.Time) ([]database.GetWorkspacesEligibleForDriftCheckRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspacesEligibleForDriftCheck", arg0, arg1)
+	ret0, _ := ret[0].([]database.GetWorkspacesEligibleForDriftCheckRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspacesEligibleForDriftCheck indicates an expected call of GetWorkspacesEligibleForDriftCheck.
+func (mr *MockStoreMockRecorder) GetWorkspacesEligibleForDriftCheck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspacesEligibleForDriftCheck", reflect.TypeOf((*MockStore)(nil).GetWorkspacesEligibleForDriftCheck), arg0, arg1)
+}
+
 // GetWorkspacesEligibleForTransition mocks base method.
 func (m *MockStore) GetWorkspacesEligibleForTransition(arg0 context.Context, arg1 time.Time) ([]database.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -3618,6 +4004,21 @@ func (mr *MockStoreMockRecorder) InsertGroupMember(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGroupMember", reflect.TypeOf((*MockStore)(nil).InsertGroupMember), arg0, arg1)
 }
 
+// InsertHealthcheckResult mocks base method.
+func (m *MockStore) InsertHealthcheckResult(arg0 context.Context, arg1 database.InsertHealthcheckResultParams) (database.HealthcheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertHealthcheckResult", arg0, arg1)
+	ret0, _ := ret[0].(database.HealthcheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertHealthcheckResult indicates an expected call of InsertHealthcheckResult.
+func (mr *MockStoreMockRecorder) InsertHealthcheckResult(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertHealthcheckResult", reflect.TypeOf((*MockStore)(nil).InsertHealthcheckResult), arg0, arg1)
+}
+
 // InsertLicense mocks base method.
 func (m *MockStore) InsertLicense(arg0 context.Context, arg1 database.InsertLicenseParams) (database.License, error) {
 	m.ctrl.T.Helper()
@@ -3633,6 +4034,21 @@ func (mr *MockStoreMockRecorder) InsertLicense(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLicense", reflect.TypeOf((*MockStore)(nil).InsertLicense), arg0, arg1)
 }
 
+// InsertMaintenanceWindow mocks base method.
+func (m *MockStore) InsertMaintenanceWindow(arg0 context.Context, arg1 database.InsertMaintenanceWindowParams) (database.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMaintenanceWindow", arg0, arg1)
+	ret0, _ := ret[0].(database.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertMaintenanceWindow indicates an expected call of InsertMaintenanceWindow.
+func (mr *MockStoreMockRecorder) InsertMaintenanceWindow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMaintenanceWindow", reflect.TypeOf((*MockStore)(nil).InsertMaintenanceWindow), arg0, arg1)
+}
+
 // InsertMissingGroups mocks base method.
 func (m *MockStore) InsertMissingGroups(arg0 context.Context, arg1 database.InsertMissingGroupsParams) ([]database.Group, error) {
 	m.ctrl.T.Helper()
@@ -3768,6 +4184,21 @@ func (mr *MockStoreMockRecorder) InsertProvisionerJobLogs(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertProvisionerJobLogs", reflect.TypeOf((*MockStore)(nil).InsertProvisionerJobLogs), arg0, arg1)
 }
 
+// InsertProvisionerJobResourceChanges mocks base method.
+func (m *MockStore) InsertProvisionerJobResourceChanges(arg0 context.Context, arg1 database.InsertProvisionerJobResourceChangesParams) ([]database.ProvisionerJobResourceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertProvisionerJobResourceChanges", arg0, arg1)
+	ret0, _ := ret[0].([]database.ProvisionerJobResourceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertProvisionerJobResourceChanges indicates an expected call of InsertProvisionerJobResourceChanges.
+func (mr *MockStoreMockRecorder) InsertProvisionerJobResourceChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertProvisionerJobResourceChanges", reflect.TypeOf((*MockStore)(nil).InsertProvisionerJobResourceChanges), arg0, arg1)
+}
+
 // InsertProvisionerJobTimings mocks base method.
 func (m *MockStore) InsertProvisionerJobTimings(arg0 context.Context, arg1 database.InsertProvisionerJobTimingsParams) ([]database.ProvisionerJobTiming, error) {
 	m.ctrl.T.Helper()
@@ -3856,6 +4287,21 @@ func (mr *MockStoreMockRecorder) InsertTemplateVersionParameter(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplateVersionParameter", reflect.TypeOf((*MockStore)(nil).InsertTemplateVersionParameter), arg0, arg1)
 }
 
+// InsertTemplateVersionRollout mocks base method.
+func (m *MockStore) InsertTemplateVersionRollout(arg0 context.Context, arg1 database.InsertTemplateVersionRolloutParams) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTemplateVersionRollout", arg0, arg1)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertTemplateVersionRollout indicates an expected call of InsertTemplateVersionRollout.
+func (mr *MockStoreMockRecorder) InsertTemplateVersionRollout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplateVersionRollout", reflect.TypeOf((*MockStore)(nil).InsertTemplateVersionRollout), arg0, arg1)
+}
+
 // InsertTemplateVersionVariable mocks base method.
 func (m *MockStore) InsertTemplateVersionVariable(arg0 context.Context, arg1 database.InsertTemplateVersionVariableParams) (database.TemplateVersionVariable, error) {
 	m.ctrl.T.Helper()
@@ -4091,6 +4537,20 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceBuild(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBuild", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBuild), arg0, arg1)
 }
 
+// InsertWorkspaceBuildDriftCheck mocks base method.
+func (m *MockStore) InsertWorkspaceBuildDriftCheck(arg0 context.Context, arg1 database.InsertWorkspaceBuildDriftCheckParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceBuildDriftCheck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWorkspaceBuildDriftCheck indicates an expected call of InsertWorkspaceBuildDriftCheck.
+func (mr *MockStoreMockRecorder) InsertWorkspaceBuildDriftCheck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBuildDriftCheck", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBuildDriftCheck), arg0, arg1)
+}
+
 // InsertWorkspaceBuildParameters mocks base method.
 func (m *MockStore) InsertWorkspaceBuildParameters(arg0 context.Context, arg1 database.InsertWorkspaceBuildParametersParams) error {
 	m.ctrl.T.Helper()
@@ -4105,6 +4565,35 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceBuildParameters(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBuildParameters", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBuildParameters), arg0, arg1)
 }
 
+// InsertWorkspaceBulkOperation mocks base method.
+func (m *MockStore) InsertWorkspaceBulkOperation(arg0 context.Context, arg1 database.InsertWorkspaceBulkOperationParams) (database.WorkspaceBulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceBulkOperation", arg0, arg1)
+	ret0, _ := ret[0].(database.WorkspaceBulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceBulkOperation indicates an expected call of InsertWorkspaceBulkOperation.
+func (mr *MockStoreMockRecorder) InsertWorkspaceBulkOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBulkOperation", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBulkOperation), arg0, arg1)
+}
+
+// InsertWorkspaceBulkOperationWorkspaces mocks base method.
+func (m *MockStore) InsertWorkspaceBulkOperationWorkspaces(arg0 context.Context, arg1 database.InsertWorkspaceBulkOperationWorkspacesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceBulkOperationWorkspaces", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWorkspaceBulkOperationWorkspaces indicates an expected call of InsertWorkspaceBulkOperationWorkspaces.
+func (mr *MockStoreMockRecorder) InsertWorkspaceBulkOperationWorkspaces(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBulkOperationWorkspaces", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBulkOperationWorkspaces), arg0, arg1)
+}
+
 // InsertWorkspaceProxy mocks base method.
 func (m *MockStore) InsertWorkspaceProxy(arg0 context.Context, arg1 database.InsertWorkspaceProxyParams) (database.WorkspaceProxy, error) {
 	m.ctrl.T.Helper()
@@ -4150,6 +4639,21 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceResourceMetadata(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceResourceMetadata", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceResourceMetadata), arg0, arg1)
 }
 
+// InsertWorkspaceSessionRecording mocks base method.
+func (m *MockStore) InsertWorkspaceSessionRecording(arg0 context.Context, arg1 database.InsertWorkspaceSessionRecordingParams) (database.WorkspaceSessionRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceSessionRecording", arg0, arg1)
+	ret0, _ := ret[0].(database.WorkspaceSessionRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceSessionRecording indicates an expected call of InsertWorkspaceSessionRecording.
+func (mr *MockStoreMockRecorder) InsertWorkspaceSessionRecording(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceSessionRecording", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceSessionRecording), arg0, arg1)
+}
+
 // ListProvisionerKeysByOrganization mocks base method.
 func (m *MockStore) ListProvisionerKeysByOrganization(arg0 context.Context, arg1 uuid.UUID) ([]database.ProvisionerKey, error) {
 	m.ctrl.T.Helper()
@@ -4444,6 +4948,36 @@ func (mr *MockStoreMockRecorder) UpdateInactiveUsersToDormant(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInactiveUsersToDormant", reflect.TypeOf((*MockStore)(nil).UpdateInactiveUsersToDormant), arg0, arg1)
 }
 
+// UpdateMaintenanceWindowByID mocks base method.
+func (m *MockStore) UpdateMaintenanceWindowByID(arg0 context.Context, arg1 database.UpdateMaintenanceWindowByIDParams) (database.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowByID", arg0, arg1)
+	ret0, _ := ret[0].(database.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowByID indicates an expected call of UpdateMaintenanceWindowByID.
+func (mr *MockStoreMockRecorder) UpdateMaintenanceWindowByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowByID", reflect.TypeOf((*MockStore)(nil).UpdateMaintenanceWindowByID), arg0, arg1)
+}
+
+// UpdateMaintenanceWindowLastNotifiedStart mocks base method.
+func (m *MockStore) UpdateMaintenanceWindowLastNotifiedStart(arg0 context.Context, arg1 database.UpdateMaintenanceWindowLastNotifiedStartParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowLastNotifiedStart", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowLastNotifiedStart indicates an expected call of UpdateMaintenanceWindowLastNotifiedStart.
+func (mr *MockStoreMockRecorder) UpdateMaintenanceWindowLastNotifiedStart(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowLastNotifiedStart", reflect.TypeOf((*MockStore)(nil).UpdateMaintenanceWindowLastNotifiedStart), arg0, arg1)
+}
+
 // UpdateMemberRoles mocks base method.
 func (m *MockStore) UpdateMemberRoles(arg0 context.Context, arg1 database.UpdateMemberRolesParams) (database.OrganizationMember, error) {
 	m.ctrl.T.Helper()
@@ -4730,6 +5264,21 @@ func (mr *MockStoreMockRecorder) UpdateTemplateVersionExternalAuthProvidersByJob
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersionExternalAuthProvidersByJobID", reflect.TypeOf((*MockStore)(nil).UpdateTemplateVersionExternalAuthProvidersByJobID), arg0, arg1)
 }
 
+// UpdateTemplateVersionRolloutStatusByID mocks base method.
+func (m *MockStore) UpdateTemplateVersionRolloutStatusByID(arg0 context.Context, arg1 database.UpdateTemplateVersionRolloutStatusByIDParams) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateVersionRolloutStatusByID", arg0, arg1)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplateVersionRolloutStatusByID indicates an expected call of UpdateTemplateVersionRolloutStatusByID.
+func (mr *MockStoreMockRecorder) UpdateTemplateVersionRolloutStatusByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersionRolloutStatusByID", reflect.TypeOf((*MockStore)(nil).UpdateTemplateVersionRolloutStatusByID), arg0, arg1)
+}
+
 // UpdateTemplateWorkspacesLastUsedAt mocks base method.
 func (m *MockStore) UpdateTemplateWorkspacesLastUsedAt(arg0 context.Context, arg1 database.UpdateTemplateWorkspacesLastUsedAtParams) error {
 	m.ctrl.T.Helper()
@@ -4951,6 +5500,20 @@ func (mr *MockStoreMockRecorder) UpdateWorkspace(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspace", reflect.TypeOf((*MockStore)(nil).UpdateWorkspace), arg0, arg1)
 }
 
+// UpdateWorkspaceACLByID mocks base method.
+func (m *MockStore) UpdateWorkspaceACLByID(arg0 context.Context, arg1 database.UpdateWorkspaceACLByIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceACLByID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkspaceACLByID indicates an expected call of UpdateWorkspaceACLByID.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceACLByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceACLByID", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceACLByID), arg0, arg1)
+}
+
 // UpdateWorkspaceAgentConnectionByID mocks base method.
 func (m *MockStore) UpdateWorkspaceAgentConnectionByID(arg0 context.Context, arg1 database.UpdateWorkspaceAgentConnectionByIDParams) error {
 	m.ctrl.T.Helper()
@@ -5091,6 +5654,21 @@ func (mr *MockStoreMockRecorder) UpdateWorkspaceBuildDeadlineByID(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBuildDeadlineByID", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBuildDeadlineByID), arg0, arg1)
 }
 
+// UpdateWorkspaceBuildDriftByJobID mocks base method.
+func (m *MockStore) UpdateWorkspaceBuildDriftByJobID(arg0 context.Context, arg1 database.UpdateWorkspaceBuildDriftByJobIDParams) (database.WorkspaceBuildDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceBuildDriftByJobID", arg0, arg1)
+	ret0, _ := ret[0].(database.WorkspaceBuildDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspaceBuildDriftByJobID indicates an expected call of UpdateWorkspaceBuildDriftByJobID.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceBuildDriftByJobID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBuildDriftByJobID", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBuildDriftByJobID), arg0, arg1)
+}
+
 // UpdateWorkspaceBuildProvisionerStateByID mocks base method.
 func (m *MockStore) UpdateWorkspaceBuildProvisionerStateByID(arg0 context.Context, arg1 database.UpdateWorkspaceBuildProvisionerStateByIDParams) error {
 	m.ctrl.T.Helper()
@@ -5105,6 +5683,35 @@ func (mr *MockStoreMockRecorder) UpdateWorkspaceBuildProvisionerStateByID(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBuildProvisionerStateByID", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBuildProvisionerStateByID), arg0, arg1)
 }
 
+// UpdateWorkspaceBulkOperationCompletedAt mocks base method.
+func (m *MockStore) UpdateWorkspaceBulkOperationCompletedAt(arg0 context.Context, arg1 database.UpdateWorkspaceBulkOperationCompletedAtParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceBulkOperationCompletedAt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkspaceBulkOperationCompletedAt indicates an expected call of UpdateWorkspaceBulkOperationCompletedAt.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceBulkOperationCompletedAt(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBulkOperationCompletedAt", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBulkOperationCompletedAt), arg0, arg1)
+}
+
+// UpdateWorkspaceBulkOperationWorkspace mocks base method.
+func (m *MockStore) UpdateWorkspaceBulkOperationWorkspace(arg0 context.Context, arg1 database.UpdateWorkspaceBulkOperationWorkspaceParams) (database.WorkspaceBulkOperationWorkspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceBulkOperationWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(database.WorkspaceBulkOperationWorkspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspaceBulkOperationWorkspace indicates an expected call of UpdateWorkspaceBulkOperationWorkspace.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceBulkOperationWorkspace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBulkOperationWorkspace", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBulkOperationWorkspace), arg0, arg1)
+}
+
 // UpdateWorkspaceDeletedByID mocks base method.
 func (m *MockStore) UpdateWorkspaceDeletedByID(arg0 context.Context, arg1 database.UpdateWorkspaceDeletedByIDParams) error {
 	m.ctrl.T.Helper()
@@ -5248,6 +5855,21 @@ func (mr *MockStoreMockRecorder) UpsertApplicationName(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertApplicationName", reflect.TypeOf((*MockStore)(nil).UpsertApplicationName), arg0, arg1)
 }
 
+// UpsertConnectionLog mocks base method.
+func (m *MockStore) UpsertConnectionLog(arg0 context.Context, arg1 database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertConnectionLog", arg0, arg1)
+	ret0, _ := ret[0].(database.ConnectionLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertConnectionLog indicates an expected call of UpsertConnectionLog.
+func (mr *MockStoreMockRecorder) UpsertConnectionLog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertConnectionLog", reflect.TypeOf((*MockStore)(nil).UpsertConnectionLog), arg0, arg1)
+}
+
 // UpsertCoordinatorResumeTokenSigningKey mocks base method.
 func (m *MockStore) UpsertCoordinatorResumeTokenSigningKey(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -5506,6 +6128,20 @@ func (mr *MockStoreMockRecorder) UpsertTemplateUsageStats(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTemplateUsageStats", reflect.TypeOf((*MockStore)(nil).UpsertTemplateUsageStats), arg0)
 }
 
+// UpsertWorkspaceAgentClientPeer mocks base method.
+func (m *MockStore) UpsertWorkspaceAgentClientPeer(arg0 context.Context, arg1 database.UpsertWorkspaceAgentClientPeerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkspaceAgentClientPeer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkspaceAgentClientPeer indicates an expected call of UpsertWorkspaceAgentClientPeer.
+func (mr *MockStoreMockRecorder) UpsertWorkspaceAgentClientPeer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkspaceAgentClientPeer", reflect.TypeOf((*MockStore)(nil).UpsertWorkspaceAgentClientPeer), arg0, arg1)
+}
+
 // UpsertWorkspaceAgentPortShare mocks base method.
 func (m *MockStore) UpsertWorkspaceAgentPortShare(arg0 context.Context, arg1 database.UpsertWorkspaceAgentPortShareParams) (database.WorkspaceAgentPortShare, error) {
 	m.ctrl.T.Helper()
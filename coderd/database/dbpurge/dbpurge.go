@@ -16,8 +16,9 @@ import (
 )
 
 const (
-	delay          = 10 * time.Minute
-	maxAgentLogAge = 7 * 24 * time.Hour
+	delay                   = 10 * time.Minute
+	maxAgentLogAge          = 7 * 24 * time.Hour
+	maxHealthcheckResultAge = 30 * 24 * time.Hour
 )
 
 // New creates a new periodically purging database instance.
@@ -62,6 +63,14 @@ func New(ctx context.Context, logger slog.Logger, db database.Store, clk quartz.
 			if err := tx.DeleteOldNotificationMessages(ctx); err != nil {
 				return xerrors.Errorf("failed to delete old notification messages: %w", err)
 			}
+			if err := tx.DeleteOldWorkspaceBuildDriftJobs(ctx); err != nil {
+				return xerrors.Errorf("failed to delete old workspace build drift jobs: %w", err)
+			}
+
+			deleteOldHealthcheckResultsBefore := start.Add(-maxHealthcheckResultAge)
+			if err := tx.DeleteOldHealthcheckResults(ctx, deleteOldHealthcheckResultsBefore); err != nil {
+				return xerrors.Errorf("failed to delete old healthcheck results: %w", err)
+			}
 
 			logger.Info(ctx, "purged old database entries", slog.F("duration", clk.Since(start)))
 
@@ -388,6 +388,137 @@ func mustCreateAgentLogs(ctx context.Context, t *testing.T, db database.Store, a
 }
 
 //nolint:paralleltest // It uses LockIDDBPurge.
+func TestDeleteOldHealthcheckResults(t *testing.T) {
+	ctx := testutil.Context(t, testutil.WaitShort)
+	clk := quartz.NewMock(t)
+	now := dbtime.Now()
+	threshold := now.Add(-30 * 24 * time.Hour)
+	clk.Set(now).MustWait(ctx)
+
+	db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+
+	// given
+	old, err := db.InsertHealthcheckResult(ctx, database.InsertHealthcheckResultParams{
+		ID:        uuid.New(),
+		CreatedAt: threshold.Add(-time.Hour),
+		Section:   "Database",
+		Severity:  "ok",
+		Warnings:  json.RawMessage("[]"),
+	})
+	require.NoError(t, err)
+	recent, err := db.InsertHealthcheckResult(ctx, database.InsertHealthcheckResultParams{
+		ID:        uuid.New(),
+		CreatedAt: threshold.Add(time.Hour),
+		Section:   "Database",
+		Severity:  "warning",
+		Warnings:  json.RawMessage("[]"),
+	})
+	require.NoError(t, err)
+
+	// when
+	done := awaitDoTick(ctx, t, clk)
+	closer := dbpurge.New(ctx, logger, db, clk)
+	defer closer.Close()
+	<-done // doTick() has now run.
+
+	// then
+	results, err := db.GetHealthcheckResults(ctx, database.GetHealthcheckResultsParams{})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, recent.ID, results[0].ID)
+	require.NotEqual(t, old.ID, results[0].ID)
+}
+
+//nolint:paralleltest // It uses LockIDDBPurge.
+func TestDeleteOldWorkspaceBuildDriftJobs(t *testing.T) {
+	ctx := testutil.Context(t, testutil.WaitShort)
+	clk := quartz.NewMock(t)
+	now := dbtime.Now()
+	clk.Set(now).MustWait(ctx)
+
+	db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+	org := dbgen.Organization(t, db, database.Organization{})
+	user := dbgen.User(t, db, database.User{})
+	_ = dbgen.OrganizationMember(t, db, database.OrganizationMember{UserID: user.ID, OrganizationID: org.ID})
+	tv := dbgen.TemplateVersion(t, db, database.TemplateVersion{OrganizationID: org.ID, CreatedBy: user.ID})
+	tmpl := dbgen.Template(t, db, database.Template{OrganizationID: org.ID, ActiveVersionID: tv.ID, CreatedBy: user.ID})
+	ws := dbgen.Workspace(t, db, database.Workspace{OwnerID: user.ID, OrganizationID: org.ID, TemplateID: tmpl.ID})
+	wb := mustCreateWorkspaceBuild(t, db, org, tv, ws.ID, now.Add(-time.Hour), 1)
+
+	dryRun := func(refreshOnly bool, completed bool) database.ProvisionerJob {
+		job := database.ProvisionerJob{
+			OrganizationID: org.ID,
+			Type:           database.ProvisionerJobTypeTemplateVersionDryRun,
+			Input:          []byte(fmt.Sprintf(`{"template_version_id":%q,"refresh_only":%t}`, tv.ID, refreshOnly)),
+		}
+		if completed {
+			job.CompletedAt = sql.NullTime{Time: now, Valid: true}
+		}
+		job = dbgen.ProvisionerJob(t, db, nil, job)
+		_, err := db.InsertProvisionerJobResourceChanges(ctx, database.InsertProvisionerJobResourceChangesParams{
+			JobID:   job.ID,
+			Address: []string{"docker_container.workspace[0]"},
+			Type:    []string{"docker_container"},
+			Name:    []string{"workspace"},
+			Action:  []database.ProvisionerJobResourceChangeAction{database.ProvisionerJobResourceChangeActionDestroy},
+		})
+		require.NoError(t, err)
+		return job
+	}
+	startCheck := func(job database.ProvisionerJob) {
+		err := db.InsertWorkspaceBuildDriftCheck(ctx, database.InsertWorkspaceBuildDriftCheckParams{
+			WorkspaceBuildID: wb.ID,
+			JobID:            job.ID,
+			StartedAt:        now,
+		})
+		require.NoError(t, err)
+	}
+	completeCheck := func(job database.ProvisionerJob) {
+		_, err := db.UpdateWorkspaceBuildDriftByJobID(ctx, database.UpdateWorkspaceBuildDriftByJobIDParams{
+			CheckedAt: sql.NullTime{Time: now, Valid: true},
+			Drifted:   true,
+			JobID:     job.ID,
+		})
+		require.NoError(t, err)
+	}
+
+	// given
+	// The first check was superseded by the second, whose result is shown
+	// while the third is running.
+	superseded := dryRun(true, true)
+	startCheck(superseded)
+	completeCheck(superseded)
+	checked := dryRun(true, true)
+	startCheck(checked)
+	completeCheck(checked)
+	running := dryRun(true, false)
+	startCheck(running)
+	// Dry-runs of template versions are kept.
+	templateDryRun := dryRun(false, true)
+
+	// when
+	done := awaitDoTick(ctx, t, clk)
+	closer := dbpurge.New(ctx, logger, db, clk)
+	defer closer.Close()
+	<-done // doTick() has now run.
+
+	// then
+	_, err := db.GetProvisionerJobByID(ctx, superseded.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	changes, err := db.GetProvisionerJobResourceChangesByJobIDs(ctx, []uuid.UUID{superseded.ID, checked.ID, running.ID, templateDryRun.ID})
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	for _, job := range []database.ProvisionerJob{checked, running, templateDryRun} {
+		_, err := db.GetProvisionerJobByID(ctx, job.ID)
+		require.NoError(t, err)
+		require.True(t, slices.ContainsFunc(changes, func(change database.ProvisionerJobResourceChange) bool {
+			return change.JobID == job.ID
+		}))
+	}
+}
+
 func TestDeleteOldProvisionerDaemons(t *testing.T) {
 	// TODO: must refactor DeleteOldProvisionerDaemons to allow passing in cutoff
 	//       before using quartz.NewMock
@@ -33,7 +33,17 @@ CREATE TYPE build_reason AS ENUM (
     'autostop',
     'dormancy',
     'failedstop',
-    'autodelete'
+    'autodelete',
+    'drift'
+);
+
+CREATE TYPE connection_type AS ENUM (
+    'ssh',
+    'vscode',
+    'jetbrains',
+    'reconnecting_pty',
+    'port_forward',
+    'app'
 );
 
 CREATE TYPE crypto_key_feature AS ENUM (
@@ -96,7 +106,9 @@ CREATE TYPE notification_message_status AS ENUM (
 
 CREATE TYPE notification_method AS ENUM (
     'smtp',
-    'webhook'
+    'webhook',
+    'slack',
+    'teams'
 );
 
 CREATE TYPE notification_template_kind AS ENUM (
@@ -130,6 +142,13 @@ CREATE TYPE port_share_protocol AS ENUM (
     'https'
 );
 
+CREATE TYPE provisioner_job_resource_change_action AS ENUM (
+    'create',
+    'update',
+    'replace',
+    'destroy'
+);
+
 CREATE TYPE provisioner_job_status AS ENUM (
     'pending',
     'running',
@@ -183,7 +202,9 @@ CREATE TYPE resource_type AS ENUM (
     'custom_role',
     'organization_member',
     'notifications_settings',
-    'notification_template'
+    'notification_template',
+    'template_version_rollout',
+    'workspace_bulk_operation'
 );
 
 CREATE TYPE startup_script_behavior AS ENUM (
@@ -196,6 +217,12 @@ CREATE TYPE tailnet_status AS ENUM (
     'lost'
 );
 
+CREATE TYPE template_version_rollout_status AS ENUM (
+    'canary',
+    'promoted',
+    'rolled_back'
+);
+
 CREATE TYPE user_status AS ENUM (
     'active',
     'suspended',
@@ -230,6 +257,25 @@ CREATE TYPE workspace_app_health AS ENUM (
     'unhealthy'
 );
 
+CREATE TYPE workspace_bulk_operation_action AS ENUM (
+    'start',
+    'stop',
+    'update',
+    'delete'
+);
+
+CREATE TYPE workspace_bulk_operation_workspace_status AS ENUM (
+    'pending',
+    'enqueued',
+    'skipped',
+    'failed'
+);
+
+CREATE TYPE workspace_session_recording_type AS ENUM (
+    'ssh',
+    'reconnecting_pty'
+);
+
 CREATE TYPE workspace_transition AS ENUM (
     'start',
     'stop',
@@ -519,6 +565,32 @@ CREATE TABLE audit_logs (
     resource_icon text NOT NULL
 );
 
+CREATE TABLE connection_logs (
+    id uuid NOT NULL,
+    connect_time timestamp with time zone NOT NULL,
+    organization_id uuid NOT NULL,
+    workspace_owner_id uuid NOT NULL,
+    workspace_id uuid NOT NULL,
+    workspace_name text NOT NULL,
+    agent_id uuid NOT NULL,
+    agent_name text NOT NULL,
+    type connection_type NOT NULL,
+    ip inet,
+    user_id uuid,
+    connection_id uuid NOT NULL,
+    disconnect_time timestamp with time zone,
+    code integer,
+    disconnect_reason text
+);
+
+COMMENT ON TABLE connection_logs IS 'Connections to workspace agents, as reported by the agents.';
+
+COMMENT ON COLUMN connection_logs.user_id IS 'The user that connected, if the client could be matched to a user that coordinated with the agent.';
+
+COMMENT ON COLUMN connection_logs.connection_id IS 'The ID of the connection assigned by the agent, used to match connect and disconnect events.';
+
+COMMENT ON COLUMN connection_logs.code IS 'The exit code of the session, set on disconnect.';
+
 CREATE TABLE crypto_keys (
     feature crypto_key_feature NOT NULL,
     sequence integer NOT NULL,
@@ -693,6 +765,21 @@ CREATE VIEW group_members_expanded AS
 
 COMMENT ON VIEW group_members_expanded IS 'Joins group members with user information, organization ID, group name. Includes both regular group members and organization members (as part of the "Everyone" group).';
 
+CREATE TABLE healthcheck_results (
+    id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    section text NOT NULL,
+    severity text NOT NULL,
+    warnings jsonb DEFAULT '[]'::jsonb NOT NULL,
+    error text
+);
+
+COMMENT ON TABLE healthcheck_results IS 'Results of the sections of past healthcheck reports.';
+
+COMMENT ON COLUMN healthcheck_results.created_at IS 'Time the healthcheck report was generated at.';
+
+COMMENT ON COLUMN healthcheck_results.section IS 'Section of the healthcheck report, e.g. DERP or Database.';
+
 CREATE TABLE jfrog_xray_scans (
     agent_id uuid NOT NULL,
     workspace_id uuid NOT NULL,
@@ -722,6 +809,33 @@ CREATE SEQUENCE licenses_id_seq
 
 ALTER SEQUENCE licenses_id_seq OWNED BY licenses.id;
 
+CREATE TABLE maintenance_windows (
+    id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    template_id uuid,
+    name text NOT NULL,
+    message text DEFAULT ''::text NOT NULL,
+    schedule text NOT NULL,
+    duration bigint NOT NULL,
+    notice_period bigint NOT NULL,
+    block_builds boolean DEFAULT false NOT NULL,
+    last_notified_start timestamp with time zone,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE maintenance_windows IS 'Recurring windows during which autobuild is paused for the workspaces of an organization or template.';
+
+COMMENT ON COLUMN maintenance_windows.schedule IS 'Weekly cron schedule of the start of the window, in the same format as workspace autostart schedules.';
+
+COMMENT ON COLUMN maintenance_windows.duration IS 'Duration of the window in nanoseconds.';
+
+COMMENT ON COLUMN maintenance_windows.notice_period IS 'How long before the start of the window users are notified, in nanoseconds.';
+
+COMMENT ON COLUMN maintenance_windows.block_builds IS 'Whether new workspace builds are rejected during the window.';
+
+COMMENT ON COLUMN maintenance_windows.last_notified_start IS 'Start of the most recent window that users were notified about.';
+
 CREATE TABLE notification_messages (
     id uuid NOT NULL,
     notification_template_id uuid NOT NULL,
@@ -898,6 +1012,18 @@ CREATE SEQUENCE provisioner_job_logs_id_seq
 
 ALTER SEQUENCE provisioner_job_logs_id_seq OWNED BY provisioner_job_logs.id;
 
+CREATE TABLE provisioner_job_resource_changes (
+    job_id uuid NOT NULL,
+    address text NOT NULL,
+    type text NOT NULL,
+    name text NOT NULL,
+    action provisioner_job_resource_change_action NOT NULL
+);
+
+COMMENT ON TABLE provisioner_job_resource_changes IS 'Resource changes planned by template version dry-runs against the state of an existing workspace, or detected by drift checks.';
+
+COMMENT ON COLUMN provisioner_job_resource_changes.address IS 'Terraform address of the resource, e.g. docker_container.workspace[0].';
+
 CREATE VIEW provisioner_job_stats AS
 SELECT
     NULL::uuid AS job_id,
@@ -959,11 +1085,14 @@ CASE
         WHEN (started_at IS NULL) THEN 'pending'::provisioner_job_status
         ELSE 'running'::provisioner_job_status
     END
-END) STORED NOT NULL
+END) STORED NOT NULL,
+    priority integer DEFAULT 0 NOT NULL
 );
 
 COMMENT ON COLUMN provisioner_jobs.job_status IS 'Computed column to track the status of the job.';
 
+COMMENT ON COLUMN provisioner_jobs.priority IS 'Jobs with a higher priority are acquired first. Interactive workspace builds rank above autobuilds, which rank above template version imports and dry-runs.';
+
 CREATE TABLE provisioner_keys (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -1130,6 +1259,25 @@ COMMENT ON COLUMN template_version_parameters.display_order IS 'Specifies the or
 
 COMMENT ON COLUMN template_version_parameters.ephemeral IS 'The value of an ephemeral parameter will not be preserved between consecutive workspace builds.';
 
+CREATE TABLE template_version_rollouts (
+    id uuid NOT NULL,
+    template_id uuid NOT NULL,
+    template_version_id uuid NOT NULL,
+    status template_version_rollout_status DEFAULT 'canary'::template_version_rollout_status NOT NULL,
+    percent integer DEFAULT 0 NOT NULL,
+    group_id uuid,
+    created_by uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT template_version_rollouts_percent_check CHECK (((percent >= 0) AND (percent <= 100)))
+);
+
+COMMENT ON TABLE template_version_rollouts IS 'Staged rollouts of template versions to a canary cohort of workspaces before the version is promoted to the active version.';
+
+COMMENT ON COLUMN template_version_rollouts.percent IS 'Percentage of the workspaces of the template in the canary cohort.';
+
+COMMENT ON COLUMN template_version_rollouts.group_id IS 'Workspaces owned by members of this group are in the canary cohort regardless of the percentage.';
+
 CREATE TABLE template_version_variables (
     template_version_id uuid NOT NULL,
     name text NOT NULL,
@@ -1236,7 +1384,9 @@ CREATE TABLE templates (
     require_active_version boolean DEFAULT false NOT NULL,
     deprecated text DEFAULT ''::text NOT NULL,
     activity_bump bigint DEFAULT '3600000000000'::bigint NOT NULL,
-    max_port_sharing_level app_sharing_level DEFAULT 'owner'::app_sharing_level NOT NULL
+    max_port_sharing_level app_sharing_level DEFAULT 'owner'::app_sharing_level NOT NULL,
+    idle_detection_enabled boolean DEFAULT false NOT NULL,
+    idle_cpu_threshold_percent integer DEFAULT 0 NOT NULL
 );
 
 COMMENT ON COLUMN templates.default_ttl IS 'The default duration for autostop for workspaces created from this template.';
@@ -1257,6 +1407,10 @@ COMMENT ON COLUMN templates.autostart_block_days_of_week IS 'A bitmap of days of
 
 COMMENT ON COLUMN templates.deprecated IS 'If set to a non empty string, the template will no longer be able to be used. The message will be displayed to the user.';
 
+COMMENT ON COLUMN templates.idle_detection_enabled IS 'Only bump workspace deadlines on user input, app requests or CPU load instead of on any open connection.';
+
+COMMENT ON COLUMN templates.idle_cpu_threshold_percent IS 'CPU usage percentage above which a workspace is considered in use when idle detection is enabled. 0 disables CPU-based detection.';
+
 CREATE VIEW template_with_names AS
  SELECT templates.id,
     templates.created_at,
@@ -1286,6 +1440,8 @@ CREATE VIEW template_with_names AS
     templates.deprecated,
     templates.activity_bump,
     templates.max_port_sharing_level,
+    templates.idle_detection_enabled,
+    templates.idle_cpu_threshold_percent,
     COALESCE(visible_users.avatar_url, ''::text) AS created_by_avatar_url,
     COALESCE(visible_users.username, ''::text) AS created_by_username,
     COALESCE(organizations.name, ''::text) AS organization_name,
@@ -1315,6 +1471,15 @@ COMMENT ON COLUMN user_links.oauth_refresh_token_key_id IS 'The ID of the key us
 
 COMMENT ON COLUMN user_links.debug_context IS 'Debug information includes information like id_token and userinfo claims.';
 
+CREATE TABLE workspace_agent_client_peers (
+    peer_id uuid NOT NULL,
+    agent_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE workspace_agent_client_peers IS 'Tailnet peers of clients currently coordinating with a workspace agent, used to attribute connections to users.';
+
 CREATE TABLE workspace_agent_log_sources (
     workspace_agent_id uuid NOT NULL,
     id uuid NOT NULL,
@@ -1523,6 +1688,21 @@ COMMENT ON COLUMN workspace_apps.display_order IS 'Specifies the order in which
 
 COMMENT ON COLUMN workspace_apps.hidden IS 'Determines if the app is not shown in user interfaces.';
 
+CREATE TABLE workspace_build_drift (
+    workspace_build_id uuid NOT NULL,
+    job_id uuid NOT NULL,
+    started_at timestamp with time zone NOT NULL,
+    checked_job_id uuid,
+    checked_at timestamp with time zone,
+    drifted boolean DEFAULT false NOT NULL
+);
+
+COMMENT ON TABLE workspace_build_drift IS 'Drift checks of workspace builds, which compare the infrastructure of running workspaces with their Terraform state.';
+
+COMMENT ON COLUMN workspace_build_drift.job_id IS 'Refresh-only dry-run job of the most recently started drift check.';
+
+COMMENT ON COLUMN workspace_build_drift.checked_job_id IS 'Job of the most recently completed drift check. Its resource changes are the drifted resources.';
+
 CREATE TABLE workspace_build_parameters (
     workspace_build_id uuid NOT NULL,
     name text NOT NULL,
@@ -1572,6 +1752,35 @@ CREATE VIEW workspace_build_with_user AS
 
 COMMENT ON VIEW workspace_build_with_user IS 'Joins in the username + avatar url of the initiated by user.';
 
+CREATE TABLE workspace_bulk_operation_workspaces (
+    bulk_operation_id uuid NOT NULL,
+    workspace_id uuid NOT NULL,
+    status workspace_bulk_operation_workspace_status DEFAULT 'pending'::workspace_bulk_operation_workspace_status NOT NULL,
+    workspace_build_id uuid,
+    message text DEFAULT ''::text NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON COLUMN workspace_bulk_operation_workspaces.message IS 'Reason the workspace was skipped or its build failed to enqueue.';
+
+CREATE TABLE workspace_bulk_operations (
+    id uuid NOT NULL,
+    initiator_id uuid NOT NULL,
+    initiator_scope api_key_scope NOT NULL,
+    action workspace_bulk_operation_action NOT NULL,
+    search text NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    completed_at timestamp with time zone
+);
+
+COMMENT ON TABLE workspace_bulk_operations IS 'Builds of many workspaces matching a search query, enqueued in the background at a limited rate.';
+
+COMMENT ON COLUMN workspace_bulk_operations.initiator_scope IS 'Scope of the API key the operation was created with. Builds are authorized as the initiator with this scope.';
+
+COMMENT ON COLUMN workspace_bulk_operations.search IS 'Workspace search query the workspaces of the operation were resolved from.';
+
+COMMENT ON COLUMN workspace_bulk_operations.completed_at IS 'Time the builds of all workspaces of the operation were enqueued, skipped or failed.';
+
 CREATE TABLE workspace_proxies (
     id uuid NOT NULL,
     name text NOT NULL,
@@ -1641,6 +1850,26 @@ CREATE TABLE workspace_resources (
     daily_cost integer DEFAULT 0 NOT NULL
 );
 
+CREATE TABLE workspace_session_recordings (
+    id uuid NOT NULL,
+    workspace_id uuid NOT NULL,
+    agent_id uuid NOT NULL,
+    type workspace_session_recording_type NOT NULL,
+    command text NOT NULL,
+    started_at timestamp with time zone NOT NULL,
+    ended_at timestamp with time zone NOT NULL,
+    input_recorded boolean NOT NULL,
+    truncated boolean NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    data bytea NOT NULL
+);
+
+COMMENT ON TABLE workspace_session_recordings IS 'Recordings of PTY sessions in asciicast v2 format, uploaded by workspace agents.';
+
+COMMENT ON COLUMN workspace_session_recordings.input_recorded IS 'Whether the recording includes input events in addition to output.';
+
+COMMENT ON COLUMN workspace_session_recordings.truncated IS 'Whether the recording was stopped early because it reached the maximum size.';
+
 CREATE TABLE workspaces (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -1656,11 +1885,17 @@ CREATE TABLE workspaces (
     dormant_at timestamp with time zone,
     deleting_at timestamp with time zone,
     automatic_updates automatic_updates DEFAULT 'never'::automatic_updates NOT NULL,
-    favorite boolean DEFAULT false NOT NULL
+    favorite boolean DEFAULT false NOT NULL,
+    user_acl jsonb DEFAULT '{}'::jsonb NOT NULL,
+    group_acl jsonb DEFAULT '{}'::jsonb NOT NULL
 );
 
 COMMENT ON COLUMN workspaces.favorite IS 'Favorite is true if the workspace owner has favorited the workspace.';
 
+COMMENT ON COLUMN workspaces.user_acl IS 'Users the workspace is shared with, mapped to the actions they may perform.';
+
+COMMENT ON COLUMN workspaces.group_acl IS 'Groups the workspace is shared with, mapped to the actions their members may perform.';
+
 ALTER TABLE ONLY licenses ALTER COLUMN id SET DEFAULT nextval('licenses_id_seq'::regclass);
 
 ALTER TABLE ONLY provisioner_job_logs ALTER COLUMN id SET DEFAULT nextval('provisioner_job_logs_id_seq'::regclass);
@@ -1682,6 +1917,9 @@ ALTER TABLE ONLY api_keys
 ALTER TABLE ONLY audit_logs
     ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY connection_logs
+    ADD CONSTRAINT connection_logs_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY crypto_keys
     ADD CONSTRAINT crypto_keys_pkey PRIMARY KEY (feature, sequence);
 
@@ -1718,6 +1956,9 @@ ALTER TABLE ONLY groups
 ALTER TABLE ONLY groups
     ADD CONSTRAINT groups_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY healthcheck_results
+    ADD CONSTRAINT healthcheck_results_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY jfrog_xray_scans
     ADD CONSTRAINT jfrog_xray_scans_pkey PRIMARY KEY (agent_id, workspace_id);
 
@@ -1727,6 +1968,12 @@ ALTER TABLE ONLY licenses
 ALTER TABLE ONLY licenses
     ADD CONSTRAINT licenses_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY maintenance_windows
+    ADD CONSTRAINT maintenance_windows_organization_id_name_key UNIQUE (organization_id, name);
+
+ALTER TABLE ONLY maintenance_windows
+    ADD CONSTRAINT maintenance_windows_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY notification_messages
     ADD CONSTRAINT notification_messages_pkey PRIMARY KEY (id);
 
@@ -1793,6 +2040,9 @@ ALTER TABLE ONLY provisioner_daemons
 ALTER TABLE ONLY provisioner_job_logs
     ADD CONSTRAINT provisioner_job_logs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY provisioner_job_resource_changes
+    ADD CONSTRAINT provisioner_job_resource_changes_pkey PRIMARY KEY (job_id, address);
+
 ALTER TABLE ONLY provisioner_jobs
     ADD CONSTRAINT provisioner_jobs_pkey PRIMARY KEY (id);
 
@@ -1826,6 +2076,9 @@ ALTER TABLE ONLY template_usage_stats
 ALTER TABLE ONLY template_version_parameters
     ADD CONSTRAINT template_version_parameters_template_version_id_name_key UNIQUE (template_version_id, name);
 
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY template_version_variables
     ADD CONSTRAINT template_version_variables_template_version_id_name_key UNIQUE (template_version_id, name);
 
@@ -1847,6 +2100,9 @@ ALTER TABLE ONLY user_links
 ALTER TABLE ONLY users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY workspace_agent_client_peers
+    ADD CONSTRAINT workspace_agent_client_peers_pkey PRIMARY KEY (peer_id);
+
 ALTER TABLE ONLY workspace_agent_log_sources
     ADD CONSTRAINT workspace_agent_log_sources_pkey PRIMARY KEY (workspace_agent_id, id);
 
@@ -1874,6 +2130,9 @@ ALTER TABLE ONLY workspace_apps
 ALTER TABLE ONLY workspace_apps
     ADD CONSTRAINT workspace_apps_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY workspace_build_drift
+    ADD CONSTRAINT workspace_build_drift_pkey PRIMARY KEY (workspace_build_id);
+
 ALTER TABLE ONLY workspace_build_parameters
     ADD CONSTRAINT workspace_build_parameters_workspace_build_id_name_key UNIQUE (workspace_build_id, name);
 
@@ -1886,6 +2145,12 @@ ALTER TABLE ONLY workspace_builds
 ALTER TABLE ONLY workspace_builds
     ADD CONSTRAINT workspace_builds_workspace_id_build_number_key UNIQUE (workspace_id, build_number);
 
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_pkey PRIMARY KEY (bulk_operation_id, workspace_id);
+
+ALTER TABLE ONLY workspace_bulk_operations
+    ADD CONSTRAINT workspace_bulk_operations_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY workspace_proxies
     ADD CONSTRAINT workspace_proxies_pkey PRIMARY KEY (id);
 
@@ -1901,9 +2166,20 @@ ALTER TABLE ONLY workspace_resource_metadata
 ALTER TABLE ONLY workspace_resources
     ADD CONSTRAINT workspace_resources_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY workspace_session_recordings
+    ADD CONSTRAINT workspace_session_recordings_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY workspaces
     ADD CONSTRAINT workspaces_pkey PRIMARY KEY (id);
 
+CREATE INDEX connection_logs_connect_time_idx ON connection_logs USING btree (connect_time DESC);
+
+CREATE UNIQUE INDEX connection_logs_connection_id_agent_id_idx ON connection_logs USING btree (connection_id, agent_id);
+
+CREATE INDEX connection_logs_workspace_id_idx ON connection_logs USING btree (workspace_id);
+
+CREATE INDEX healthcheck_results_section_created_at_idx ON healthcheck_results USING btree (section, created_at DESC);
+
 CREATE INDEX idx_agent_stats_created_at ON workspace_agent_stats USING btree (created_at);
 
 CREATE INDEX idx_agent_stats_user_id ON workspace_agent_stats USING btree (user_id);
@@ -1952,12 +2228,20 @@ CREATE UNIQUE INDEX idx_users_email ON users USING btree (email) WHERE (deleted
 
 CREATE UNIQUE INDEX idx_users_username ON users USING btree (username) WHERE (deleted = false);
 
+CREATE INDEX maintenance_windows_template_id_idx ON maintenance_windows USING btree (template_id);
+
 CREATE UNIQUE INDEX notification_messages_dedupe_hash_idx ON notification_messages USING btree (dedupe_hash);
 
+CREATE INDEX notification_messages_user_id_template_id_method_idx ON notification_messages USING btree (user_id, notification_template_id, method);
+
 CREATE UNIQUE INDEX organizations_single_default_org ON organizations USING btree (is_default) WHERE (is_default = true);
 
 CREATE INDEX provisioner_job_logs_id_job_id_idx ON provisioner_job_logs USING btree (job_id, id);
 
+CREATE INDEX provisioner_jobs_completed_at_idx ON provisioner_jobs USING btree (completed_at DESC) WHERE (completed_at IS NOT NULL);
+
+CREATE INDEX provisioner_jobs_running_idx ON provisioner_jobs USING btree (organization_id, initiator_id) WHERE ((started_at IS NOT NULL) AND (completed_at IS NULL));
+
 CREATE INDEX provisioner_jobs_started_at_idx ON provisioner_jobs USING btree (started_at) WHERE (started_at IS NULL);
 
 CREATE UNIQUE INDEX provisioner_keys_organization_id_name_idx ON provisioner_keys USING btree (organization_id, lower((name)::text));
@@ -1970,6 +2254,10 @@ CREATE UNIQUE INDEX template_usage_stats_start_time_template_id_user_id_idx ON t
 
 COMMENT ON INDEX template_usage_stats_start_time_template_id_user_id_idx IS 'Index for primary key.';
 
+CREATE UNIQUE INDEX template_version_rollouts_template_id_canary_idx ON template_version_rollouts USING btree (template_id) WHERE (status = 'canary'::template_version_rollout_status);
+
+CREATE INDEX template_version_rollouts_template_version_id_idx ON template_version_rollouts USING btree (template_version_id);
+
 CREATE UNIQUE INDEX templates_organization_id_name_idx ON templates USING btree (organization_id, lower((name)::text)) WHERE (deleted = false);
 
 CREATE UNIQUE INDEX user_links_linked_id_login_type_idx ON user_links USING btree (linked_id, login_type) WHERE (linked_id <> ''::text);
@@ -1978,6 +2266,8 @@ CREATE UNIQUE INDEX users_email_lower_idx ON users USING btree (lower(email)) WH
 
 CREATE UNIQUE INDEX users_username_lower_idx ON users USING btree (lower(username)) WHERE (deleted = false);
 
+CREATE INDEX workspace_agent_client_peers_agent_id_idx ON workspace_agent_client_peers USING btree (agent_id);
+
 CREATE INDEX workspace_agent_scripts_workspace_agent_id_idx ON workspace_agent_scripts USING btree (workspace_agent_id);
 
 COMMENT ON INDEX workspace_agent_scripts_workspace_agent_id_idx IS 'Foreign key support index for faster lookups';
@@ -1994,10 +2284,16 @@ CREATE INDEX workspace_agents_resource_id_idx ON workspace_agents USING btree (r
 
 CREATE INDEX workspace_app_stats_workspace_id_idx ON workspace_app_stats USING btree (workspace_id);
 
+CREATE INDEX workspace_build_drift_job_id_idx ON workspace_build_drift USING btree (job_id);
+
+CREATE INDEX workspace_bulk_operations_completed_at_idx ON workspace_bulk_operations USING btree (completed_at) WHERE (completed_at IS NULL);
+
 CREATE UNIQUE INDEX workspace_proxies_lower_name_idx ON workspace_proxies USING btree (lower(name)) WHERE (deleted = false);
 
 CREATE INDEX workspace_resources_job_id_idx ON workspace_resources USING btree (job_id);
 
+CREATE INDEX workspace_session_recordings_workspace_id_started_at_idx ON workspace_session_recordings USING btree (workspace_id, started_at DESC);
+
 CREATE UNIQUE INDEX workspaces_owner_id_lower_idx ON workspaces USING btree (owner_id, lower((name)::text)) WHERE (deleted = false);
 
 CREATE OR REPLACE VIEW provisioner_job_stats AS
@@ -2112,6 +2408,12 @@ ALTER TABLE ONLY jfrog_xray_scans
 ALTER TABLE ONLY jfrog_xray_scans
     ADD CONSTRAINT jfrog_xray_scans_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY maintenance_windows
+    ADD CONSTRAINT maintenance_windows_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY maintenance_windows
+    ADD CONSTRAINT maintenance_windows_template_id_fkey FOREIGN KEY (template_id) REFERENCES templates(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY notification_messages
     ADD CONSTRAINT notification_messages_notification_template_id_fkey FOREIGN KEY (notification_template_id) REFERENCES notification_templates(id) ON DELETE CASCADE;
 
@@ -2157,6 +2459,9 @@ ALTER TABLE ONLY provisioner_daemons
 ALTER TABLE ONLY provisioner_job_logs
     ADD CONSTRAINT provisioner_job_logs_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY provisioner_job_resource_changes
+    ADD CONSTRAINT provisioner_job_resource_changes_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY provisioner_job_timings
     ADD CONSTRAINT provisioner_job_timings_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
@@ -2184,6 +2489,18 @@ ALTER TABLE ONLY tailnet_tunnels
 ALTER TABLE ONLY template_version_parameters
     ADD CONSTRAINT template_version_parameters_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE RESTRICT;
+
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_group_id_fkey FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE SET NULL;
+
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_template_id_fkey FOREIGN KEY (template_id) REFERENCES templates(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY template_version_variables
     ADD CONSTRAINT template_version_variables_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 
@@ -2214,6 +2531,12 @@ ALTER TABLE ONLY user_links
 ALTER TABLE ONLY user_links
     ADD CONSTRAINT user_links_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_agent_client_peers
+    ADD CONSTRAINT workspace_agent_client_peers_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_agent_client_peers
+    ADD CONSTRAINT workspace_agent_client_peers_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspace_agent_log_sources
     ADD CONSTRAINT workspace_agent_log_sources_workspace_agent_id_fkey FOREIGN KEY (workspace_agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 
@@ -2244,6 +2567,15 @@ ALTER TABLE ONLY workspace_app_stats
 ALTER TABLE ONLY workspace_apps
     ADD CONSTRAINT workspace_apps_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_build_drift
+    ADD CONSTRAINT workspace_build_drift_checked_job_id_fkey FOREIGN KEY (checked_job_id) REFERENCES provisioner_jobs(id) ON DELETE SET NULL;
+
+ALTER TABLE ONLY workspace_build_drift
+    ADD CONSTRAINT workspace_build_drift_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_build_drift
+    ADD CONSTRAINT workspace_build_drift_workspace_build_id_fkey FOREIGN KEY (workspace_build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspace_build_parameters
     ADD CONSTRAINT workspace_build_parameters_workspace_build_id_fkey FOREIGN KEY (workspace_build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
 
@@ -2256,12 +2588,27 @@ ALTER TABLE ONLY workspace_builds
 ALTER TABLE ONLY workspace_builds
     ADD CONSTRAINT workspace_builds_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_bulk_operation_id_fkey FOREIGN KEY (bulk_operation_id) REFERENCES workspace_bulk_operations(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_workspace_build_id_fkey FOREIGN KEY (workspace_build_id) REFERENCES workspace_builds(id) ON DELETE SET NULL;
+
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_bulk_operations
+    ADD CONSTRAINT workspace_bulk_operations_initiator_id_fkey FOREIGN KEY (initiator_id) REFERENCES users(id) ON DELETE RESTRICT;
+
 ALTER TABLE ONLY workspace_resource_metadata
     ADD CONSTRAINT workspace_resource_metadata_workspace_resource_id_fkey FOREIGN KEY (workspace_resource_id) REFERENCES workspace_resources(id) ON DELETE CASCADE;
 
 ALTER TABLE ONLY workspace_resources
     ADD CONSTRAINT workspace_resources_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_session_recordings
+    ADD CONSTRAINT workspace_session_recordings_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspaces
     ADD CONSTRAINT workspaces_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE RESTRICT;
 
@@ -11,6 +11,7 @@ const (
 	LockIDDBRollup
 	LockIDDBPurge
 	LockIDNotificationsReportGenerator
+	LockIDHealthcheckHistory
 )
 
 // GenLockID generates a unique and consistent lock ID from a given string.
@@ -0,0 +1,3 @@
+-- This has to be outside a transaction
+ALTER TYPE notification_method ADD VALUE IF NOT EXISTS 'slack';
+ALTER TYPE notification_method ADD VALUE IF NOT EXISTS 'teams';
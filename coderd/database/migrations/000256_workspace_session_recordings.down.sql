@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS workspace_session_recordings;
+
+DROP TYPE IF EXISTS workspace_session_recording_type;
@@ -0,0 +1,24 @@
+CREATE TYPE workspace_session_recording_type AS ENUM ('ssh', 'reconnecting_pty');
+
+CREATE TABLE workspace_session_recordings (
+	id uuid NOT NULL,
+	workspace_id uuid NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+	-- The agent is not a foreign key so that recordings outlive the build
+	-- which created them.
+	agent_id uuid NOT NULL,
+	type workspace_session_recording_type NOT NULL,
+	command text NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	ended_at timestamp with time zone NOT NULL,
+	input_recorded boolean NOT NULL,
+	truncated boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	data bytea NOT NULL,
+	PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE workspace_session_recordings IS 'Recordings of PTY sessions in asciicast v2 format, uploaded by workspace agents.';
+COMMENT ON COLUMN workspace_session_recordings.input_recorded IS 'Whether the recording includes input events in addition to output.';
+COMMENT ON COLUMN workspace_session_recordings.truncated IS 'Whether the recording was stopped early because it reached the maximum size.';
+
+CREATE INDEX workspace_session_recordings_workspace_id_started_at_idx ON workspace_session_recordings (workspace_id, started_at DESC);
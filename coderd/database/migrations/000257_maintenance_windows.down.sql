@@ -0,0 +1,3 @@
+DELETE FROM notification_templates WHERE id = 'd6b7b0a9-8d4e-4c6b-a6e6-7b9f4b3e2c15';
+
+DROP TABLE IF EXISTS maintenance_windows;
@@ -0,0 +1,32 @@
+CREATE TABLE maintenance_windows (
+	id uuid NOT NULL,
+	organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+	-- A window without a template applies to every template in the
+	-- organization.
+	template_id uuid REFERENCES templates (id) ON DELETE CASCADE,
+	name text NOT NULL,
+	message text NOT NULL DEFAULT '',
+	schedule text NOT NULL,
+	duration bigint NOT NULL,
+	notice_period bigint NOT NULL,
+	block_builds boolean NOT NULL DEFAULT false,
+	last_notified_start timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (id),
+	UNIQUE (organization_id, name)
+);
+
+COMMENT ON TABLE maintenance_windows IS 'Recurring windows during which autobuild is paused for the workspaces of an organization or template.';
+COMMENT ON COLUMN maintenance_windows.schedule IS 'Weekly cron schedule of the start of the window, in the same format as workspace autostart schedules.';
+COMMENT ON COLUMN maintenance_windows.duration IS 'Duration of the window in nanoseconds.';
+COMMENT ON COLUMN maintenance_windows.notice_period IS 'How long before the start of the window users are notified, in nanoseconds.';
+COMMENT ON COLUMN maintenance_windows.block_builds IS 'Whether new workspace builds are rejected during the window.';
+COMMENT ON COLUMN maintenance_windows.last_notified_start IS 'Start of the most recent window that users were notified about.';
+
+CREATE INDEX maintenance_windows_template_id_idx ON maintenance_windows (template_id);
+
+INSERT INTO notification_templates (id, name, title_template, body_template, "group", actions)
+VALUES ('d6b7b0a9-8d4e-4c6b-a6e6-7b9f4b3e2c15', 'Maintenance Window Scheduled', E'Scheduled maintenance "{{.Labels.name}}"',
+        E'Hi {{.UserName}},\n\nThe maintenance window **{{.Labels.name}}** starts at **{{.Labels.starts_at}}** and ends at **{{.Labels.ends_at}}**.\nAutomatic starts, stops and deletions of your workspaces are deferred until the window ends.{{if eq .Labels.block_builds "true"}}\nWorkspaces cannot be built during the window.{{end}}{{if .Labels.message}}\n\n{{.Labels.message}}{{end}}',
+        'Workspace Events', '[]'::jsonb);
@@ -0,0 +1,2 @@
+ALTER TABLE workspaces DROP COLUMN group_acl;
+ALTER TABLE workspaces DROP COLUMN user_acl;
@@ -0,0 +1,5 @@
+ALTER TABLE workspaces ADD COLUMN user_acl jsonb NOT NULL DEFAULT '{}';
+ALTER TABLE workspaces ADD COLUMN group_acl jsonb NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN workspaces.user_acl IS 'Users the workspace is shared with, mapped to the actions they may perform.';
+COMMENT ON COLUMN workspaces.group_acl IS 'Groups the workspace is shared with, mapped to the actions their members may perform.';
@@ -0,0 +1,3 @@
+DELETE FROM notification_templates WHERE id = '5b4e8f0c-3a7d-4b9e-9c21-6f8d2e1a7b43';
+
+DROP TABLE IF EXISTS healthcheck_results;
@@ -0,0 +1,25 @@
+CREATE TABLE healthcheck_results (
+	id uuid NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	section text NOT NULL,
+	severity text NOT NULL,
+	warnings jsonb NOT NULL DEFAULT '[]'::jsonb,
+	error text,
+	PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE healthcheck_results IS 'Results of the sections of past healthcheck reports.';
+COMMENT ON COLUMN healthcheck_results.created_at IS 'Time the healthcheck report was generated at.';
+COMMENT ON COLUMN healthcheck_results.section IS 'Section of the healthcheck report, e.g. DERP or Database.';
+
+CREATE INDEX healthcheck_results_section_created_at_idx ON healthcheck_results (section, created_at DESC);
+
+INSERT INTO notification_templates (id, name, title_template, body_template, "group", actions)
+VALUES ('5b4e8f0c-3a7d-4b9e-9c21-6f8d2e1a7b43', 'Deployment Health Changed', E'Deployment health: {{.Labels.section}} is {{.Labels.severity}}',
+        E'Hi {{.UserName}},\n\nThe health of **{{.Labels.section}}** changed from **{{.Labels.previous_severity}}** to **{{.Labels.severity}}**.{{if .Labels.summary}}\n\n{{.Labels.summary}}{{end}}',
+        'Deployment Events', '[
+        {
+            "label": "View health",
+            "url": "{{ base_url }}/health"
+        }
+    ]'::jsonb);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS workspace_agent_client_peers;
+DROP TABLE IF EXISTS connection_logs;
+
+DROP TYPE IF EXISTS connection_type;
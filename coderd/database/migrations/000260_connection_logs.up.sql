@@ -0,0 +1,50 @@
+CREATE TYPE connection_type AS ENUM (
+	'ssh',
+	'vscode',
+	'jetbrains',
+	'reconnecting_pty',
+	'port_forward',
+	'app'
+);
+
+CREATE TABLE connection_logs (
+	id uuid NOT NULL,
+	connect_time timestamp with time zone NOT NULL,
+	organization_id uuid NOT NULL,
+	-- The workspace and agent are not foreign keys so that logs outlive the
+	-- workspace.
+	workspace_owner_id uuid NOT NULL,
+	workspace_id uuid NOT NULL,
+	workspace_name text NOT NULL,
+	agent_id uuid NOT NULL,
+	agent_name text NOT NULL,
+	type connection_type NOT NULL,
+	ip inet,
+	user_id uuid,
+	connection_id uuid NOT NULL,
+	disconnect_time timestamp with time zone,
+	code integer,
+	disconnect_reason text,
+	PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE connection_logs IS 'Connections to workspace agents, as reported by the agents.';
+COMMENT ON COLUMN connection_logs.user_id IS 'The user that connected, if the client could be matched to a user that coordinated with the agent.';
+COMMENT ON COLUMN connection_logs.connection_id IS 'The ID of the connection assigned by the agent, used to match connect and disconnect events.';
+COMMENT ON COLUMN connection_logs.code IS 'The exit code of the session, set on disconnect.';
+
+CREATE UNIQUE INDEX connection_logs_connection_id_agent_id_idx ON connection_logs (connection_id, agent_id);
+CREATE INDEX connection_logs_connect_time_idx ON connection_logs (connect_time DESC);
+CREATE INDEX connection_logs_workspace_id_idx ON connection_logs (workspace_id);
+
+CREATE TABLE workspace_agent_client_peers (
+	peer_id uuid NOT NULL,
+	agent_id uuid NOT NULL REFERENCES workspace_agents (id) ON DELETE CASCADE,
+	user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (peer_id)
+);
+
+COMMENT ON TABLE workspace_agent_client_peers IS 'Tailnet peers of clients currently coordinating with a workspace agent, used to attribute connections to users.';
+
+CREATE INDEX workspace_agent_client_peers_agent_id_idx ON workspace_agent_client_peers (agent_id);
@@ -0,0 +1,4 @@
+-- Values cannot be dropped from the resource_type enum, so the up migration
+-- adds 'template_version_rollout' with IF NOT EXISTS.
+DROP TABLE IF EXISTS template_version_rollouts;
+DROP TYPE IF EXISTS template_version_rollout_status;
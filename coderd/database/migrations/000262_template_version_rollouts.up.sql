@@ -0,0 +1,29 @@
+CREATE TYPE template_version_rollout_status AS ENUM (
+	'canary',
+	'promoted',
+	'rolled_back'
+);
+
+CREATE TABLE template_version_rollouts (
+	id uuid NOT NULL,
+	template_id uuid NOT NULL REFERENCES templates (id) ON DELETE CASCADE,
+	template_version_id uuid NOT NULL REFERENCES template_versions (id) ON DELETE CASCADE,
+	status template_version_rollout_status NOT NULL DEFAULT 'canary'::template_version_rollout_status,
+	percent integer NOT NULL DEFAULT 0,
+	group_id uuid REFERENCES groups (id) ON DELETE SET NULL,
+	created_by uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (id),
+	CONSTRAINT template_version_rollouts_percent_check CHECK (percent >= 0 AND percent <= 100)
+);
+
+COMMENT ON TABLE template_version_rollouts IS 'Staged rollouts of template versions to a canary cohort of workspaces before the version is promoted to the active version.';
+COMMENT ON COLUMN template_version_rollouts.percent IS 'Percentage of the workspaces of the template in the canary cohort.';
+COMMENT ON COLUMN template_version_rollouts.group_id IS 'Workspaces owned by members of this group are in the canary cohort regardless of the percentage.';
+
+-- A template has at most one canary at a time.
+CREATE UNIQUE INDEX template_version_rollouts_template_id_canary_idx ON template_version_rollouts (template_id) WHERE status = 'canary'::template_version_rollout_status;
+CREATE INDEX template_version_rollouts_template_version_id_idx ON template_version_rollouts (template_version_id);
+
+ALTER TYPE resource_type ADD VALUE IF NOT EXISTS 'template_version_rollout';
@@ -0,0 +1,6 @@
+-- Values cannot be dropped from the resource_type enum, so the up migration
+-- adds 'workspace_bulk_operation' with IF NOT EXISTS.
+DROP TABLE IF EXISTS workspace_bulk_operation_workspaces;
+DROP TABLE IF EXISTS workspace_bulk_operations;
+DROP TYPE IF EXISTS workspace_bulk_operation_workspace_status;
+DROP TYPE IF EXISTS workspace_bulk_operation_action;
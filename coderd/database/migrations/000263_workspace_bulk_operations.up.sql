@@ -0,0 +1,45 @@
+CREATE TYPE workspace_bulk_operation_action AS ENUM (
+	'start',
+	'stop',
+	'update',
+	'delete'
+);
+
+CREATE TYPE workspace_bulk_operation_workspace_status AS ENUM (
+	'pending',
+	'enqueued',
+	'skipped',
+	'failed'
+);
+
+CREATE TABLE workspace_bulk_operations (
+	id uuid NOT NULL,
+	initiator_id uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT,
+	initiator_scope api_key_scope NOT NULL,
+	action workspace_bulk_operation_action NOT NULL,
+	search text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	completed_at timestamp with time zone,
+	PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE workspace_bulk_operations IS 'Builds of many workspaces matching a search query, enqueued in the background at a limited rate.';
+COMMENT ON COLUMN workspace_bulk_operations.initiator_scope IS 'Scope of the API key the operation was created with. Builds are authorized as the initiator with this scope.';
+COMMENT ON COLUMN workspace_bulk_operations.search IS 'Workspace search query the workspaces of the operation were resolved from.';
+COMMENT ON COLUMN workspace_bulk_operations.completed_at IS 'Time the builds of all workspaces of the operation were enqueued, skipped or failed.';
+
+CREATE INDEX workspace_bulk_operations_completed_at_idx ON workspace_bulk_operations (completed_at) WHERE completed_at IS NULL;
+
+CREATE TABLE workspace_bulk_operation_workspaces (
+	bulk_operation_id uuid NOT NULL REFERENCES workspace_bulk_operations (id) ON DELETE CASCADE,
+	workspace_id uuid NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+	status workspace_bulk_operation_workspace_status NOT NULL DEFAULT 'pending'::workspace_bulk_operation_workspace_status,
+	workspace_build_id uuid REFERENCES workspace_builds (id) ON DELETE SET NULL,
+	message text NOT NULL DEFAULT ''::text,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (bulk_operation_id, workspace_id)
+);
+
+COMMENT ON COLUMN workspace_bulk_operation_workspaces.message IS 'Reason the workspace was skipped or its build failed to enqueue.';
+
+ALTER TYPE resource_type ADD VALUE IF NOT EXISTS 'workspace_bulk_operation';
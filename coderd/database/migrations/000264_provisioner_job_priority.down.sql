@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS provisioner_jobs_completed_at_idx;
+DROP INDEX IF EXISTS provisioner_jobs_running_idx;
+ALTER TABLE provisioner_jobs DROP COLUMN IF EXISTS priority;
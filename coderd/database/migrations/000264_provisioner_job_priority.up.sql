@@ -0,0 +1,10 @@
+ALTER TABLE provisioner_jobs ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN provisioner_jobs.priority IS 'Jobs with a higher priority are acquired first. Interactive workspace builds rank above autobuilds, which rank above template version imports and dry-runs.';
+
+-- Used to count the running jobs of an organization or user for fair-share
+-- scheduling.
+CREATE INDEX provisioner_jobs_running_idx ON provisioner_jobs USING btree (organization_id, initiator_id) WHERE (started_at IS NOT NULL AND completed_at IS NULL);
+
+-- Used to estimate the duration of queued jobs from recently completed ones.
+CREATE INDEX provisioner_jobs_completed_at_idx ON provisioner_jobs USING btree (completed_at DESC) WHERE (completed_at IS NOT NULL);
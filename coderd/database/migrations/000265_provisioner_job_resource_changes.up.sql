@@ -0,0 +1,18 @@
+CREATE TYPE provisioner_job_resource_change_action AS ENUM (
+	'create',
+	'update',
+	'replace',
+	'destroy'
+);
+
+CREATE TABLE provisioner_job_resource_changes (
+	job_id uuid NOT NULL REFERENCES provisioner_jobs (id) ON DELETE CASCADE,
+	address text NOT NULL,
+	type text NOT NULL,
+	name text NOT NULL,
+	action provisioner_job_resource_change_action NOT NULL,
+	PRIMARY KEY (job_id, address)
+);
+
+COMMENT ON TABLE provisioner_job_resource_changes IS 'Resource changes planned by template version dry-runs against the state of an existing workspace.';
+COMMENT ON COLUMN provisioner_job_resource_changes.address IS 'Terraform address of the resource, e.g. docker_container.workspace[0].';
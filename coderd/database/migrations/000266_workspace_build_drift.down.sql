@@ -0,0 +1,7 @@
+DELETE FROM notification_templates WHERE id = 'f3b8a6d2-4c1e-4e7a-9d25-8a1b6c3e5f47';
+
+COMMENT ON TABLE provisioner_job_resource_changes IS 'Resource changes planned by template version dry-runs against the state of an existing workspace.';
+
+DROP TABLE workspace_build_drift;
+
+-- It's not possible to delete enum values.
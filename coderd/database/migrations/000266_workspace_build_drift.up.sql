@@ -0,0 +1,29 @@
+ALTER TYPE build_reason ADD VALUE IF NOT EXISTS 'drift';
+
+CREATE TABLE workspace_build_drift (
+	workspace_build_id uuid NOT NULL REFERENCES workspace_builds (id) ON DELETE CASCADE,
+	job_id uuid NOT NULL REFERENCES provisioner_jobs (id) ON DELETE CASCADE,
+	started_at timestamp with time zone NOT NULL,
+	checked_job_id uuid REFERENCES provisioner_jobs (id) ON DELETE SET NULL,
+	checked_at timestamp with time zone,
+	drifted boolean NOT NULL DEFAULT false,
+	PRIMARY KEY (workspace_build_id)
+);
+
+COMMENT ON TABLE workspace_build_drift IS 'Drift checks of workspace builds, which compare the infrastructure of running workspaces with their Terraform state.';
+COMMENT ON COLUMN workspace_build_drift.job_id IS 'Refresh-only dry-run job of the most recently started drift check.';
+COMMENT ON COLUMN workspace_build_drift.checked_job_id IS 'Job of the most recently completed drift check. Its resource changes are the drifted resources.';
+
+CREATE INDEX workspace_build_drift_job_id_idx ON workspace_build_drift (job_id);
+
+COMMENT ON TABLE provisioner_job_resource_changes IS 'Resource changes planned by template version dry-runs against the state of an existing workspace, or detected by drift checks.';
+
+INSERT INTO notification_templates (id, name, title_template, body_template, "group", actions)
+VALUES ('f3b8a6d2-4c1e-4e7a-9d25-8a1b6c3e5f47', 'Workspace Drifted', E'Workspace "{{.Labels.name}}" has drifted',
+        E'Hi {{.UserName}},\n\nResources of your workspace **{{.Labels.name}}** were changed or deleted outside of Coder:\n\n{{.Labels.resources}}\n\nRestart the workspace to restore them.',
+        'Workspace Events', '[
+        {
+            "label": "View workspace",
+            "url": "{{ base_url }}/@{{.UserUsername}}/{{.Labels.name}}"
+        }
+    ]'::jsonb);
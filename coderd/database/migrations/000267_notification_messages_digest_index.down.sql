@@ -0,0 +1 @@
+DROP INDEX IF EXISTS notification_messages_user_id_template_id_method_idx;
@@ -0,0 +1,3 @@
+-- Speeds up looking for the oldest outstanding message of a user, template and
+-- method when notification digests are enabled.
+CREATE INDEX notification_messages_user_id_template_id_method_idx ON notification_messages (user_id, notification_template_id, method);
@@ -0,0 +1,14 @@
+INSERT INTO workspace_session_recordings (id, workspace_id, agent_id, type, command, started_at, ended_at, input_recorded, truncated, created_at, data)
+VALUES (
+	'8f4c2a61-5e0b-4d3e-9b7a-1c2d3e4f5a6b',
+	'3a9a1feb-e89d-457c-9d53-ac751b198ebe',
+	'7a1ce5f8-8d00-431c-ad1b-97a846512804',
+	'ssh',
+	'',
+	NOW() - INTERVAL '10 minutes',
+	NOW() - INTERVAL '5 minutes',
+	false,
+	false,
+	NOW() - INTERVAL '5 minutes',
+	'{"version": 2, "width": 80, "height": 24}'::bytea
+);
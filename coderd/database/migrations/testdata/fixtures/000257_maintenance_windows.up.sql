@@ -0,0 +1,14 @@
+INSERT INTO maintenance_windows (id, organization_id, template_id, name, message, schedule, duration, notice_period, block_builds, created_at, updated_at)
+VALUES (
+	'2e7d6c1b-9a3f-4b8e-8c5d-0f1a2b3c4d5e',
+	'bb640d07-ca8a-4869-b6bc-ae61ebb2fda1',
+	'4cc1f466-f326-477e-8762-9d0c6781fc56',
+	'weekly-upgrade',
+	'Kubernetes nodes are upgraded.',
+	'CRON_TZ=UTC 0 2 * * 6',
+	7200000000000,
+	86400000000000,
+	true,
+	NOW(),
+	NOW()
+);
@@ -0,0 +1,9 @@
+INSERT INTO healthcheck_results (id, created_at, section, severity, warnings, error)
+VALUES (
+	'9d1c7e4a-2f6b-4c8d-a3e5-7b0f1e2d3c4a',
+	NOW(),
+	'Database',
+	'warning',
+	'[{"code": "EDB02", "message": "Database ping took 20ms, which is over the threshold of 15ms"}]'::jsonb,
+	NULL
+);
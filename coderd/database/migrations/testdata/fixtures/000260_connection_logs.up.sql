@@ -0,0 +1,26 @@
+INSERT INTO connection_logs (id, connect_time, organization_id, workspace_owner_id, workspace_id, workspace_name, agent_id, agent_name, type, ip, user_id, connection_id, disconnect_time, code, disconnect_reason)
+VALUES (
+	'b2e7f1c4-6a3d-4e8b-9f05-2d1c7a9e3b6f',
+	NOW() - INTERVAL '10 minutes',
+	'bb640d07-ca8a-4869-b6bc-ae61ebb2fda1',
+	'30095c71-380b-457a-8995-97b8ee6e5307',
+	'3a9a1feb-e89d-457c-9d53-ac751b198ebe',
+	'my-workspace',
+	'7a1ce5f8-8d00-431c-ad1b-97a846512804',
+	'main',
+	'ssh',
+	'fd7a:115c:a1e0::1',
+	'30095c71-380b-457a-8995-97b8ee6e5307',
+	'4c5d6e7f-8a9b-4c0d-a1e2-f3a4b5c6d7e8',
+	NOW() - INTERVAL '5 minutes',
+	0,
+	NULL
+);
+
+INSERT INTO workspace_agent_client_peers (peer_id, agent_id, user_id, created_at)
+VALUES (
+	'e1f2a3b4-c5d6-4e7f-8091-a2b3c4d5e6f7',
+	'7a1ce5f8-8d00-431c-ad1b-97a846512804',
+	'30095c71-380b-457a-8995-97b8ee6e5307',
+	NOW()
+);
@@ -0,0 +1,12 @@
+INSERT INTO template_version_rollouts (id, template_id, template_version_id, status, percent, group_id, created_by, created_at, updated_at)
+VALUES (
+	'7c1d5e2a-3b4f-4a6d-9e8c-1f2a3b4c5d6e',
+	'4cc1f466-f326-477e-8762-9d0c6781fc56',
+	'4e681a60-83da-42c2-902e-6535376ebb77',
+	'canary',
+	10,
+	NULL,
+	'30095c71-380b-457a-8995-97b8ee6e5307',
+	NOW(),
+	NOW()
+);
@@ -0,0 +1,20 @@
+INSERT INTO workspace_bulk_operations (id, initiator_id, initiator_scope, action, search, created_at, completed_at)
+VALUES (
+	'2b5c8e1d-6f3a-4c9b-8d7e-0a1b2c3d4e5f',
+	'30095c71-380b-457a-8995-97b8ee6e5307',
+	'all',
+	'update',
+	'template:docker outdated:true',
+	NOW(),
+	NULL
+);
+
+INSERT INTO workspace_bulk_operation_workspaces (bulk_operation_id, workspace_id, status, workspace_build_id, message, updated_at)
+VALUES (
+	'2b5c8e1d-6f3a-4c9b-8d7e-0a1b2c3d4e5f',
+	'3a9a1feb-e89d-457c-9d53-ac751b198ebe',
+	'pending',
+	NULL,
+	'',
+	NOW()
+);
@@ -0,0 +1,4 @@
+INSERT INTO provisioner_job_resource_changes (job_id, address, type, name, action)
+VALUES
+	('424a58cb-61d6-4627-9907-613c396c4a38', 'docker_container.workspace[0]', 'docker_container', 'workspace', 'replace'),
+	('424a58cb-61d6-4627-9907-613c396c4a38', 'docker_volume.home', 'docker_volume', 'home', 'update');
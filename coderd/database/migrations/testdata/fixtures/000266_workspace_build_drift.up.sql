@@ -0,0 +1,3 @@
+INSERT INTO workspace_build_drift (workspace_build_id, job_id, started_at, checked_job_id, checked_at, drifted)
+VALUES
+	('a8c0b8c5-c9a8-4f33-93a4-8142e6858244', '424a58cb-61d6-4627-9907-613c396c4a38', '2024-10-01 12:00:00+00', '424a58cb-61d6-4627-9907-613c396c4a38', '2024-10-01 12:01:00+00', true);
@@ -203,7 +203,19 @@ func (w Workspace) RBACObject() rbac.Object {
 
 	return rbac.ResourceWorkspace.WithID(w.ID).
 		InOrg(w.OrganizationID).
-		WithOwner(w.OwnerID.String())
+		WithOwner(w.OwnerID.String()).
+		WithACLUserList(w.UserACL).
+		WithGroupACL(w.GroupACL)
+}
+
+// RBACObjectWithoutACL returns the RBAC object of the workspace without the
+// users and groups it is shared with. It authorizes actions that sharing
+// must not grant, like changing who the workspace is shared with.
+func (w Workspace) RBACObjectWithoutACL() rbac.Object {
+	obj := w.RBACObject()
+	obj.ACLUserList = nil
+	obj.ACLGroupList = nil
+	return obj
 }
 
 func (w Workspace) DormantRBAC() rbac.Object {
@@ -231,6 +243,12 @@ func (m GetOrganizationIDsByMemberIDsRow) RBACObject() rbac.Object {
 	return rbac.ResourceUserObject(m.UserID)
 }
 
+// RBACObject returns the organization of the maintenance window, as windows
+// are managed as part of the organization settings.
+func (w MaintenanceWindow) RBACObject() rbac.Object {
+	return rbac.ResourceOrganization.WithID(w.OrganizationID).InOrg(w.OrganizationID)
+}
+
 func (o Organization) RBACObject() rbac.Object {
 	return rbac.ResourceOrganization.
 		WithID(o.ID).
@@ -277,6 +295,10 @@ func (u GitSSHKey) RBACObject() rbac.Object        { return rbac.ResourceUserObj
 func (u ExternalAuthLink) RBACObject() rbac.Object { return rbac.ResourceUserObject(u.UserID) }
 func (u UserLink) RBACObject() rbac.Object         { return rbac.ResourceUserObject(u.UserID) }
 
+func (o WorkspaceBulkOperation) RBACObject() rbac.Object {
+	return rbac.ResourceUserObject(o.InitiatorID)
+}
+
 func (u ExternalAuthLink) OAuthToken() *oauth2.Token {
 	return &oauth2.Token{
 		AccessToken:  u.OAuthAccessToken,
@@ -403,6 +425,8 @@ func ConvertWorkspaceRows(rows []GetWorkspacesRow) []Workspace {
 			DeletingAt:        r.DeletingAt,
 			AutomaticUpdates:  r.AutomaticUpdates,
 			Favorite:          r.Favorite,
+			UserACL:           r.UserACL,
+			GroupACL:          r.GroupACL,
 		}
 	}
 
@@ -116,6 +116,8 @@ func (q *sqlQuerier) GetAuthorizedTemplates(ctx context.Context, arg GetTemplate
 			&i.Deprecated,
 			&i.ActivityBump,
 			&i.MaxPortSharingLevel,
+			&i.IdleDetectionEnabled,
+			&i.IdleCPUThresholdPercent,
 			&i.CreatedByAvatarURL,
 			&i.CreatedByUsername,
 			&i.OrganizationName,
@@ -288,6 +290,8 @@ func (q *sqlQuerier) GetAuthorizedWorkspaces(ctx context.Context, arg GetWorkspa
 			&i.DeletingAt,
 			&i.AutomaticUpdates,
 			&i.Favorite,
+			&i.UserACL,
+			&i.GroupACL,
 			&i.TemplateName,
 			&i.TemplateVersionID,
 			&i.TemplateVersionName,
@@ -278,6 +278,7 @@ const (
 	BuildReasonDormancy   BuildReason = "dormancy"
 	BuildReasonFailedstop BuildReason = "failedstop"
 	BuildReasonAutodelete BuildReason = "autodelete"
+	BuildReasonDrift      BuildReason = "drift"
 )
 
 func (e *BuildReason) Scan(src interface{}) error {
@@ -322,7 +323,8 @@ func (e BuildReason) Valid() bool {
 		BuildReasonAutostop,
 		BuildReasonDormancy,
 		BuildReasonFailedstop,
-		BuildReasonAutodelete:
+		BuildReasonAutodelete,
+		BuildReasonDrift:
 		return true
 	}
 	return false
@@ -336,6 +338,77 @@ func AllBuildReasonValues() []BuildReason {
 		BuildReasonDormancy,
 		BuildReasonFailedstop,
 		BuildReasonAutodelete,
+		BuildReasonDrift,
+	}
+}
+
+type ConnectionType string
+
+const (
+	ConnectionTypeSsh             ConnectionType = "ssh"
+	ConnectionTypeVscode          ConnectionType = "vscode"
+	ConnectionTypeJetbrains       ConnectionType = "jetbrains"
+	ConnectionTypeReconnectingPty ConnectionType = "reconnecting_pty"
+	ConnectionTypePortForward     ConnectionType = "port_forward"
+	ConnectionTypeApp             ConnectionType = "app"
+)
+
+func (e *ConnectionType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ConnectionType(s)
+	case string:
+		*e = ConnectionType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ConnectionType: %T", src)
+	}
+	return nil
+}
+
+type NullConnectionType struct {
+	ConnectionType ConnectionType `json:"connection_type"`
+	Valid          bool           `json:"valid"` // Valid is true if ConnectionType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullConnectionType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ConnectionType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ConnectionType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullConnectionType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ConnectionType), nil
+}
+
+func (e ConnectionType) Valid() bool {
+	switch e {
+	case ConnectionTypeSsh,
+		ConnectionTypeVscode,
+		ConnectionTypeJetbrains,
+		ConnectionTypeReconnectingPty,
+		ConnectionTypePortForward,
+		ConnectionTypeApp:
+		return true
+	}
+	return false
+}
+
+func AllConnectionTypeValues() []ConnectionType {
+	return []ConnectionType{
+		ConnectionTypeSsh,
+		ConnectionTypeVscode,
+		ConnectionTypeJetbrains,
+		ConnectionTypeReconnectingPty,
+		ConnectionTypePortForward,
+		ConnectionTypeApp,
 	}
 }
 
@@ -799,6 +872,8 @@ type NotificationMethod string
 const (
 	NotificationMethodSmtp    NotificationMethod = "smtp"
 	NotificationMethodWebhook NotificationMethod = "webhook"
+	NotificationMethodSlack   NotificationMethod = "slack"
+	NotificationMethodTeams   NotificationMethod = "teams"
 )
 
 func (e *NotificationMethod) Scan(src interface{}) error {
@@ -839,7 +914,9 @@ func (ns NullNotificationMethod) Value() (driver.Value, error) {
 func (e NotificationMethod) Valid() bool {
 	switch e {
 	case NotificationMethodSmtp,
-		NotificationMethodWebhook:
+		NotificationMethodWebhook,
+		NotificationMethodSlack,
+		NotificationMethodTeams:
 		return true
 	}
 	return false
@@ -849,6 +926,8 @@ func AllNotificationMethodValues() []NotificationMethod {
 	return []NotificationMethod{
 		NotificationMethodSmtp,
 		NotificationMethodWebhook,
+		NotificationMethodSlack,
+		NotificationMethodTeams,
 	}
 }
 
@@ -1203,6 +1282,70 @@ func AllPortShareProtocolValues() []PortShareProtocol {
 	}
 }
 
+type ProvisionerJobResourceChangeAction string
+
+const (
+	ProvisionerJobResourceChangeActionCreate  ProvisionerJobResourceChangeAction = "create"
+	ProvisionerJobResourceChangeActionUpdate  ProvisionerJobResourceChangeAction = "update"
+	ProvisionerJobResourceChangeActionReplace ProvisionerJobResourceChangeAction = "replace"
+	ProvisionerJobResourceChangeActionDestroy ProvisionerJobResourceChangeAction = "destroy"
+)
+
+func (e *ProvisionerJobResourceChangeAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProvisionerJobResourceChangeAction(s)
+	case string:
+		*e = ProvisionerJobResourceChangeAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProvisionerJobResourceChangeAction: %T", src)
+	}
+	return nil
+}
+
+type NullProvisionerJobResourceChangeAction struct {
+	ProvisionerJobResourceChangeAction ProvisionerJobResourceChangeAction `json:"provisioner_job_resource_change_action"`
+	Valid                              bool                               `json:"valid"` // Valid is true if ProvisionerJobResourceChangeAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProvisionerJobResourceChangeAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProvisionerJobResourceChangeAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProvisionerJobResourceChangeAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProvisionerJobResourceChangeAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProvisionerJobResourceChangeAction), nil
+}
+
+func (e ProvisionerJobResourceChangeAction) Valid() bool {
+	switch e {
+	case ProvisionerJobResourceChangeActionCreate,
+		ProvisionerJobResourceChangeActionUpdate,
+		ProvisionerJobResourceChangeActionReplace,
+		ProvisionerJobResourceChangeActionDestroy:
+		return true
+	}
+	return false
+}
+
+func AllProvisionerJobResourceChangeActionValues() []ProvisionerJobResourceChangeAction {
+	return []ProvisionerJobResourceChangeAction{
+		ProvisionerJobResourceChangeActionCreate,
+		ProvisionerJobResourceChangeActionUpdate,
+		ProvisionerJobResourceChangeActionReplace,
+		ProvisionerJobResourceChangeActionDestroy,
+	}
+}
+
 // Computed status of a provisioner job. Jobs could be stuck in a hung state, these states do not guarantee any transition to another state.
 type ProvisionerJobStatus string
 
@@ -1537,6 +1680,8 @@ const (
 	ResourceTypeOrganizationMember      ResourceType = "organization_member"
 	ResourceTypeNotificationsSettings   ResourceType = "notifications_settings"
 	ResourceTypeNotificationTemplate    ResourceType = "notification_template"
+	ResourceTypeTemplateVersionRollout  ResourceType = "template_version_rollout"
+	ResourceTypeWorkspaceBulkOperation  ResourceType = "workspace_bulk_operation"
 )
 
 func (e *ResourceType) Scan(src interface{}) error {
@@ -1594,7 +1739,9 @@ func (e ResourceType) Valid() bool {
 		ResourceTypeCustomRole,
 		ResourceTypeOrganizationMember,
 		ResourceTypeNotificationsSettings,
-		ResourceTypeNotificationTemplate:
+		ResourceTypeNotificationTemplate,
+		ResourceTypeTemplateVersionRollout,
+		ResourceTypeWorkspaceBulkOperation:
 		return true
 	}
 	return false
@@ -1621,6 +1768,8 @@ func AllResourceTypeValues() []ResourceType {
 		ResourceTypeOrganizationMember,
 		ResourceTypeNotificationsSettings,
 		ResourceTypeNotificationTemplate,
+		ResourceTypeTemplateVersionRollout,
+		ResourceTypeWorkspaceBulkOperation,
 	}
 }
 
@@ -1740,6 +1889,67 @@ func AllTailnetStatusValues() []TailnetStatus {
 	}
 }
 
+type TemplateVersionRolloutStatus string
+
+const (
+	TemplateVersionRolloutStatusCanary     TemplateVersionRolloutStatus = "canary"
+	TemplateVersionRolloutStatusPromoted   TemplateVersionRolloutStatus = "promoted"
+	TemplateVersionRolloutStatusRolledBack TemplateVersionRolloutStatus = "rolled_back"
+)
+
+func (e *TemplateVersionRolloutStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TemplateVersionRolloutStatus(s)
+	case string:
+		*e = TemplateVersionRolloutStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TemplateVersionRolloutStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTemplateVersionRolloutStatus struct {
+	TemplateVersionRolloutStatus TemplateVersionRolloutStatus `json:"template_version_rollout_status"`
+	Valid                        bool                         `json:"valid"` // Valid is true if TemplateVersionRolloutStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTemplateVersionRolloutStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TemplateVersionRolloutStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TemplateVersionRolloutStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTemplateVersionRolloutStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TemplateVersionRolloutStatus), nil
+}
+
+func (e TemplateVersionRolloutStatus) Valid() bool {
+	switch e {
+	case TemplateVersionRolloutStatusCanary,
+		TemplateVersionRolloutStatusPromoted,
+		TemplateVersionRolloutStatusRolledBack:
+		return true
+	}
+	return false
+}
+
+func AllTemplateVersionRolloutStatusValues() []TemplateVersionRolloutStatus {
+	return []TemplateVersionRolloutStatus{
+		TemplateVersionRolloutStatusCanary,
+		TemplateVersionRolloutStatusPromoted,
+		TemplateVersionRolloutStatusRolledBack,
+	}
+}
+
 // Defines the users status: active, dormant, or suspended.
 type UserStatus string
 
@@ -2009,6 +2219,192 @@ func AllWorkspaceAppHealthValues() []WorkspaceAppHealth {
 	}
 }
 
+type WorkspaceBulkOperationAction string
+
+const (
+	WorkspaceBulkOperationActionStart  WorkspaceBulkOperationAction = "start"
+	WorkspaceBulkOperationActionStop   WorkspaceBulkOperationAction = "stop"
+	WorkspaceBulkOperationActionUpdate WorkspaceBulkOperationAction = "update"
+	WorkspaceBulkOperationActionDelete WorkspaceBulkOperationAction = "delete"
+)
+
+func (e *WorkspaceBulkOperationAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceBulkOperationAction(s)
+	case string:
+		*e = WorkspaceBulkOperationAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceBulkOperationAction: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceBulkOperationAction struct {
+	WorkspaceBulkOperationAction WorkspaceBulkOperationAction `json:"workspace_bulk_operation_action"`
+	Valid                        bool                         `json:"valid"` // Valid is true if WorkspaceBulkOperationAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceBulkOperationAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceBulkOperationAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceBulkOperationAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceBulkOperationAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceBulkOperationAction), nil
+}
+
+func (e WorkspaceBulkOperationAction) Valid() bool {
+	switch e {
+	case WorkspaceBulkOperationActionStart,
+		WorkspaceBulkOperationActionStop,
+		WorkspaceBulkOperationActionUpdate,
+		WorkspaceBulkOperationActionDelete:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceBulkOperationActionValues() []WorkspaceBulkOperationAction {
+	return []WorkspaceBulkOperationAction{
+		WorkspaceBulkOperationActionStart,
+		WorkspaceBulkOperationActionStop,
+		WorkspaceBulkOperationActionUpdate,
+		WorkspaceBulkOperationActionDelete,
+	}
+}
+
+type WorkspaceBulkOperationWorkspaceStatus string
+
+const (
+	WorkspaceBulkOperationWorkspaceStatusPending  WorkspaceBulkOperationWorkspaceStatus = "pending"
+	WorkspaceBulkOperationWorkspaceStatusEnqueued WorkspaceBulkOperationWorkspaceStatus = "enqueued"
+	WorkspaceBulkOperationWorkspaceStatusSkipped  WorkspaceBulkOperationWorkspaceStatus = "skipped"
+	WorkspaceBulkOperationWorkspaceStatusFailed   WorkspaceBulkOperationWorkspaceStatus = "failed"
+)
+
+func (e *WorkspaceBulkOperationWorkspaceStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceBulkOperationWorkspaceStatus(s)
+	case string:
+		*e = WorkspaceBulkOperationWorkspaceStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceBulkOperationWorkspaceStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceBulkOperationWorkspaceStatus struct {
+	WorkspaceBulkOperationWorkspaceStatus WorkspaceBulkOperationWorkspaceStatus `json:"workspace_bulk_operation_workspace_status"`
+	Valid                                 bool                                  `json:"valid"` // Valid is true if WorkspaceBulkOperationWorkspaceStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceBulkOperationWorkspaceStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceBulkOperationWorkspaceStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceBulkOperationWorkspaceStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceBulkOperationWorkspaceStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceBulkOperationWorkspaceStatus), nil
+}
+
+func (e WorkspaceBulkOperationWorkspaceStatus) Valid() bool {
+	switch e {
+	case WorkspaceBulkOperationWorkspaceStatusPending,
+		WorkspaceBulkOperationWorkspaceStatusEnqueued,
+		WorkspaceBulkOperationWorkspaceStatusSkipped,
+		WorkspaceBulkOperationWorkspaceStatusFailed:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceBulkOperationWorkspaceStatusValues() []WorkspaceBulkOperationWorkspaceStatus {
+	return []WorkspaceBulkOperationWorkspaceStatus{
+		WorkspaceBulkOperationWorkspaceStatusPending,
+		WorkspaceBulkOperationWorkspaceStatusEnqueued,
+		WorkspaceBulkOperationWorkspaceStatusSkipped,
+		WorkspaceBulkOperationWorkspaceStatusFailed,
+	}
+}
+
+type WorkspaceSessionRecordingType string
+
+const (
+	WorkspaceSessionRecordingTypeSsh             WorkspaceSessionRecordingType = "ssh"
+	WorkspaceSessionRecordingTypeReconnectingPty WorkspaceSessionRecordingType = "reconnecting_pty"
+)
+
+func (e *WorkspaceSessionRecordingType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceSessionRecordingType(s)
+	case string:
+		*e = WorkspaceSessionRecordingType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceSessionRecordingType: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceSessionRecordingType struct {
+	WorkspaceSessionRecordingType WorkspaceSessionRecordingType `json:"workspace_session_recording_type"`
+	Valid                         bool                          `json:"valid"` // Valid is true if WorkspaceSessionRecordingType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceSessionRecordingType) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceSessionRecordingType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceSessionRecordingType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceSessionRecordingType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceSessionRecordingType), nil
+}
+
+func (e WorkspaceSessionRecordingType) Valid() bool {
+	switch e {
+	case WorkspaceSessionRecordingTypeSsh,
+		WorkspaceSessionRecordingTypeReconnectingPty:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceSessionRecordingTypeValues() []WorkspaceSessionRecordingType {
+	return []WorkspaceSessionRecordingType{
+		WorkspaceSessionRecordingTypeSsh,
+		WorkspaceSessionRecordingTypeReconnectingPty,
+	}
+}
+
 type WorkspaceTransition string
 
 const (
@@ -2104,6 +2500,28 @@ type AuditLog struct {
 	ResourceIcon     string          `db:"resource_icon" json:"resource_icon"`
 }
 
+// Connections to workspace agents, as reported by the agents.
+type ConnectionLog struct {
+	ID               uuid.UUID      `db:"id" json:"id"`
+	ConnectTime      time.Time      `db:"connect_time" json:"connect_time"`
+	OrganizationID   uuid.UUID      `db:"organization_id" json:"organization_id"`
+	WorkspaceOwnerID uuid.UUID      `db:"workspace_owner_id" json:"workspace_owner_id"`
+	WorkspaceID      uuid.UUID      `db:"workspace_id" json:"workspace_id"`
+	WorkspaceName    string         `db:"workspace_name" json:"workspace_name"`
+	AgentID          uuid.UUID      `db:"agent_id" json:"agent_id"`
+	AgentName        string         `db:"agent_name" json:"agent_name"`
+	Type             ConnectionType `db:"type" json:"type"`
+	Ip               pqtype.Inet    `db:"ip" json:"ip"`
+	// The user that connected, if the client could be matched to a user that coordinated with the agent.
+	UserID uuid.NullUUID `db:"user_id" json:"user_id"`
+	// The ID of the connection assigned by the agent, used to match connect and disconnect events.
+	ConnectionID   uuid.UUID    `db:"connection_id" json:"connection_id"`
+	DisconnectTime sql.NullTime `db:"disconnect_time" json:"disconnect_time"`
+	// The exit code of the session, set on disconnect.
+	Code             sql.NullInt32  `db:"code" json:"code"`
+	DisconnectReason sql.NullString `db:"disconnect_reason" json:"disconnect_reason"`
+}
+
 type CryptoKey struct {
 	Feature     CryptoKeyFeature `db:"feature" json:"feature"`
 	Sequence    int32            `db:"sequence" json:"sequence"`
@@ -2216,6 +2634,18 @@ type GroupMemberTable struct {
 	GroupID uuid.UUID `db:"group_id" json:"group_id"`
 }
 
+// Results of the sections of past healthcheck reports.
+type HealthcheckResult struct {
+	ID uuid.UUID `db:"id" json:"id"`
+	// Time the healthcheck report was generated at.
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	// Section of the healthcheck report, e.g. DERP or Database.
+	Section  string          `db:"section" json:"section"`
+	Severity string          `db:"severity" json:"severity"`
+	Warnings json.RawMessage `db:"warnings" json:"warnings"`
+	Error    sql.NullString  `db:"error" json:"error"`
+}
+
 type JfrogXrayScan struct {
 	AgentID     uuid.UUID `db:"agent_id" json:"agent_id"`
 	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
@@ -2234,6 +2664,27 @@ type License struct {
 	UUID uuid.UUID `db:"uuid" json:"uuid"`
 }
 
+// Recurring windows during which autobuild is paused for the workspaces of an organization or template.
+type MaintenanceWindow struct {
+	ID             uuid.UUID     `db:"id" json:"id"`
+	OrganizationID uuid.UUID     `db:"organization_id" json:"organization_id"`
+	TemplateID     uuid.NullUUID `db:"template_id" json:"template_id"`
+	Name           string        `db:"name" json:"name"`
+	Message        string        `db:"message" json:"message"`
+	// Weekly cron schedule of the start of the window, in the same format as workspace autostart schedules.
+	Schedule string `db:"schedule" json:"schedule"`
+	// Duration of the window in nanoseconds.
+	Duration int64 `db:"duration" json:"duration"`
+	// How long before the start of the window users are notified, in nanoseconds.
+	NoticePeriod int64 `db:"notice_period" json:"notice_period"`
+	// Whether new workspace builds are rejected during the window.
+	BlockBuilds bool `db:"block_builds" json:"block_builds"`
+	// Start of the most recent window that users were notified about.
+	LastNotifiedStart sql.NullTime `db:"last_notified_start" json:"last_notified_start"`
+	CreatedAt         time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time    `db:"updated_at" json:"updated_at"`
+}
+
 type NotificationMessage struct {
 	ID                     uuid.UUID                 `db:"id" json:"id"`
 	NotificationTemplateID uuid.UUID                 `db:"notification_template_id" json:"notification_template_id"`
@@ -2411,6 +2862,8 @@ type ProvisionerJob struct {
 	TraceMetadata  pqtype.NullRawMessage    `db:"trace_metadata" json:"trace_metadata"`
 	// Computed column to track the status of the job.
 	JobStatus ProvisionerJobStatus `db:"job_status" json:"job_status"`
+	// Jobs with a higher priority are acquired first. Interactive workspace builds rank above autobuilds, which rank above template version imports and dry-runs.
+	Priority int32 `db:"priority" json:"priority"`
 }
 
 type ProvisionerJobLog struct {
@@ -2423,6 +2876,16 @@ type ProvisionerJobLog struct {
 	ID        int64     `db:"id" json:"id"`
 }
 
+// Resource changes planned by template version dry-runs against the state of an existing workspace, or detected by drift checks.
+type ProvisionerJobResourceChange struct {
+	JobID uuid.UUID `db:"job_id" json:"job_id"`
+	// Terraform address of the resource, e.g. docker_container.workspace[0].
+	Address string                             `db:"address" json:"address"`
+	Type    string                             `db:"type" json:"type"`
+	Name    string                             `db:"name" json:"name"`
+	Action  ProvisionerJobResourceChangeAction `db:"action" json:"action"`
+}
+
 type ProvisionerJobStat struct {
 	JobID          uuid.UUID            `db:"job_id" json:"job_id"`
 	JobStatus      ProvisionerJobStatus `db:"job_status" json:"job_status"`
@@ -2551,6 +3014,8 @@ type Template struct {
 	Deprecated                    string          `db:"deprecated" json:"deprecated"`
 	ActivityBump                  int64           `db:"activity_bump" json:"activity_bump"`
 	MaxPortSharingLevel           AppSharingLevel `db:"max_port_sharing_level" json:"max_port_sharing_level"`
+	IdleDetectionEnabled          bool            `db:"idle_detection_enabled" json:"idle_detection_enabled"`
+	IdleCPUThresholdPercent       int32           `db:"idle_cpu_threshold_percent" json:"idle_cpu_threshold_percent"`
 	CreatedByAvatarURL            string          `db:"created_by_avatar_url" json:"created_by_avatar_url"`
 	CreatedByUsername             string          `db:"created_by_username" json:"created_by_username"`
 	OrganizationName              string          `db:"organization_name" json:"organization_name"`
@@ -2596,6 +3061,10 @@ type TemplateTable struct {
 	Deprecated          string          `db:"deprecated" json:"deprecated"`
 	ActivityBump        int64           `db:"activity_bump" json:"activity_bump"`
 	MaxPortSharingLevel AppSharingLevel `db:"max_port_sharing_level" json:"max_port_sharing_level"`
+	// Only bump workspace deadlines on user input, app requests or CPU load instead of on any open connection.
+	IdleDetectionEnabled bool `db:"idle_detection_enabled" json:"idle_detection_enabled"`
+	// CPU usage percentage above which a workspace is considered in use when idle detection is enabled. 0 disables CPU-based detection.
+	IdleCPUThresholdPercent int32 `db:"idle_cpu_threshold_percent" json:"idle_cpu_threshold_percent"`
 }
 
 // Records aggregated usage statistics for templates/users. All usage is rounded up to the nearest minute.
@@ -2680,6 +3149,21 @@ type TemplateVersionParameter struct {
 	Ephemeral bool `db:"ephemeral" json:"ephemeral"`
 }
 
+// Staged rollouts of template versions to a canary cohort of workspaces before the version is promoted to the active version.
+type TemplateVersionRollout struct {
+	ID                uuid.UUID                    `db:"id" json:"id"`
+	TemplateID        uuid.UUID                    `db:"template_id" json:"template_id"`
+	TemplateVersionID uuid.UUID                    `db:"template_version_id" json:"template_version_id"`
+	Status            TemplateVersionRolloutStatus `db:"status" json:"status"`
+	// Percentage of the workspaces of the template in the canary cohort.
+	Percent int32 `db:"percent" json:"percent"`
+	// Workspaces owned by members of this group are in the canary cohort regardless of the percentage.
+	GroupID   uuid.NullUUID `db:"group_id" json:"group_id"`
+	CreatedBy uuid.UUID     `db:"created_by" json:"created_by"`
+	CreatedAt time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at" json:"updated_at"`
+}
+
 type TemplateVersionTable struct {
 	ID             uuid.UUID     `db:"id" json:"id"`
 	TemplateID     uuid.NullUUID `db:"template_id" json:"template_id"`
@@ -2783,6 +3267,10 @@ type Workspace struct {
 	AutomaticUpdates  AutomaticUpdates `db:"automatic_updates" json:"automatic_updates"`
 	// Favorite is true if the workspace owner has favorited the workspace.
 	Favorite bool `db:"favorite" json:"favorite"`
+	// Users the workspace is shared with, mapped to the actions they may perform.
+	UserACL WorkspaceACL `db:"user_acl" json:"user_acl"`
+	// Groups the workspace is shared with, mapped to the actions their members may perform.
+	GroupACL WorkspaceACL `db:"group_acl" json:"group_acl"`
 }
 
 type WorkspaceAgent struct {
@@ -2830,6 +3318,14 @@ type WorkspaceAgent struct {
 	DisplayOrder int32 `db:"display_order" json:"display_order"`
 }
 
+// Tailnet peers of clients currently coordinating with a workspace agent, used to attribute connections to users.
+type WorkspaceAgentClientPeer struct {
+	PeerID    uuid.UUID `db:"peer_id" json:"peer_id"`
+	AgentID   uuid.UUID `db:"agent_id" json:"agent_id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
 type WorkspaceAgentLog struct {
 	AgentID     uuid.UUID `db:"agent_id" json:"agent_id"`
 	CreatedAt   time.Time `db:"created_at" json:"created_at"`
@@ -2968,6 +3464,18 @@ type WorkspaceBuild struct {
 	InitiatorByUsername  string              `db:"initiator_by_username" json:"initiator_by_username"`
 }
 
+// Drift checks of workspace builds, which compare the infrastructure of running workspaces with their Terraform state.
+type WorkspaceBuildDrift struct {
+	WorkspaceBuildID uuid.UUID `db:"workspace_build_id" json:"workspace_build_id"`
+	// Refresh-only dry-run job of the most recently started drift check.
+	JobID     uuid.UUID `db:"job_id" json:"job_id"`
+	StartedAt time.Time `db:"started_at" json:"started_at"`
+	// Job of the most recently completed drift check. Its resource changes are the drifted resources.
+	CheckedJobID uuid.NullUUID `db:"checked_job_id" json:"checked_job_id"`
+	CheckedAt    sql.NullTime  `db:"checked_at" json:"checked_at"`
+	Drifted      bool          `db:"drifted" json:"drifted"`
+}
+
 type WorkspaceBuildParameter struct {
 	WorkspaceBuildID uuid.UUID `db:"workspace_build_id" json:"workspace_build_id"`
 	// Parameter name
@@ -2993,6 +3501,30 @@ type WorkspaceBuildTable struct {
 	MaxDeadline       time.Time           `db:"max_deadline" json:"max_deadline"`
 }
 
+// Builds of many workspaces matching a search query, enqueued in the background at a limited rate.
+type WorkspaceBulkOperation struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	InitiatorID uuid.UUID `db:"initiator_id" json:"initiator_id"`
+	// Scope of the API key the operation was created with. Builds are authorized as the initiator with this scope.
+	InitiatorScope APIKeyScope                  `db:"initiator_scope" json:"initiator_scope"`
+	Action         WorkspaceBulkOperationAction `db:"action" json:"action"`
+	// Workspace search query the workspaces of the operation were resolved from.
+	Search    string    `db:"search" json:"search"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	// Time the builds of all workspaces of the operation were enqueued, skipped or failed.
+	CompletedAt sql.NullTime `db:"completed_at" json:"completed_at"`
+}
+
+type WorkspaceBulkOperationWorkspace struct {
+	BulkOperationID  uuid.UUID                             `db:"bulk_operation_id" json:"bulk_operation_id"`
+	WorkspaceID      uuid.UUID                             `db:"workspace_id" json:"workspace_id"`
+	Status           WorkspaceBulkOperationWorkspaceStatus `db:"status" json:"status"`
+	WorkspaceBuildID uuid.NullUUID                         `db:"workspace_build_id" json:"workspace_build_id"`
+	// Reason the workspace was skipped or its build failed to enqueue.
+	Message   string    `db:"message" json:"message"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
 type WorkspaceProxy struct {
 	ID          uuid.UUID `db:"id" json:"id"`
 	Name        string    `db:"name" json:"name"`
@@ -3036,3 +3568,20 @@ type WorkspaceResourceMetadatum struct {
 	Sensitive           bool           `db:"sensitive" json:"sensitive"`
 	ID                  int64          `db:"id" json:"id"`
 }
+
+// Recordings of PTY sessions in asciicast v2 format, uploaded by workspace agents.
+type WorkspaceSessionRecording struct {
+	ID          uuid.UUID                     `db:"id" json:"id"`
+	WorkspaceID uuid.UUID                     `db:"workspace_id" json:"workspace_id"`
+	AgentID     uuid.UUID                     `db:"agent_id" json:"agent_id"`
+	Type        WorkspaceSessionRecordingType `db:"type" json:"type"`
+	Command     string                        `db:"command" json:"command"`
+	StartedAt   time.Time                     `db:"started_at" json:"started_at"`
+	EndedAt     time.Time                     `db:"ended_at" json:"ended_at"`
+	// Whether the recording includes input events in addition to output.
+	InputRecorded bool `db:"input_recorded" json:"input_recorded"`
+	// Whether the recording was stopped early because it reached the maximum size.
+	Truncated bool      `db:"truncated" json:"truncated"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	Data      []byte    `db:"data" json:"data"`
+}
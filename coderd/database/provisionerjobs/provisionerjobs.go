@@ -12,6 +12,24 @@ import (
 
 const EventJobPosted = "provisioner_job_posted"
 
+// EventJobCompleted is published when a job completes, so that jobs held back
+// by the concurrent job limits of their organization or initiator are
+// acquired.
+const EventJobCompleted = "provisioner_job_completed"
+
+// Jobs with a higher priority are acquired first. Users waiting on their own
+// workspace builds take precedence over background work.
+const (
+	// PriorityTemplateVersion is used for template version imports and
+	// dry-runs.
+	PriorityTemplateVersion int32 = 0
+	// PriorityBackground is used for workspace builds no user is waiting on,
+	// e.g. autobuilds and bulk operations.
+	PriorityBackground int32 = 10
+	// PriorityInteractive is used for workspace builds started by a user.
+	PriorityInteractive int32 = 20
+)
+
 type JobPosting struct {
 	OrganizationID  uuid.UUID                `json:"organization_id"`
 	ProvisionerType database.ProvisionerType `json:"type"`
@@ -30,3 +48,18 @@ func PostJob(ps pubsub.Pubsub, job database.ProvisionerJob) error {
 	err = ps.Publish(EventJobPosted, msg)
 	return err
 }
+
+// CompleteJob notifies acquirers that a job of the organization stopped
+// running.
+func CompleteJob(ps pubsub.Pubsub, job database.ProvisionerJob) error {
+	msg, err := json.Marshal(JobPosting{
+		OrganizationID:  job.OrganizationID,
+		ProvisionerType: job.Provisioner,
+		Tags:            job.Tags,
+	})
+	if err != nil {
+		return xerrors.Errorf("marshal job completion: %w", err)
+	}
+	err = ps.Publish(EventJobCompleted, msg)
+	return err
+}
@@ -761,6 +761,353 @@ func (q *sqlQuerier) InsertAuditLog(ctx context.Context, arg InsertAuditLogParam
 	return i, err
 }
 
+const deleteWorkspaceAgentClientPeer = `-- name: DeleteWorkspaceAgentClientPeer :exec
+DELETE FROM workspace_agent_client_peers WHERE peer_id = $1
+`
+
+func (q *sqlQuerier) DeleteWorkspaceAgentClientPeer(ctx context.Context, peerID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteWorkspaceAgentClientPeer, peerID)
+	return err
+}
+
+const getConnectionLogsOffset = `-- name: GetConnectionLogsOffset :many
+SELECT
+	connection_logs.id, connection_logs.connect_time, connection_logs.organization_id, connection_logs.workspace_owner_id, connection_logs.workspace_id, connection_logs.workspace_name, connection_logs.agent_id, connection_logs.agent_name, connection_logs.type, connection_logs.ip, connection_logs.user_id, connection_logs.connection_id, connection_logs.disconnect_time, connection_logs.code, connection_logs.disconnect_reason,
+	users.username AS user_username,
+	users.name AS user_name,
+	users.avatar_url AS user_avatar_url,
+	COALESCE(workspace_owner.username, '') AS workspace_owner_username,
+	COALESCE(organizations.name, '') AS organization_name,
+	COUNT(connection_logs.*) OVER () AS count
+FROM
+	connection_logs
+	LEFT JOIN users ON connection_logs.user_id = users.id
+	LEFT JOIN users AS workspace_owner ON connection_logs.workspace_owner_id = workspace_owner.id
+	LEFT JOIN organizations ON connection_logs.organization_id = organizations.id
+WHERE
+	-- Filter organization_id
+	CASE
+		WHEN $1 :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.organization_id = $1
+		ELSE true
+	END
+	-- Filter by workspace_owner_id
+	AND CASE
+		WHEN $2 :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.workspace_owner_id = $2
+		ELSE true
+	END
+	-- Filter by workspace_owner username
+	AND CASE
+		WHEN $3 :: text != '' THEN
+			connection_logs.workspace_owner_id = (SELECT id FROM users WHERE lower(username) = lower($3) AND deleted = false)
+		ELSE true
+	END
+	-- Filter by workspace_id
+	AND CASE
+		WHEN $4 :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.workspace_id = $4
+		ELSE true
+	END
+	-- Filter by workspace name
+	AND CASE
+		WHEN $5 :: text != '' THEN
+			lower(connection_logs.workspace_name) = lower($5)
+		ELSE true
+	END
+	-- Filter by type
+	AND CASE
+		WHEN $6 :: text != '' THEN
+			connection_logs.type = $6 :: connection_type
+		ELSE true
+	END
+	-- Filter by user_id
+	AND CASE
+		WHEN $7 :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.user_id = $7
+		ELSE true
+	END
+	-- Filter by username
+	AND CASE
+		WHEN $8 :: text != '' THEN
+			connection_logs.user_id = (SELECT id FROM users WHERE lower(username) = lower($8) AND deleted = false)
+		ELSE true
+	END
+	-- Filter by connected_after
+	AND CASE
+		WHEN $9 :: timestamp with time zone != '0001-01-01 00:00:00Z' THEN
+			connection_logs.connect_time >= $9
+		ELSE true
+	END
+	-- Filter by connected_before
+	AND CASE
+		WHEN $10 :: timestamp with time zone != '0001-01-01 00:00:00Z' THEN
+			connection_logs.connect_time <= $10
+		ELSE true
+	END
+	-- Filter by status
+	AND CASE
+		WHEN $11 :: text = 'ongoing' THEN
+			connection_logs.disconnect_time IS NULL
+		WHEN $11 :: text = 'completed' THEN
+			connection_logs.disconnect_time IS NOT NULL
+		ELSE true
+	END
+ORDER BY
+	connection_logs.connect_time DESC
+LIMIT
+	-- a limit of 0 means "no limit". The connection log table is unbounded
+	-- in size, so default to 100 to prevent accidental excessively large
+	-- queries.
+	COALESCE(NULLIF($13 :: int, 0), 100)
+OFFSET
+	$12
+`
+
+type GetConnectionLogsOffsetParams struct {
+	OrganizationID   uuid.UUID `db:"organization_id" json:"organization_id"`
+	WorkspaceOwnerID uuid.UUID `db:"workspace_owner_id" json:"workspace_owner_id"`
+	WorkspaceOwner   string    `db:"workspace_owner" json:"workspace_owner"`
+	WorkspaceID      uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	WorkspaceName    string    `db:"workspace_name" json:"workspace_name"`
+	Type             string    `db:"type" json:"type"`
+	UserID           uuid.UUID `db:"user_id" json:"user_id"`
+	Username         string    `db:"username" json:"username"`
+	ConnectedAfter   time.Time `db:"connected_after" json:"connected_after"`
+	ConnectedBefore  time.Time `db:"connected_before" json:"connected_before"`
+	Status           string    `db:"status" json:"status"`
+	OffsetOpt        int32     `db:"offset_opt" json:"offset_opt"`
+	LimitOpt         int32     `db:"limit_opt" json:"limit_opt"`
+}
+
+type GetConnectionLogsOffsetRow struct {
+	ConnectionLog          ConnectionLog  `db:"connection_log" json:"connection_log"`
+	UserUsername           sql.NullString `db:"user_username" json:"user_username"`
+	UserName               sql.NullString `db:"user_name" json:"user_name"`
+	UserAvatarUrl          sql.NullString `db:"user_avatar_url" json:"user_avatar_url"`
+	WorkspaceOwnerUsername string         `db:"workspace_owner_username" json:"workspace_owner_username"`
+	OrganizationName       string         `db:"organization_name" json:"organization_name"`
+	Count                  int64          `db:"count" json:"count"`
+}
+
+func (q *sqlQuerier) GetConnectionLogsOffset(ctx context.Context, arg GetConnectionLogsOffsetParams) ([]GetConnectionLogsOffsetRow, error) {
+	rows, err := q.db.QueryContext(ctx, getConnectionLogsOffset,
+		arg.OrganizationID,
+		arg.WorkspaceOwnerID,
+		arg.WorkspaceOwner,
+		arg.WorkspaceID,
+		arg.WorkspaceName,
+		arg.Type,
+		arg.UserID,
+		arg.Username,
+		arg.ConnectedAfter,
+		arg.ConnectedBefore,
+		arg.Status,
+		arg.OffsetOpt,
+		arg.LimitOpt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetConnectionLogsOffsetRow
+	for rows.Next() {
+		var i GetConnectionLogsOffsetRow
+		if err := rows.Scan(
+			&i.ConnectionLog.ID,
+			&i.ConnectionLog.ConnectTime,
+			&i.ConnectionLog.OrganizationID,
+			&i.ConnectionLog.WorkspaceOwnerID,
+			&i.ConnectionLog.WorkspaceID,
+			&i.ConnectionLog.WorkspaceName,
+			&i.ConnectionLog.AgentID,
+			&i.ConnectionLog.AgentName,
+			&i.ConnectionLog.Type,
+			&i.ConnectionLog.Ip,
+			&i.ConnectionLog.UserID,
+			&i.ConnectionLog.ConnectionID,
+			&i.ConnectionLog.DisconnectTime,
+			&i.ConnectionLog.Code,
+			&i.ConnectionLog.DisconnectReason,
+			&i.UserUsername,
+			&i.UserName,
+			&i.UserAvatarUrl,
+			&i.WorkspaceOwnerUsername,
+			&i.OrganizationName,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceAgentClientPeersByAgentID = `-- name: GetWorkspaceAgentClientPeersByAgentID :many
+SELECT
+	peer_id, agent_id, user_id, created_at
+FROM
+	workspace_agent_client_peers
+WHERE
+	agent_id = $1
+ORDER BY
+	created_at DESC
+`
+
+func (q *sqlQuerier) GetWorkspaceAgentClientPeersByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceAgentClientPeer, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceAgentClientPeersByAgentID, agentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceAgentClientPeer
+	for rows.Next() {
+		var i WorkspaceAgentClientPeer
+		if err := rows.Scan(
+			&i.PeerID,
+			&i.AgentID,
+			&i.UserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertConnectionLog = `-- name: UpsertConnectionLog :one
+INSERT INTO connection_logs (
+	id,
+	connect_time,
+	organization_id,
+	workspace_owner_id,
+	workspace_id,
+	workspace_name,
+	agent_id,
+	agent_name,
+	type,
+	ip,
+	user_id,
+	connection_id,
+	disconnect_time,
+	code,
+	disconnect_reason
+) VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (connection_id, agent_id) DO UPDATE SET
+	-- A disconnect event only carries a placeholder connect time.
+	connect_time = CASE
+		WHEN EXCLUDED.disconnect_time IS NULL THEN EXCLUDED.connect_time
+		ELSE connection_logs.connect_time
+	END,
+	user_id = COALESCE(connection_logs.user_id, EXCLUDED.user_id),
+	disconnect_time = COALESCE(EXCLUDED.disconnect_time, connection_logs.disconnect_time),
+	code = COALESCE(EXCLUDED.code, connection_logs.code),
+	disconnect_reason = COALESCE(EXCLUDED.disconnect_reason, connection_logs.disconnect_reason)
+RETURNING id, connect_time, organization_id, workspace_owner_id, workspace_id, workspace_name, agent_id, agent_name, type, ip, user_id, connection_id, disconnect_time, code, disconnect_reason
+`
+
+type UpsertConnectionLogParams struct {
+	ID               uuid.UUID      `db:"id" json:"id"`
+	ConnectTime      time.Time      `db:"connect_time" json:"connect_time"`
+	OrganizationID   uuid.UUID      `db:"organization_id" json:"organization_id"`
+	WorkspaceOwnerID uuid.UUID      `db:"workspace_owner_id" json:"workspace_owner_id"`
+	WorkspaceID      uuid.UUID      `db:"workspace_id" json:"workspace_id"`
+	WorkspaceName    string         `db:"workspace_name" json:"workspace_name"`
+	AgentID          uuid.UUID      `db:"agent_id" json:"agent_id"`
+	AgentName        string         `db:"agent_name" json:"agent_name"`
+	Type             ConnectionType `db:"type" json:"type"`
+	Ip               pqtype.Inet    `db:"ip" json:"ip"`
+	UserID           uuid.NullUUID  `db:"user_id" json:"user_id"`
+	ConnectionID     uuid.UUID      `db:"connection_id" json:"connection_id"`
+	DisconnectTime   sql.NullTime   `db:"disconnect_time" json:"disconnect_time"`
+	Code             sql.NullInt32  `db:"code" json:"code"`
+	DisconnectReason sql.NullString `db:"disconnect_reason" json:"disconnect_reason"`
+}
+
+// Agents report the connect and disconnect events of a connection
+// separately, and they may arrive in any order. The first event creates the
+// log and the second completes it.
+func (q *sqlQuerier) UpsertConnectionLog(ctx context.Context, arg UpsertConnectionLogParams) (ConnectionLog, error) {
+	row := q.db.QueryRowContext(ctx, upsertConnectionLog,
+		arg.ID,
+		arg.ConnectTime,
+		arg.OrganizationID,
+		arg.WorkspaceOwnerID,
+		arg.WorkspaceID,
+		arg.WorkspaceName,
+		arg.AgentID,
+		arg.AgentName,
+		arg.Type,
+		arg.Ip,
+		arg.UserID,
+		arg.ConnectionID,
+		arg.DisconnectTime,
+		arg.Code,
+		arg.DisconnectReason,
+	)
+	var i ConnectionLog
+	err := row.Scan(
+		&i.ID,
+		&i.ConnectTime,
+		&i.OrganizationID,
+		&i.WorkspaceOwnerID,
+		&i.WorkspaceID,
+		&i.WorkspaceName,
+		&i.AgentID,
+		&i.AgentName,
+		&i.Type,
+		&i.Ip,
+		&i.UserID,
+		&i.ConnectionID,
+		&i.DisconnectTime,
+		&i.Code,
+		&i.DisconnectReason,
+	)
+	return i, err
+}
+
+const upsertWorkspaceAgentClientPeer = `-- name: UpsertWorkspaceAgentClientPeer :exec
+INSERT INTO
+	workspace_agent_client_peers (peer_id, agent_id, user_id, created_at)
+VALUES
+	($1, $2, $3, $4)
+ON CONFLICT (peer_id) DO UPDATE SET
+	agent_id = EXCLUDED.agent_id,
+	user_id = EXCLUDED.user_id,
+	created_at = EXCLUDED.created_at
+`
+
+type UpsertWorkspaceAgentClientPeerParams struct {
+	PeerID    uuid.UUID `db:"peer_id" json:"peer_id"`
+	AgentID   uuid.UUID `db:"agent_id" json:"agent_id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) UpsertWorkspaceAgentClientPeer(ctx context.Context, arg UpsertWorkspaceAgentClientPeerParams) error {
+	_, err := q.db.ExecContext(ctx, upsertWorkspaceAgentClientPeer,
+		arg.PeerID,
+		arg.AgentID,
+		arg.UserID,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const deleteCryptoKey = `-- name: DeleteCryptoKey :one
 UPDATE crypto_keys
 SET secret = NULL, secret_key_id = NULL
@@ -2093,6 +2440,148 @@ func (q *sqlQuerier) UpdateGroupByID(ctx context.Context, arg UpdateGroupByIDPar
 	return i, err
 }
 
+const deleteOldHealthcheckResults = `-- name: DeleteOldHealthcheckResults :exec
+DELETE FROM healthcheck_results WHERE created_at < $1
+`
+
+func (q *sqlQuerier) DeleteOldHealthcheckResults(ctx context.Context, beforeTime time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteOldHealthcheckResults, beforeTime)
+	return err
+}
+
+const getHealthcheckResults = `-- name: GetHealthcheckResults :many
+SELECT
+	id, created_at, section, severity, warnings, error
+FROM
+	healthcheck_results
+WHERE
+	CASE
+		WHEN $1 :: text != '' THEN
+			section = $1
+		ELSE true
+	END
+	AND created_at > $2
+ORDER BY
+	created_at DESC, section ASC
+LIMIT
+	-- A limit of 0 means "no limit"
+	NULLIF($3 :: int, 0)
+`
+
+type GetHealthcheckResultsParams struct {
+	Section      string    `db:"section" json:"section"`
+	CreatedAfter time.Time `db:"created_after" json:"created_after"`
+	LimitOpt     int32     `db:"limit_opt" json:"limit_opt"`
+}
+
+func (q *sqlQuerier) GetHealthcheckResults(ctx context.Context, arg GetHealthcheckResultsParams) ([]HealthcheckResult, error) {
+	rows, err := q.db.QueryContext(ctx, getHealthcheckResults, arg.Section, arg.CreatedAfter, arg.LimitOpt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []HealthcheckResult
+	for rows.Next() {
+		var i HealthcheckResult
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Section,
+			&i.Severity,
+			&i.Warnings,
+			&i.Error,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestHealthcheckResults = `-- name: GetLatestHealthcheckResults :many
+SELECT DISTINCT ON (section)
+	id, created_at, section, severity, warnings, error
+FROM
+	healthcheck_results
+ORDER BY
+	section, created_at DESC
+`
+
+// Returns the most recent result of every section.
+func (q *sqlQuerier) GetLatestHealthcheckResults(ctx context.Context) ([]HealthcheckResult, error) {
+	rows, err := q.db.QueryContext(ctx, getLatestHealthcheckResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []HealthcheckResult
+	for rows.Next() {
+		var i HealthcheckResult
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Section,
+			&i.Severity,
+			&i.Warnings,
+			&i.Error,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertHealthcheckResult = `-- name: InsertHealthcheckResult :one
+INSERT INTO
+	healthcheck_results (id, created_at, section, severity, warnings, error)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at, section, severity, warnings, error
+`
+
+type InsertHealthcheckResultParams struct {
+	ID        uuid.UUID       `db:"id" json:"id"`
+	CreatedAt time.Time       `db:"created_at" json:"created_at"`
+	Section   string          `db:"section" json:"section"`
+	Severity  string          `db:"severity" json:"severity"`
+	Warnings  json.RawMessage `db:"warnings" json:"warnings"`
+	Error     sql.NullString  `db:"error" json:"error"`
+}
+
+func (q *sqlQuerier) InsertHealthcheckResult(ctx context.Context, arg InsertHealthcheckResultParams) (HealthcheckResult, error) {
+	row := q.db.QueryRowContext(ctx, insertHealthcheckResult,
+		arg.ID,
+		arg.CreatedAt,
+		arg.Section,
+		arg.Severity,
+		arg.Warnings,
+		arg.Error,
+	)
+	var i HealthcheckResult
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Section,
+		&i.Severity,
+		&i.Warnings,
+		&i.Error,
+	)
+	return i, err
+}
+
 const getTemplateAppInsights = `-- name: GetTemplateAppInsights :many
 WITH
 	-- Create a list of all unique apps by template, this is used to
@@ -3527,8 +4016,292 @@ func (q *sqlQuerier) TryAcquireLock(ctx context.Context, pgTryAdvisoryXactLock i
 	return pg_try_advisory_xact_lock, err
 }
 
-const acquireNotificationMessages = `-- name: AcquireNotificationMessages :many
-WITH acquired AS (
+const deleteMaintenanceWindowByID = `-- name: DeleteMaintenanceWindowByID :exec
+DELETE FROM
+	maintenance_windows
+WHERE
+	id = $1
+`
+
+func (q *sqlQuerier) DeleteMaintenanceWindowByID(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteMaintenanceWindowByID, id)
+	return err
+}
+
+const getMaintenanceWindowByID = `-- name: GetMaintenanceWindowByID :one
+SELECT
+	id, organization_id, template_id, name, message, schedule, duration, notice_period, block_builds, last_notified_start, created_at, updated_at
+FROM
+	maintenance_windows
+WHERE
+	id = $1
+`
+
+func (q *sqlQuerier) GetMaintenanceWindowByID(ctx context.Context, id uuid.UUID) (MaintenanceWindow, error) {
+	row := q.db.QueryRowContext(ctx, getMaintenanceWindowByID, id)
+	var i MaintenanceWindow
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.TemplateID,
+		&i.Name,
+		&i.Message,
+		&i.Schedule,
+		&i.Duration,
+		&i.NoticePeriod,
+		&i.BlockBuilds,
+		&i.LastNotifiedStart,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMaintenanceWindows = `-- name: GetMaintenanceWindows :many
+SELECT
+	id, organization_id, template_id, name, message, schedule, duration, notice_period, block_builds, last_notified_start, created_at, updated_at
+FROM
+	maintenance_windows
+ORDER BY
+	organization_id, name ASC
+`
+
+func (q *sqlQuerier) GetMaintenanceWindows(ctx context.Context) ([]MaintenanceWindow, error) {
+	rows, err := q.db.QueryContext(ctx, getMaintenanceWindows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MaintenanceWindow
+	for rows.Next() {
+		var i MaintenanceWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.TemplateID,
+			&i.Name,
+			&i.Message,
+			&i.Schedule,
+			&i.Duration,
+			&i.NoticePeriod,
+			&i.BlockBuilds,
+			&i.LastNotifiedStart,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMaintenanceWindowsByOrganizationID = `-- name: GetMaintenanceWindowsByOrganizationID :many
+SELECT
+	id, organization_id, template_id, name, message, schedule, duration, notice_period, block_builds, last_notified_start, created_at, updated_at
+FROM
+	maintenance_windows
+WHERE
+	organization_id = $1
+ORDER BY
+	name ASC
+`
+
+func (q *sqlQuerier) GetMaintenanceWindowsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]MaintenanceWindow, error) {
+	rows, err := q.db.QueryContext(ctx, getMaintenanceWindowsByOrganizationID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MaintenanceWindow
+	for rows.Next() {
+		var i MaintenanceWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.TemplateID,
+			&i.Name,
+			&i.Message,
+			&i.Schedule,
+			&i.Duration,
+			&i.NoticePeriod,
+			&i.BlockBuilds,
+			&i.LastNotifiedStart,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertMaintenanceWindow = `-- name: InsertMaintenanceWindow :one
+INSERT INTO
+	maintenance_windows (
+		id,
+		organization_id,
+		template_id,
+		name,
+		message,
+		schedule,
+		duration,
+		notice_period,
+		block_builds,
+		created_at,
+		updated_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, organization_id, template_id, name, message, schedule, duration, notice_period, block_builds, last_notified_start, created_at, updated_at
+`
+
+type InsertMaintenanceWindowParams struct {
+	ID             uuid.UUID     `db:"id" json:"id"`
+	OrganizationID uuid.UUID     `db:"organization_id" json:"organization_id"`
+	TemplateID     uuid.NullUUID `db:"template_id" json:"template_id"`
+	Name           string        `db:"name" json:"name"`
+	Message        string        `db:"message" json:"message"`
+	Schedule       string        `db:"schedule" json:"schedule"`
+	Duration       int64         `db:"duration" json:"duration"`
+	NoticePeriod   int64         `db:"notice_period" json:"notice_period"`
+	BlockBuilds    bool          `db:"block_builds" json:"block_builds"`
+	CreatedAt      time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time     `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) InsertMaintenanceWindow(ctx context.Context, arg InsertMaintenanceWindowParams) (MaintenanceWindow, error) {
+	row := q.db.QueryRowContext(ctx, insertMaintenanceWindow,
+		arg.ID,
+		arg.OrganizationID,
+		arg.TemplateID,
+		arg.Name,
+		arg.Message,
+		arg.Schedule,
+		arg.Duration,
+		arg.NoticePeriod,
+		arg.BlockBuilds,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i MaintenanceWindow
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.TemplateID,
+		&i.Name,
+		&i.Message,
+		&i.Schedule,
+		&i.Duration,
+		&i.NoticePeriod,
+		&i.BlockBuilds,
+		&i.LastNotifiedStart,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateMaintenanceWindowByID = `-- name: UpdateMaintenanceWindowByID :one
+UPDATE
+	maintenance_windows
+SET
+	name = $1,
+	message = $2,
+	last_notified_start = CASE WHEN schedule = $3 THEN last_notified_start ELSE NULL END,
+	schedule = $3,
+	duration = $4,
+	notice_period = $5,
+	block_builds = $6,
+	updated_at = $7
+WHERE
+	id = $8
+RETURNING id, organization_id, template_id, name, message, schedule, duration, notice_period, block_builds, last_notified_start, created_at, updated_at
+`
+
+type UpdateMaintenanceWindowByIDParams struct {
+	Name         string    `db:"name" json:"name"`
+	Message      string    `db:"message" json:"message"`
+	Schedule     string    `db:"schedule" json:"schedule"`
+	Duration     int64     `db:"duration" json:"duration"`
+	NoticePeriod int64     `db:"notice_period" json:"notice_period"`
+	BlockBuilds  bool      `db:"block_builds" json:"block_builds"`
+	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+	ID           uuid.UUID `db:"id" json:"id"`
+}
+
+// Changing the schedule of a window resets its notification state, so that
+// users are notified of the rescheduled window.
+func (q *sqlQuerier) UpdateMaintenanceWindowByID(ctx context.Context, arg UpdateMaintenanceWindowByIDParams) (MaintenanceWindow, error) {
+	row := q.db.QueryRowContext(ctx, updateMaintenanceWindowByID,
+		arg.Name,
+		arg.Message,
+		arg.Schedule,
+		arg.Duration,
+		arg.NoticePeriod,
+		arg.BlockBuilds,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	var i MaintenanceWindow
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.TemplateID,
+		&i.Name,
+		&i.Message,
+		&i.Schedule,
+		&i.Duration,
+		&i.NoticePeriod,
+		&i.BlockBuilds,
+		&i.LastNotifiedStart,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateMaintenanceWindowLastNotifiedStart = `-- name: UpdateMaintenanceWindowLastNotifiedStart :execrows
+UPDATE
+	maintenance_windows
+SET
+	last_notified_start = $1
+WHERE
+	id = $2
+	AND last_notified_start IS DISTINCT FROM $1
+`
+
+type UpdateMaintenanceWindowLastNotifiedStartParams struct {
+	LastNotifiedStart sql.NullTime `db:"last_notified_start" json:"last_notified_start"`
+	ID                uuid.UUID    `db:"id" json:"id"`
+}
+
+// Only updates a window that wasn't already notified of the given start, so
+// that of several replicas claiming an occurrence at once only one notifies
+// users of it.
+func (q *sqlQuerier) UpdateMaintenanceWindowLastNotifiedStart(ctx context.Context, arg UpdateMaintenanceWindowLastNotifiedStartParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateMaintenanceWindowLastNotifiedStart, arg.LastNotifiedStart, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const acquireNotificationMessages = `-- name: AcquireNotificationMessages :many
+WITH acquired AS (
     UPDATE
         notification_messages
             SET queued_seconds = GREATEST(0, EXTRACT(EPOCH FROM (NOW() - updated_at)))::FLOAT,
@@ -3563,11 +4336,28 @@ WITH acquired AS (
                                  ELSE true
                                  END
                              )
+                           -- when digests are enabled, hold messages back until the oldest outstanding message of the
+                           -- same template and method for the same user has exceeded the digest window
+                           AND (
+                             $4::int = 0
+                                 OR EXISTS (SELECT 1
+                                            FROM notification_messages AS oldest
+                                            WHERE oldest.user_id = nm.user_id
+                                              AND oldest.notification_template_id = nm.notification_template_id
+                                              AND oldest.method = nm.method
+                                              AND oldest.status IN (
+                                                                    'pending'::notification_message_status,
+                                                                    'temporary_failure'::notification_message_status,
+                                                                    'leased'::notification_message_status
+                                                )
+                                              AND oldest.created_at <=
+                                                  NOW() - CONCAT($4::int, ' seconds')::interval)
+                             )
                          ORDER BY nm.created_at ASC
                                   -- Ensure that multiple concurrent readers cannot retrieve the same rows
                              FOR UPDATE OF nm
                                  SKIP LOCKED
-                         LIMIT $4)
+                         LIMIT $5)
             RETURNING id, notification_template_id, user_id, method, status, status_reason, created_by, payload, attempt_count, targets, created_at, updated_at, leased_until, next_retry_after, queued_seconds, dedupe_hash)
 SELECT
     -- message
@@ -3576,6 +4366,8 @@ SELECT
     nm.method,
     nm.attempt_count::int                                                 AS attempt_count,
     nm.queued_seconds::float                                              AS queued_seconds,
+    nm.user_id,
+    nm.created_at,
     -- template
     nt.id                                                                 AS template_id,
     nt.title_template,
@@ -3589,10 +4381,11 @@ FROM acquired nm
 `
 
 type AcquireNotificationMessagesParams struct {
-	NotifierID      uuid.UUID `db:"notifier_id" json:"notifier_id"`
-	LeaseSeconds    int32     `db:"lease_seconds" json:"lease_seconds"`
-	MaxAttemptCount int32     `db:"max_attempt_count" json:"max_attempt_count"`
-	Count           int32     `db:"count" json:"count"`
+	NotifierID          uuid.UUID `db:"notifier_id" json:"notifier_id"`
+	LeaseSeconds        int32     `db:"lease_seconds" json:"lease_seconds"`
+	MaxAttemptCount     int32     `db:"max_attempt_count" json:"max_attempt_count"`
+	DigestWindowSeconds int32     `db:"digest_window_seconds" json:"digest_window_seconds"`
+	Count               int32     `db:"count" json:"count"`
 }
 
 type AcquireNotificationMessagesRow struct {
@@ -3601,6 +4394,8 @@ type AcquireNotificationMessagesRow struct {
 	Method        NotificationMethod `db:"method" json:"method"`
 	AttemptCount  int32              `db:"attempt_count" json:"attempt_count"`
 	QueuedSeconds float64            `db:"queued_seconds" json:"queued_seconds"`
+	UserID        uuid.UUID          `db:"user_id" json:"user_id"`
+	CreatedAt     time.Time          `db:"created_at" json:"created_at"`
 	TemplateID    uuid.UUID          `db:"template_id" json:"template_id"`
 	TitleTemplate string             `db:"title_template" json:"title_template"`
 	BodyTemplate  string             `db:"body_template" json:"body_template"`
@@ -3622,6 +4417,7 @@ func (q *sqlQuerier) AcquireNotificationMessages(ctx context.Context, arg Acquir
 		arg.NotifierID,
 		arg.LeaseSeconds,
 		arg.MaxAttemptCount,
+		arg.DigestWindowSeconds,
 		arg.Count,
 	)
 	if err != nil {
@@ -3637,6 +4433,8 @@ func (q *sqlQuerier) AcquireNotificationMessages(ctx context.Context, arg Acquir
 			&i.Method,
 			&i.AttemptCount,
 			&i.QueuedSeconds,
+			&i.UserID,
+			&i.CreatedAt,
 			&i.TemplateID,
 			&i.TitleTemplate,
 			&i.BodyTemplate,
@@ -5498,26 +6296,71 @@ WHERE
 				-- Ensure the caller satisfies all job tags.
 				ELSE nested.tags :: jsonb <@ $5 :: jsonb
 			END
+			AND (
+				$6 :: integer <= 0
+				OR (
+					SELECT
+						COUNT(*)
+					FROM
+						provisioner_jobs AS running
+					WHERE
+						running.organization_id = nested.organization_id
+						AND running.started_at IS NOT NULL
+						AND running.completed_at IS NULL
+				) < $6 :: integer
+			)
+			AND (
+				$7 :: integer <= 0
+				OR (
+					SELECT
+						COUNT(*)
+					FROM
+						provisioner_jobs AS running
+					WHERE
+						running.initiator_id = nested.initiator_id
+						AND running.started_at IS NOT NULL
+						AND running.completed_at IS NULL
+				) < $7 :: integer
+			)
 		ORDER BY
+			nested.priority DESC,
+			(
+				SELECT
+					COUNT(*)
+				FROM
+					provisioner_jobs AS running
+				WHERE
+					running.initiator_id = nested.initiator_id
+					AND running.started_at IS NOT NULL
+					AND running.completed_at IS NULL
+			) ASC,
 			nested.created_at
 		FOR UPDATE
 		SKIP LOCKED
 		LIMIT
 			1
-	) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status
+	) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, priority
 `
 
 type AcquireProvisionerJobParams struct {
-	StartedAt      sql.NullTime      `db:"started_at" json:"started_at"`
-	WorkerID       uuid.NullUUID     `db:"worker_id" json:"worker_id"`
-	OrganizationID uuid.UUID         `db:"organization_id" json:"organization_id"`
-	Types          []ProvisionerType `db:"types" json:"types"`
-	Tags           json.RawMessage   `db:"tags" json:"tags"`
+	StartedAt                     sql.NullTime      `db:"started_at" json:"started_at"`
+	WorkerID                      uuid.NullUUID     `db:"worker_id" json:"worker_id"`
+	OrganizationID                uuid.UUID         `db:"organization_id" json:"organization_id"`
+	Types                         []ProvisionerType `db:"types" json:"types"`
+	Tags                          json.RawMessage   `db:"tags" json:"tags"`
+	MaxRunningJobsPerOrganization int32             `db:"max_running_jobs_per_organization" json:"max_running_jobs_per_organization"`
+	MaxRunningJobsPerUser         int32             `db:"max_running_jobs_per_user" json:"max_running_jobs_per_user"`
 }
 
 // Acquires the lock for a single job that isn't started, completed,
 // canceled, and that matches an array of provisioner types.
 //
+// Jobs with a higher priority are acquired first. Among jobs of the same
+// priority, the jobs of initiators with the fewest running jobs are acquired
+// first, so that a single user cannot starve everyone else. Jobs of
+// organizations or initiators that already run as many jobs as they are
+// allowed to are skipped. A limit of zero or less disables it.
+//
 // SKIP LOCKED is used to jump over locked rows. This prevents
 // multiple provisioners from acquiring the same jobs. See:
 // https://www.postgresql.org/docs/9.5/sql-select.html#SQL-FOR-UPDATE-SHARE
@@ -5528,6 +6371,8 @@ func (q *sqlQuerier) AcquireProvisionerJob(ctx context.Context, arg AcquireProvi
 		arg.OrganizationID,
 		pq.Array(arg.Types),
 		arg.Tags,
+		arg.MaxRunningJobsPerOrganization,
+		arg.MaxRunningJobsPerUser,
 	)
 	var i ProvisionerJob
 	err := row.Scan(
@@ -5550,13 +6395,14 @@ func (q *sqlQuerier) AcquireProvisionerJob(ctx context.Context, arg AcquireProvi
 		&i.ErrorCode,
 		&i.TraceMetadata,
 		&i.JobStatus,
+		&i.Priority,
 	)
 	return i, err
 }
 
 const getHungProvisionerJobs = `-- name: GetHungProvisionerJobs :many
 SELECT
-	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status
+	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, priority
 FROM
 	provisioner_jobs
 WHERE
@@ -5594,6 +6440,7 @@ func (q *sqlQuerier) GetHungProvisionerJobs(ctx context.Context, updatedAt time.
 			&i.ErrorCode,
 			&i.TraceMetadata,
 			&i.JobStatus,
+			&i.Priority,
 		); err != nil {
 			return nil, err
 		}
@@ -5610,7 +6457,7 @@ func (q *sqlQuerier) GetHungProvisionerJobs(ctx context.Context, updatedAt time.
 
 const getProvisionerJobByID = `-- name: GetProvisionerJobByID :one
 SELECT
-	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status
+	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, priority
 FROM
 	provisioner_jobs
 WHERE
@@ -5640,10 +6487,81 @@ func (q *sqlQuerier) GetProvisionerJobByID(ctx context.Context, id uuid.UUID) (P
 		&i.ErrorCode,
 		&i.TraceMetadata,
 		&i.JobStatus,
+		&i.Priority,
 	)
 	return i, err
 }
 
+const getProvisionerJobResourceChangesByJobID = `-- name: GetProvisionerJobResourceChangesByJobID :many
+SELECT job_id, address, type, name, action FROM provisioner_job_resource_changes
+WHERE job_id = $1
+ORDER BY address ASC
+`
+
+func (q *sqlQuerier) GetProvisionerJobResourceChangesByJobID(ctx context.Context, jobID uuid.UUID) ([]ProvisionerJobResourceChange, error) {
+	rows, err := q.db.QueryContext(ctx, getProvisionerJobResourceChangesByJobID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProvisionerJobResourceChange
+	for rows.Next() {
+		var i ProvisionerJobResourceChange
+		if err := rows.Scan(
+			&i.JobID,
+			&i.Address,
+			&i.Type,
+			&i.Name,
+			&i.Action,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProvisionerJobResourceChangesByJobIDs = `-- name: GetProvisionerJobResourceChangesByJobIDs :many
+SELECT job_id, address, type, name, action FROM provisioner_job_resource_changes
+WHERE job_id = ANY($1 :: uuid [ ])
+ORDER BY job_id, address ASC
+`
+
+func (q *sqlQuerier) GetProvisionerJobResourceChangesByJobIDs(ctx context.Context, ids []uuid.UUID) ([]ProvisionerJobResourceChange, error) {
+	rows, err := q.db.QueryContext(ctx, getProvisionerJobResourceChangesByJobIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProvisionerJobResourceChange
+	for rows.Next() {
+		var i ProvisionerJobResourceChange
+		if err := rows.Scan(
+			&i.JobID,
+			&i.Address,
+			&i.Type,
+			&i.Name,
+			&i.Action,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getProvisionerJobTimingsByJobID = `-- name: GetProvisionerJobTimingsByJobID :many
 SELECT job_id, started_at, ended_at, stage, source, action, resource FROM provisioner_job_timings
 WHERE job_id = $1
@@ -5683,7 +6601,7 @@ func (q *sqlQuerier) GetProvisionerJobTimingsByJobID(ctx context.Context, jobID
 
 const getProvisionerJobsByIDs = `-- name: GetProvisionerJobsByIDs :many
 SELECT
-	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status
+	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, priority
 FROM
 	provisioner_jobs
 WHERE
@@ -5719,6 +6637,7 @@ func (q *sqlQuerier) GetProvisionerJobsByIDs(ctx context.Context, ids []uuid.UUI
 			&i.ErrorCode,
 			&i.TraceMetadata,
 			&i.JobStatus,
+			&i.Priority,
 		); err != nil {
 			return nil, err
 		}
@@ -5736,7 +6655,7 @@ func (q *sqlQuerier) GetProvisionerJobsByIDs(ctx context.Context, ids []uuid.UUI
 const getProvisionerJobsByIDsWithQueuePosition = `-- name: GetProvisionerJobsByIDsWithQueuePosition :many
 WITH unstarted_jobs AS (
     SELECT
-        id, created_at
+        id, created_at, priority
     FROM
         provisioner_jobs
     WHERE
@@ -5745,33 +6664,69 @@ WITH unstarted_jobs AS (
 queue_position AS (
     SELECT
         id,
-        ROW_NUMBER() OVER (ORDER BY created_at ASC) AS queue_position
+        ROW_NUMBER() OVER (ORDER BY priority DESC, created_at ASC) AS queue_position
     FROM
         unstarted_jobs
 ),
 queue_size AS (
 	SELECT COUNT(*) as count FROM unstarted_jobs
+),
+running_jobs AS (
+	SELECT
+		COUNT(*) as count
+	FROM
+		provisioner_jobs
+	WHERE
+		started_at IS NOT NULL
+		AND completed_at IS NULL
+),
+average_duration AS (
+	SELECT
+		AVG(EXTRACT(EPOCH FROM recent.completed_at - recent.started_at)) AS seconds
+	FROM (
+		SELECT
+			started_at, completed_at
+		FROM
+			provisioner_jobs
+		WHERE
+			started_at IS NOT NULL
+			AND completed_at IS NOT NULL
+		ORDER BY
+			completed_at DESC
+		LIMIT
+			100
+	) AS recent
 )
 SELECT
-	pj.id, pj.created_at, pj.updated_at, pj.started_at, pj.canceled_at, pj.completed_at, pj.error, pj.organization_id, pj.initiator_id, pj.provisioner, pj.storage_method, pj.type, pj.input, pj.worker_id, pj.file_id, pj.tags, pj.error_code, pj.trace_metadata, pj.job_status,
+	pj.id, pj.created_at, pj.updated_at, pj.started_at, pj.canceled_at, pj.completed_at, pj.error, pj.organization_id, pj.initiator_id, pj.provisioner, pj.storage_method, pj.type, pj.input, pj.worker_id, pj.file_id, pj.tags, pj.error_code, pj.trace_metadata, pj.job_status, pj.priority,
     COALESCE(qp.queue_position, 0) AS queue_position,
-    COALESCE(qs.count, 0) AS queue_size
+    COALESCE(qs.count, 0) AS queue_size,
+    COALESCE(rj.count, 0) AS running_jobs,
+    COALESCE(ad.seconds, 0) :: float AS average_duration_seconds
 FROM
 	provisioner_jobs pj
 LEFT JOIN
 	queue_position qp ON qp.id = pj.id
 LEFT JOIN
 	queue_size qs ON TRUE
+LEFT JOIN
+	running_jobs rj ON TRUE
+LEFT JOIN
+	average_duration ad ON TRUE
 WHERE
 	pj.id = ANY($1 :: uuid [ ])
 `
 
 type GetProvisionerJobsByIDsWithQueuePositionRow struct {
-	ProvisionerJob ProvisionerJob `db:"provisioner_job" json:"provisioner_job"`
-	QueuePosition  int64          `db:"queue_position" json:"queue_position"`
-	QueueSize      int64          `db:"queue_size" json:"queue_size"`
+	ProvisionerJob         ProvisionerJob `db:"provisioner_job" json:"provisioner_job"`
+	QueuePosition          int64          `db:"queue_position" json:"queue_position"`
+	QueueSize              int64          `db:"queue_size" json:"queue_size"`
+	RunningJobs            int64          `db:"running_jobs" json:"running_jobs"`
+	AverageDurationSeconds float64        `db:"average_duration_seconds" json:"average_duration_seconds"`
 }
 
+// The running jobs and the average duration of recently completed jobs are
+// returned to estimate when queued jobs will start.
 func (q *sqlQuerier) GetProvisionerJobsByIDsWithQueuePosition(ctx context.Context, ids []uuid.UUID) ([]GetProvisionerJobsByIDsWithQueuePositionRow, error) {
 	rows, err := q.db.QueryContext(ctx, getProvisionerJobsByIDsWithQueuePosition, pq.Array(ids))
 	if err != nil {
@@ -5801,8 +6756,11 @@ func (q *sqlQuerier) GetProvisionerJobsByIDsWithQueuePosition(ctx context.Contex
 			&i.ProvisionerJob.ErrorCode,
 			&i.ProvisionerJob.TraceMetadata,
 			&i.ProvisionerJob.JobStatus,
+			&i.ProvisionerJob.Priority,
 			&i.QueuePosition,
 			&i.QueueSize,
+			&i.RunningJobs,
+			&i.AverageDurationSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -5818,7 +6776,7 @@ func (q *sqlQuerier) GetProvisionerJobsByIDsWithQueuePosition(ctx context.Contex
 }
 
 const getProvisionerJobsCreatedAfter = `-- name: GetProvisionerJobsCreatedAfter :many
-SELECT id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status FROM provisioner_jobs WHERE created_at > $1
+SELECT id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, priority FROM provisioner_jobs WHERE created_at > $1
 `
 
 func (q *sqlQuerier) GetProvisionerJobsCreatedAfter(ctx context.Context, createdAt time.Time) ([]ProvisionerJob, error) {
@@ -5850,6 +6808,7 @@ func (q *sqlQuerier) GetProvisionerJobsCreatedAfter(ctx context.Context, created
 			&i.ErrorCode,
 			&i.TraceMetadata,
 			&i.JobStatus,
+			&i.Priority,
 		); err != nil {
 			return nil, err
 		}
@@ -5878,10 +6837,11 @@ INSERT INTO
 		"type",
 		"input",
 		tags,
-		trace_metadata
+		trace_metadata,
+		priority
 	)
 VALUES
-	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, priority
 `
 
 type InsertProvisionerJobParams struct {
@@ -5897,6 +6857,7 @@ type InsertProvisionerJobParams struct {
 	Input          json.RawMessage          `db:"input" json:"input"`
 	Tags           StringMap                `db:"tags" json:"tags"`
 	TraceMetadata  pqtype.NullRawMessage    `db:"trace_metadata" json:"trace_metadata"`
+	Priority       int32                    `db:"priority" json:"priority"`
 }
 
 func (q *sqlQuerier) InsertProvisionerJob(ctx context.Context, arg InsertProvisionerJobParams) (ProvisionerJob, error) {
@@ -5913,6 +6874,7 @@ func (q *sqlQuerier) InsertProvisionerJob(ctx context.Context, arg InsertProvisi
 		arg.Input,
 		arg.Tags,
 		arg.TraceMetadata,
+		arg.Priority,
 	)
 	var i ProvisionerJob
 	err := row.Scan(
@@ -5935,11 +6897,66 @@ func (q *sqlQuerier) InsertProvisionerJob(ctx context.Context, arg InsertProvisi
 		&i.ErrorCode,
 		&i.TraceMetadata,
 		&i.JobStatus,
+		&i.Priority,
 	)
 	return i, err
 }
 
-const insertProvisionerJobTimings = `-- name: InsertProvisionerJobTimings :many
+const insertProvisionerJobResourceChanges = `-- name: InsertProvisionerJobResourceChanges :many
+INSERT INTO provisioner_job_resource_changes (job_id, address, type, name, action)
+SELECT
+    $1::uuid AS provisioner_job_id,
+    unnest($2::text[]),
+    unnest($3::text[]),
+    unnest($4::text[]),
+    unnest($5::provisioner_job_resource_change_action[])
+RETURNING job_id, address, type, name, action
+`
+
+type InsertProvisionerJobResourceChangesParams struct {
+	JobID   uuid.UUID                            `db:"job_id" json:"job_id"`
+	Address []string                             `db:"address" json:"address"`
+	Type    []string                             `db:"type" json:"type"`
+	Name    []string                             `db:"name" json:"name"`
+	Action  []ProvisionerJobResourceChangeAction `db:"action" json:"action"`
+}
+
+func (q *sqlQuerier) InsertProvisionerJobResourceChanges(ctx context.Context, arg InsertProvisionerJobResourceChangesParams) ([]ProvisionerJobResourceChange, error) {
+	rows, err := q.db.QueryContext(ctx, insertProvisionerJobResourceChanges,
+		arg.JobID,
+		pq.Array(arg.Address),
+		pq.Array(arg.Type),
+		pq.Array(arg.Name),
+		pq.Array(arg.Action),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProvisionerJobResourceChange
+	for rows.Next() {
+		var i ProvisionerJobResourceChange
+		if err := rows.Scan(
+			&i.JobID,
+			&i.Address,
+			&i.Type,
+			&i.Name,
+			&i.Action,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertProvisionerJobTimings = `-- name: InsertProvisionerJobTimings :many
 INSERT INTO provisioner_job_timings (job_id, started_at, ended_at, stage, source, action, resource)
 SELECT
     $1::uuid AS provisioner_job_id,
@@ -8226,7 +9243,7 @@ func (q *sqlQuerier) GetTemplateAverageBuildTime(ctx context.Context, arg GetTem
 
 const getTemplateByID = `-- name: GetTemplateByID :one
 SELECT
-	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon
+	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, idle_detection_enabled, idle_cpu_threshold_percent, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon
 FROM
 	template_with_names
 WHERE
@@ -8267,6 +9284,8 @@ func (q *sqlQuerier) GetTemplateByID(ctx context.Context, id uuid.UUID) (Templat
 		&i.Deprecated,
 		&i.ActivityBump,
 		&i.MaxPortSharingLevel,
+		&i.IdleDetectionEnabled,
+		&i.IdleCPUThresholdPercent,
 		&i.CreatedByAvatarURL,
 		&i.CreatedByUsername,
 		&i.OrganizationName,
@@ -8278,7 +9297,7 @@ func (q *sqlQuerier) GetTemplateByID(ctx context.Context, id uuid.UUID) (Templat
 
 const getTemplateByOrganizationAndName = `-- name: GetTemplateByOrganizationAndName :one
 SELECT
-	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon
+	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, idle_detection_enabled, idle_cpu_threshold_percent, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon
 FROM
 	template_with_names AS templates
 WHERE
@@ -8327,6 +9346,8 @@ func (q *sqlQuerier) GetTemplateByOrganizationAndName(ctx context.Context, arg G
 		&i.Deprecated,
 		&i.ActivityBump,
 		&i.MaxPortSharingLevel,
+		&i.IdleDetectionEnabled,
+		&i.IdleCPUThresholdPercent,
 		&i.CreatedByAvatarURL,
 		&i.CreatedByUsername,
 		&i.OrganizationName,
@@ -8337,7 +9358,7 @@ func (q *sqlQuerier) GetTemplateByOrganizationAndName(ctx context.Context, arg G
 }
 
 const getTemplates = `-- name: GetTemplates :many
-SELECT id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon FROM template_with_names AS templates
+SELECT id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, idle_detection_enabled, idle_cpu_threshold_percent, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon FROM template_with_names AS templates
 ORDER BY (name, id) ASC
 `
 
@@ -8379,6 +9400,8 @@ func (q *sqlQuerier) GetTemplates(ctx context.Context) ([]Template, error) {
 			&i.Deprecated,
 			&i.ActivityBump,
 			&i.MaxPortSharingLevel,
+			&i.IdleDetectionEnabled,
+			&i.IdleCPUThresholdPercent,
 			&i.CreatedByAvatarURL,
 			&i.CreatedByUsername,
 			&i.OrganizationName,
@@ -8400,7 +9423,7 @@ func (q *sqlQuerier) GetTemplates(ctx context.Context) ([]Template, error) {
 
 const getTemplatesWithFilter = `-- name: GetTemplatesWithFilter :many
 SELECT
-	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon
+	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, idle_detection_enabled, idle_cpu_threshold_percent, created_by_avatar_url, created_by_username, organization_name, organization_display_name, organization_icon
 FROM
 	template_with_names AS templates
 WHERE
@@ -8500,6 +9523,8 @@ func (q *sqlQuerier) GetTemplatesWithFilter(ctx context.Context, arg GetTemplate
 			&i.Deprecated,
 			&i.ActivityBump,
 			&i.MaxPortSharingLevel,
+			&i.IdleDetectionEnabled,
+			&i.IdleCPUThresholdPercent,
 			&i.CreatedByAvatarURL,
 			&i.CreatedByUsername,
 			&i.OrganizationName,
@@ -8722,7 +9747,9 @@ SET
 	autostart_block_days_of_week = $9,
 	failure_ttl = $10,
 	time_til_dormant = $11,
-	time_til_dormant_autodelete = $12
+	time_til_dormant_autodelete = $12,
+	idle_detection_enabled = $13,
+	idle_cpu_threshold_percent = $14
 WHERE
 	id = $1
 `
@@ -8740,6 +9767,8 @@ type UpdateTemplateScheduleByIDParams struct {
 	FailureTTL                    int64     `db:"failure_ttl" json:"failure_ttl"`
 	TimeTilDormant                int64     `db:"time_til_dormant" json:"time_til_dormant"`
 	TimeTilDormantAutoDelete      int64     `db:"time_til_dormant_autodelete" json:"time_til_dormant_autodelete"`
+	IdleDetectionEnabled          bool      `db:"idle_detection_enabled" json:"idle_detection_enabled"`
+	IdleCPUThresholdPercent       int32     `db:"idle_cpu_threshold_percent" json:"idle_cpu_threshold_percent"`
 }
 
 func (q *sqlQuerier) UpdateTemplateScheduleByID(ctx context.Context, arg UpdateTemplateScheduleByIDParams) error {
@@ -8756,6 +9785,8 @@ func (q *sqlQuerier) UpdateTemplateScheduleByID(ctx context.Context, arg UpdateT
 		arg.FailureTTL,
 		arg.TimeTilDormant,
 		arg.TimeTilDormantAutoDelete,
+		arg.IdleDetectionEnabled,
+		arg.IdleCPUThresholdPercent,
 	)
 	return err
 }
@@ -8911,6 +9942,177 @@ func (q *sqlQuerier) InsertTemplateVersionParameter(ctx context.Context, arg Ins
 	return i, err
 }
 
+const getActiveTemplateVersionRolloutByTemplateID = `-- name: GetActiveTemplateVersionRolloutByTemplateID :one
+SELECT
+	id, template_id, template_version_id, status, percent, group_id, created_by, created_at, updated_at
+FROM
+	template_version_rollouts
+WHERE
+	template_id = $1
+	AND status = 'canary'::template_version_rollout_status
+`
+
+func (q *sqlQuerier) GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, getActiveTemplateVersionRolloutByTemplateID, templateID)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.Status,
+		&i.Percent,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLatestTemplateVersionRolloutByTemplateVersionID = `-- name: GetLatestTemplateVersionRolloutByTemplateVersionID :one
+SELECT
+	id, template_id, template_version_id, status, percent, group_id, created_by, created_at, updated_at
+FROM
+	template_version_rollouts
+WHERE
+	template_version_id = $1
+ORDER BY
+	created_at DESC
+LIMIT
+	1
+`
+
+func (q *sqlQuerier) GetLatestTemplateVersionRolloutByTemplateVersionID(ctx context.Context, templateVersionID uuid.UUID) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, getLatestTemplateVersionRolloutByTemplateVersionID, templateVersionID)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.Status,
+		&i.Percent,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTemplateVersionRolloutByID = `-- name: GetTemplateVersionRolloutByID :one
+SELECT
+	id, template_id, template_version_id, status, percent, group_id, created_by, created_at, updated_at
+FROM
+	template_version_rollouts
+WHERE
+	id = $1
+`
+
+func (q *sqlQuerier) GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, getTemplateVersionRolloutByID, id)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.Status,
+		&i.Percent,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const insertTemplateVersionRollout = `-- name: InsertTemplateVersionRollout :one
+INSERT INTO
+	template_version_rollouts (
+		id,
+		template_id,
+		template_version_id,
+		percent,
+		group_id,
+		created_by,
+		created_at,
+		updated_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, template_id, template_version_id, status, percent, group_id, created_by, created_at, updated_at
+`
+
+type InsertTemplateVersionRolloutParams struct {
+	ID                uuid.UUID     `db:"id" json:"id"`
+	TemplateID        uuid.UUID     `db:"template_id" json:"template_id"`
+	TemplateVersionID uuid.UUID     `db:"template_version_id" json:"template_version_id"`
+	Percent           int32         `db:"percent" json:"percent"`
+	GroupID           uuid.NullUUID `db:"group_id" json:"group_id"`
+	CreatedBy         uuid.UUID     `db:"created_by" json:"created_by"`
+	CreatedAt         time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time     `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) InsertTemplateVersionRollout(ctx context.Context, arg InsertTemplateVersionRolloutParams) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, insertTemplateVersionRollout,
+		arg.ID,
+		arg.TemplateID,
+		arg.TemplateVersionID,
+		arg.Percent,
+		arg.GroupID,
+		arg.CreatedBy,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.Status,
+		&i.Percent,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateTemplateVersionRolloutStatusByID = `-- name: UpdateTemplateVersionRolloutStatusByID :one
+UPDATE
+	template_version_rollouts
+SET
+	status = $1,
+	updated_at = $2
+WHERE
+	id = $3
+RETURNING id, template_id, template_version_id, status, percent, group_id, created_by, created_at, updated_at
+`
+
+type UpdateTemplateVersionRolloutStatusByIDParams struct {
+	Status    TemplateVersionRolloutStatus `db:"status" json:"status"`
+	UpdatedAt time.Time                    `db:"updated_at" json:"updated_at"`
+	ID        uuid.UUID                    `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg UpdateTemplateVersionRolloutStatusByIDParams) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, updateTemplateVersionRolloutStatusByID, arg.Status, arg.UpdatedAt, arg.ID)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.Status,
+		&i.Percent,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const archiveUnusedTemplateVersions = `-- name: ArchiveUnusedTemplateVersions :many
 UPDATE
 	template_versions
@@ -10982,7 +12184,7 @@ func (q *sqlQuerier) DeleteOldWorkspaceAgentLogs(ctx context.Context, threshold
 
 const getWorkspaceAgentAndLatestBuildByAuthToken = `-- name: GetWorkspaceAgentAndLatestBuildByAuthToken :one
 SELECT
-	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite,
+	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite, workspaces.user_acl, workspaces.group_acl,
 	workspace_agents.id, workspace_agents.created_at, workspace_agents.updated_at, workspace_agents.name, workspace_agents.first_connected_at, workspace_agents.last_connected_at, workspace_agents.disconnected_at, workspace_agents.resource_id, workspace_agents.auth_token, workspace_agents.auth_instance_id, workspace_agents.architecture, workspace_agents.environment_variables, workspace_agents.operating_system, workspace_agents.instance_metadata, workspace_agents.resource_metadata, workspace_agents.directory, workspace_agents.version, workspace_agents.last_connected_replica_id, workspace_agents.connection_timeout_seconds, workspace_agents.troubleshooting_url, workspace_agents.motd_file, workspace_agents.lifecycle_state, workspace_agents.expanded_directory, workspace_agents.logs_length, workspace_agents.logs_overflowed, workspace_agents.started_at, workspace_agents.ready_at, workspace_agents.subsystems, workspace_agents.display_apps, workspace_agents.api_version, workspace_agents.display_order,
 	workspace_build_with_user.id, workspace_build_with_user.created_at, workspace_build_with_user.updated_at, workspace_build_with_user.workspace_id, workspace_build_with_user.template_version_id, workspace_build_with_user.build_number, workspace_build_with_user.transition, workspace_build_with_user.initiator_id, workspace_build_with_user.provisioner_state, workspace_build_with_user.job_id, workspace_build_with_user.deadline, workspace_build_with_user.reason, workspace_build_with_user.daily_cost, workspace_build_with_user.max_deadline, workspace_build_with_user.initiator_by_avatar_url, workspace_build_with_user.initiator_by_username
 FROM
@@ -11041,6 +12243,8 @@ func (q *sqlQuerier) GetWorkspaceAgentAndLatestBuildByAuthToken(ctx context.Cont
 		&i.Workspace.DeletingAt,
 		&i.Workspace.AutomaticUpdates,
 		&i.Workspace.Favorite,
+		&i.Workspace.UserACL,
+		&i.Workspace.GroupACL,
 		&i.WorkspaceAgent.ID,
 		&i.WorkspaceAgent.CreatedAt,
 		&i.WorkspaceAgent.UpdatedAt,
@@ -12745,54 +13949,80 @@ func (q *sqlQuerier) InsertWorkspaceAppStats(ctx context.Context, arg InsertWork
 	return err
 }
 
-const getUserWorkspaceBuildParameters = `-- name: GetUserWorkspaceBuildParameters :many
-SELECT name, value
-FROM (
-    SELECT DISTINCT ON (tvp.name)
-        tvp.name,
-        wbp.value,
-        wb.created_at
-    FROM
-        workspace_build_parameters wbp
-    JOIN
-        workspace_builds wb ON wb.id = wbp.workspace_build_id
-    JOIN
-        workspaces w ON w.id = wb.workspace_id
-    JOIN
-        template_version_parameters tvp ON tvp.template_version_id = wb.template_version_id
-    WHERE
-		w.owner_id = $1
-		AND wb.transition = 'start'
-		AND w.template_id = $2
-		AND tvp.ephemeral = false
-		AND tvp.name = wbp.name
-    ORDER BY
-        tvp.name, wb.created_at DESC
-) q1
-ORDER BY created_at DESC, name
-LIMIT 100
+const deleteOldWorkspaceBuildDriftJobs = `-- name: DeleteOldWorkspaceBuildDriftJobs :exec
+DELETE FROM
+	provisioner_jobs
+WHERE
+	type = 'template_version_dry_run'::provisioner_job_type AND
+	(input ->> 'refresh_only') :: boolean AND
+	completed_at IS NOT NULL AND
+	NOT EXISTS (
+		SELECT
+			1
+		FROM
+			workspace_build_drift
+		WHERE
+			workspace_build_drift.job_id = provisioner_jobs.id OR
+			workspace_build_drift.checked_job_id = provisioner_jobs.id
+	)
 `
 
-type GetUserWorkspaceBuildParametersParams struct {
-	OwnerID    uuid.UUID `db:"owner_id" json:"owner_id"`
-	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+// Deletes the jobs of drift checks that were superseded by a newer check of
+// the same build. Their logs and resource changes are deleted with them.
+func (q *sqlQuerier) DeleteOldWorkspaceBuildDriftJobs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteOldWorkspaceBuildDriftJobs)
+	return err
 }
 
-type GetUserWorkspaceBuildParametersRow struct {
-	Name  string `db:"name" json:"name"`
-	Value string `db:"value" json:"value"`
+const getWorkspaceBuildDriftByJobID = `-- name: GetWorkspaceBuildDriftByJobID :one
+SELECT
+	workspace_build_id, job_id, started_at, checked_job_id, checked_at, drifted
+FROM
+	workspace_build_drift
+WHERE
+	job_id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceBuildDriftByJobID(ctx context.Context, jobID uuid.UUID) (WorkspaceBuildDrift, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceBuildDriftByJobID, jobID)
+	var i WorkspaceBuildDrift
+	err := row.Scan(
+		&i.WorkspaceBuildID,
+		&i.JobID,
+		&i.StartedAt,
+		&i.CheckedJobID,
+		&i.CheckedAt,
+		&i.Drifted,
+	)
+	return i, err
 }
 
-func (q *sqlQuerier) GetUserWorkspaceBuildParameters(ctx context.Context, arg GetUserWorkspaceBuildParametersParams) ([]GetUserWorkspaceBuildParametersRow, error) {
-	rows, err := q.db.QueryContext(ctx, getUserWorkspaceBuildParameters, arg.OwnerID, arg.TemplateID)
+const getWorkspaceBuildDriftsByWorkspaceBuildIDs = `-- name: GetWorkspaceBuildDriftsByWorkspaceBuildIDs :many
+SELECT
+	workspace_build_id, job_id, started_at, checked_job_id, checked_at, drifted
+FROM
+	workspace_build_drift
+WHERE
+	workspace_build_id = ANY($1 :: uuid [ ])
+`
+
+func (q *sqlQuerier) GetWorkspaceBuildDriftsByWorkspaceBuildIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceBuildDrift, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceBuildDriftsByWorkspaceBuildIDs, pq.Array(ids))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetUserWorkspaceBuildParametersRow
+	var items []WorkspaceBuildDrift
 	for rows.Next() {
-		var i GetUserWorkspaceBuildParametersRow
-		if err := rows.Scan(&i.Name, &i.Value); err != nil {
+		var i WorkspaceBuildDrift
+		if err := rows.Scan(
+			&i.WorkspaceBuildID,
+			&i.JobID,
+			&i.StartedAt,
+			&i.CheckedJobID,
+			&i.CheckedAt,
+			&i.Drifted,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -12806,25 +14036,77 @@ func (q *sqlQuerier) GetUserWorkspaceBuildParameters(ctx context.Context, arg Ge
 	return items, nil
 }
 
-const getWorkspaceBuildParameters = `-- name: GetWorkspaceBuildParameters :many
+const getWorkspacesEligibleForDriftCheck = `-- name: GetWorkspacesEligibleForDriftCheck :many
 SELECT
-    workspace_build_id, name, value
+	workspaces.id AS workspace_id,
+	workspace_builds.id AS workspace_build_id,
+	workspace_builds.template_version_id,
+	COALESCE(workspace_build_drift.drifted, false) :: boolean AS drifted,
+	(
+		workspace_build_drift.workspace_build_id IS NULL OR (
+			workspace_build_drift.started_at < $1 :: timestamptz AND
+			drift_jobs.completed_at IS NOT NULL
+		)
+	) :: boolean AS check_due
 FROM
-    workspace_build_parameters
+	workspaces
+INNER JOIN
+	workspace_builds ON workspace_builds.workspace_id = workspaces.id
+INNER JOIN
+	provisioner_jobs ON workspace_builds.job_id = provisioner_jobs.id
+LEFT JOIN
+	workspace_build_drift ON workspace_build_drift.workspace_build_id = workspace_builds.id
+LEFT JOIN
+	provisioner_jobs AS drift_jobs ON drift_jobs.id = workspace_build_drift.job_id
 WHERE
-    workspace_build_id = $1
+	workspace_builds.build_number = (
+		SELECT
+			MAX(build_number)
+		FROM
+			workspace_builds
+		WHERE
+			workspace_builds.workspace_id = workspaces.id
+	) AND
+	workspaces.deleted = false AND
+	workspaces.dormant_at IS NULL AND
+	workspace_builds.transition = 'start'::workspace_transition AND
+	provisioner_jobs.job_status = 'succeeded'::provisioner_job_status AND
+	(
+		COALESCE(workspace_build_drift.drifted, false) OR
+		workspace_build_drift.workspace_build_id IS NULL OR (
+			workspace_build_drift.started_at < $1 :: timestamptz AND
+			drift_jobs.completed_at IS NOT NULL
+		)
+	)
 `
 
-func (q *sqlQuerier) GetWorkspaceBuildParameters(ctx context.Context, workspaceBuildID uuid.UUID) ([]WorkspaceBuildParameter, error) {
-	rows, err := q.db.QueryContext(ctx, getWorkspaceBuildParameters, workspaceBuildID)
+type GetWorkspacesEligibleForDriftCheckRow struct {
+	WorkspaceID       uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	WorkspaceBuildID  uuid.UUID `db:"workspace_build_id" json:"workspace_build_id"`
+	TemplateVersionID uuid.UUID `db:"template_version_id" json:"template_version_id"`
+	Drifted           bool      `db:"drifted" json:"drifted"`
+	CheckDue          bool      `db:"check_due" json:"check_due"`
+}
+
+// Returns the running workspaces that are due for a drift check or have
+// drifted. A workspace is due if its latest build was never checked, or its
+// last check completed and started before @started_before.
+func (q *sqlQuerier) GetWorkspacesEligibleForDriftCheck(ctx context.Context, startedBefore time.Time) ([]GetWorkspacesEligibleForDriftCheckRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspacesEligibleForDriftCheck, startedBefore)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []WorkspaceBuildParameter
+	var items []GetWorkspacesEligibleForDriftCheckRow
 	for rows.Next() {
-		var i WorkspaceBuildParameter
-		if err := rows.Scan(&i.WorkspaceBuildID, &i.Name, &i.Value); err != nil {
+		var i GetWorkspacesEligibleForDriftCheckRow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.WorkspaceBuildID,
+			&i.TemplateVersionID,
+			&i.Drifted,
+			&i.CheckDue,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -12838,17 +14120,169 @@ func (q *sqlQuerier) GetWorkspaceBuildParameters(ctx context.Context, workspaceB
 	return items, nil
 }
 
-const insertWorkspaceBuildParameters = `-- name: InsertWorkspaceBuildParameters :exec
+const insertWorkspaceBuildDriftCheck = `-- name: InsertWorkspaceBuildDriftCheck :exec
 INSERT INTO
-    workspace_build_parameters (workspace_build_id, name, value)
-SELECT
-    $1 :: uuid AS workspace_build_id,
-    unnest($2 :: text[]) AS name,
-    unnest($3 :: text[]) AS value
-RETURNING workspace_build_id, name, value
+	workspace_build_drift (
+		workspace_build_id,
+		job_id,
+		started_at
+	)
+VALUES
+	($1, $2, $3)
+ON CONFLICT (workspace_build_id) DO UPDATE SET
+	job_id = EXCLUDED.job_id,
+	started_at = EXCLUDED.started_at
 `
 
-type InsertWorkspaceBuildParametersParams struct {
+type InsertWorkspaceBuildDriftCheckParams struct {
+	WorkspaceBuildID uuid.UUID `db:"workspace_build_id" json:"workspace_build_id"`
+	JobID            uuid.UUID `db:"job_id" json:"job_id"`
+	StartedAt        time.Time `db:"started_at" json:"started_at"`
+}
+
+// Starts a drift check of a workspace build. The result of the previous check
+// is kept until the new check completes.
+func (q *sqlQuerier) InsertWorkspaceBuildDriftCheck(ctx context.Context, arg InsertWorkspaceBuildDriftCheckParams) error {
+	_, err := q.db.ExecContext(ctx, insertWorkspaceBuildDriftCheck, arg.WorkspaceBuildID, arg.JobID, arg.StartedAt)
+	return err
+}
+
+const updateWorkspaceBuildDriftByJobID = `-- name: UpdateWorkspaceBuildDriftByJobID :one
+UPDATE
+	workspace_build_drift
+SET
+	checked_job_id = job_id,
+	checked_at = $1,
+	drifted = $2
+WHERE
+	job_id = $3
+RETURNING workspace_build_id, job_id, started_at, checked_job_id, checked_at, drifted
+`
+
+type UpdateWorkspaceBuildDriftByJobIDParams struct {
+	CheckedAt sql.NullTime `db:"checked_at" json:"checked_at"`
+	Drifted   bool         `db:"drifted" json:"drifted"`
+	JobID     uuid.UUID    `db:"job_id" json:"job_id"`
+}
+
+func (q *sqlQuerier) UpdateWorkspaceBuildDriftByJobID(ctx context.Context, arg UpdateWorkspaceBuildDriftByJobIDParams) (WorkspaceBuildDrift, error) {
+	row := q.db.QueryRowContext(ctx, updateWorkspaceBuildDriftByJobID, arg.CheckedAt, arg.Drifted, arg.JobID)
+	var i WorkspaceBuildDrift
+	err := row.Scan(
+		&i.WorkspaceBuildID,
+		&i.JobID,
+		&i.StartedAt,
+		&i.CheckedJobID,
+		&i.CheckedAt,
+		&i.Drifted,
+	)
+	return i, err
+}
+
+const getUserWorkspaceBuildParameters = `-- name: GetUserWorkspaceBuildParameters :many
+SELECT name, value
+FROM (
+    SELECT DISTINCT ON (tvp.name)
+        tvp.name,
+        wbp.value,
+        wb.created_at
+    FROM
+        workspace_build_parameters wbp
+    JOIN
+        workspace_builds wb ON wb.id = wbp.workspace_build_id
+    JOIN
+        workspaces w ON w.id = wb.workspace_id
+    JOIN
+        template_version_parameters tvp ON tvp.template_version_id = wb.template_version_id
+    WHERE
+		w.owner_id = $1
+		AND wb.transition = 'start'
+		AND w.template_id = $2
+		AND tvp.ephemeral = false
+		AND tvp.name = wbp.name
+    ORDER BY
+        tvp.name, wb.created_at DESC
+) q1
+ORDER BY created_at DESC, name
+LIMIT 100
+`
+
+type GetUserWorkspaceBuildParametersParams struct {
+	OwnerID    uuid.UUID `db:"owner_id" json:"owner_id"`
+	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+}
+
+type GetUserWorkspaceBuildParametersRow struct {
+	Name  string `db:"name" json:"name"`
+	Value string `db:"value" json:"value"`
+}
+
+func (q *sqlQuerier) GetUserWorkspaceBuildParameters(ctx context.Context, arg GetUserWorkspaceBuildParametersParams) ([]GetUserWorkspaceBuildParametersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserWorkspaceBuildParameters, arg.OwnerID, arg.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserWorkspaceBuildParametersRow
+	for rows.Next() {
+		var i GetUserWorkspaceBuildParametersRow
+		if err := rows.Scan(&i.Name, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceBuildParameters = `-- name: GetWorkspaceBuildParameters :many
+SELECT
+    workspace_build_id, name, value
+FROM
+    workspace_build_parameters
+WHERE
+    workspace_build_id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceBuildParameters(ctx context.Context, workspaceBuildID uuid.UUID) ([]WorkspaceBuildParameter, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceBuildParameters, workspaceBuildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceBuildParameter
+	for rows.Next() {
+		var i WorkspaceBuildParameter
+		if err := rows.Scan(&i.WorkspaceBuildID, &i.Name, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceBuildParameters = `-- name: InsertWorkspaceBuildParameters :exec
+INSERT INTO
+    workspace_build_parameters (workspace_build_id, name, value)
+SELECT
+    $1 :: uuid AS workspace_build_id,
+    unnest($2 :: text[]) AS name,
+    unnest($3 :: text[]) AS value
+RETURNING workspace_build_id, name, value
+`
+
+type InsertWorkspaceBuildParametersParams struct {
 	WorkspaceBuildID uuid.UUID `db:"workspace_build_id" json:"workspace_build_id"`
 	Name             []string  `db:"name" json:"name"`
 	Value            []string  `db:"value" json:"value"`
@@ -13265,6 +14699,39 @@ func (q *sqlQuerier) GetWorkspaceBuildByWorkspaceIDAndBuildNumber(ctx context.Co
 	return i, err
 }
 
+const getWorkspaceBuildStatsByTemplateVersionID = `-- name: GetWorkspaceBuildStatsByTemplateVersionID :one
+SELECT
+	COUNT(*) AS total_builds,
+	COUNT(CASE WHEN pj.job_status = 'failed' THEN 1 END) AS failed_builds
+FROM
+	workspace_builds AS wb
+JOIN
+	provisioner_jobs AS pj
+ON
+	wb.job_id = pj.id
+WHERE
+	wb.template_version_id = $1
+	AND wb.created_at >= $2
+	AND pj.completed_at IS NOT NULL
+`
+
+type GetWorkspaceBuildStatsByTemplateVersionIDParams struct {
+	TemplateVersionID uuid.UUID `db:"template_version_id" json:"template_version_id"`
+	Since             time.Time `db:"since" json:"since"`
+}
+
+type GetWorkspaceBuildStatsByTemplateVersionIDRow struct {
+	TotalBuilds  int64 `db:"total_builds" json:"total_builds"`
+	FailedBuilds int64 `db:"failed_builds" json:"failed_builds"`
+}
+
+func (q *sqlQuerier) GetWorkspaceBuildStatsByTemplateVersionID(ctx context.Context, arg GetWorkspaceBuildStatsByTemplateVersionIDParams) (GetWorkspaceBuildStatsByTemplateVersionIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceBuildStatsByTemplateVersionID, arg.TemplateVersionID, arg.Since)
+	var i GetWorkspaceBuildStatsByTemplateVersionIDRow
+	err := row.Scan(&i.TotalBuilds, &i.FailedBuilds)
+	return i, err
+}
+
 const getWorkspaceBuildStatsByTemplates = `-- name: GetWorkspaceBuildStatsByTemplates :many
 SELECT
     w.template_id,
@@ -13586,6 +15053,277 @@ func (q *sqlQuerier) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Contex
 	return err
 }
 
+const getIncompleteWorkspaceBulkOperations = `-- name: GetIncompleteWorkspaceBulkOperations :many
+SELECT
+	id, initiator_id, initiator_scope, action, search, created_at, completed_at
+FROM
+	workspace_bulk_operations
+WHERE
+	completed_at IS NULL
+ORDER BY
+	created_at ASC
+`
+
+func (q *sqlQuerier) GetIncompleteWorkspaceBulkOperations(ctx context.Context) ([]WorkspaceBulkOperation, error) {
+	rows, err := q.db.QueryContext(ctx, getIncompleteWorkspaceBulkOperations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceBulkOperation
+	for rows.Next() {
+		var i WorkspaceBulkOperation
+		if err := rows.Scan(
+			&i.ID,
+			&i.InitiatorID,
+			&i.InitiatorScope,
+			&i.Action,
+			&i.Search,
+			&i.CreatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceBulkOperationByID = `-- name: GetWorkspaceBulkOperationByID :one
+SELECT
+	id, initiator_id, initiator_scope, action, search, created_at, completed_at
+FROM
+	workspace_bulk_operations
+WHERE
+	id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (WorkspaceBulkOperation, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceBulkOperationByID, id)
+	var i WorkspaceBulkOperation
+	err := row.Scan(
+		&i.ID,
+		&i.InitiatorID,
+		&i.InitiatorScope,
+		&i.Action,
+		&i.Search,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getWorkspaceBulkOperationWorkspacesByBulkOperationID = `-- name: GetWorkspaceBulkOperationWorkspacesByBulkOperationID :many
+SELECT
+	workspace_bulk_operation_workspaces.bulk_operation_id, workspace_bulk_operation_workspaces.workspace_id, workspace_bulk_operation_workspaces.status, workspace_bulk_operation_workspaces.workspace_build_id, workspace_bulk_operation_workspaces.message, workspace_bulk_operation_workspaces.updated_at,
+	workspaces.name AS workspace_name,
+	users.username AS workspace_owner_username,
+	provisioner_jobs.job_status AS job_status
+FROM
+	workspace_bulk_operation_workspaces
+JOIN
+	workspaces ON workspaces.id = workspace_bulk_operation_workspaces.workspace_id
+JOIN
+	users ON users.id = workspaces.owner_id
+LEFT JOIN
+	workspace_builds ON workspace_builds.id = workspace_bulk_operation_workspaces.workspace_build_id
+LEFT JOIN
+	provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+WHERE
+	workspace_bulk_operation_workspaces.bulk_operation_id = $1
+ORDER BY
+	users.username ASC,
+	workspaces.name ASC
+`
+
+type GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow struct {
+	BulkOperationID        uuid.UUID                             `db:"bulk_operation_id" json:"bulk_operation_id"`
+	WorkspaceID            uuid.UUID                             `db:"workspace_id" json:"workspace_id"`
+	Status                 WorkspaceBulkOperationWorkspaceStatus `db:"status" json:"status"`
+	WorkspaceBuildID       uuid.NullUUID                         `db:"workspace_build_id" json:"workspace_build_id"`
+	Message                string                                `db:"message" json:"message"`
+	UpdatedAt              time.Time                             `db:"updated_at" json:"updated_at"`
+	WorkspaceName          string                                `db:"workspace_name" json:"workspace_name"`
+	WorkspaceOwnerUsername string                                `db:"workspace_owner_username" json:"workspace_owner_username"`
+	JobStatus              NullProvisionerJobStatus              `db:"job_status" json:"job_status"`
+}
+
+func (q *sqlQuerier) GetWorkspaceBulkOperationWorkspacesByBulkOperationID(ctx context.Context, bulkOperationID uuid.UUID) ([]GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceBulkOperationWorkspacesByBulkOperationID, bulkOperationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow
+	for rows.Next() {
+		var i GetWorkspaceBulkOperationWorkspacesByBulkOperationIDRow
+		if err := rows.Scan(
+			&i.BulkOperationID,
+			&i.WorkspaceID,
+			&i.Status,
+			&i.WorkspaceBuildID,
+			&i.Message,
+			&i.UpdatedAt,
+			&i.WorkspaceName,
+			&i.WorkspaceOwnerUsername,
+			&i.JobStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceBulkOperation = `-- name: InsertWorkspaceBulkOperation :one
+INSERT INTO
+	workspace_bulk_operations (
+		id,
+		initiator_id,
+		initiator_scope,
+		action,
+		search,
+		created_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING id, initiator_id, initiator_scope, action, search, created_at, completed_at
+`
+
+type InsertWorkspaceBulkOperationParams struct {
+	ID             uuid.UUID                    `db:"id" json:"id"`
+	InitiatorID    uuid.UUID                    `db:"initiator_id" json:"initiator_id"`
+	InitiatorScope APIKeyScope                  `db:"initiator_scope" json:"initiator_scope"`
+	Action         WorkspaceBulkOperationAction `db:"action" json:"action"`
+	Search         string                       `db:"search" json:"search"`
+	CreatedAt      time.Time                    `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceBulkOperation(ctx context.Context, arg InsertWorkspaceBulkOperationParams) (WorkspaceBulkOperation, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceBulkOperation,
+		arg.ID,
+		arg.InitiatorID,
+		arg.InitiatorScope,
+		arg.Action,
+		arg.Search,
+		arg.CreatedAt,
+	)
+	var i WorkspaceBulkOperation
+	err := row.Scan(
+		&i.ID,
+		&i.InitiatorID,
+		&i.InitiatorScope,
+		&i.Action,
+		&i.Search,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const insertWorkspaceBulkOperationWorkspaces = `-- name: InsertWorkspaceBulkOperationWorkspaces :exec
+INSERT INTO
+	workspace_bulk_operation_workspaces (
+		bulk_operation_id,
+		workspace_id,
+		updated_at
+	)
+SELECT
+	$1 :: uuid AS bulk_operation_id,
+	unnest($2 :: uuid [ ]) AS workspace_id,
+	$3 :: timestamptz AS updated_at
+`
+
+type InsertWorkspaceBulkOperationWorkspacesParams struct {
+	BulkOperationID uuid.UUID   `db:"bulk_operation_id" json:"bulk_operation_id"`
+	WorkspaceIds    []uuid.UUID `db:"workspace_ids" json:"workspace_ids"`
+	UpdatedAt       time.Time   `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg InsertWorkspaceBulkOperationWorkspacesParams) error {
+	_, err := q.db.ExecContext(ctx, insertWorkspaceBulkOperationWorkspaces, arg.BulkOperationID, pq.Array(arg.WorkspaceIds), arg.UpdatedAt)
+	return err
+}
+
+const updateWorkspaceBulkOperationCompletedAt = `-- name: UpdateWorkspaceBulkOperationCompletedAt :exec
+UPDATE
+	workspace_bulk_operations
+SET
+	completed_at = $1
+WHERE
+	id = $2
+`
+
+type UpdateWorkspaceBulkOperationCompletedAtParams struct {
+	CompletedAt sql.NullTime `db:"completed_at" json:"completed_at"`
+	ID          uuid.UUID    `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateWorkspaceBulkOperationCompletedAt(ctx context.Context, arg UpdateWorkspaceBulkOperationCompletedAtParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkspaceBulkOperationCompletedAt, arg.CompletedAt, arg.ID)
+	return err
+}
+
+const updateWorkspaceBulkOperationWorkspace = `-- name: UpdateWorkspaceBulkOperationWorkspace :one
+UPDATE
+	workspace_bulk_operation_workspaces
+SET
+	status = $1,
+	workspace_build_id = $2,
+	message = $3,
+	updated_at = $4
+WHERE
+	bulk_operation_id = $5
+	AND workspace_id = $6
+	AND status = 'pending'::workspace_bulk_operation_workspace_status
+RETURNING bulk_operation_id, workspace_id, status, workspace_build_id, message, updated_at
+`
+
+type UpdateWorkspaceBulkOperationWorkspaceParams struct {
+	Status           WorkspaceBulkOperationWorkspaceStatus `db:"status" json:"status"`
+	WorkspaceBuildID uuid.NullUUID                         `db:"workspace_build_id" json:"workspace_build_id"`
+	Message          string                                `db:"message" json:"message"`
+	UpdatedAt        time.Time                             `db:"updated_at" json:"updated_at"`
+	BulkOperationID  uuid.UUID                             `db:"bulk_operation_id" json:"bulk_operation_id"`
+	WorkspaceID      uuid.UUID                             `db:"workspace_id" json:"workspace_id"`
+}
+
+// UpdateWorkspaceBulkOperationWorkspace only updates workspaces that are still
+// pending, so that each workspace is built at most once even if several
+// replicas process the same operation.
+func (q *sqlQuerier) UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg UpdateWorkspaceBulkOperationWorkspaceParams) (WorkspaceBulkOperationWorkspace, error) {
+	row := q.db.QueryRowContext(ctx, updateWorkspaceBulkOperationWorkspace,
+		arg.Status,
+		arg.WorkspaceBuildID,
+		arg.Message,
+		arg.UpdatedAt,
+		arg.BulkOperationID,
+		arg.WorkspaceID,
+	)
+	var i WorkspaceBulkOperationWorkspace
+	err := row.Scan(
+		&i.BulkOperationID,
+		&i.WorkspaceID,
+		&i.Status,
+		&i.WorkspaceBuildID,
+		&i.Message,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getWorkspaceResourceByID = `-- name: GetWorkspaceResourceByID :one
 SELECT
 	id, created_at, job_id, transition, type, name, hide, icon, instance_type, daily_cost
@@ -14027,8 +15765,9 @@ func (q *sqlQuerier) GetDeploymentWorkspaceStats(ctx context.Context) (GetDeploy
 
 const getWorkspaceByAgentID = `-- name: GetWorkspaceByAgentID :one
 SELECT
-	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite,
-	templates.name as template_name
+	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite, workspaces.user_acl, workspaces.group_acl,
+	templates.name as template_name,
+	templates.idle_detection_enabled as template_idle_detection_enabled
 FROM
 	workspaces
 INNER JOIN
@@ -14059,8 +15798,9 @@ WHERE
 `
 
 type GetWorkspaceByAgentIDRow struct {
-	Workspace    Workspace `db:"workspace" json:"workspace"`
-	TemplateName string    `db:"template_name" json:"template_name"`
+	Workspace                    Workspace `db:"workspace" json:"workspace"`
+	TemplateName                 string    `db:"template_name" json:"template_name"`
+	TemplateIdleDetectionEnabled bool      `db:"template_idle_detection_enabled" json:"template_idle_detection_enabled"`
 }
 
 func (q *sqlQuerier) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (GetWorkspaceByAgentIDRow, error) {
@@ -14082,14 +15822,17 @@ func (q *sqlQuerier) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUI
 		&i.Workspace.DeletingAt,
 		&i.Workspace.AutomaticUpdates,
 		&i.Workspace.Favorite,
+		&i.Workspace.UserACL,
+		&i.Workspace.GroupACL,
 		&i.TemplateName,
+		&i.TemplateIdleDetectionEnabled,
 	)
 	return i, err
 }
 
 const getWorkspaceByID = `-- name: GetWorkspaceByID :one
 SELECT
-	id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite
+	id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite, user_acl, group_acl
 FROM
 	workspaces
 WHERE
@@ -14117,13 +15860,15 @@ func (q *sqlQuerier) GetWorkspaceByID(ctx context.Context, id uuid.UUID) (Worksp
 		&i.DeletingAt,
 		&i.AutomaticUpdates,
 		&i.Favorite,
+		&i.UserACL,
+		&i.GroupACL,
 	)
 	return i, err
 }
 
 const getWorkspaceByOwnerIDAndName = `-- name: GetWorkspaceByOwnerIDAndName :one
 SELECT
-	id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite
+	id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite, user_acl, group_acl
 FROM
 	workspaces
 WHERE
@@ -14158,13 +15903,15 @@ func (q *sqlQuerier) GetWorkspaceByOwnerIDAndName(ctx context.Context, arg GetWo
 		&i.DeletingAt,
 		&i.AutomaticUpdates,
 		&i.Favorite,
+		&i.UserACL,
+		&i.GroupACL,
 	)
 	return i, err
 }
 
 const getWorkspaceByWorkspaceAppID = `-- name: GetWorkspaceByWorkspaceAppID :one
 SELECT
-	id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite
+	id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite, user_acl, group_acl
 FROM
 	workspaces
 WHERE
@@ -14218,6 +15965,8 @@ func (q *sqlQuerier) GetWorkspaceByWorkspaceAppID(ctx context.Context, workspace
 		&i.DeletingAt,
 		&i.AutomaticUpdates,
 		&i.Favorite,
+		&i.UserACL,
+		&i.GroupACL,
 	)
 	return i, err
 }
@@ -14269,7 +16018,7 @@ SELECT
 ),
 filtered_workspaces AS (
 SELECT
-	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite,
+	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite, workspaces.user_acl, workspaces.group_acl,
 	COALESCE(template.name, 'unknown') as template_name,
 	latest_build.template_version_id,
 	latest_build.template_version_name,
@@ -14317,7 +16066,7 @@ LEFT JOIN LATERAL (
 ) latest_build ON TRUE
 LEFT JOIN LATERAL (
 	SELECT
-		id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level
+		id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, idle_detection_enabled, idle_cpu_threshold_percent
 	FROM
 		templates
 	WHERE
@@ -14511,7 +16260,7 @@ WHERE
 	-- @authorize_filter
 ), filtered_workspaces_order AS (
 	SELECT
-		fw.id, fw.created_at, fw.updated_at, fw.owner_id, fw.organization_id, fw.template_id, fw.deleted, fw.name, fw.autostart_schedule, fw.ttl, fw.last_used_at, fw.dormant_at, fw.deleting_at, fw.automatic_updates, fw.favorite, fw.template_name, fw.template_version_id, fw.template_version_name, fw.username, fw.latest_build_completed_at, fw.latest_build_canceled_at, fw.latest_build_error, fw.latest_build_transition, fw.latest_build_status
+		fw.id, fw.created_at, fw.updated_at, fw.owner_id, fw.organization_id, fw.template_id, fw.deleted, fw.name, fw.autostart_schedule, fw.ttl, fw.last_used_at, fw.dormant_at, fw.deleting_at, fw.automatic_updates, fw.favorite, fw.user_acl, fw.group_acl, fw.template_name, fw.template_version_id, fw.template_version_name, fw.username, fw.latest_build_completed_at, fw.latest_build_canceled_at, fw.latest_build_error, fw.latest_build_transition, fw.latest_build_status
 	FROM
 		filtered_workspaces fw
 	ORDER BY
@@ -14532,7 +16281,7 @@ WHERE
 		$20
 ), filtered_workspaces_order_with_summary AS (
 	SELECT
-		fwo.id, fwo.created_at, fwo.updated_at, fwo.owner_id, fwo.organization_id, fwo.template_id, fwo.deleted, fwo.name, fwo.autostart_schedule, fwo.ttl, fwo.last_used_at, fwo.dormant_at, fwo.deleting_at, fwo.automatic_updates, fwo.favorite, fwo.template_name, fwo.template_version_id, fwo.template_version_name, fwo.username, fwo.latest_build_completed_at, fwo.latest_build_canceled_at, fwo.latest_build_error, fwo.latest_build_transition, fwo.latest_build_status
+		fwo.id, fwo.created_at, fwo.updated_at, fwo.owner_id, fwo.organization_id, fwo.template_id, fwo.deleted, fwo.name, fwo.autostart_schedule, fwo.ttl, fwo.last_used_at, fwo.dormant_at, fwo.deleting_at, fwo.automatic_updates, fwo.favorite, fwo.user_acl, fwo.group_acl, fwo.template_name, fwo.template_version_id, fwo.template_version_name, fwo.username, fwo.latest_build_completed_at, fwo.latest_build_canceled_at, fwo.latest_build_error, fwo.latest_build_transition, fwo.latest_build_status
 	FROM
 		filtered_workspaces_order fwo
 	-- Return a technical summary row with total count of workspaces.
@@ -14554,6 +16303,8 @@ WHERE
 		'0001-01-01 00:00:00+00'::timestamptz, -- deleting_at
 		'never'::automatic_updates, -- automatic_updates
 		false, -- favorite
+		'{}'::jsonb, -- user_acl
+		'{}'::jsonb, -- group_acl
 		-- Extra columns added to ` + "`" + `filtered_workspaces` + "`" + `
 		'', -- template_name
 		'00000000-0000-0000-0000-000000000000'::uuid, -- template_version_id
@@ -14573,7 +16324,7 @@ WHERE
 		filtered_workspaces
 )
 SELECT
-	fwos.id, fwos.created_at, fwos.updated_at, fwos.owner_id, fwos.organization_id, fwos.template_id, fwos.deleted, fwos.name, fwos.autostart_schedule, fwos.ttl, fwos.last_used_at, fwos.dormant_at, fwos.deleting_at, fwos.automatic_updates, fwos.favorite, fwos.template_name, fwos.template_version_id, fwos.template_version_name, fwos.username, fwos.latest_build_completed_at, fwos.latest_build_canceled_at, fwos.latest_build_error, fwos.latest_build_transition, fwos.latest_build_status,
+	fwos.id, fwos.created_at, fwos.updated_at, fwos.owner_id, fwos.organization_id, fwos.template_id, fwos.deleted, fwos.name, fwos.autostart_schedule, fwos.ttl, fwos.last_used_at, fwos.dormant_at, fwos.deleting_at, fwos.automatic_updates, fwos.favorite, fwos.user_acl, fwos.group_acl, fwos.template_name, fwos.template_version_id, fwos.template_version_name, fwos.username, fwos.latest_build_completed_at, fwos.latest_build_canceled_at, fwos.latest_build_error, fwos.latest_build_transition, fwos.latest_build_status,
 	tc.count
 FROM
 	filtered_workspaces_order_with_summary fwos
@@ -14622,6 +16373,8 @@ type GetWorkspacesRow struct {
 	DeletingAt             sql.NullTime         `db:"deleting_at" json:"deleting_at"`
 	AutomaticUpdates       AutomaticUpdates     `db:"automatic_updates" json:"automatic_updates"`
 	Favorite               bool                 `db:"favorite" json:"favorite"`
+	UserACL                WorkspaceACL         `db:"user_acl" json:"user_acl"`
+	GroupACL               WorkspaceACL         `db:"group_acl" json:"group_acl"`
 	TemplateName           string               `db:"template_name" json:"template_name"`
 	TemplateVersionID      uuid.UUID            `db:"template_version_id" json:"template_version_id"`
 	TemplateVersionName    sql.NullString       `db:"template_version_name" json:"template_version_name"`
@@ -14685,6 +16438,8 @@ func (q *sqlQuerier) GetWorkspaces(ctx context.Context, arg GetWorkspacesParams)
 			&i.DeletingAt,
 			&i.AutomaticUpdates,
 			&i.Favorite,
+			&i.UserACL,
+			&i.GroupACL,
 			&i.TemplateName,
 			&i.TemplateVersionID,
 			&i.TemplateVersionName,
@@ -14711,7 +16466,7 @@ func (q *sqlQuerier) GetWorkspaces(ctx context.Context, arg GetWorkspacesParams)
 
 const getWorkspacesEligibleForTransition = `-- name: GetWorkspacesEligibleForTransition :many
 SELECT
-	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite
+	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite, workspaces.user_acl, workspaces.group_acl
 FROM
 	workspaces
 LEFT JOIN
@@ -14808,6 +16563,8 @@ func (q *sqlQuerier) GetWorkspacesEligibleForTransition(ctx context.Context, now
 			&i.DeletingAt,
 			&i.AutomaticUpdates,
 			&i.Favorite,
+			&i.UserACL,
+			&i.GroupACL,
 		); err != nil {
 			return nil, err
 		}
@@ -14838,7 +16595,7 @@ INSERT INTO
 		automatic_updates
 	)
 VALUES
-	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite, user_acl, group_acl
 `
 
 type InsertWorkspaceParams struct {
@@ -14886,6 +16643,8 @@ func (q *sqlQuerier) InsertWorkspace(ctx context.Context, arg InsertWorkspacePar
 		&i.DeletingAt,
 		&i.AutomaticUpdates,
 		&i.Favorite,
+		&i.UserACL,
+		&i.GroupACL,
 	)
 	return i, err
 }
@@ -14925,7 +16684,7 @@ SET
 WHERE
 	id = $1
 	AND deleted = false
-RETURNING id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite
+RETURNING id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite, user_acl, group_acl
 `
 
 type UpdateWorkspaceParams struct {
@@ -14952,10 +16711,33 @@ func (q *sqlQuerier) UpdateWorkspace(ctx context.Context, arg UpdateWorkspacePar
 		&i.DeletingAt,
 		&i.AutomaticUpdates,
 		&i.Favorite,
+		&i.UserACL,
+		&i.GroupACL,
 	)
 	return i, err
 }
 
+const updateWorkspaceACLByID = `-- name: UpdateWorkspaceACLByID :exec
+UPDATE
+	workspaces
+SET
+	group_acl = $1,
+	user_acl = $2
+WHERE
+	id = $3
+`
+
+type UpdateWorkspaceACLByIDParams struct {
+	GroupACL WorkspaceACL `db:"group_acl" json:"group_acl"`
+	UserACL  WorkspaceACL `db:"user_acl" json:"user_acl"`
+	ID       uuid.UUID    `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateWorkspaceACLByID(ctx context.Context, arg UpdateWorkspaceACLByIDParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkspaceACLByID, arg.GroupACL, arg.UserACL, arg.ID)
+	return err
+}
+
 const updateWorkspaceAutomaticUpdates = `-- name: UpdateWorkspaceAutomaticUpdates :exec
 UPDATE
 	workspaces
@@ -15038,7 +16820,7 @@ WHERE
     workspaces.id = $1
     AND templates.id = workspaces.template_id
 RETURNING
-    workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite
+    workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite, workspaces.user_acl, workspaces.group_acl
 `
 
 type UpdateWorkspaceDormantDeletingAtParams struct {
@@ -15065,6 +16847,8 @@ func (q *sqlQuerier) UpdateWorkspaceDormantDeletingAt(ctx context.Context, arg U
 		&i.DeletingAt,
 		&i.AutomaticUpdates,
 		&i.Favorite,
+		&i.UserACL,
+		&i.GroupACL,
 	)
 	return i, err
 }
@@ -15120,7 +16904,7 @@ WHERE
     template_id = $3
 AND
     dormant_at IS NOT NULL
-RETURNING id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite
+RETURNING id, created_at, updated_at, owner_id, organization_id, template_id, deleted, name, autostart_schedule, ttl, last_used_at, dormant_at, deleting_at, automatic_updates, favorite, user_acl, group_acl
 `
 
 type UpdateWorkspacesDormantDeletingAtByTemplateIDParams struct {
@@ -15154,6 +16938,8 @@ func (q *sqlQuerier) UpdateWorkspacesDormantDeletingAtByTemplateID(ctx context.C
 			&i.DeletingAt,
 			&i.AutomaticUpdates,
 			&i.Favorite,
+			&i.UserACL,
+			&i.GroupACL,
 		); err != nil {
 			return nil, err
 		}
@@ -15280,3 +17066,208 @@ func (q *sqlQuerier) InsertWorkspaceAgentScripts(ctx context.Context, arg Insert
 	}
 	return items, nil
 }
+
+const appendWorkspaceSessionRecordingData = `-- name: AppendWorkspaceSessionRecordingData :one
+UPDATE
+	workspace_session_recordings
+SET
+	data = data || $1
+WHERE
+	id = $2
+	AND agent_id = $3
+	AND octet_length(data) = $4 :: bigint
+RETURNING
+	octet_length(data) :: bigint AS size
+`
+
+type AppendWorkspaceSessionRecordingDataParams struct {
+	Data    []byte    `db:"data" json:"data"`
+	ID      uuid.UUID `db:"id" json:"id"`
+	AgentID uuid.UUID `db:"agent_id" json:"agent_id"`
+	Offset  int64     `db:"offset" json:"offset"`
+}
+
+// Appends data to a recording, as long as it is appended at the current end
+// of the recording.
+func (q *sqlQuerier) AppendWorkspaceSessionRecordingData(ctx context.Context, arg AppendWorkspaceSessionRecordingDataParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, appendWorkspaceSessionRecordingData,
+		arg.Data,
+		arg.ID,
+		arg.AgentID,
+		arg.Offset,
+	)
+	var size int64
+	err := row.Scan(&size)
+	return size, err
+}
+
+const getWorkspaceSessionRecordingByID = `-- name: GetWorkspaceSessionRecordingByID :one
+SELECT
+	id, workspace_id, agent_id, type, command, started_at, ended_at, input_recorded, truncated, created_at, data
+FROM
+	workspace_session_recordings
+WHERE
+	id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceSessionRecordingByID(ctx context.Context, id uuid.UUID) (WorkspaceSessionRecording, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceSessionRecordingByID, id)
+	var i WorkspaceSessionRecording
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.AgentID,
+		&i.Type,
+		&i.Command,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.InputRecorded,
+		&i.Truncated,
+		&i.CreatedAt,
+		&i.Data,
+	)
+	return i, err
+}
+
+const getWorkspaceSessionRecordingsByWorkspaceID = `-- name: GetWorkspaceSessionRecordingsByWorkspaceID :many
+SELECT
+	id,
+	workspace_id,
+	agent_id,
+	type,
+	command,
+	started_at,
+	ended_at,
+	input_recorded,
+	truncated,
+	created_at,
+	octet_length(data) :: bigint AS size
+FROM
+	workspace_session_recordings
+WHERE
+	workspace_id = $1
+ORDER BY
+	started_at DESC
+`
+
+type GetWorkspaceSessionRecordingsByWorkspaceIDRow struct {
+	ID            uuid.UUID                     `db:"id" json:"id"`
+	WorkspaceID   uuid.UUID                     `db:"workspace_id" json:"workspace_id"`
+	AgentID       uuid.UUID                     `db:"agent_id" json:"agent_id"`
+	Type          WorkspaceSessionRecordingType `db:"type" json:"type"`
+	Command       string                        `db:"command" json:"command"`
+	StartedAt     time.Time                     `db:"started_at" json:"started_at"`
+	EndedAt       time.Time                     `db:"ended_at" json:"ended_at"`
+	InputRecorded bool                          `db:"input_recorded" json:"input_recorded"`
+	Truncated     bool                          `db:"truncated" json:"truncated"`
+	CreatedAt     time.Time                     `db:"created_at" json:"created_at"`
+	Size          int64                         `db:"size" json:"size"`
+}
+
+func (q *sqlQuerier) GetWorkspaceSessionRecordingsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]GetWorkspaceSessionRecordingsByWorkspaceIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceSessionRecordingsByWorkspaceID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspaceSessionRecordingsByWorkspaceIDRow
+	for rows.Next() {
+		var i GetWorkspaceSessionRecordingsByWorkspaceIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.AgentID,
+			&i.Type,
+			&i.Command,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.InputRecorded,
+			&i.Truncated,
+			&i.CreatedAt,
+			&i.Size,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceSessionRecording = `-- name: InsertWorkspaceSessionRecording :one
+INSERT INTO
+	workspace_session_recordings (
+		id,
+		workspace_id,
+		agent_id,
+		type,
+		command,
+		started_at,
+		ended_at,
+		input_recorded,
+		truncated,
+		created_at,
+		data
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (id) DO UPDATE SET
+	ended_at = EXCLUDED.ended_at,
+	truncated = EXCLUDED.truncated,
+	data = EXCLUDED.data
+WHERE
+	workspace_session_recordings.agent_id = EXCLUDED.agent_id
+RETURNING id, workspace_id, agent_id, type, command, started_at, ended_at, input_recorded, truncated, created_at, data
+`
+
+type InsertWorkspaceSessionRecordingParams struct {
+	ID            uuid.UUID                     `db:"id" json:"id"`
+	WorkspaceID   uuid.UUID                     `db:"workspace_id" json:"workspace_id"`
+	AgentID       uuid.UUID                     `db:"agent_id" json:"agent_id"`
+	Type          WorkspaceSessionRecordingType `db:"type" json:"type"`
+	Command       string                        `db:"command" json:"command"`
+	StartedAt     time.Time                     `db:"started_at" json:"started_at"`
+	EndedAt       time.Time                     `db:"ended_at" json:"ended_at"`
+	InputRecorded bool                          `db:"input_recorded" json:"input_recorded"`
+	Truncated     bool                          `db:"truncated" json:"truncated"`
+	CreatedAt     time.Time                     `db:"created_at" json:"created_at"`
+	Data          []byte                        `db:"data" json:"data"`
+}
+
+// Uploads restart from the beginning of a recording, so inserting an existing
+// recording replaces its data. An agent can only replace its own recordings.
+func (q *sqlQuerier) InsertWorkspaceSessionRecording(ctx context.Context, arg InsertWorkspaceSessionRecordingParams) (WorkspaceSessionRecording, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceSessionRecording,
+		arg.ID,
+		arg.WorkspaceID,
+		arg.AgentID,
+		arg.Type,
+		arg.Command,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.InputRecorded,
+		arg.Truncated,
+		arg.CreatedAt,
+		arg.Data,
+	)
+	var i WorkspaceSessionRecording
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.AgentID,
+		&i.Type,
+		&i.Command,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.InputRecorded,
+		&i.Truncated,
+		&i.CreatedAt,
+		&i.Data,
+	)
+	return i, err
+}
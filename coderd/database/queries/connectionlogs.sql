@@ -0,0 +1,149 @@
+-- name: UpsertConnectionLog :one
+-- Agents report the connect and disconnect events of a connection
+-- separately, and they may arrive in any order. The first event creates the
+-- log and the second completes it.
+INSERT INTO connection_logs (
+	id,
+	connect_time,
+	organization_id,
+	workspace_owner_id,
+	workspace_id,
+	workspace_name,
+	agent_id,
+	agent_name,
+	type,
+	ip,
+	user_id,
+	connection_id,
+	disconnect_time,
+	code,
+	disconnect_reason
+) VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (connection_id, agent_id) DO UPDATE SET
+	-- A disconnect event only carries a placeholder connect time.
+	connect_time = CASE
+		WHEN EXCLUDED.disconnect_time IS NULL THEN EXCLUDED.connect_time
+		ELSE connection_logs.connect_time
+	END,
+	user_id = COALESCE(connection_logs.user_id, EXCLUDED.user_id),
+	disconnect_time = COALESCE(EXCLUDED.disconnect_time, connection_logs.disconnect_time),
+	code = COALESCE(EXCLUDED.code, connection_logs.code),
+	disconnect_reason = COALESCE(EXCLUDED.disconnect_reason, connection_logs.disconnect_reason)
+RETURNING *;
+
+-- name: GetConnectionLogsOffset :many
+SELECT
+	sqlc.embed(connection_logs),
+	users.username AS user_username,
+	users.name AS user_name,
+	users.avatar_url AS user_avatar_url,
+	COALESCE(workspace_owner.username, '') AS workspace_owner_username,
+	COALESCE(organizations.name, '') AS organization_name,
+	COUNT(connection_logs.*) OVER () AS count
+FROM
+	connection_logs
+	LEFT JOIN users ON connection_logs.user_id = users.id
+	LEFT JOIN users AS workspace_owner ON connection_logs.workspace_owner_id = workspace_owner.id
+	LEFT JOIN organizations ON connection_logs.organization_id = organizations.id
+WHERE
+	-- Filter organization_id
+	CASE
+		WHEN @organization_id :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.organization_id = @organization_id
+		ELSE true
+	END
+	-- Filter by workspace_owner_id
+	AND CASE
+		WHEN @workspace_owner_id :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.workspace_owner_id = @workspace_owner_id
+		ELSE true
+	END
+	-- Filter by workspace_owner username
+	AND CASE
+		WHEN @workspace_owner :: text != '' THEN
+			connection_logs.workspace_owner_id = (SELECT id FROM users WHERE lower(username) = lower(@workspace_owner) AND deleted = false)
+		ELSE true
+	END
+	-- Filter by workspace_id
+	AND CASE
+		WHEN @workspace_id :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.workspace_id = @workspace_id
+		ELSE true
+	END
+	-- Filter by workspace name
+	AND CASE
+		WHEN @workspace_name :: text != '' THEN
+			lower(connection_logs.workspace_name) = lower(@workspace_name)
+		ELSE true
+	END
+	-- Filter by type
+	AND CASE
+		WHEN @type :: text != '' THEN
+			connection_logs.type = @type :: connection_type
+		ELSE true
+	END
+	-- Filter by user_id
+	AND CASE
+		WHEN @user_id :: uuid != '00000000-0000-0000-0000-000000000000'::uuid THEN
+			connection_logs.user_id = @user_id
+		ELSE true
+	END
+	-- Filter by username
+	AND CASE
+		WHEN @username :: text != '' THEN
+			connection_logs.user_id = (SELECT id FROM users WHERE lower(username) = lower(@username) AND deleted = false)
+		ELSE true
+	END
+	-- Filter by connected_after
+	AND CASE
+		WHEN @connected_after :: timestamp with time zone != '0001-01-01 00:00:00Z' THEN
+			connection_logs.connect_time >= @connected_after
+		ELSE true
+	END
+	-- Filter by connected_before
+	AND CASE
+		WHEN @connected_before :: timestamp with time zone != '0001-01-01 00:00:00Z' THEN
+			connection_logs.connect_time <= @connected_before
+		ELSE true
+	END
+	-- Filter by status
+	AND CASE
+		WHEN @status :: text = 'ongoing' THEN
+			connection_logs.disconnect_time IS NULL
+		WHEN @status :: text = 'completed' THEN
+			connection_logs.disconnect_time IS NOT NULL
+		ELSE true
+	END
+ORDER BY
+	connection_logs.connect_time DESC
+LIMIT
+	-- a limit of 0 means "no limit". The connection log table is unbounded
+	-- in size, so default to 100 to prevent accidental excessively large
+	-- queries.
+	COALESCE(NULLIF(@limit_opt :: int, 0), 100)
+OFFSET
+	@offset_opt;
+
+-- name: UpsertWorkspaceAgentClientPeer :exec
+INSERT INTO
+	workspace_agent_client_peers (peer_id, agent_id, user_id, created_at)
+VALUES
+	($1, $2, $3, $4)
+ON CONFLICT (peer_id) DO UPDATE SET
+	agent_id = EXCLUDED.agent_id,
+	user_id = EXCLUDED.user_id,
+	created_at = EXCLUDED.created_at;
+
+-- name: GetWorkspaceAgentClientPeersByAgentID :many
+SELECT
+	*
+FROM
+	workspace_agent_client_peers
+WHERE
+	agent_id = $1
+ORDER BY
+	created_at DESC;
+
+-- name: DeleteWorkspaceAgentClientPeer :exec
+DELETE FROM workspace_agent_client_peers WHERE peer_id = $1;
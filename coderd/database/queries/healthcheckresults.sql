@@ -0,0 +1,36 @@
+-- name: InsertHealthcheckResult :one
+INSERT INTO
+	healthcheck_results (id, created_at, section, severity, warnings, error)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetLatestHealthcheckResults :many
+-- Returns the most recent result of every section.
+SELECT DISTINCT ON (section)
+	*
+FROM
+	healthcheck_results
+ORDER BY
+	section, created_at DESC;
+
+-- name: GetHealthcheckResults :many
+SELECT
+	*
+FROM
+	healthcheck_results
+WHERE
+	CASE
+		WHEN @section :: text != '' THEN
+			section = @section
+		ELSE true
+	END
+	AND created_at > @created_after
+ORDER BY
+	created_at DESC, section ASC
+LIMIT
+	-- A limit of 0 means "no limit"
+	NULLIF(@limit_opt :: int, 0);
+
+-- name: DeleteOldHealthcheckResults :exec
+DELETE FROM healthcheck_results WHERE created_at < @before_time;
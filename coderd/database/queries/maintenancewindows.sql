@@ -0,0 +1,80 @@
+-- name: InsertMaintenanceWindow :one
+INSERT INTO
+	maintenance_windows (
+		id,
+		organization_id,
+		template_id,
+		name,
+		message,
+		schedule,
+		duration,
+		notice_period,
+		block_builds,
+		created_at,
+		updated_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: GetMaintenanceWindowByID :one
+SELECT
+	*
+FROM
+	maintenance_windows
+WHERE
+	id = $1;
+
+-- name: GetMaintenanceWindowsByOrganizationID :many
+SELECT
+	*
+FROM
+	maintenance_windows
+WHERE
+	organization_id = $1
+ORDER BY
+	name ASC;
+
+-- name: GetMaintenanceWindows :many
+SELECT
+	*
+FROM
+	maintenance_windows
+ORDER BY
+	organization_id, name ASC;
+
+-- name: UpdateMaintenanceWindowByID :one
+-- Changing the schedule of a window resets its notification state, so that
+-- users are notified of the rescheduled window.
+UPDATE
+	maintenance_windows
+SET
+	name = @name,
+	message = @message,
+	last_notified_start = CASE WHEN schedule = @schedule THEN last_notified_start ELSE NULL END,
+	schedule = @schedule,
+	duration = @duration,
+	notice_period = @notice_period,
+	block_builds = @block_builds,
+	updated_at = @updated_at
+WHERE
+	id = @id
+RETURNING *;
+
+-- name: UpdateMaintenanceWindowLastNotifiedStart :execrows
+-- Only updates a window that wasn't already notified of the given start, so
+-- that of several replicas claiming an occurrence at once only one notifies
+-- users of it.
+UPDATE
+	maintenance_windows
+SET
+	last_notified_start = @last_notified_start
+WHERE
+	id = @id
+	AND last_notified_start IS DISTINCT FROM @last_notified_start;
+
+-- name: DeleteMaintenanceWindowByID :exec
+DELETE FROM
+	maintenance_windows
+WHERE
+	id = $1;
@@ -71,6 +71,23 @@ WITH acquired AS (
                                  ELSE true
                                  END
                              )
+                           -- when digests are enabled, hold messages back until the oldest outstanding message of the
+                           -- same template and method for the same user has exceeded the digest window
+                           AND (
+                             sqlc.arg('digest_window_seconds')::int = 0
+                                 OR EXISTS (SELECT 1
+                                            FROM notification_messages AS oldest
+                                            WHERE oldest.user_id = nm.user_id
+                                              AND oldest.notification_template_id = nm.notification_template_id
+                                              AND oldest.method = nm.method
+                                              AND oldest.status IN (
+                                                                    'pending'::notification_message_status,
+                                                                    'temporary_failure'::notification_message_status,
+                                                                    'leased'::notification_message_status
+                                                )
+                                              AND oldest.created_at <=
+                                                  NOW() - CONCAT(sqlc.arg('digest_window_seconds')::int, ' seconds')::interval)
+                             )
                          ORDER BY nm.created_at ASC
                                   -- Ensure that multiple concurrent readers cannot retrieve the same rows
                              FOR UPDATE OF nm
@@ -84,6 +101,8 @@ SELECT
     nm.method,
     nm.attempt_count::int                                                 AS attempt_count,
     nm.queued_seconds::float                                              AS queued_seconds,
+    nm.user_id,
+    nm.created_at,
     -- template
     nt.id                                                                 AS template_id,
     nt.title_template,
@@ -1,6 +1,12 @@
 -- Acquires the lock for a single job that isn't started, completed,
 -- canceled, and that matches an array of provisioner types.
 --
+-- Jobs with a higher priority are acquired first. Among jobs of the same
+-- priority, the jobs of initiators with the fewest running jobs are acquired
+-- first, so that a single user cannot starve everyone else. Jobs of
+-- organizations or initiators that already run as many jobs as they are
+-- allowed to are skipped. A limit of zero or less disables it.
+--
 -- SKIP LOCKED is used to jump over locked rows. This prevents
 -- multiple provisioners from acquiring the same jobs. See:
 -- https://www.postgresql.org/docs/9.5/sql-select.html#SQL-FOR-UPDATE-SHARE
@@ -29,7 +35,44 @@ WHERE
 				-- Ensure the caller satisfies all job tags.
 				ELSE nested.tags :: jsonb <@ @tags :: jsonb
 			END
+			AND (
+				@max_running_jobs_per_organization :: integer <= 0
+				OR (
+					SELECT
+						COUNT(*)
+					FROM
+						provisioner_jobs AS running
+					WHERE
+						running.organization_id = nested.organization_id
+						AND running.started_at IS NOT NULL
+						AND running.completed_at IS NULL
+				) < @max_running_jobs_per_organization :: integer
+			)
+			AND (
+				@max_running_jobs_per_user :: integer <= 0
+				OR (
+					SELECT
+						COUNT(*)
+					FROM
+						provisioner_jobs AS running
+					WHERE
+						running.initiator_id = nested.initiator_id
+						AND running.started_at IS NOT NULL
+						AND running.completed_at IS NULL
+				) < @max_running_jobs_per_user :: integer
+			)
 		ORDER BY
+			nested.priority DESC,
+			(
+				SELECT
+					COUNT(*)
+				FROM
+					provisioner_jobs AS running
+				WHERE
+					running.initiator_id = nested.initiator_id
+					AND running.started_at IS NOT NULL
+					AND running.completed_at IS NULL
+			) ASC,
 			nested.created_at
 		FOR UPDATE
 		SKIP LOCKED
@@ -53,10 +96,12 @@ FROM
 WHERE
 	id = ANY(@ids :: uuid [ ]);
 
+-- The running jobs and the average duration of recently completed jobs are
+-- returned to estimate when queued jobs will start.
 -- name: GetProvisionerJobsByIDsWithQueuePosition :many
 WITH unstarted_jobs AS (
     SELECT
-        id, created_at
+        id, created_at, priority
     FROM
         provisioner_jobs
     WHERE
@@ -65,23 +110,55 @@ WITH unstarted_jobs AS (
 queue_position AS (
     SELECT
         id,
-        ROW_NUMBER() OVER (ORDER BY created_at ASC) AS queue_position
+        ROW_NUMBER() OVER (ORDER BY priority DESC, created_at ASC) AS queue_position
     FROM
         unstarted_jobs
 ),
 queue_size AS (
 	SELECT COUNT(*) as count FROM unstarted_jobs
+),
+running_jobs AS (
+	SELECT
+		COUNT(*) as count
+	FROM
+		provisioner_jobs
+	WHERE
+		started_at IS NOT NULL
+		AND completed_at IS NULL
+),
+average_duration AS (
+	SELECT
+		AVG(EXTRACT(EPOCH FROM recent.completed_at - recent.started_at)) AS seconds
+	FROM (
+		SELECT
+			started_at, completed_at
+		FROM
+			provisioner_jobs
+		WHERE
+			started_at IS NOT NULL
+			AND completed_at IS NOT NULL
+		ORDER BY
+			completed_at DESC
+		LIMIT
+			100
+	) AS recent
 )
 SELECT
 	sqlc.embed(pj),
     COALESCE(qp.queue_position, 0) AS queue_position,
-    COALESCE(qs.count, 0) AS queue_size
+    COALESCE(qs.count, 0) AS queue_size,
+    COALESCE(rj.count, 0) AS running_jobs,
+    COALESCE(ad.seconds, 0) :: float AS average_duration_seconds
 FROM
 	provisioner_jobs pj
 LEFT JOIN
 	queue_position qp ON qp.id = pj.id
 LEFT JOIN
 	queue_size qs ON TRUE
+LEFT JOIN
+	running_jobs rj ON TRUE
+LEFT JOIN
+	average_duration ad ON TRUE
 WHERE
 	pj.id = ANY(@ids :: uuid [ ]);
 
@@ -102,10 +179,11 @@ INSERT INTO
 		"type",
 		"input",
 		tags,
-		trace_metadata
+		trace_metadata,
+		priority
 	)
 VALUES
-	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *;
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING *;
 
 -- name: UpdateProvisionerJobByID :exec
 UPDATE
@@ -160,4 +238,24 @@ RETURNING *;
 -- name: GetProvisionerJobTimingsByJobID :many
 SELECT * FROM provisioner_job_timings
 WHERE job_id = $1
-ORDER BY started_at ASC;
\ No newline at end of file
+ORDER BY started_at ASC;
+
+-- name: InsertProvisionerJobResourceChanges :many
+INSERT INTO provisioner_job_resource_changes (job_id, address, type, name, action)
+SELECT
+    @job_id::uuid AS provisioner_job_id,
+    unnest(@address::text[]),
+    unnest(@type::text[]),
+    unnest(@name::text[]),
+    unnest(@action::provisioner_job_resource_change_action[])
+RETURNING *;
+
+-- name: GetProvisionerJobResourceChangesByJobID :many
+SELECT * FROM provisioner_job_resource_changes
+WHERE job_id = $1
+ORDER BY address ASC;
+
+-- name: GetProvisionerJobResourceChangesByJobIDs :many
+SELECT * FROM provisioner_job_resource_changes
+WHERE job_id = ANY(@ids :: uuid [ ])
+ORDER BY job_id, address ASC;
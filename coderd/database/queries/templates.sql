@@ -143,7 +143,9 @@ SET
 	autostart_block_days_of_week = $9,
 	failure_ttl = $10,
 	time_til_dormant = $11,
-	time_til_dormant_autodelete = $12
+	time_til_dormant_autodelete = $12,
+	idle_detection_enabled = $13,
+	idle_cpu_threshold_percent = $14
 WHERE
 	id = $1
 ;
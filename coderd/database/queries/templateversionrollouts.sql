@@ -0,0 +1,54 @@
+-- name: InsertTemplateVersionRollout :one
+INSERT INTO
+	template_version_rollouts (
+		id,
+		template_id,
+		template_version_id,
+		percent,
+		group_id,
+		created_by,
+		created_at,
+		updated_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetActiveTemplateVersionRolloutByTemplateID :one
+SELECT
+	*
+FROM
+	template_version_rollouts
+WHERE
+	template_id = $1
+	AND status = 'canary'::template_version_rollout_status;
+
+-- name: GetTemplateVersionRolloutByID :one
+SELECT
+	*
+FROM
+	template_version_rollouts
+WHERE
+	id = $1;
+
+-- name: GetLatestTemplateVersionRolloutByTemplateVersionID :one
+SELECT
+	*
+FROM
+	template_version_rollouts
+WHERE
+	template_version_id = $1
+ORDER BY
+	created_at DESC
+LIMIT
+	1;
+
+-- name: UpdateTemplateVersionRolloutStatusByID :one
+UPDATE
+	template_version_rollouts
+SET
+	status = @status,
+	updated_at = @updated_at
+WHERE
+	id = @id
+RETURNING *;
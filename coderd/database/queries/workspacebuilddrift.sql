@@ -0,0 +1,106 @@
+-- name: InsertWorkspaceBuildDriftCheck :exec
+-- Starts a drift check of a workspace build. The result of the previous check
+-- is kept until the new check completes.
+INSERT INTO
+	workspace_build_drift (
+		workspace_build_id,
+		job_id,
+		started_at
+	)
+VALUES
+	($1, $2, $3)
+ON CONFLICT (workspace_build_id) DO UPDATE SET
+	job_id = EXCLUDED.job_id,
+	started_at = EXCLUDED.started_at;
+
+-- name: GetWorkspaceBuildDriftByJobID :one
+SELECT
+	*
+FROM
+	workspace_build_drift
+WHERE
+	job_id = $1;
+
+-- name: UpdateWorkspaceBuildDriftByJobID :one
+UPDATE
+	workspace_build_drift
+SET
+	checked_job_id = job_id,
+	checked_at = @checked_at,
+	drifted = @drifted
+WHERE
+	job_id = @job_id
+RETURNING *;
+
+-- name: GetWorkspaceBuildDriftsByWorkspaceBuildIDs :many
+SELECT
+	*
+FROM
+	workspace_build_drift
+WHERE
+	workspace_build_id = ANY(@ids :: uuid [ ]);
+
+-- name: GetWorkspacesEligibleForDriftCheck :many
+-- Returns the running workspaces that are due for a drift check or have
+-- drifted. A workspace is due if its latest build was never checked, or its
+-- last check completed and started before @started_before.
+SELECT
+	workspaces.id AS workspace_id,
+	workspace_builds.id AS workspace_build_id,
+	workspace_builds.template_version_id,
+	COALESCE(workspace_build_drift.drifted, false) :: boolean AS drifted,
+	(
+		workspace_build_drift.workspace_build_id IS NULL OR (
+			workspace_build_drift.started_at < @started_before :: timestamptz AND
+			drift_jobs.completed_at IS NOT NULL
+		)
+	) :: boolean AS check_due
+FROM
+	workspaces
+INNER JOIN
+	workspace_builds ON workspace_builds.workspace_id = workspaces.id
+INNER JOIN
+	provisioner_jobs ON workspace_builds.job_id = provisioner_jobs.id
+LEFT JOIN
+	workspace_build_drift ON workspace_build_drift.workspace_build_id = workspace_builds.id
+LEFT JOIN
+	provisioner_jobs AS drift_jobs ON drift_jobs.id = workspace_build_drift.job_id
+WHERE
+	workspace_builds.build_number = (
+		SELECT
+			MAX(build_number)
+		FROM
+			workspace_builds
+		WHERE
+			workspace_builds.workspace_id = workspaces.id
+	) AND
+	workspaces.deleted = false AND
+	workspaces.dormant_at IS NULL AND
+	workspace_builds.transition = 'start'::workspace_transition AND
+	provisioner_jobs.job_status = 'succeeded'::provisioner_job_status AND
+	(
+		COALESCE(workspace_build_drift.drifted, false) OR
+		workspace_build_drift.workspace_build_id IS NULL OR (
+			workspace_build_drift.started_at < @started_before :: timestamptz AND
+			drift_jobs.completed_at IS NOT NULL
+		)
+	);
+
+-- name: DeleteOldWorkspaceBuildDriftJobs :exec
+-- Deletes the jobs of drift checks that were superseded by a newer check of
+-- the same build. Their logs and resource changes are deleted with them.
+DELETE FROM
+	provisioner_jobs
+WHERE
+	type = 'template_version_dry_run'::provisioner_job_type AND
+	(input ->> 'refresh_only') :: boolean AND
+	completed_at IS NOT NULL AND
+	NOT EXISTS (
+		SELECT
+			1
+		FROM
+			workspace_build_drift
+		WHERE
+			workspace_build_drift.job_id = provisioner_jobs.id OR
+			workspace_build_drift.checked_job_id = provisioner_jobs.id
+	);
@@ -242,3 +242,18 @@ WHERE
 	AND pj.job_status = 'failed'
 ORDER BY
 	tv.name ASC, wb.build_number DESC;
+
+-- name: GetWorkspaceBuildStatsByTemplateVersionID :one
+SELECT
+	COUNT(*) AS total_builds,
+	COUNT(CASE WHEN pj.job_status = 'failed' THEN 1 END) AS failed_builds
+FROM
+	workspace_builds AS wb
+JOIN
+	provisioner_jobs AS pj
+ON
+	wb.job_id = pj.id
+WHERE
+	wb.template_version_id = @template_version_id
+	AND wb.created_at >= @since
+	AND pj.completed_at IS NOT NULL;
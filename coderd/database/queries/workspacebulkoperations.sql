@@ -0,0 +1,90 @@
+-- name: InsertWorkspaceBulkOperation :one
+INSERT INTO
+	workspace_bulk_operations (
+		id,
+		initiator_id,
+		initiator_scope,
+		action,
+		search,
+		created_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: InsertWorkspaceBulkOperationWorkspaces :exec
+INSERT INTO
+	workspace_bulk_operation_workspaces (
+		bulk_operation_id,
+		workspace_id,
+		updated_at
+	)
+SELECT
+	@bulk_operation_id :: uuid AS bulk_operation_id,
+	unnest(@workspace_ids :: uuid [ ]) AS workspace_id,
+	@updated_at :: timestamptz AS updated_at;
+
+-- name: GetWorkspaceBulkOperationByID :one
+SELECT
+	*
+FROM
+	workspace_bulk_operations
+WHERE
+	id = $1;
+
+-- name: GetIncompleteWorkspaceBulkOperations :many
+SELECT
+	*
+FROM
+	workspace_bulk_operations
+WHERE
+	completed_at IS NULL
+ORDER BY
+	created_at ASC;
+
+-- name: GetWorkspaceBulkOperationWorkspacesByBulkOperationID :many
+SELECT
+	workspace_bulk_operation_workspaces.*,
+	workspaces.name AS workspace_name,
+	users.username AS workspace_owner_username,
+	provisioner_jobs.job_status AS job_status
+FROM
+	workspace_bulk_operation_workspaces
+JOIN
+	workspaces ON workspaces.id = workspace_bulk_operation_workspaces.workspace_id
+JOIN
+	users ON users.id = workspaces.owner_id
+LEFT JOIN
+	workspace_builds ON workspace_builds.id = workspace_bulk_operation_workspaces.workspace_build_id
+LEFT JOIN
+	provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+WHERE
+	workspace_bulk_operation_workspaces.bulk_operation_id = $1
+ORDER BY
+	users.username ASC,
+	workspaces.name ASC;
+
+-- UpdateWorkspaceBulkOperationWorkspace only updates workspaces that are still
+-- pending, so that each workspace is built at most once even if several
+-- replicas process the same operation.
+-- name: UpdateWorkspaceBulkOperationWorkspace :one
+UPDATE
+	workspace_bulk_operation_workspaces
+SET
+	status = @status,
+	workspace_build_id = @workspace_build_id,
+	message = @message,
+	updated_at = @updated_at
+WHERE
+	bulk_operation_id = @bulk_operation_id
+	AND workspace_id = @workspace_id
+	AND status = 'pending'::workspace_bulk_operation_workspace_status
+RETURNING *;
+
+-- name: UpdateWorkspaceBulkOperationCompletedAt :exec
+UPDATE
+	workspace_bulk_operations
+SET
+	completed_at = @completed_at
+WHERE
+	id = @id;
@@ -47,7 +47,8 @@ WHERE
 -- name: GetWorkspaceByAgentID :one
 SELECT
 	sqlc.embed(workspaces),
-	templates.name as template_name
+	templates.name as template_name,
+	templates.idle_detection_enabled as template_idle_detection_enabled
 FROM
 	workspaces
 INNER JOIN
@@ -373,6 +374,8 @@ WHERE
 		'0001-01-01 00:00:00+00'::timestamptz, -- deleting_at
 		'never'::automatic_updates, -- automatic_updates
 		false, -- favorite
+		'{}'::jsonb, -- user_acl
+		'{}'::jsonb, -- group_acl
 		-- Extra columns added to `filtered_workspaces`
 		'', -- template_name
 		'00000000-0000-0000-0000-000000000000'::uuid, -- template_version_id
@@ -674,6 +677,15 @@ SET
 WHERE
 	template_id = @template_id;
 
+-- name: UpdateWorkspaceACLByID :exec
+UPDATE
+	workspaces
+SET
+	group_acl = @group_acl,
+	user_acl = @user_acl
+WHERE
+	id = @id;
+
 -- name: UpdateWorkspaceAutomaticUpdates :exec
 UPDATE
 	workspaces
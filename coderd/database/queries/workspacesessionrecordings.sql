@@ -0,0 +1,68 @@
+-- name: InsertWorkspaceSessionRecording :one
+-- Uploads restart from the beginning of a recording, so inserting an existing
+-- recording replaces its data. An agent can only replace its own recordings.
+INSERT INTO
+	workspace_session_recordings (
+		id,
+		workspace_id,
+		agent_id,
+		type,
+		command,
+		started_at,
+		ended_at,
+		input_recorded,
+		truncated,
+		created_at,
+		data
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (id) DO UPDATE SET
+	ended_at = EXCLUDED.ended_at,
+	truncated = EXCLUDED.truncated,
+	data = EXCLUDED.data
+WHERE
+	workspace_session_recordings.agent_id = EXCLUDED.agent_id
+RETURNING *;
+
+-- name: AppendWorkspaceSessionRecordingData :one
+-- Appends data to a recording, as long as it is appended at the current end
+-- of the recording.
+UPDATE
+	workspace_session_recordings
+SET
+	data = data || @data
+WHERE
+	id = @id
+	AND agent_id = @agent_id
+	AND octet_length(data) = @offset :: bigint
+RETURNING
+	octet_length(data) :: bigint AS size;
+
+-- name: GetWorkspaceSessionRecordingsByWorkspaceID :many
+SELECT
+	id,
+	workspace_id,
+	agent_id,
+	type,
+	command,
+	started_at,
+	ended_at,
+	input_recorded,
+	truncated,
+	created_at,
+	octet_length(data) :: bigint AS size
+FROM
+	workspace_session_recordings
+WHERE
+	workspace_id = @workspace_id
+ORDER BY
+	started_at DESC;
+
+-- name: GetWorkspaceSessionRecordingByID :one
+SELECT
+	*
+FROM
+	workspace_session_recordings
+WHERE
+	id = $1;
@@ -69,6 +69,24 @@ func (t TemplateACL) Value() (driver.Value, error) {
 	return json.Marshal(t)
 }
 
+type WorkspaceACL map[string][]policy.Action
+
+func (w *WorkspaceACL) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(v), &w)
+	case []byte, json.RawMessage:
+		//nolint
+		return json.Unmarshal(v.([]byte), &w)
+	}
+
+	return xerrors.Errorf("unexpected type %T", src)
+}
+
+func (w WorkspaceACL) Value() (driver.Value, error) {
+	return json.Marshal(w)
+}
+
 type ExternalAuthProvider struct {
 	ID       string `json:"id"`
 	Optional bool   `json:"optional,omitempty"`
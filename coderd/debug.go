@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,13 +17,18 @@ import (
 	"cdr.dev/slog"
 	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/notifications"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/coderd/rbac/policy"
 	"github.com/coder/coder/v2/coderd/util/slice"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/codersdk/healthsdk"
+	"github.com/coder/quartz"
 )
 
 // @Summary Debug Info Wireguard Coordinator
@@ -108,6 +114,173 @@ func (api *API) debugDeploymentHealth(rw http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// runHealthcheckHistory runs the healthcheck every refresh interval and
+// records the report until the API is closed. Every replica runs the
+// healthcheck, but only the replica holding the lock records its report, and
+// only if no report was recorded during the last half interval, so that the
+// history has a single writer per interval.
+func (api *API) runHealthcheckHistory(clk quartz.Clock) {
+	defer close(api.healthcheckHistoryDone)
+
+	ticker := clk.NewTicker(api.Options.HealthcheckRefresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-api.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(api.ctx, api.Options.HealthcheckTimeout)
+		// The websocket check needs a session token, so it fails without one
+		// and isn't recorded.
+		report := api.HealthcheckFunc(ctx, "")
+		api.recordHealthcheckReport(ctx, report)
+		cancel()
+	}
+}
+
+type healthcheckSectionChange struct {
+	section  healthsdk.HealthSection
+	previous health.Severity
+	report   healthsdk.BaseReport
+}
+
+// recordHealthcheckReport stores the result of every section of the report and
+// notifies owners about sections that changed severity since the previous
+// report. Dismissed sections are recorded, but owners are not notified about
+// them.
+func (api *API) recordHealthcheckReport(ctx context.Context, report *healthsdk.HealthcheckReport) {
+	if report == nil {
+		return
+	}
+	//nolint:gocritic // Healthcheck reports are recorded by the system.
+	ctx = dbauthz.AsSystemRestricted(ctx)
+	logger := api.Logger.Named("healthcheck_history")
+
+	createdAt := dbtime.Time(report.Time)
+	if report.Time.IsZero() {
+		createdAt = dbtime.Now()
+	}
+	var changes []healthcheckSectionChange
+	err := api.Database.InTx(func(tx database.Store) error {
+		// Only one replica records reports at a time.
+		ok, err := tx.TryAcquireLock(ctx, database.LockIDHealthcheckHistory)
+		if err != nil {
+			return xerrors.Errorf("acquire lock: %w", err)
+		}
+		if !ok {
+			logger.Debug(ctx, "unable to acquire lock for recording healthcheck report, skipping")
+			return nil
+		}
+
+		latest, err := tx.GetLatestHealthcheckResults(ctx)
+		if err != nil {
+			return xerrors.Errorf("get latest healthcheck results: %w", err)
+		}
+		previous := make(map[healthsdk.HealthSection]health.Severity, len(latest))
+		for _, result := range latest {
+			if createdAt.Sub(result.CreatedAt) < api.Options.HealthcheckRefresh/2 {
+				logger.Debug(ctx, "healthcheck report recorded by another replica during this interval, skipping")
+				return nil
+			}
+			previous[healthsdk.HealthSection(result.Section)] = health.Severity(result.Severity)
+		}
+
+		sections := healthcheckSections(report)
+		for _, section := range healthsdk.HealthSections {
+			if section == healthsdk.HealthSectionWebsocket {
+				continue
+			}
+			base := sections[section]
+			warnings, err := json.Marshal(base.Warnings)
+			if err != nil {
+				return xerrors.Errorf("marshal %s warnings: %w", section, err)
+			}
+			if len(base.Warnings) == 0 {
+				warnings = []byte("[]")
+			}
+			var errMsg sql.NullString
+			if base.Error != nil {
+				errMsg = sql.NullString{String: *base.Error, Valid: true}
+			}
+			_, err = tx.InsertHealthcheckResult(ctx, database.InsertHealthcheckResultParams{
+				ID:        uuid.New(),
+				CreatedAt: createdAt,
+				Section:   string(section),
+				Severity:  string(base.Severity),
+				Warnings:  warnings,
+				Error:     errMsg,
+			})
+			if err != nil {
+				return xerrors.Errorf("insert %s result: %w", section, err)
+			}
+
+			// The first report of a section has nothing to compare against.
+			previousSeverity, ok := previous[section]
+			if ok && previousSeverity != base.Severity {
+				changes = append(changes, healthcheckSectionChange{section: section, previous: previousSeverity, report: base})
+			}
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "record healthcheck report", slog.Error(err))
+		return
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+	dismissed := loadDismissedHealthchecks(ctx, api.Database, api.Logger)
+	for _, change := range changes {
+		if slices.Contains(dismissed, change.section) {
+			continue
+		}
+		api.notifyHealthcheckSectionChanged(ctx, change.section, change.previous, change.report)
+	}
+}
+
+func (api *API) notifyHealthcheckSectionChanged(ctx context.Context, section healthsdk.HealthSection, previous health.Severity, base healthsdk.BaseReport) {
+	owners, err := api.Database.GetUsers(ctx, database.GetUsersParams{
+		RbacRole: []string{codersdk.RoleOwner},
+	})
+	if err != nil {
+		api.Logger.Error(ctx, "unable to find owners to notify about healthcheck", slog.Error(err))
+		return
+	}
+
+	labels := map[string]string{
+		"section":           string(section),
+		"severity":          string(base.Severity),
+		"previous_severity": string(previous),
+		"summary":           strings.Join(base.Summarize("", api.DeploymentValues.DocsURL.String()), "\n"),
+	}
+	for _, owner := range owners {
+		if _, err := api.NotificationsEnqueuer.Enqueue(ctx, owner.ID, notifications.TemplateDeploymentHealthChanged,
+			labels, "healthcheck",
+		); err != nil {
+			api.Logger.Warn(ctx, "unable to notify about changed deployment health", slog.F("section", section), slog.Error(err))
+		}
+	}
+}
+
+// healthcheckSections returns the common fields of every section of the
+// report.
+func healthcheckSections(report *healthsdk.HealthcheckReport) map[healthsdk.HealthSection]healthsdk.BaseReport {
+	return map[healthsdk.HealthSection]healthsdk.BaseReport{
+		healthsdk.HealthSectionDERP:               report.DERP.BaseReport,
+		healthsdk.HealthSectionAccessURL:          report.AccessURL.BaseReport,
+		healthsdk.HealthSectionWebsocket:          report.Websocket.BaseReport,
+		healthsdk.HealthSectionDatabase:           report.Database.BaseReport,
+		healthsdk.HealthSectionWorkspaceProxy:     report.WorkspaceProxy.BaseReport,
+		healthsdk.HealthSectionProvisionerDaemons: report.ProvisionerDaemons.BaseReport,
+		healthsdk.HealthSectionPubsub:             report.Pubsub.BaseReport,
+		healthsdk.HealthSectionNotifications:      report.Notifications.BaseReport,
+		healthsdk.HealthSectionExternalAuth:       report.ExternalAuth.BaseReport,
+	}
+}
+
 func formatHealthcheck(ctx context.Context, rw http.ResponseWriter, r *http.Request, hc healthsdk.HealthcheckReport, dismissed ...healthsdk.HealthSection) {
 	// Mark any sections previously marked as dismissed.
 	for _, d := range dismissed {
@@ -122,6 +295,12 @@ func formatHealthcheck(ctx context.Context, rw http.ResponseWriter, r *http.Requ
 			hc.Websocket.Dismissed = true
 		case healthsdk.HealthSectionWorkspaceProxy:
 			hc.WorkspaceProxy.Dismissed = true
+		case healthsdk.HealthSectionPubsub:
+			hc.Pubsub.Dismissed = true
+		case healthsdk.HealthSectionNotifications:
+			hc.Notifications.Dismissed = true
+		case healthsdk.HealthSectionExternalAuth:
+			hc.ExternalAuth.Dismissed = true
 		}
 	}
 
@@ -266,6 +445,72 @@ func (api *API) putDeploymentHealthSettings(rw http.ResponseWriter, r *http.Requ
 	httpapi.Write(r.Context(), rw, http.StatusOK, settings)
 }
 
+// @Summary Get healthcheck history
+// @ID get-healthcheck-history
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Debug
+// @Param section query string false "Healthcheck section" Enums(DERP,AccessURL,Websocket,Database,WorkspaceProxy,ProvisionerDaemons,Pubsub,Notifications,ExternalAuth)
+// @Param limit query int false "Maximum number of results, 100 by default"
+// @Success 200 {array} healthsdk.HealthcheckSectionResult
+// @Router /debug/health/history [get]
+func (api *API) debugDeploymentHealthHistory(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vals := r.URL.Query()
+	parser := httpapi.NewQueryParamParser()
+	section := healthsdk.HealthSection(parser.String(vals, "", "section"))
+	limit := parser.PositiveInt32(vals, 100, "limit")
+	parser.ErrorExcessParams(vals)
+	if section != "" && !slices.Contains(healthsdk.HealthSections, section) {
+		parser.Errors = append(parser.Errors, codersdk.ValidationError{
+			Field:  "section",
+			Detail: fmt.Sprintf("Unknown healthcheck section %q.", section),
+		})
+	}
+	if len(parser.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid query parameters.",
+			Validations: parser.Errors,
+		})
+		return
+	}
+
+	results, err := api.Database.GetHealthcheckResults(ctx, database.GetHealthcheckResultsParams{
+		Section:  string(section),
+		LimitOpt: limit,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to fetch healthcheck history.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	history := make([]healthsdk.HealthcheckSectionResult, 0, len(results))
+	for _, result := range results {
+		converted := healthsdk.HealthcheckSectionResult{
+			Time:     result.CreatedAt,
+			Section:  healthsdk.HealthSection(result.Section),
+			Severity: health.Severity(result.Severity),
+			Warnings: []health.Message{},
+		}
+		if err := json.Unmarshal(result.Warnings, &converted.Warnings); err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Failed to unmarshal healthcheck warnings.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		if result.Error.Valid {
+			converted.Error = &result.Error.String
+		}
+		history = append(history, converted)
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, history)
+}
+
 func validateHealthSettings(settings healthsdk.HealthSettings) error {
 	for _, dismissed := range settings.DismissedHealthchecks {
 		ok := slices.Contains(healthsdk.HealthSections, dismissed)
@@ -15,6 +15,9 @@ import (
 	"cdr.dev/slog/sloggers/slogtest"
 
 	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
+	"github.com/coder/coder/v2/coderd/notifications"
+	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/codersdk/healthsdk"
 	"github.com/coder/coder/v2/testutil"
 )
@@ -129,8 +132,12 @@ func TestDebugHealth(t *testing.T) {
 			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
 			client      = coderdtest.New(t, &coderdtest.Options{
 				HealthcheckRefresh: time.Microsecond,
-				HealthcheckFunc: func(context.Context, string) *healthsdk.HealthcheckReport {
-					calls <- struct{}{}
+				HealthcheckFunc: func(_ context.Context, apiKey string) *healthsdk.HealthcheckReport {
+					// Ignore the background healthcheck, which runs without
+					// a session token.
+					if apiKey != "" {
+						calls <- struct{}{}
+					}
 					return &healthsdk.HealthcheckReport{}
 				},
 			})
@@ -237,6 +244,106 @@ func TestDebugHealth(t *testing.T) {
 	})
 }
 
+func TestDebugHealthHistory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx              = testutil.Context(t, testutil.WaitLong)
+		databaseSeverity atomic.Pointer[health.Severity]
+		enqueuer         = &testutil.FakeNotificationsEnqueuer{}
+	)
+	ok := health.SeverityOK
+	databaseSeverity.Store(&ok)
+	client, _, api := coderdtest.NewWithAPI(t, &coderdtest.Options{
+		NotificationsEnqueuer: enqueuer,
+		HealthcheckFunc: func(context.Context, string) *healthsdk.HealthcheckReport {
+			report := &healthsdk.HealthcheckReport{Time: time.Now()}
+			report.DERP.Severity = health.SeverityOK
+			report.AccessURL.Severity = health.SeverityOK
+			report.Websocket.Severity = health.SeverityError
+			report.Database.Severity = *databaseSeverity.Load()
+			report.WorkspaceProxy.Severity = health.SeverityOK
+			report.ProvisionerDaemons.Severity = health.SeverityOK
+			report.Pubsub.Severity = health.SeverityOK
+			report.Notifications.Severity = health.SeverityOK
+			report.ExternalAuth.Severity = health.SeverityOK
+			if report.Database.Severity == health.SeverityWarning {
+				report.Database.Warnings = []health.Message{{Code: health.CodeDatabasePingSlow, Message: "slow"}}
+			}
+			return report
+		},
+		// The healthcheck history is recorded in the background every
+		// refresh interval.
+		HealthcheckRefresh: testutil.IntervalFast,
+	})
+	owner := coderdtest.CreateFirstUser(t, client)
+	sdk := healthsdk.New(client)
+
+	// waitForDatabaseHistory waits until the history of the database section
+	// ends with n results of the given severity.
+	waitForDatabaseHistory := func(severity health.Severity, n int) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			history, err := sdk.HealthcheckHistory(ctx, healthsdk.HealthcheckHistoryRequest{
+				Section: healthsdk.HealthSectionDatabase,
+				Limit:   n,
+			})
+			if !assert.NoError(t, err) || len(history) < n {
+				return false
+			}
+			for _, result := range history {
+				if result.Severity != severity {
+					return false
+				}
+			}
+			return true
+		}, testutil.WaitLong, testutil.IntervalFast)
+	}
+
+	waitForDatabaseHistory(health.SeverityOK, 2)
+	warning := health.SeverityWarning
+	databaseSeverity.Store(&warning)
+	waitForDatabaseHistory(health.SeverityWarning, 2)
+
+	history, err := sdk.HealthcheckHistory(ctx, healthsdk.HealthcheckHistoryRequest{
+		Section: healthsdk.HealthSectionDatabase,
+	})
+	require.NoError(t, err)
+	require.Equal(t, health.CodeDatabasePingSlow, history[0].Warnings[0].Code)
+
+	// The websocket check can't run in the background, so it isn't recorded.
+	history, err = sdk.HealthcheckHistory(ctx, healthsdk.HealthcheckHistoryRequest{
+		Section: healthsdk.HealthSectionWebsocket,
+	})
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	history, err = sdk.HealthcheckHistory(ctx, healthsdk.HealthcheckHistoryRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	_, err = sdk.HealthcheckHistory(ctx, healthsdk.HealthcheckHistoryRequest{Section: "foo"})
+	var apiErr *codersdk.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+
+	// Stop the background healthcheck before inspecting the notifications.
+	require.NoError(t, api.Close())
+	var sent []*testutil.Notification
+	for _, n := range enqueuer.Sent {
+		if n.TemplateID == notifications.TemplateDeploymentHealthChanged {
+			sent = append(sent, n)
+		}
+	}
+	// Only the change of the database section is notified about.
+	require.Len(t, sent, 1)
+	require.Equal(t, owner.UserID, sent[0].UserID)
+	require.Equal(t, "Database", sent[0].Labels["section"])
+	require.Equal(t, "ok", sent[0].Labels["previous_severity"])
+	require.Equal(t, "warning", sent[0].Labels["severity"])
+	require.Contains(t, sent[0].Labels["summary"], "slow")
+}
+
 func TestHealthSettings(t *testing.T) {
 	t.Parallel()
 
@@ -269,10 +376,10 @@ func TestHealthSettings(t *testing.T) {
 		_ = coderdtest.CreateFirstUser(t, adminClient)
 
 		expected := healthsdk.HealthSettings{
-			DismissedHealthchecks: []healthsdk.HealthSection{healthsdk.HealthSectionDERP, healthsdk.HealthSectionWebsocket},
+			DismissedHealthchecks: []healthsdk.HealthSection{healthsdk.HealthSectionDERP, healthsdk.HealthSectionWebsocket, healthsdk.HealthSectionPubsub},
 		}
 
-		// when: dismiss "derp" and "websocket"
+		// when: dismiss "derp", "websocket" and "pubsub"
 		err := healthsdk.New(adminClient).PutHealthSettings(ctx, expected)
 		require.NoError(t, err)
 
@@ -291,6 +398,7 @@ func TestHealthSettings(t *testing.T) {
 		require.NoError(t, json.Unmarshal(bs, &hc))
 		require.True(t, hc.DERP.Dismissed)
 		require.True(t, hc.Websocket.Dismissed)
+		require.True(t, hc.Pubsub.Dismissed)
 	})
 
 	t.Run("UnDismissSection", func(t *testing.T) {
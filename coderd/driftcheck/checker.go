@@ -0,0 +1,308 @@
+package driftcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
+	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/provisionerdserver"
+	"github.com/coder/coder/v2/coderd/wsbuilder"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// PollInterval is how often the checker looks for workspaces that are due
+// for a drift check. The check interval itself is configured on the
+// deployment.
+const PollInterval = time.Minute
+
+// Checker starts drift checks of running workspaces on every tick from its
+// channel. A drift check is a refresh-only dry-run of the latest build of a
+// workspace, whose result is recorded when the job completes. Drifted
+// workspaces are started again if the deployment is configured to reconcile
+// them.
+type Checker struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	db    database.Store
+	ps    pubsub.Pubsub
+	log   slog.Logger
+	dv    *codersdk.DeploymentValues
+	tick  <-chan time.Time
+	stats chan<- Stats
+}
+
+// Stats contains information about one run of the checker.
+type Stats struct {
+	// Checked contains the IDs of the workspaces whose drift check was
+	// started.
+	Checked []uuid.UUID
+	// Reconciled contains the IDs of the drifted workspaces that were
+	// started.
+	Reconciled []uuid.UUID
+	// Errors contains the errors that occurred per workspace.
+	Errors map[uuid.UUID]error
+}
+
+// New returns a new drift checker.
+func New(ctx context.Context, db database.Store, ps pubsub.Pubsub, log slog.Logger, dv *codersdk.DeploymentValues, tick <-chan time.Time) *Checker {
+	//nolint:gocritic // The checker creates jobs and builds of all workspaces.
+	ctx, cancel := context.WithCancel(dbauthz.AsSystemRestricted(ctx))
+	return &Checker{
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		db:     db,
+		ps:     ps,
+		log:    log.Named("driftcheck"),
+		dv:     dv,
+		tick:   tick,
+	}
+}
+
+// WithStatsChannel will cause the checker to push Stats to ch after every
+// tick. This push is blocking, so it should only be used in tests.
+func (c *Checker) WithStatsChannel(ch chan<- Stats) *Checker {
+	c.stats = ch
+	return c
+}
+
+// Start will cause the checker to check workspaces on every tick from its
+// channel. It will stop when its context is Done, or when its channel is
+// closed.
+//
+// Start should only be called once.
+func (c *Checker) Start() {
+	go func() {
+		defer close(c.done)
+		defer c.cancel()
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case t, ok := <-c.tick:
+				if !ok {
+					return
+				}
+				stats := c.run(t)
+				if c.stats != nil {
+					select {
+					case <-c.ctx.Done():
+						return
+					case c.stats <- stats:
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Close will stop the checker.
+func (c *Checker) Close() {
+	c.cancel()
+	<-c.done
+}
+
+func (c *Checker) run(t time.Time) Stats {
+	stats := Stats{
+		Checked:    []uuid.UUID{},
+		Reconciled: []uuid.UUID{},
+		Errors:     map[uuid.UUID]error{},
+	}
+	interval := c.dv.WorkspaceDrift.CheckInterval.Value()
+	if interval <= 0 {
+		return stats
+	}
+	startedBefore := t.Add(-interval)
+	reconcile := c.dv.WorkspaceDrift.Action == codersdk.WorkspaceDriftActionReconcile
+
+	workspaces, err := c.db.GetWorkspacesEligibleForDriftCheck(c.ctx, startedBefore)
+	if err != nil {
+		c.log.Error(c.ctx, "get workspaces eligible for drift check", slog.Error(err))
+		return stats
+	}
+	for _, ws := range workspaces {
+		log := c.log.With(slog.F("workspace_id", ws.WorkspaceID), slog.F("workspace_build_id", ws.WorkspaceBuildID))
+		switch {
+		case ws.Drifted && reconcile:
+			err = c.reconcile(ws)
+			if err == nil {
+				stats.Reconciled = append(stats.Reconciled, ws.WorkspaceID)
+			}
+		case ws.CheckDue:
+			err = c.check(ws, startedBefore)
+			if err == nil {
+				stats.Checked = append(stats.Checked, ws.WorkspaceID)
+			}
+		default:
+			continue
+		}
+		if xerrors.Is(err, errSkipped) {
+			continue
+		}
+		if err != nil {
+			stats.Errors[ws.WorkspaceID] = err
+			log.Warn(c.ctx, "failed to process workspace drift", slog.Error(err))
+		}
+	}
+	return stats
+}
+
+// errSkipped is returned when another replica processed the workspace first.
+var errSkipped = xerrors.New("workspace already processed")
+
+// check starts a drift check of the latest build of a workspace.
+func (c *Checker) check(ws database.GetWorkspacesEligibleForDriftCheckRow, startedBefore time.Time) error {
+	var job database.ProvisionerJob
+	err := c.db.InTx(func(tx database.Store) error {
+		err := lock(c.ctx, tx, ws.WorkspaceID)
+		if err != nil {
+			return err
+		}
+		drifts, err := tx.GetWorkspaceBuildDriftsByWorkspaceBuildIDs(c.ctx, []uuid.UUID{ws.WorkspaceBuildID})
+		if err != nil {
+			return xerrors.Errorf("get workspace build drift: %w", err)
+		}
+		if len(drifts) > 0 && !drifts[0].StartedAt.Before(startedBefore) {
+			return errSkipped
+		}
+
+		workspace, err := tx.GetWorkspaceByID(c.ctx, ws.WorkspaceID)
+		if err != nil {
+			return xerrors.Errorf("get workspace: %w", err)
+		}
+		templateVersion, err := tx.GetTemplateVersionByID(c.ctx, ws.TemplateVersionID)
+		if err != nil {
+			return xerrors.Errorf("get template version: %w", err)
+		}
+		importJob, err := tx.GetProvisionerJobByID(c.ctx, templateVersion.JobID)
+		if err != nil {
+			return xerrors.Errorf("get template version import job: %w", err)
+		}
+		parameters, err := tx.GetWorkspaceBuildParameters(c.ctx, ws.WorkspaceBuildID)
+		if err != nil {
+			return xerrors.Errorf("get workspace build parameters: %w", err)
+		}
+
+		input, err := json.Marshal(provisionerdserver.TemplateVersionDryRunJob{
+			TemplateVersionID:   templateVersion.ID,
+			WorkspaceID:         workspace.ID,
+			WorkspaceName:       workspace.Name,
+			RichParameterValues: parameters,
+			RefreshOnly:         true,
+		})
+		if err != nil {
+			return xerrors.Errorf("marshal job input: %w", err)
+		}
+		now := dbtime.Now()
+		job, err = tx.InsertProvisionerJob(c.ctx, database.InsertProvisionerJobParams{
+			ID:             uuid.New(),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			OrganizationID: templateVersion.OrganizationID,
+			InitiatorID:    workspace.OwnerID,
+			Provisioner:    importJob.Provisioner,
+			StorageMethod:  importJob.StorageMethod,
+			FileID:         importJob.FileID,
+			Type:           database.ProvisionerJobTypeTemplateVersionDryRun,
+			Input:          input,
+			Tags:           importJob.Tags,
+			Priority:       provisionerjobs.PriorityTemplateVersion,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert provisioner job: %w", err)
+		}
+		err = tx.InsertWorkspaceBuildDriftCheck(c.ctx, database.InsertWorkspaceBuildDriftCheckParams{
+			WorkspaceBuildID: ws.WorkspaceBuildID,
+			JobID:            job.ID,
+			StartedAt:        now,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert workspace build drift check: %w", err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	err = provisionerjobs.PostJob(c.ps, job)
+	if err != nil {
+		c.log.Error(c.ctx, "failed to post provisioner job to pubsub", slog.Error(err))
+	}
+	return nil
+}
+
+// reconcile starts a drifted workspace, which recreates or restores the
+// drifted resources.
+func (c *Checker) reconcile(ws database.GetWorkspacesEligibleForDriftCheckRow) error {
+	var job *database.ProvisionerJob
+	err := c.db.InTx(func(tx database.Store) error {
+		err := lock(c.ctx, tx, ws.WorkspaceID)
+		if err != nil {
+			return err
+		}
+		workspace, err := tx.GetWorkspaceByID(c.ctx, ws.WorkspaceID)
+		if err != nil {
+			return xerrors.Errorf("get workspace: %w", err)
+		}
+		latestBuild, err := tx.GetLatestWorkspaceBuildByWorkspaceID(c.ctx, workspace.ID)
+		if err != nil {
+			return xerrors.Errorf("get latest workspace build: %w", err)
+		}
+		if latestBuild.ID != ws.WorkspaceBuildID {
+			return errSkipped
+		}
+
+		// The workspace is started with the template version and parameters
+		// of its latest build, so only the drifted resources change.
+		builder := wsbuilder.New(workspace, database.WorkspaceTransitionStart).
+			SetLastWorkspaceBuildInTx(&latestBuild).
+			Reason(database.BuildReasonDrift)
+		_, job, err = builder.Build(c.ctx, tx, nil, audit.WorkspaceBuildBaggage{IP: "127.0.0.1"})
+		if err != nil {
+			return xerrors.Errorf("build workspace: %w", err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	err = provisionerjobs.PostJob(c.ps, *job)
+	if err != nil {
+		c.log.Error(c.ctx, "failed to post provisioner job to pubsub", slog.Error(err))
+	}
+	err = c.ps.Publish(codersdk.WorkspaceNotifyChannel(ws.WorkspaceID), []byte{})
+	if err != nil {
+		c.log.Warn(c.ctx, "failed to publish workspace update", slog.F("workspace_id", ws.WorkspaceID), slog.Error(err))
+	}
+	return nil
+}
+
+// lock ensures only one replica processes a workspace at a time. It returns
+// errSkipped if another replica holds the lock.
+func lock(ctx context.Context, tx database.Store, workspaceID uuid.UUID) error {
+	locked, err := tx.TryAcquireLock(ctx, database.GenLockID(fmt.Sprintf("drift-check:%s", workspaceID)))
+	if err != nil {
+		return xerrors.Errorf("acquire lock: %w", err)
+	}
+	if !locked {
+		return errSkipped
+	}
+	return nil
+}
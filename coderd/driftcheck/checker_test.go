@@ -0,0 +1,201 @@
+package driftcheck_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/driftcheck"
+	"github.com/coder/coder/v2/coderd/notifications"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/provisioner/echo"
+	"github.com/coder/coder/v2/provisionersdk/proto"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tickCh  = make(chan time.Time)
+			statsCh = make(chan driftcheck.Stats)
+			client  = coderdtest.New(t, &coderdtest.Options{
+				IncludeProvisionerDaemon: true,
+				DriftCheckTicker:         tickCh,
+				DriftCheckStats:          statsCh,
+			})
+			user = coderdtest.CreateFirstUser(t, client)
+		)
+		workspace := createDriftedWorkspace(t, client, user.OrganizationID)
+
+		go func() {
+			tickCh <- time.Now()
+			close(tickCh)
+		}()
+		stats := <-statsCh
+		assert.Empty(t, stats.Errors)
+		assert.Empty(t, stats.Checked)
+
+		workspace = coderdtest.MustWorkspace(t, client, workspace.ID)
+		require.Equal(t, codersdk.WorkspaceDriftStatusUnknown, workspace.Drift.Status)
+		require.Nil(t, workspace.Drift.CheckedAt)
+	})
+
+	t.Run("Notify", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tickCh   = make(chan time.Time)
+			statsCh  = make(chan driftcheck.Stats)
+			enqueuer = &testutil.FakeNotificationsEnqueuer{}
+			dv       = coderdtest.DeploymentValues(t)
+		)
+		require.NoError(t, dv.WorkspaceDrift.CheckInterval.Set("1h"))
+		dv.WorkspaceDrift.Action = codersdk.WorkspaceDriftActionNotify
+		client := coderdtest.New(t, &coderdtest.Options{
+			IncludeProvisionerDaemon: true,
+			DeploymentValues:         dv,
+			DriftCheckTicker:         tickCh,
+			DriftCheckStats:          statsCh,
+			NotificationsEnqueuer:    enqueuer,
+		})
+		user := coderdtest.CreateFirstUser(t, client)
+		workspace := createDriftedWorkspace(t, client, user.OrganizationID)
+
+		// The latest build was never checked, so it is checked right away.
+		now := time.Now()
+		tickCh <- now
+		stats := <-statsCh
+		assert.Empty(t, stats.Errors)
+		require.Equal(t, []uuid.UUID{workspace.ID}, stats.Checked)
+
+		workspace = awaitDriftStatus(t, client, workspace.ID, codersdk.WorkspaceDriftStatusDrifted)
+		require.NotNil(t, workspace.Drift.CheckedAt)
+		require.Equal(t, []codersdk.WorkspaceResourceChange{{
+			Address: "docker_container.workspace[0]",
+			Type:    "docker_container",
+			Name:    "workspace",
+			Action:  codersdk.WorkspaceResourceChangeActionDestroy,
+		}}, workspace.Drift.Resources)
+
+		require.Len(t, enqueuer.Sent, 1)
+		require.Equal(t, notifications.TemplateWorkspaceDrifted, enqueuer.Sent[0].TemplateID)
+		require.Equal(t, workspace.OwnerID, enqueuer.Sent[0].UserID)
+		require.Equal(t, workspace.Name, enqueuer.Sent[0].Labels["name"])
+		require.Equal(t, "- `docker_container.workspace[0]` was deleted", enqueuer.Sent[0].Labels["resources"])
+		require.Contains(t, enqueuer.Sent[0].Targets, workspace.ID)
+
+		// The workspace is not checked again before the interval elapsed.
+		tickCh <- now.Add(time.Minute)
+		stats = <-statsCh
+		assert.Empty(t, stats.Errors)
+		assert.Empty(t, stats.Checked)
+
+		// A workspace that is still drifted does not notify its owner twice.
+		tickCh <- now.Add(2 * time.Hour)
+		stats = <-statsCh
+		assert.Empty(t, stats.Errors)
+		require.Equal(t, []uuid.UUID{workspace.ID}, stats.Checked)
+		require.Eventually(t, func() bool {
+			ws := coderdtest.MustWorkspace(t, client, workspace.ID)
+			return ws.Drift.CheckedAt != nil && ws.Drift.CheckedAt.After(*workspace.Drift.CheckedAt)
+		}, testutil.WaitLong, testutil.IntervalFast)
+		require.Len(t, enqueuer.Sent, 1)
+		close(tickCh)
+	})
+
+	t.Run("Reconcile", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tickCh  = make(chan time.Time)
+			statsCh = make(chan driftcheck.Stats)
+			dv      = coderdtest.DeploymentValues(t)
+		)
+		require.NoError(t, dv.WorkspaceDrift.CheckInterval.Set("1h"))
+		dv.WorkspaceDrift.Action = codersdk.WorkspaceDriftActionReconcile
+		client := coderdtest.New(t, &coderdtest.Options{
+			IncludeProvisionerDaemon: true,
+			DeploymentValues:         dv,
+			DriftCheckTicker:         tickCh,
+			DriftCheckStats:          statsCh,
+		})
+		user := coderdtest.CreateFirstUser(t, client)
+		workspace := createDriftedWorkspace(t, client, user.OrganizationID)
+
+		tickCh <- time.Now()
+		stats := <-statsCh
+		assert.Empty(t, stats.Errors)
+		require.Equal(t, []uuid.UUID{workspace.ID}, stats.Checked)
+		awaitDriftStatus(t, client, workspace.ID, codersdk.WorkspaceDriftStatusDrifted)
+
+		// The drifted workspace is started again with the same version.
+		tickCh <- time.Now()
+		stats = <-statsCh
+		assert.Empty(t, stats.Errors)
+		require.Equal(t, []uuid.UUID{workspace.ID}, stats.Reconciled)
+		close(tickCh)
+
+		reconciled := coderdtest.MustWorkspace(t, client, workspace.ID)
+		require.Equal(t, workspace.LatestBuild.BuildNumber+1, reconciled.LatestBuild.BuildNumber)
+		require.Equal(t, codersdk.BuildReasonDrift, reconciled.LatestBuild.Reason)
+		require.Equal(t, codersdk.WorkspaceTransitionStart, reconciled.LatestBuild.Transition)
+		require.Equal(t, workspace.LatestBuild.TemplateVersionID, reconciled.LatestBuild.TemplateVersionID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, reconciled.LatestBuild.ID)
+
+		// The new build has not been checked yet.
+		reconciled = coderdtest.MustWorkspace(t, client, workspace.ID)
+		require.Equal(t, codersdk.WorkspaceDriftStatusUnknown, reconciled.Drift.Status)
+	})
+}
+
+// createDriftedWorkspace creates a running workspace whose refresh-only plans
+// report a deleted resource.
+func createDriftedWorkspace(t *testing.T, client *codersdk.Client, orgID uuid.UUID) codersdk.Workspace {
+	t.Helper()
+
+	version := coderdtest.CreateTemplateVersion(t, client, orgID, &echo.Responses{
+		Parse:          echo.ParseComplete,
+		ProvisionApply: echo.ApplyComplete,
+		ProvisionPlan: []*proto.Response{{
+			Type: &proto.Response_Plan{
+				Plan: &proto.PlanComplete{
+					ResourceChanges: []*proto.ResourceChange{{
+						Address: "docker_container.workspace[0]",
+						Type:    "docker_container",
+						Name:    "workspace",
+						Action:  proto.ResourceChange_DESTROY,
+					}},
+				},
+			},
+		}},
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, orgID, version.ID)
+	workspace := coderdtest.CreateWorkspace(t, client, template.ID)
+	coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+	return coderdtest.MustWorkspace(t, client, workspace.ID)
+}
+
+func awaitDriftStatus(t *testing.T, client *codersdk.Client, workspaceID uuid.UUID, status codersdk.WorkspaceDriftStatus) codersdk.Workspace {
+	t.Helper()
+
+	var workspace codersdk.Workspace
+	require.Eventually(t, func() bool {
+		workspace = coderdtest.MustWorkspace(t, client, workspaceID)
+		return workspace.Drift.Status == status
+	}, testutil.WaitLong, testutil.IntervalFast)
+	return workspace
+}
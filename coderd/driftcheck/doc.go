@@ -0,0 +1,4 @@
+// Package driftcheck detects running workspaces whose infrastructure was
+// changed or deleted outside of Coder, by periodically running refresh-only
+// plans against their Terraform state.
+package driftcheck
@@ -0,0 +1,92 @@
+package healthcheck
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/externalauth"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
+	"github.com/coder/coder/v2/codersdk/healthsdk"
+)
+
+// externalAuthProbeToken is sent to the token and validation endpoints of
+// external auth providers. Providers are expected to reject it.
+const externalAuthProbeToken = "coder-healthcheck"
+
+type ExternalAuthReport healthsdk.ExternalAuthReport
+
+type ExternalAuthReportOptions struct {
+	Configs []*externalauth.Config
+
+	Dismissed bool
+}
+
+func (r *ExternalAuthReport) Run(ctx context.Context, opts *ExternalAuthReportOptions) {
+	r.Items = []healthsdk.ExternalAuthReportItem{}
+	r.Warnings = []health.Message{}
+	r.Severity = health.SeverityOK
+	r.Dismissed = opts.Dismissed
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	for _, cfg := range opts.Configs {
+		it := healthsdk.ExternalAuthReportItem{
+			ID:       cfg.ID,
+			Type:     cfg.Type,
+			Warnings: []health.Message{},
+		}
+		for _, msg := range probeExternalAuth(ctx, cfg) {
+			it.Warnings = append(it.Warnings, msg)
+			r.Severity = health.SeverityError
+			r.Warnings = append(r.Warnings, health.Messagef(msg.Code, "External auth provider %q: %s", cfg.ID, msg.Message))
+		}
+		r.Items = append(r.Items, it)
+	}
+}
+
+// probeExternalAuth checks that the token endpoint of the provider accepts the
+// client credentials, and that the validation endpoint is reachable.
+func probeExternalAuth(ctx context.Context, cfg *externalauth.Config) []health.Message {
+	var msgs []health.Message
+
+	// Refreshing an invalid token authenticates the client, so the error
+	// returned by the provider tells whether the client credentials are valid
+	// without needing a valid token.
+	_, err := cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: externalAuthProbeToken}).Token()
+	var retrieveErr *oauth2.RetrieveError
+	switch {
+	case err == nil:
+	case xerrors.As(err, &retrieveErr):
+		if clientCredentialsRejected(retrieveErr) {
+			msgs = append(msgs, health.Messagef(health.CodeExternalAuthCredentialsRejected, "token endpoint rejected the client credentials: %s", err))
+		}
+	default:
+		msgs = append(msgs, health.Messagef(health.CodeExternalAuthUnreachable, "token endpoint: %s", err))
+	}
+
+	if cfg.ValidateURL != "" {
+		// An invalid token is reported as not valid without an error, any
+		// other response is unexpected.
+		_, _, err := cfg.ValidateToken(ctx, &oauth2.Token{AccessToken: externalAuthProbeToken})
+		if err != nil {
+			msgs = append(msgs, health.Messagef(health.CodeExternalAuthUnreachable, "validate endpoint: %s", err))
+		}
+	}
+	return msgs
+}
+
+// clientCredentialsRejected returns true if the token endpoint rejected the
+// client, as opposed to the refresh token. GitHub responds with a 200 and its
+// own error code.
+func clientCredentialsRejected(err *oauth2.RetrieveError) bool {
+	switch err.ErrorCode {
+	case "invalid_client", "unauthorized_client", "incorrect_client_credentials":
+		return true
+	}
+	return err.Response != nil && err.Response.StatusCode == http.StatusUnauthorized
+}
@@ -0,0 +1,157 @@
+package healthcheck_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/coder/coder/v2/coderd/externalauth"
+	"github.com/coder/coder/v2/coderd/healthcheck"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
+	"github.com/coder/coder/v2/coderd/promoauth"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestExternalAuth(t *testing.T) {
+	t.Parallel()
+
+	// newConfig returns a provider whose token endpoint responds with the
+	// given status and body, and whose validate endpoint responds with
+	// validateStatus.
+	newConfig := func(t *testing.T, tokenStatus int, tokenBody string, validateStatus int) *externalauth.Config {
+		t.Helper()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/token":
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tokenStatus)
+				_, _ = w.Write([]byte(tokenBody))
+			case "/validate":
+				w.WriteHeader(validateStatus)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		t.Cleanup(srv.Close)
+		return &externalauth.Config{
+			InstrumentedOAuth2Config: promoauth.NewFactory(prometheus.NewRegistry()).New("test", &oauth2.Config{
+				ClientID:     "client",
+				ClientSecret: "secret",
+				Endpoint: oauth2.Endpoint{
+					AuthURL:  srv.URL + "/authorize",
+					TokenURL: srv.URL + "/token",
+				},
+			}),
+			ID:          "test",
+			Type:        "github",
+			ValidateURL: srv.URL + "/validate",
+		}
+	}
+
+	for _, tc := range []struct {
+		name           string
+		tokenStatus    int
+		tokenBody      string
+		validateStatus int
+		expectedCode   health.Code
+	}{
+		{
+			name:           "OK",
+			tokenStatus:    http.StatusBadRequest,
+			tokenBody:      `{"error":"invalid_grant"}`,
+			validateStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "GitHubOK",
+			tokenStatus:    http.StatusOK,
+			tokenBody:      `{"error":"bad_refresh_token"}`,
+			validateStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "InvalidClient",
+			tokenStatus:    http.StatusUnauthorized,
+			tokenBody:      `{"error":"invalid_client"}`,
+			validateStatus: http.StatusUnauthorized,
+			expectedCode:   health.CodeExternalAuthCredentialsRejected,
+		},
+		{
+			name:           "GitHubIncorrectClientCredentials",
+			tokenStatus:    http.StatusOK,
+			tokenBody:      `{"error":"incorrect_client_credentials"}`,
+			validateStatus: http.StatusUnauthorized,
+			expectedCode:   health.CodeExternalAuthCredentialsRejected,
+		},
+		{
+			name:           "ValidateError",
+			tokenStatus:    http.StatusBadRequest,
+			tokenBody:      `{"error":"invalid_grant"}`,
+			validateStatus: http.StatusInternalServerError,
+			expectedCode:   health.CodeExternalAuthUnreachable,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+				report      = healthcheck.ExternalAuthReport{}
+			)
+			defer cancel()
+
+			report.Run(ctx, &healthcheck.ExternalAuthReportOptions{
+				Configs: []*externalauth.Config{newConfig(t, tc.tokenStatus, tc.tokenBody, tc.validateStatus)},
+			})
+
+			require.Len(t, report.Items, 1)
+			assert.Equal(t, "test", report.Items[0].ID)
+			assert.Nil(t, report.Error)
+			if tc.expectedCode == "" {
+				assert.Equal(t, health.SeverityOK, report.Severity)
+				assert.Empty(t, report.Warnings)
+				assert.Empty(t, report.Items[0].Warnings)
+				return
+			}
+			assert.Equal(t, health.SeverityError, report.Severity)
+			require.Len(t, report.Items[0].Warnings, 1)
+			assert.Equal(t, tc.expectedCode, report.Items[0].Warnings[0].Code)
+			require.Len(t, report.Warnings, 1)
+			assert.Equal(t, tc.expectedCode, report.Warnings[0].Code)
+		})
+	}
+
+	t.Run("Unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.ExternalAuthReport{}
+			srv         = httptest.NewServer(http.NotFoundHandler())
+		)
+		defer cancel()
+		// Close the server so that connections are refused.
+		srv.Close()
+
+		report.Run(ctx, &healthcheck.ExternalAuthReportOptions{
+			Configs: []*externalauth.Config{{
+				InstrumentedOAuth2Config: promoauth.NewFactory(prometheus.NewRegistry()).New("test", &oauth2.Config{
+					Endpoint: oauth2.Endpoint{TokenURL: srv.URL + "/token"},
+				}),
+				ID: "test",
+			}},
+			Dismissed: true,
+		})
+
+		assert.Equal(t, health.SeverityError, report.Severity)
+		assert.True(t, report.Dismissed)
+		require.Len(t, report.Items, 1)
+		require.Len(t, report.Items[0].Warnings, 1)
+		assert.Equal(t, health.CodeExternalAuthUnreachable, report.Items[0].Warnings[0].Code)
+	})
+}
@@ -44,6 +44,14 @@ const (
 	CodeProvisionerDaemonAPIMajorVersionDeprecated Code = `EPD03`
 
 	CodeInterfaceSmallMTU = `EIF01`
+
+	CodePubsubMeasureFailed Code = `EPS01`
+	CodePubsubSlow          Code = `EPS02`
+
+	CodeNotificationsMethodFailed Code = `ENOT01`
+
+	CodeExternalAuthUnreachable         Code = `EEXT01`
+	CodeExternalAuthCredentialsRejected Code = `EEXT02`
 )
 
 // Default docs URL
@@ -19,6 +19,9 @@ type Checker interface {
 	Database(ctx context.Context, opts *DatabaseReportOptions) healthsdk.DatabaseReport
 	WorkspaceProxy(ctx context.Context, opts *WorkspaceProxyReportOptions) healthsdk.WorkspaceProxyReport
 	ProvisionerDaemons(ctx context.Context, opts *ProvisionerDaemonsReportDeps) healthsdk.ProvisionerDaemonsReport
+	Pubsub(ctx context.Context, opts *PubsubReportOptions) healthsdk.PubsubReport
+	Notifications(ctx context.Context, opts *NotificationsReportOptions) healthsdk.NotificationsReport
+	ExternalAuth(ctx context.Context, opts *ExternalAuthReportOptions) healthsdk.ExternalAuthReport
 }
 
 type ReportOptions struct {
@@ -28,6 +31,9 @@ type ReportOptions struct {
 	Websocket          WebsocketReportOptions
 	WorkspaceProxy     WorkspaceProxyReportOptions
 	ProvisionerDaemons ProvisionerDaemonsReportDeps
+	Pubsub             PubsubReportOptions
+	Notifications      NotificationsReportOptions
+	ExternalAuth       ExternalAuthReportOptions
 
 	Checker Checker
 }
@@ -70,6 +76,24 @@ func (defaultChecker) ProvisionerDaemons(ctx context.Context, opts *ProvisionerD
 	return healthsdk.ProvisionerDaemonsReport(report)
 }
 
+func (defaultChecker) Pubsub(ctx context.Context, opts *PubsubReportOptions) healthsdk.PubsubReport {
+	var report PubsubReport
+	report.Run(ctx, opts)
+	return healthsdk.PubsubReport(report)
+}
+
+func (defaultChecker) Notifications(ctx context.Context, opts *NotificationsReportOptions) healthsdk.NotificationsReport {
+	var report NotificationsReport
+	report.Run(ctx, opts)
+	return healthsdk.NotificationsReport(report)
+}
+
+func (defaultChecker) ExternalAuth(ctx context.Context, opts *ExternalAuthReportOptions) healthsdk.ExternalAuthReport {
+	var report ExternalAuthReport
+	report.Run(ctx, opts)
+	return healthsdk.ExternalAuthReport(report)
+}
+
 func Run(ctx context.Context, opts *ReportOptions) *healthsdk.HealthcheckReport {
 	var (
 		wg     sync.WaitGroup
@@ -152,6 +176,42 @@ func Run(ctx context.Context, opts *ReportOptions) *healthsdk.HealthcheckReport
 		report.ProvisionerDaemons = opts.Checker.ProvisionerDaemons(ctx, &opts.ProvisionerDaemons)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() {
+			if err := recover(); err != nil {
+				report.Pubsub.Error = health.Errorf(health.CodeUnknown, "pubsub report panic: %s", err)
+			}
+		}()
+
+		report.Pubsub = opts.Checker.Pubsub(ctx, &opts.Pubsub)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() {
+			if err := recover(); err != nil {
+				report.Notifications.Error = health.Errorf(health.CodeUnknown, "notifications report panic: %s", err)
+			}
+		}()
+
+		report.Notifications = opts.Checker.Notifications(ctx, &opts.Notifications)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() {
+			if err := recover(); err != nil {
+				report.ExternalAuth.Error = health.Errorf(health.CodeUnknown, "external auth report panic: %s", err)
+			}
+		}()
+
+		report.ExternalAuth = opts.Checker.ExternalAuth(ctx, &opts.ExternalAuth)
+	}()
+
 	report.CoderVersion = buildinfo.Version()
 	wg.Wait()
 
@@ -175,6 +235,15 @@ func Run(ctx context.Context, opts *ReportOptions) *healthsdk.HealthcheckReport
 	if report.ProvisionerDaemons.Severity.Value() > health.SeverityWarning.Value() {
 		failingSections = append(failingSections, healthsdk.HealthSectionProvisionerDaemons)
 	}
+	if report.Pubsub.Severity.Value() > health.SeverityWarning.Value() {
+		failingSections = append(failingSections, healthsdk.HealthSectionPubsub)
+	}
+	if report.Notifications.Severity.Value() > health.SeverityWarning.Value() {
+		failingSections = append(failingSections, healthsdk.HealthSectionNotifications)
+	}
+	if report.ExternalAuth.Severity.Value() > health.SeverityWarning.Value() {
+		failingSections = append(failingSections, healthsdk.HealthSectionExternalAuth)
+	}
 
 	report.Healthy = len(failingSections) == 0
 
@@ -199,6 +268,15 @@ func Run(ctx context.Context, opts *ReportOptions) *healthsdk.HealthcheckReport
 	if report.ProvisionerDaemons.Severity.Value() > report.Severity.Value() {
 		report.Severity = report.ProvisionerDaemons.Severity
 	}
+	if report.Pubsub.Severity.Value() > report.Severity.Value() {
+		report.Severity = report.Pubsub.Severity
+	}
+	if report.Notifications.Severity.Value() > report.Severity.Value() {
+		report.Severity = report.Notifications.Severity
+	}
+	if report.ExternalAuth.Severity.Value() > report.Severity.Value() {
+		report.Severity = report.ExternalAuth.Severity
+	}
 	return &report
 }
 
@@ -19,6 +19,9 @@ type testChecker struct {
 	DatabaseReport           healthsdk.DatabaseReport
 	WorkspaceProxyReport     healthsdk.WorkspaceProxyReport
 	ProvisionerDaemonsReport healthsdk.ProvisionerDaemonsReport
+	PubsubReport             healthsdk.PubsubReport
+	NotificationsReport      healthsdk.NotificationsReport
+	ExternalAuthReport       healthsdk.ExternalAuthReport
 }
 
 func (c *testChecker) DERP(context.Context, *derphealth.ReportOptions) healthsdk.DERPHealthReport {
@@ -45,6 +48,18 @@ func (c *testChecker) ProvisionerDaemons(context.Context, *healthcheck.Provision
 	return c.ProvisionerDaemonsReport
 }
 
+func (c *testChecker) Pubsub(context.Context, *healthcheck.PubsubReportOptions) healthsdk.PubsubReport {
+	return c.PubsubReport
+}
+
+func (c *testChecker) Notifications(context.Context, *healthcheck.NotificationsReportOptions) healthsdk.NotificationsReport {
+	return c.NotificationsReport
+}
+
+func (c *testChecker) ExternalAuth(context.Context, *healthcheck.ExternalAuthReportOptions) healthsdk.ExternalAuthReport {
+	return c.ExternalAuthReport
+}
+
 func TestHealthcheck(t *testing.T) {
 	t.Parallel()
 
@@ -91,6 +106,21 @@ func TestHealthcheck(t *testing.T) {
 					Severity: health.SeverityOK,
 				},
 			},
+			PubsubReport: healthsdk.PubsubReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			NotificationsReport: healthsdk.NotificationsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ExternalAuthReport: healthsdk.ExternalAuthReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
 		},
 		healthy:  true,
 		severity: health.SeverityOK,
@@ -466,6 +496,227 @@ func TestHealthcheck(t *testing.T) {
 		},
 		severity: health.SeverityWarning,
 		healthy:  true,
+	}, {
+		name: "PubsubFail",
+		checker: &testChecker{
+			DERPReport: healthsdk.DERPHealthReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			AccessURLReport: healthsdk.AccessURLReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WebsocketReport: healthsdk.WebsocketReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			DatabaseReport: healthsdk.DatabaseReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WorkspaceProxyReport: healthsdk.WorkspaceProxyReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ProvisionerDaemonsReport: healthsdk.ProvisionerDaemonsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			PubsubReport: healthsdk.PubsubReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityError,
+				},
+			},
+			NotificationsReport: healthsdk.NotificationsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ExternalAuthReport: healthsdk.ExternalAuthReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+		},
+		severity: health.SeverityError,
+	}, {
+		name: "PubsubWarn",
+		checker: &testChecker{
+			DERPReport: healthsdk.DERPHealthReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			AccessURLReport: healthsdk.AccessURLReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WebsocketReport: healthsdk.WebsocketReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			DatabaseReport: healthsdk.DatabaseReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WorkspaceProxyReport: healthsdk.WorkspaceProxyReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ProvisionerDaemonsReport: healthsdk.ProvisionerDaemonsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			PubsubReport: healthsdk.PubsubReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityWarning,
+				},
+			},
+			NotificationsReport: healthsdk.NotificationsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ExternalAuthReport: healthsdk.ExternalAuthReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+		},
+		healthy:  true,
+		severity: health.SeverityWarning,
+	}, {
+		name: "NotificationsFail",
+		checker: &testChecker{
+			DERPReport: healthsdk.DERPHealthReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			AccessURLReport: healthsdk.AccessURLReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WebsocketReport: healthsdk.WebsocketReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			DatabaseReport: healthsdk.DatabaseReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WorkspaceProxyReport: healthsdk.WorkspaceProxyReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ProvisionerDaemonsReport: healthsdk.ProvisionerDaemonsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			PubsubReport: healthsdk.PubsubReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			NotificationsReport: healthsdk.NotificationsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityError,
+				},
+			},
+			ExternalAuthReport: healthsdk.ExternalAuthReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+		},
+		severity: health.SeverityError,
+	}, {
+		name: "ExternalAuthFail",
+		checker: &testChecker{
+			DERPReport: healthsdk.DERPHealthReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			AccessURLReport: healthsdk.AccessURLReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WebsocketReport: healthsdk.WebsocketReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			DatabaseReport: healthsdk.DatabaseReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			WorkspaceProxyReport: healthsdk.WorkspaceProxyReport{
+				Healthy: true,
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ProvisionerDaemonsReport: healthsdk.ProvisionerDaemonsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			PubsubReport: healthsdk.PubsubReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			NotificationsReport: healthsdk.NotificationsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityOK,
+				},
+			},
+			ExternalAuthReport: healthsdk.ExternalAuthReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityError,
+				},
+			},
+		},
+		severity: health.SeverityError,
 	}, {
 		name:    "AllFail",
 		healthy: false,
@@ -505,6 +756,21 @@ func TestHealthcheck(t *testing.T) {
 					Severity: health.SeverityError,
 				},
 			},
+			PubsubReport: healthsdk.PubsubReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityError,
+				},
+			},
+			NotificationsReport: healthsdk.NotificationsReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityError,
+				},
+			},
+			ExternalAuthReport: healthsdk.ExternalAuthReport{
+				BaseReport: healthsdk.BaseReport{
+					Severity: health.SeverityError,
+				},
+			},
 		},
 		severity: health.SeverityError,
 	}} {
@@ -529,6 +795,9 @@ func TestHealthcheck(t *testing.T) {
 			assert.Equal(t, c.checker.WebsocketReport.Severity, report.Websocket.Severity)
 			assert.Equal(t, c.checker.DatabaseReport.Healthy, report.Database.Healthy)
 			assert.Equal(t, c.checker.DatabaseReport.Severity, report.Database.Severity)
+			assert.Equal(t, c.checker.PubsubReport.Severity, report.Pubsub.Severity)
+			assert.Equal(t, c.checker.NotificationsReport.Severity, report.Notifications.Severity)
+			assert.Equal(t, c.checker.ExternalAuthReport.Severity, report.ExternalAuth.Severity)
 			assert.NotZero(t, report.Time)
 			assert.NotZero(t, report.CoderVersion)
 		})
@@ -0,0 +1,92 @@
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
+	"github.com/coder/coder/v2/coderd/notifications/dispatch"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/healthsdk"
+)
+
+type NotificationsReport healthsdk.NotificationsReport
+
+type NotificationsReportOptions struct {
+	// Enabled is false if the notifications experiment is not enabled.
+	Enabled bool
+	Config  codersdk.NotificationsConfig
+	Logger  slog.Logger
+
+	Dismissed bool
+}
+
+func (r *NotificationsReport) Run(ctx context.Context, opts *NotificationsReportOptions) {
+	r.Items = []healthsdk.NotificationsReportItem{}
+	r.Warnings = []health.Message{}
+	r.Severity = health.SeverityOK
+	r.Dismissed = opts.Dismissed
+	r.Enabled = opts.Enabled
+	if !opts.Enabled {
+		return
+	}
+
+	timeout := opts.Config.DispatchTimeout.Value()
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	defaultMethod := database.NotificationMethod(opts.Config.Method.String())
+	if !defaultMethod.Valid() {
+		r.Severity = health.SeverityError
+		r.Error = health.Errorf(health.CodeNotificationsMethodFailed, "unknown notification method %q", defaultMethod)
+		return
+	}
+
+	// The default method is always checked, the other methods only if they
+	// are configured, as templates may be set to use them.
+	validators := map[database.NotificationMethod]dispatch.Validator{
+		database.NotificationMethodSmtp:    dispatch.NewSMTPHandler(opts.Config.SMTP, nil, opts.Logger.Named("smtp")),
+		database.NotificationMethodWebhook: dispatch.NewWebhookHandler(opts.Config.Webhook, opts.Logger.Named("webhook")),
+		database.NotificationMethodSlack:   dispatch.NewSlackHandler(opts.Config.Slack, opts.Logger.Named("slack")),
+		database.NotificationMethodTeams:   dispatch.NewTeamsHandler(opts.Config.Teams, opts.Logger.Named("teams")),
+	}
+	// The smarthost has a default value, so SMTP is considered configured once
+	// a sender address is set.
+	configured := map[database.NotificationMethod]bool{
+		database.NotificationMethodSmtp:    opts.Config.SMTP.From.String() != "",
+		database.NotificationMethodWebhook: opts.Config.Webhook.Endpoint.String() != "",
+		database.NotificationMethodSlack:   opts.Config.Slack.Endpoint.String() != "" || opts.Config.Slack.BotToken.String() != "",
+		database.NotificationMethodTeams:   opts.Config.Teams.Endpoint.String() != "",
+	}
+
+	for _, method := range database.AllNotificationMethodValues() {
+		isDefault := method == defaultMethod
+		if !isDefault && !configured[method] {
+			continue
+		}
+
+		it := healthsdk.NotificationsReportItem{
+			Method:   string(method),
+			Default:  isDefault,
+			Warnings: []health.Message{},
+		}
+		if err := validators[method].Validate(ctx); err != nil {
+			it.Warnings = append(it.Warnings, health.Messagef(health.CodeNotificationsMethodFailed, "Validate %s: %s", method, err))
+			if isDefault {
+				r.Severity = health.SeverityError
+				r.Warnings = append(r.Warnings, health.Messagef(health.CodeNotificationsMethodFailed, "The default notification method %q is unable to deliver notifications.", method))
+			} else {
+				if r.Severity.Value() < health.SeverityWarning.Value() {
+					r.Severity = health.SeverityWarning
+				}
+				r.Warnings = append(r.Warnings, health.Messagef(health.CodeNotificationsMethodFailed, "The notification method %q is unable to deliver notifications.", method))
+			}
+		}
+		r.Items = append(r.Items, it)
+	}
+}
@@ -0,0 +1,161 @@
+package healthcheck_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/serpent"
+
+	"github.com/coder/coder/v2/coderd/healthcheck"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestNotifications(t *testing.T) {
+	t.Parallel()
+
+	// endpoint returns the URL of a server that accepts connections, or one
+	// that refuses them if closed is true.
+	endpoint := func(t *testing.T, closed bool) serpent.URL {
+		t.Helper()
+		srv := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(srv.Close)
+		if closed {
+			srv.Close()
+		}
+		u, err := url.Parse(srv.URL)
+		require.NoError(t, err)
+		return serpent.URL(*u)
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.NotificationsReport{}
+		)
+		defer cancel()
+
+		report.Run(ctx, &healthcheck.NotificationsReportOptions{
+			Config: codersdk.NotificationsConfig{Method: "smtp"},
+			Logger: slogtest.Make(t, nil),
+		})
+
+		assert.False(t, report.Enabled)
+		assert.Equal(t, health.SeverityOK, report.Severity)
+		assert.Empty(t, report.Items)
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.NotificationsReport{}
+		)
+		defer cancel()
+
+		report.Run(ctx, &healthcheck.NotificationsReportOptions{
+			Enabled: true,
+			Config: codersdk.NotificationsConfig{
+				Method:  "webhook",
+				Webhook: codersdk.NotificationsWebhookConfig{Endpoint: endpoint(t, false)},
+			},
+			Logger: slogtest.Make(t, nil),
+		})
+
+		assert.True(t, report.Enabled)
+		assert.Equal(t, health.SeverityOK, report.Severity)
+		assert.Empty(t, report.Warnings)
+		assert.Nil(t, report.Error)
+		// SMTP is not configured, so only the webhook is checked.
+		require.Len(t, report.Items, 1)
+		assert.Equal(t, "webhook", report.Items[0].Method)
+		assert.True(t, report.Items[0].Default)
+		assert.Empty(t, report.Items[0].Warnings)
+	})
+
+	t.Run("DefaultMethodFails", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.NotificationsReport{}
+		)
+		defer cancel()
+
+		report.Run(ctx, &healthcheck.NotificationsReportOptions{
+			Enabled: true,
+			Config: codersdk.NotificationsConfig{
+				Method:  "webhook",
+				Webhook: codersdk.NotificationsWebhookConfig{Endpoint: endpoint(t, true)},
+			},
+			Logger: slogtest.Make(t, nil),
+		})
+
+		assert.Equal(t, health.SeverityError, report.Severity)
+		require.Len(t, report.Warnings, 1)
+		assert.Equal(t, health.CodeNotificationsMethodFailed, report.Warnings[0].Code)
+		require.Len(t, report.Items, 1)
+		require.Len(t, report.Items[0].Warnings, 1)
+		assert.Equal(t, health.CodeNotificationsMethodFailed, report.Items[0].Warnings[0].Code)
+	})
+
+	t.Run("OtherMethodFails", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.NotificationsReport{}
+		)
+		defer cancel()
+
+		report.Run(ctx, &healthcheck.NotificationsReportOptions{
+			Enabled: true,
+			Config: codersdk.NotificationsConfig{
+				Method:  "webhook",
+				Webhook: codersdk.NotificationsWebhookConfig{Endpoint: endpoint(t, false)},
+				Teams:   codersdk.NotificationsTeamsConfig{Endpoint: endpoint(t, true)},
+			},
+			Logger: slogtest.Make(t, nil),
+		})
+
+		assert.Equal(t, health.SeverityWarning, report.Severity)
+		require.Len(t, report.Warnings, 1)
+		require.Len(t, report.Items, 2)
+		assert.Equal(t, "teams", report.Items[1].Method)
+		assert.False(t, report.Items[1].Default)
+		assert.Len(t, report.Items[1].Warnings, 1)
+	})
+
+	t.Run("UnknownMethod", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.NotificationsReport{}
+		)
+		defer cancel()
+
+		report.Run(ctx, &healthcheck.NotificationsReportOptions{
+			Enabled:   true,
+			Config:    codersdk.NotificationsConfig{Method: "carrier-pigeon"},
+			Logger:    slogtest.Make(t, nil),
+			Dismissed: true,
+		})
+
+		assert.Equal(t, health.SeverityError, report.Severity)
+		assert.True(t, report.Dismissed)
+		require.NotNil(t, report.Error)
+		assert.Contains(t, *report.Error, health.CodeNotificationsMethodFailed)
+	})
+}
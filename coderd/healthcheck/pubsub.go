@@ -0,0 +1,64 @@
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
+	"github.com/coder/coder/v2/codersdk/healthsdk"
+)
+
+const (
+	PubsubDefaultThreshold = 100 * time.Millisecond
+)
+
+type PubsubReport healthsdk.PubsubReport
+
+type PubsubReportOptions struct {
+	Pubsub    pubsub.Pubsub
+	Threshold time.Duration
+	Logger    slog.Logger
+
+	Dismissed bool
+}
+
+func (r *PubsubReport) Run(ctx context.Context, opts *PubsubReportOptions) {
+	r.Warnings = []health.Message{}
+	r.Severity = health.SeverityOK
+	r.Dismissed = opts.Dismissed
+
+	threshold := opts.Threshold
+	if threshold == 0 {
+		threshold = PubsubDefaultThreshold
+	}
+	r.ThresholdMS = threshold.Milliseconds()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	measurer := pubsub.NewLatencyMeasurer(opts.Logger)
+	measureCount := 3
+	latencies := make([]time.Duration, 0, measureCount)
+	// Measure 3 times and take the median round-trip latency.
+	for i := 0; i < measureCount; i++ {
+		_, recv, err := measurer.Measure(ctx, opts.Pubsub)
+		if err != nil {
+			r.Error = health.Errorf(health.CodePubsubMeasureFailed, "measure pubsub latency: %s", err)
+			r.Severity = health.SeverityError
+			return
+		}
+		latencies = append(latencies, recv)
+	}
+	slices.Sort(latencies)
+
+	latency := latencies[measureCount/2]
+	r.Latency = latency.String()
+	r.LatencyMS = latency.Milliseconds()
+	if latency >= threshold {
+		r.Severity = health.SeverityWarning
+		r.Warnings = append(r.Warnings, health.Messagef(health.CodePubsubSlow, "median pubsub round-trip latency above threshold"))
+	}
+}
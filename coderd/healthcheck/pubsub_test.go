@@ -0,0 +1,94 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/database/pubsub/psmock"
+	"github.com/coder/coder/v2/coderd/healthcheck"
+	"github.com/coder/coder/v2/coderd/healthcheck/health"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestPubsub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.PubsubReport{}
+			ps          = pubsub.NewInMemory()
+		)
+		defer cancel()
+
+		report.Run(ctx, &healthcheck.PubsubReportOptions{
+			Pubsub: ps,
+			Logger: slogtest.Make(t, nil),
+		})
+
+		assert.Equal(t, health.SeverityOK, report.Severity)
+		assert.NotEmpty(t, report.Latency)
+		assert.Equal(t, healthcheck.PubsubDefaultThreshold.Milliseconds(), report.ThresholdMS)
+		assert.Empty(t, report.Warnings)
+		assert.Nil(t, report.Error)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.PubsubReport{}
+			ps          = psmock.NewMockPubsub(gomock.NewController(t))
+			err         = xerrors.New("listen error")
+		)
+		defer cancel()
+
+		ps.EXPECT().Subscribe(gomock.Any(), gomock.Any()).Return(nil, err)
+
+		report.Run(ctx, &healthcheck.PubsubReportOptions{
+			Pubsub:    ps,
+			Logger:    slogtest.Make(t, nil),
+			Dismissed: true,
+		})
+
+		assert.Equal(t, health.SeverityError, report.Severity)
+		assert.True(t, report.Dismissed)
+		assert.Zero(t, report.Latency)
+		require.NotNil(t, report.Error)
+		assert.Contains(t, *report.Error, err.Error())
+		assert.Contains(t, *report.Error, health.CodePubsubMeasureFailed)
+	})
+
+	t.Run("Threshold", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitShort)
+			report      = healthcheck.PubsubReport{}
+			ps          = pubsub.NewInMemory()
+		)
+		defer cancel()
+
+		report.Run(ctx, &healthcheck.PubsubReportOptions{
+			Pubsub:    ps,
+			Logger:    slogtest.Make(t, nil),
+			Threshold: time.Nanosecond,
+		})
+
+		assert.Equal(t, health.SeverityWarning, report.Severity)
+		require.Len(t, report.Warnings, 1)
+		assert.Equal(t, health.CodePubsubSlow, report.Warnings[0].Code)
+		assert.Nil(t, report.Error)
+	})
+}
@@ -0,0 +1,199 @@
+// Package maintenance computes when the maintenance windows of organizations
+// and templates are in effect.
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/appearance"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// BannerBackgroundColor is the background color of the announcement banners
+// of maintenance windows.
+const BannerBackgroundColor = "#f59e0b"
+
+// Occurrence is a single occurrence of a recurring maintenance window.
+type Occurrence struct {
+	Window database.MaintenanceWindow
+	Start  time.Time
+	End    time.Time
+}
+
+// TimeLayout is the layout used to present the start and end of occurrences
+// to users.
+const TimeLayout = "Mon Jan 2 15:04 MST"
+
+// FormatTimes returns the start and end of the occurrence formatted in the
+// time zone of the schedule of the window.
+func (o Occurrence) FormatTimes() (start string, end string) {
+	startTime, endTime := o.Start, o.End
+	if sched, err := Schedule(o.Window); err == nil {
+		startTime, endTime = startTime.In(sched.Location()), endTime.In(sched.Location())
+	}
+	return startTime.Format(TimeLayout), endTime.Format(TimeLayout)
+}
+
+// Schedule parses the schedule of a maintenance window.
+func Schedule(window database.MaintenanceWindow) (*cron.Schedule, error) {
+	sched, err := cron.Weekly(window.Schedule)
+	if err != nil {
+		return nil, xerrors.Errorf("parse schedule of maintenance window %q: %w", window.Name, err)
+	}
+	return sched, nil
+}
+
+// Current returns the occurrence of the window that is in effect at t. If
+// occurrences overlap, they are merged into a single occurrence.
+func Current(window database.MaintenanceWindow, t time.Time) (Occurrence, bool) {
+	sched, err := Schedule(window)
+	if err != nil || window.Duration <= 0 {
+		return Occurrence{}, false
+	}
+	duration := time.Duration(window.Duration)
+	start := sched.Next(t.Add(-duration))
+	if start.IsZero() || start.After(t) {
+		return Occurrence{}, false
+	}
+	end := start.Add(duration)
+	for next := sched.Next(start); !next.IsZero() && !next.After(t); next = sched.Next(next) {
+		end = next.Add(duration)
+	}
+	return Occurrence{Window: window, Start: start, End: end}, true
+}
+
+// Next returns the next occurrence of the window that starts after t.
+func Next(window database.MaintenanceWindow, t time.Time) (Occurrence, bool) {
+	sched, err := Schedule(window)
+	if err != nil || window.Duration <= 0 {
+		return Occurrence{}, false
+	}
+	start := sched.Next(t)
+	if start.IsZero() {
+		return Occurrence{}, false
+	}
+	return Occurrence{Window: window, Start: start, End: start.Add(time.Duration(window.Duration))}, true
+}
+
+// Upcoming returns the next occurrence of the window if it starts within the
+// notice period of the window.
+func Upcoming(window database.MaintenanceWindow, t time.Time) (Occurrence, bool) {
+	occurrence, ok := Next(window, t)
+	if !ok || occurrence.Start.Sub(t) > time.Duration(window.NoticePeriod) {
+		return Occurrence{}, false
+	}
+	return occurrence, true
+}
+
+// Applies reports whether the window applies to workspaces of the template.
+func Applies(window database.MaintenanceWindow, organizationID, templateID uuid.UUID) bool {
+	if window.OrganizationID != organizationID {
+		return false
+	}
+	return !window.TemplateID.Valid || window.TemplateID.UUID == templateID
+}
+
+// ForWorkspace returns the occurrences of the windows that are in effect for
+// workspaces of the template at t.
+func ForWorkspace(windows []database.MaintenanceWindow, organizationID, templateID uuid.UUID, t time.Time) []Occurrence {
+	var occurrences []Occurrence
+	for _, window := range windows {
+		if !Applies(window, organizationID, templateID) {
+			continue
+		}
+		if occurrence, ok := Current(window, t); ok {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	return occurrences
+}
+
+// Banner returns the announcement banner of an occurrence.
+func Banner(occurrence Occurrence, t time.Time) codersdk.BannerConfig {
+	start, end := occurrence.FormatTimes()
+	var message string
+	if occurrence.Start.After(t) {
+		message = fmt.Sprintf("Scheduled maintenance %q from %s to %s.", occurrence.Window.Name, start, end)
+	} else {
+		message = fmt.Sprintf("Maintenance %q is in progress until %s.", occurrence.Window.Name, end)
+	}
+	if occurrence.Window.BlockBuilds {
+		message += " Workspaces cannot be started during maintenance."
+	}
+	if occurrence.Window.Message != "" {
+		message += " " + occurrence.Window.Message
+	}
+	return codersdk.BannerConfig{
+		Enabled:         true,
+		Message:         message,
+		BackgroundColor: BannerBackgroundColor,
+	}
+}
+
+type appearanceFetcher struct {
+	fetcher appearance.Fetcher
+	db      database.Store
+}
+
+// NewAppearanceFetcher returns a fetcher that adds announcement banners for
+// maintenance windows that are in effect or within their notice period to
+// the appearance returned by f.
+func NewAppearanceFetcher(f appearance.Fetcher, db database.Store) appearance.Fetcher {
+	return &appearanceFetcher{fetcher: f, db: db}
+}
+
+func (f *appearanceFetcher) Fetch(ctx context.Context) (codersdk.AppearanceConfig, error) {
+	cfg, err := f.fetcher.Fetch(ctx)
+	if err != nil {
+		return codersdk.AppearanceConfig{}, err
+	}
+	// Banners are only shown for the organizations of the requesting user,
+	// or of the owner of the requesting agent.
+	actor, ok := dbauthz.ActorFromContext(ctx)
+	if !ok {
+		return cfg, nil
+	}
+	userID, err := uuid.Parse(actor.ID)
+	if err != nil {
+		return cfg, nil
+	}
+	// Banners are shown to members regardless of whether they can read the
+	// maintenance windows.
+	//nolint:gocritic // Reading maintenance windows is a system function.
+	sysCtx := dbauthz.AsSystemRestricted(ctx)
+	memberships, err := f.db.GetOrganizationIDsByMemberIDs(sysCtx, []uuid.UUID{userID})
+	if err != nil {
+		return codersdk.AppearanceConfig{}, xerrors.Errorf("get organizations of user: %w", err)
+	}
+	if len(memberships) == 0 {
+		return cfg, nil
+	}
+	windows, err := f.db.GetMaintenanceWindows(sysCtx)
+	if err != nil {
+		return codersdk.AppearanceConfig{}, xerrors.Errorf("get maintenance windows: %w", err)
+	}
+	now := dbtime.Now()
+	for _, window := range windows {
+		if !slices.Contains(memberships[0].OrganizationIDs, window.OrganizationID) {
+			continue
+		}
+		occurrence, ok := Current(window, now)
+		if !ok {
+			occurrence, ok = Upcoming(window, now)
+		}
+		if ok {
+			cfg.AnnouncementBanners = append(cfg.AnnouncementBanners, Banner(occurrence, now))
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,149 @@
+package maintenance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/appearance"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbmem"
+	"github.com/coder/coder/v2/coderd/maintenance"
+	"github.com/coder/coder/v2/coderd/rbac"
+)
+
+func TestCurrent(t *testing.T) {
+	t.Parallel()
+
+	// Saturdays at 02:00 UTC for two hours.
+	window := database.MaintenanceWindow{
+		Name:     "weekly",
+		Schedule: "CRON_TZ=UTC 0 2 * * 6",
+		Duration: int64(2 * time.Hour),
+	}
+	start := time.Date(2024, time.October, 5, 2, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name   string
+		t      time.Time
+		active bool
+	}{
+		{name: "Before", t: start.Add(-time.Minute)},
+		{name: "Start", t: start, active: true},
+		{name: "During", t: start.Add(time.Hour), active: true},
+		{name: "End", t: start.Add(2 * time.Hour)},
+		{name: "NextWeek", t: start.Add(7*24*time.Hour + time.Hour), active: true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			occurrence, ok := maintenance.Current(window, tc.t)
+			require.Equal(t, tc.active, ok)
+			if ok {
+				require.False(t, occurrence.Start.After(tc.t))
+				require.True(t, occurrence.End.After(tc.t))
+			}
+		})
+	}
+
+	t.Run("Overlapping", func(t *testing.T) {
+		t.Parallel()
+
+		// Every minute for an hour, so occurrences always overlap.
+		window := database.MaintenanceWindow{
+			Schedule: "CRON_TZ=UTC * * * * *",
+			Duration: int64(time.Hour),
+		}
+		now := time.Date(2024, time.October, 5, 2, 30, 0, 0, time.UTC)
+		occurrence, ok := maintenance.Current(window, now)
+		require.True(t, ok)
+		require.Equal(t, now.Add(time.Hour), occurrence.End)
+	})
+}
+
+func TestUpcoming(t *testing.T) {
+	t.Parallel()
+
+	window := database.MaintenanceWindow{
+		Schedule:     "CRON_TZ=UTC 0 2 * * 6",
+		Duration:     int64(time.Hour),
+		NoticePeriod: int64(24 * time.Hour),
+	}
+	start := time.Date(2024, time.October, 5, 2, 0, 0, 0, time.UTC)
+
+	_, ok := maintenance.Upcoming(window, start.Add(-25*time.Hour))
+	require.False(t, ok)
+	occurrence, ok := maintenance.Upcoming(window, start.Add(-23*time.Hour))
+	require.True(t, ok)
+	require.Equal(t, start, occurrence.Start)
+	require.Equal(t, start.Add(time.Hour), occurrence.End)
+}
+
+func TestApplies(t *testing.T) {
+	t.Parallel()
+
+	orgID, templateID := uuid.New(), uuid.New()
+	require.True(t, maintenance.Applies(database.MaintenanceWindow{OrganizationID: orgID}, orgID, templateID))
+	require.True(t, maintenance.Applies(database.MaintenanceWindow{
+		OrganizationID: orgID,
+		TemplateID:     uuid.NullUUID{UUID: templateID, Valid: true},
+	}, orgID, templateID))
+	require.False(t, maintenance.Applies(database.MaintenanceWindow{
+		OrganizationID: orgID,
+		TemplateID:     uuid.NullUUID{UUID: uuid.New(), Valid: true},
+	}, orgID, templateID))
+	require.False(t, maintenance.Applies(database.MaintenanceWindow{OrganizationID: uuid.New()}, orgID, templateID))
+}
+
+func TestAppearanceFetcher(t *testing.T) {
+	t.Parallel()
+
+	db := dbmem.New()
+	org := dbgen.Organization(t, db, database.Organization{})
+	_ = dbgen.MaintenanceWindow(t, db, database.MaintenanceWindow{
+		OrganizationID: org.ID,
+		Name:           "always",
+		Message:        "Upgrading the cluster.",
+		Schedule:       "CRON_TZ=UTC * * * * *",
+		BlockBuilds:    true,
+	})
+	// A window that is neither in effect nor within its notice period.
+	_ = dbgen.MaintenanceWindow(t, db, database.MaintenanceWindow{
+		OrganizationID: org.ID,
+		Schedule:       fmt.Sprintf("CRON_TZ=UTC 0 %d * * *", (time.Now().UTC().Hour()+12)%24),
+		Duration:       int64(time.Minute),
+		NoticePeriod:   int64(time.Minute),
+	})
+
+	// A window of an organization the user isn't a member of.
+	other := dbgen.Organization(t, db, database.Organization{})
+	_ = dbgen.MaintenanceWindow(t, db, database.MaintenanceWindow{
+		OrganizationID: other.ID,
+		Name:           "other",
+		Schedule:       "CRON_TZ=UTC * * * * *",
+	})
+	user := dbgen.User(t, db, database.User{})
+	_ = dbgen.OrganizationMember(t, db, database.OrganizationMember{UserID: user.ID, OrganizationID: org.ID})
+
+	fetcher := maintenance.NewAppearanceFetcher(appearance.NewDefaultFetcher(""), db)
+	cfg, err := fetcher.Fetch(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, cfg.AnnouncementBanners)
+
+	cfg, err = fetcher.Fetch(dbauthz.As(context.Background(), rbac.Subject{ID: user.ID.String()}))
+	require.NoError(t, err)
+	require.Len(t, cfg.AnnouncementBanners, 1)
+	banner := cfg.AnnouncementBanners[0]
+	require.True(t, banner.Enabled)
+	require.Equal(t, maintenance.BannerBackgroundColor, banner.BackgroundColor)
+	require.Contains(t, banner.Message, `Maintenance "always" is in progress`)
+	require.Contains(t, banner.Message, "Workspaces cannot be started during maintenance.")
+	require.Contains(t, banner.Message, "Upgrading the cluster.")
+}
@@ -0,0 +1,276 @@
+package coderd
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
+	"github.com/coder/coder/v2/coderd/util/ptr"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// maxMaintenanceWindowDuration is the longest a maintenance window may last.
+// Schedules are weekly, so longer windows would never end.
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// @Summary Get maintenance windows by organization
+// @ID get-maintenance-windows-by-organization
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Success 200 {array} codersdk.MaintenanceWindow
+// @Router /organizations/{organization}/maintenance-windows [get]
+func (api *API) maintenanceWindows(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organization := httpmw.OrganizationParam(r)
+
+	windows, err := api.Database.GetMaintenanceWindowsByOrganizationID(ctx, organization.ID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	resp := make([]codersdk.MaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		resp = append(resp, convertMaintenanceWindow(window))
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Create maintenance window
+// @ID create-maintenance-window
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param request body codersdk.CreateMaintenanceWindowRequest true "Create maintenance window request"
+// @Success 201 {object} codersdk.MaintenanceWindow
+// @Router /organizations/{organization}/maintenance-windows [post]
+func (api *API) postMaintenanceWindow(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organization := httpmw.OrganizationParam(r)
+
+	var req codersdk.CreateMaintenanceWindowRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	validErrs := validateMaintenanceWindow(req.Name, req.Schedule, req.DurationMillis, req.NoticeMillis)
+	if len(validErrs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid maintenance window.",
+			Validations: validErrs,
+		})
+		return
+	}
+
+	var templateID uuid.NullUUID
+	if req.TemplateID != nil {
+		template, err := api.Database.GetTemplateByID(ctx, *req.TemplateID)
+		if err != nil && !httpapi.Is404Error(err) {
+			httpapi.InternalServerError(rw, err)
+			return
+		}
+		if err != nil || template.OrganizationID != organization.ID {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: fmt.Sprintf("Template %q not found in organization.", req.TemplateID.String()),
+				Validations: []codersdk.ValidationError{
+					{Field: "template_id", Detail: "Template not found in organization"},
+				},
+			})
+			return
+		}
+		templateID = uuid.NullUUID{UUID: template.ID, Valid: true}
+	}
+
+	now := dbtime.Now()
+	window, err := api.Database.InsertMaintenanceWindow(ctx, database.InsertMaintenanceWindowParams{
+		ID:             uuid.New(),
+		OrganizationID: organization.ID,
+		TemplateID:     templateID,
+		Name:           req.Name,
+		Message:        req.Message,
+		Schedule:       req.Schedule,
+		Duration:       int64(time.Duration(req.DurationMillis) * time.Millisecond),
+		NoticePeriod:   int64(time.Duration(req.NoticeMillis) * time.Millisecond),
+		BlockBuilds:    req.BlockBuilds,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	if database.IsUniqueViolation(err, database.UniqueMaintenanceWindowsOrganizationIDNameKey) {
+		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+			Message: fmt.Sprintf("Maintenance window with name %q already exists in organization.", req.Name),
+		})
+		return
+	}
+	if httpapi.Is404Error(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusCreated, convertMaintenanceWindow(window))
+}
+
+// @Summary Update maintenance window
+// @ID update-maintenance-window
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param maintenancewindow path string true "Maintenance window ID" format(uuid)
+// @Param request body codersdk.UpdateMaintenanceWindowRequest true "Update maintenance window request"
+// @Success 200 {object} codersdk.MaintenanceWindow
+// @Router /organizations/{organization}/maintenance-windows/{maintenancewindow} [patch]
+func (api *API) patchMaintenanceWindow(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	window, ok := api.maintenanceWindowParam(rw, r)
+	if !ok {
+		return
+	}
+
+	var req codersdk.UpdateMaintenanceWindowRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	validErrs := validateMaintenanceWindow(req.Name, req.Schedule, req.DurationMillis, req.NoticeMillis)
+	if len(validErrs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid maintenance window.",
+			Validations: validErrs,
+		})
+		return
+	}
+
+	updated, err := api.Database.UpdateMaintenanceWindowByID(ctx, database.UpdateMaintenanceWindowByIDParams{
+		ID:           window.ID,
+		Name:         req.Name,
+		Message:      req.Message,
+		Schedule:     req.Schedule,
+		Duration:     int64(time.Duration(req.DurationMillis) * time.Millisecond),
+		NoticePeriod: int64(time.Duration(req.NoticeMillis) * time.Millisecond),
+		BlockBuilds:  req.BlockBuilds,
+		UpdatedAt:    dbtime.Now(),
+	})
+	if database.IsUniqueViolation(err, database.UniqueMaintenanceWindowsOrganizationIDNameKey) {
+		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+			Message: fmt.Sprintf("Maintenance window with name %q already exists in organization.", req.Name),
+		})
+		return
+	}
+	if httpapi.Is404Error(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, convertMaintenanceWindow(updated))
+}
+
+// @Summary Delete maintenance window
+// @ID delete-maintenance-window
+// @Security CoderSessionToken
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param maintenancewindow path string true "Maintenance window ID" format(uuid)
+// @Success 204
+// @Router /organizations/{organization}/maintenance-windows/{maintenancewindow} [delete]
+func (api *API) deleteMaintenanceWindow(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	window, ok := api.maintenanceWindowParam(rw, r)
+	if !ok {
+		return
+	}
+
+	err := api.Database.DeleteMaintenanceWindowByID(ctx, window.ID)
+	if httpapi.Is404Error(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusNoContent, nil)
+}
+
+// maintenanceWindowParam fetches the maintenance window of the URL and makes
+// sure it belongs to the organization of the URL.
+func (api *API) maintenanceWindowParam(rw http.ResponseWriter, r *http.Request) (database.MaintenanceWindow, bool) {
+	ctx := r.Context()
+	organization := httpmw.OrganizationParam(r)
+
+	id, ok := httpmw.ParseUUIDParam(rw, r, "maintenancewindow")
+	if !ok {
+		return database.MaintenanceWindow{}, false
+	}
+	window, err := api.Database.GetMaintenanceWindowByID(ctx, id)
+	if httpapi.Is404Error(err) || (err == nil && window.OrganizationID != organization.ID) {
+		httpapi.ResourceNotFound(rw)
+		return database.MaintenanceWindow{}, false
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return database.MaintenanceWindow{}, false
+	}
+	return window, true
+}
+
+func validateMaintenanceWindow(name, schedule string, durationMillis, noticeMillis int64) []codersdk.ValidationError {
+	var validErrs []codersdk.ValidationError
+	if err := codersdk.NameValid(name); err != nil {
+		validErrs = append(validErrs, codersdk.ValidationError{Field: "name", Detail: err.Error()})
+	}
+	if _, err := cron.Weekly(schedule); err != nil {
+		validErrs = append(validErrs, codersdk.ValidationError{Field: "schedule", Detail: err.Error()})
+	}
+	duration := time.Duration(durationMillis) * time.Millisecond
+	if duration <= 0 || duration > maxMaintenanceWindowDuration {
+		validErrs = append(validErrs, codersdk.ValidationError{
+			Field:  "duration_ms",
+			Detail: fmt.Sprintf("Duration must be positive and at most %s", maxMaintenanceWindowDuration),
+		})
+	}
+	if noticeMillis < 0 {
+		validErrs = append(validErrs, codersdk.ValidationError{Field: "notice_ms", Detail: "Notice must not be negative"})
+	}
+	return validErrs
+}
+
+func convertMaintenanceWindow(window database.MaintenanceWindow) codersdk.MaintenanceWindow {
+	var templateID *uuid.UUID
+	if window.TemplateID.Valid {
+		templateID = ptr.Ref(window.TemplateID.UUID)
+	}
+	return codersdk.MaintenanceWindow{
+		ID:             window.ID,
+		OrganizationID: window.OrganizationID,
+		TemplateID:     templateID,
+		Name:           window.Name,
+		Message:        window.Message,
+		Schedule:       window.Schedule,
+		DurationMillis: time.Duration(window.Duration).Milliseconds(),
+		NoticeMillis:   time.Duration(window.NoticePeriod).Milliseconds(),
+		BlockBuilds:    window.BlockBuilds,
+		CreatedAt:      window.CreatedAt,
+		UpdatedAt:      window.UpdatedAt,
+	}
+}
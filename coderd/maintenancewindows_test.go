@@ -0,0 +1,151 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestMaintenanceWindows(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	orgAdmin, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.ScopedRoleOrgAdmin(owner.OrganizationID))
+	member, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+	version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+
+	t.Run("CRUD", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		window, err := orgAdmin.CreateMaintenanceWindow(ctx, owner.OrganizationID, codersdk.CreateMaintenanceWindowRequest{
+			TemplateID:     &template.ID,
+			Name:           "crud",
+			Message:        "Upgrading the cluster.",
+			Schedule:       "CRON_TZ=Europe/Berlin 0 2 * * 6",
+			DurationMillis: time.Hour.Milliseconds(),
+			NoticeMillis:   (24 * time.Hour).Milliseconds(),
+			BlockBuilds:    true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, owner.OrganizationID, window.OrganizationID)
+		require.Equal(t, &template.ID, window.TemplateID)
+		require.Equal(t, time.Hour.Milliseconds(), window.DurationMillis)
+		require.True(t, window.BlockBuilds)
+
+		// Members can see the windows of their organization.
+		windows, err := member.MaintenanceWindows(ctx, owner.OrganizationID)
+		require.NoError(t, err)
+		require.Contains(t, windows, window)
+
+		updated, err := orgAdmin.UpdateMaintenanceWindow(ctx, owner.OrganizationID, window.ID, codersdk.UpdateMaintenanceWindowRequest{
+			Name:           "crud-updated",
+			Schedule:       "CRON_TZ=UTC 0 3 * * 0",
+			DurationMillis: (2 * time.Hour).Milliseconds(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "crud-updated", updated.Name)
+		require.Equal(t, "CRON_TZ=UTC 0 3 * * 0", updated.Schedule)
+		require.Empty(t, updated.Message)
+		require.False(t, updated.BlockBuilds)
+
+		err = orgAdmin.DeleteMaintenanceWindow(ctx, owner.OrganizationID, window.ID)
+		require.NoError(t, err)
+		windows, err = member.MaintenanceWindows(ctx, owner.OrganizationID)
+		require.NoError(t, err)
+		require.NotContains(t, windows, updated)
+	})
+
+	t.Run("MemberForbidden", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		_, err := member.CreateMaintenanceWindow(ctx, owner.OrganizationID, codersdk.CreateMaintenanceWindowRequest{
+			Name:           "member",
+			Schedule:       "CRON_TZ=UTC 0 2 * * 6",
+			DurationMillis: time.Hour.Milliseconds(),
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		_, err := client.CreateMaintenanceWindow(ctx, owner.OrganizationID, codersdk.CreateMaintenanceWindowRequest{
+			Name:           "invalid",
+			Schedule:       "0 2 * * 6 *",
+			DurationMillis: (8 * 24 * time.Hour).Milliseconds(),
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		require.Len(t, apiErr.Validations, 2)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		req := codersdk.CreateMaintenanceWindowRequest{
+			Name:           "conflict",
+			Schedule:       "CRON_TZ=UTC 0 2 * * 6",
+			DurationMillis: time.Hour.Milliseconds(),
+		}
+		_, err := client.CreateMaintenanceWindow(ctx, owner.OrganizationID, req)
+		require.NoError(t, err)
+		_, err = client.CreateMaintenanceWindow(ctx, owner.OrganizationID, req)
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusConflict, apiErr.StatusCode())
+	})
+
+	t.Run("BlocksBuilds", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		// Create a separate template so the window does not block the builds
+		// of the other tests.
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		workspace := coderdtest.CreateWorkspace(t, member, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, member, workspace.LatestBuild.ID)
+		_, err := client.CreateMaintenanceWindow(ctx, owner.OrganizationID, codersdk.CreateMaintenanceWindowRequest{
+			TemplateID:     &template.ID,
+			Name:           "blocking",
+			Schedule:       "CRON_TZ=UTC * * * * *",
+			DurationMillis: time.Hour.Milliseconds(),
+			BlockBuilds:    true,
+		})
+		require.NoError(t, err)
+
+		_, err = member.CreateUserWorkspace(ctx, codersdk.Me, codersdk.CreateWorkspaceRequest{
+			TemplateID: template.ID,
+			Name:       "blocked",
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode())
+		require.Contains(t, apiErr.Message, `maintenance window "blocking"`)
+
+		// Workspaces can still be stopped.
+		build, err := member.CreateWorkspaceBuild(ctx, workspace.ID, codersdk.CreateWorkspaceBuildRequest{
+			Transition: codersdk.WorkspaceTransitionStop,
+		})
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, member, build.ID)
+	})
+}
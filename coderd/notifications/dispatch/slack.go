@@ -0,0 +1,235 @@
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	markdown "github.com/coder/coder/v2/coderd/render"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// SlackPostMessageURL is the Slack Web API method used to deliver messages when a bot token is configured.
+const SlackPostMessageURL = "https://slack.com/api/chat.postMessage"
+
+// Block Kit text length limits; see https://api.slack.com/reference/block-kit/blocks.
+const (
+	slackHeaderMaxLen  = 150
+	slackSectionMaxLen = 3000
+)
+
+// SlackHandler dispatches notification messages to Slack, either via an incoming webhook or via the chat.postMessage
+// API using a bot token. Messages are rendered using Block Kit.
+type SlackHandler struct {
+	cfg codersdk.NotificationsSlackConfig
+	log slog.Logger
+
+	cl *http.Client
+}
+
+// SlackPayload describes the JSON payload to be delivered to Slack.
+// See https://api.slack.com/reference/block-kit/blocks.
+type SlackPayload struct {
+	// Channel is only used (and required) when delivering via the chat.postMessage API.
+	Channel string `json:"channel,omitempty"`
+	// Text is used as the fallback for notifications and clients which cannot render blocks.
+	Text   string       `json:"text"`
+	Blocks []SlackBlock `json:"blocks"`
+}
+
+type SlackBlock struct {
+	Type     string         `json:"type"`
+	Text     *SlackText     `json:"text,omitempty"`
+	Elements []SlackElement `json:"elements,omitempty"`
+}
+
+type SlackText struct {
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+	Emoji bool   `json:"emoji,omitempty"`
+}
+
+type SlackElement struct {
+	Type     string    `json:"type"`
+	Text     SlackText `json:"text"`
+	URL      string    `json:"url,omitempty"`
+	ActionID string    `json:"action_id,omitempty"`
+}
+
+// slackAPIResponse is the envelope returned by all Slack Web API methods.
+type slackAPIResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+func NewSlackHandler(cfg codersdk.NotificationsSlackConfig, log slog.Logger) *SlackHandler {
+	return &SlackHandler{cfg: cfg, log: log, cl: &http.Client{}}
+}
+
+func (s *SlackHandler) Dispatcher(payload types.MessagePayload, titleTmpl, bodyTmpl string) (DeliveryFunc, error) {
+	endpoint := s.cfg.Endpoint.String()
+	if s.usesAPI() {
+		if s.cfg.Channel.String() == "" {
+			return nil, xerrors.New("slack channel not defined")
+		}
+		if endpoint == "" {
+			endpoint = SlackPostMessageURL
+		}
+	}
+	if endpoint == "" {
+		return nil, xerrors.New("slack endpoint not defined")
+	}
+
+	title, err := markdown.PlaintextFromMarkdown(titleTmpl)
+	if err != nil {
+		return nil, xerrors.Errorf("render title: %w", err)
+	}
+	body, err := markdown.PlaintextFromMarkdown(bodyTmpl)
+	if err != nil {
+		return nil, xerrors.Errorf("render body: %w", err)
+	}
+
+	msg := s.buildPayload(payload, title, body)
+	return s.dispatch(msg, endpoint), nil
+}
+
+// Validate checks that either an endpoint or a bot token and channel are defined, and that the endpoint accepts
+// connections.
+func (s *SlackHandler) Validate(ctx context.Context) error {
+	endpoint := s.cfg.Endpoint.String()
+	if s.usesAPI() {
+		if s.cfg.Channel.String() == "" {
+			return xerrors.New("slack channel not defined")
+		}
+		if endpoint == "" {
+			endpoint = SlackPostMessageURL
+		}
+	}
+	if endpoint == "" {
+		return xerrors.New("slack endpoint not defined")
+	}
+	return validateEndpoint(ctx, endpoint)
+}
+
+// usesAPI returns true when messages should be delivered via the chat.postMessage API instead of an incoming webhook.
+func (s *SlackHandler) usesAPI() bool {
+	return s.cfg.BotToken.String() != ""
+}
+
+func (s *SlackHandler) buildPayload(payload types.MessagePayload, title, body string) SlackPayload {
+	msg := SlackPayload{
+		Text: title,
+		Blocks: []SlackBlock{
+			{
+				Type: "header",
+				Text: &SlackText{Type: "plain_text", Text: truncate(title, slackHeaderMaxLen), Emoji: true},
+			},
+			{
+				Type: "section",
+				Text: &SlackText{Type: "plain_text", Text: truncate(body, slackSectionMaxLen), Emoji: true},
+			},
+		},
+	}
+	if s.usesAPI() {
+		msg.Channel = s.cfg.Channel.String()
+	}
+
+	if len(payload.Actions) > 0 {
+		actions := SlackBlock{Type: "actions"}
+		for i, action := range payload.Actions {
+			actions.Elements = append(actions.Elements, SlackElement{
+				Type:     "button",
+				Text:     SlackText{Type: "plain_text", Text: action.Label, Emoji: true},
+				URL:      action.URL,
+				ActionID: fmt.Sprintf("action_%d", i),
+			})
+		}
+		msg.Blocks = append(msg.Blocks, actions)
+	}
+
+	return msg
+}
+
+func (s *SlackHandler) dispatch(msg SlackPayload, endpoint string) DeliveryFunc {
+	return func(ctx context.Context, msgID uuid.UUID) (retryable bool, err error) {
+		m, err := json.Marshal(msg)
+		if err != nil {
+			return false, xerrors.Errorf("marshal payload: %v", err)
+		}
+
+		// Outer context has a deadline (see CODER_NOTIFICATIONS_DISPATCH_TIMEOUT).
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(m))
+		if err != nil {
+			return false, xerrors.Errorf("create HTTP request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		if s.usesAPI() {
+			req.Header.Set("Authorization", "Bearer "+s.cfg.BotToken.String())
+		}
+
+		resp, err := s.cl.Do(req)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return true, xerrors.Errorf("request timeout: %w", err)
+			}
+
+			return true, xerrors.Errorf("request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode/100 > 2 {
+			// Body could be quite long here, let's grab the first 512B and hope it contains useful debug info.
+			respBody := make([]byte, 512)
+			lr := io.LimitReader(resp.Body, int64(len(respBody)))
+			n, err := lr.Read(respBody)
+			if err != nil && !errors.Is(err, io.EOF) {
+				return true, xerrors.Errorf("non-2xx response (%d), read body: %w", resp.StatusCode, err)
+			}
+			s.log.Warn(ctx, "unsuccessful delivery", slog.F("status_code", resp.StatusCode),
+				slog.F("response", respBody[:n]), slog.F("msg_id", msgID))
+			// Client errors (other than rate limiting) indicate a problem with the configuration or payload, which
+			// will not be resolved by retrying.
+			return retryableStatus(resp.StatusCode), xerrors.Errorf("non-2xx response (%d)", resp.StatusCode)
+		}
+
+		// Incoming webhooks respond with a plain "ok"; only the Web API responds with a JSON envelope.
+		if !s.usesAPI() {
+			return false, nil
+		}
+
+		var apiResp slackAPIResponse
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&apiResp); err != nil {
+			return true, xerrors.Errorf("decode response: %w", err)
+		}
+		if !apiResp.OK {
+			s.log.Warn(ctx, "unsuccessful delivery", slog.F("error", apiResp.Error), slog.F("msg_id", msgID))
+			return apiResp.Error == "ratelimited", xerrors.Errorf("slack API error: %s", apiResp.Error)
+		}
+
+		return false, nil
+	}
+}
+
+// retryableStatus determines whether a non-2xx HTTP response status warrants a retry.
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusRequestTimeout || code >= http.StatusInternalServerError
+}
+
+// truncate shortens s to at most n runes, marking the truncation with an ellipsis.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
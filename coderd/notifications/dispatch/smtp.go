@@ -92,6 +92,43 @@ func (s *SMTPHandler) Dispatcher(payload types.MessagePayload, titleTmpl, bodyTm
 	return s.dispatch(subject, htmlBody, plainBody, payload.UserEmail), nil
 }
 
+// Validate connects to the smarthost and authenticates, without sending a message.
+// The context must have a deadline.
+func (s *SMTPHandler) Validate(ctx context.Context) error {
+	if _, err := s.validateFromAddr(s.cfg.From.String()); err != nil {
+		return xerrors.Errorf("'from' validation: %w", err)
+	}
+	smarthost, smarthostPort, err := s.smarthost()
+	if err != nil {
+		return xerrors.Errorf("'smarthost' validation: %w", err)
+	}
+
+	c, err := s.client(ctx, smarthost, smarthostPort)
+	if err != nil {
+		return xerrors.Errorf("SMTP client creation: %w", err)
+	}
+	defer func() {
+		if err := c.Quit(); err != nil {
+			s.log.Warn(ctx, "failed to close SMTP connection", slog.Error(err))
+		}
+	}()
+
+	if ok, avail := c.Extension("AUTH"); ok {
+		auth, err := s.auth(ctx, avail)
+		if err != nil {
+			return xerrors.Errorf("determine auth mechanism: %w", err)
+		}
+		if auth != nil {
+			if err := c.Auth(auth); err != nil {
+				return xerrors.Errorf("%T auth: %w", auth, err)
+			}
+		}
+	} else if !s.cfg.Auth.Empty() {
+		return xerrors.New("no authentication mechanisms supported by server")
+	}
+	return nil
+}
+
 // dispatch returns a DeliveryFunc capable of delivering a notification via SMTP.
 //
 // Our requirements are too complex to be implemented using smtp.SendMail:
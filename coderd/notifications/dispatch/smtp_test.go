@@ -523,6 +523,84 @@ func TestSMTP(t *testing.T) {
 	}
 }
 
+func TestSMTPValidate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		username = "bob"
+		password = "🤫"
+	)
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	backend := NewBackend(Config{
+		AuthMechanisms:   []string{sasl.Plain},
+		AcceptedUsername: username,
+		AcceptedPassword: password,
+	})
+	srv, listen, err := createMockSMTPServer(backend, false)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, srv.Serve(listen))
+	}()
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Close())
+		wg.Wait()
+	})
+
+	var hp serpent.HostPort
+	require.NoError(t, hp.Set(listen.Addr().String()))
+
+	for _, tc := range []struct {
+		name        string
+		password    string
+		expectedErr string
+	}{
+		{name: "OK", password: password},
+		{name: "WrongPassword", password: "wrong", expectedErr: "auth"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := testutil.Context(t, testutil.WaitShort)
+
+			handler := dispatch.NewSMTPHandler(codersdk.NotificationsEmailConfig{
+				Hello:     "localhost",
+				From:      "system@coder.com",
+				Smarthost: hp,
+				Auth: codersdk.NotificationsEmailAuthConfig{
+					Username: username,
+					Password: serpent.String(tc.password),
+				},
+			}, nil, logger.Named("smtp"))
+			err := handler.Validate(ctx)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				// Validating authenticates, but must not send a message.
+				msg := backend.LastMessage()
+				require.NotNil(t, msg)
+				require.Equal(t, username, msg.Username)
+				require.Empty(t, msg.From)
+				require.Empty(t, msg.Contents)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+
+	t.Run("NoSmarthost", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitShort)
+
+		handler := dispatch.NewSMTPHandler(codersdk.NotificationsEmailConfig{
+			Hello: "localhost",
+			From:  "system@coder.com",
+		}, nil, logger.Named("smtp"))
+		require.ErrorIs(t, handler.Validate(ctx), dispatch.ValidationNoSmarthostHostErr)
+	})
+}
+
 func pingClient(listen net.Listener, useTLS bool, startTLS bool) (*smtp.Client, error) {
 	tlsCfg := &tls.Config{
 		// nolint:gosec // It's a test.
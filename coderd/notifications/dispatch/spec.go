@@ -2,8 +2,11 @@ package dispatch
 
 import (
 	"context"
+	"net"
+	"net/url"
 
 	"github.com/google/uuid"
+	"golang.org/x/xerrors"
 )
 
 // DeliveryFunc delivers the notification.
@@ -11,3 +14,37 @@ import (
 // any error that may have arisen.
 // If (false, nil) is returned, that is considered a successful dispatch.
 type DeliveryFunc func(ctx context.Context, msgID uuid.UUID) (retryable bool, err error)
+
+// Validator is implemented by handlers which can check that their configuration allows messages to be delivered,
+// without delivering a message.
+type Validator interface {
+	Validate(ctx context.Context) error
+}
+
+// validateEndpoint checks that the given URL is a valid HTTP(S) URL and that its host accepts connections.
+func validateEndpoint(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return xerrors.Errorf("parse endpoint: %w", err)
+	}
+	port := u.Port()
+	switch u.Scheme {
+	case "http":
+		if port == "" {
+			port = "80"
+		}
+	case "https":
+		if port == "" {
+			port = "443"
+		}
+	default:
+		return xerrors.Errorf("endpoint scheme must be http or https, got %q", u.Scheme)
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return xerrors.Errorf("connect to endpoint: %w", err)
+	}
+	return conn.Close()
+}
@@ -0,0 +1,168 @@
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	markdown "github.com/coder/coder/v2/coderd/render"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const (
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	// Version 1.4 is the highest version supported across Teams clients.
+	adaptiveCardVersion = "1.4"
+)
+
+// TeamsHandler dispatches notification messages to a Microsoft Teams incoming webhook (or Workflows webhook)
+// as an Adaptive Card.
+type TeamsHandler struct {
+	cfg codersdk.NotificationsTeamsConfig
+	log slog.Logger
+
+	cl *http.Client
+}
+
+// TeamsPayload describes the JSON payload to be delivered to the configured Teams webhook.
+// See https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/connectors-using.
+type TeamsPayload struct {
+	Type        string            `json:"type"`
+	Attachments []TeamsAttachment `json:"attachments"`
+}
+
+type TeamsAttachment struct {
+	ContentType string       `json:"contentType"`
+	ContentURL  *string      `json:"contentUrl"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+// AdaptiveCard is a minimal representation of an Adaptive Card.
+// See https://adaptivecards.io/explorer/AdaptiveCard.html.
+type AdaptiveCard struct {
+	Schema  string               `json:"$schema"`
+	Type    string               `json:"type"`
+	Version string               `json:"version"`
+	Body    []AdaptiveCardBlock  `json:"body"`
+	Actions []AdaptiveCardAction `json:"actions,omitempty"`
+}
+
+type AdaptiveCardBlock struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Size   string `json:"size,omitempty"`
+	Weight string `json:"weight,omitempty"`
+	Wrap   bool   `json:"wrap"`
+}
+
+type AdaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func NewTeamsHandler(cfg codersdk.NotificationsTeamsConfig, log slog.Logger) *TeamsHandler {
+	return &TeamsHandler{cfg: cfg, log: log, cl: &http.Client{}}
+}
+
+func (t *TeamsHandler) Dispatcher(payload types.MessagePayload, titleTmpl, bodyTmpl string) (DeliveryFunc, error) {
+	if t.cfg.Endpoint.String() == "" {
+		return nil, xerrors.New("teams endpoint not defined")
+	}
+
+	title, err := markdown.PlaintextFromMarkdown(titleTmpl)
+	if err != nil {
+		return nil, xerrors.Errorf("render title: %w", err)
+	}
+	body, err := markdown.PlaintextFromMarkdown(bodyTmpl)
+	if err != nil {
+		return nil, xerrors.Errorf("render body: %w", err)
+	}
+
+	return t.dispatch(buildTeamsPayload(payload, title, body), t.cfg.Endpoint.String()), nil
+}
+
+// Validate checks that the Teams endpoint is defined and accepts connections.
+func (t *TeamsHandler) Validate(ctx context.Context) error {
+	if t.cfg.Endpoint.String() == "" {
+		return xerrors.New("teams endpoint not defined")
+	}
+	return validateEndpoint(ctx, t.cfg.Endpoint.String())
+}
+
+func buildTeamsPayload(payload types.MessagePayload, title, body string) TeamsPayload {
+	card := AdaptiveCard{
+		Schema:  adaptiveCardSchema,
+		Type:    "AdaptiveCard",
+		Version: adaptiveCardVersion,
+		Body: []AdaptiveCardBlock{
+			{Type: "TextBlock", Text: title, Size: "Medium", Weight: "Bolder", Wrap: true},
+			{Type: "TextBlock", Text: body, Wrap: true},
+		},
+	}
+	for _, action := range payload.Actions {
+		card.Actions = append(card.Actions, AdaptiveCardAction{
+			Type:  "Action.OpenUrl",
+			Title: action.Label,
+			URL:   action.URL,
+		})
+	}
+
+	return TeamsPayload{
+		Type: "message",
+		Attachments: []TeamsAttachment{
+			{ContentType: adaptiveCardContentType, Content: card},
+		},
+	}
+}
+
+func (t *TeamsHandler) dispatch(msg TeamsPayload, endpoint string) DeliveryFunc {
+	return func(ctx context.Context, msgID uuid.UUID) (retryable bool, err error) {
+		m, err := json.Marshal(msg)
+		if err != nil {
+			return false, xerrors.Errorf("marshal payload: %v", err)
+		}
+
+		// Outer context has a deadline (see CODER_NOTIFICATIONS_DISPATCH_TIMEOUT).
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(m))
+		if err != nil {
+			return false, xerrors.Errorf("create HTTP request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := t.cl.Do(req)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return true, xerrors.Errorf("request timeout: %w", err)
+			}
+
+			return true, xerrors.Errorf("request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode/100 > 2 {
+			// Body could be quite long here, let's grab the first 512B and hope it contains useful debug info.
+			respBody := make([]byte, 512)
+			lr := io.LimitReader(resp.Body, int64(len(respBody)))
+			n, err := lr.Read(respBody)
+			if err != nil && !errors.Is(err, io.EOF) {
+				return true, xerrors.Errorf("non-2xx response (%d), read body: %w", resp.StatusCode, err)
+			}
+			t.log.Warn(ctx, "unsuccessful delivery", slog.F("status_code", resp.StatusCode),
+				slog.F("response", respBody[:n]), slog.F("msg_id", msgID))
+			return retryableStatus(resp.StatusCode), xerrors.Errorf("non-2xx response (%d)", resp.StatusCode)
+		}
+
+		return false, nil
+	}
+}
@@ -0,0 +1,181 @@
+package dispatch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog"
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/serpent"
+
+	"github.com/coder/coder/v2/coderd/notifications/dispatch"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestTeams(t *testing.T) {
+	t.Parallel()
+
+	const (
+		title = "this is the title"
+		body  = "this is the body"
+	)
+
+	msgPayload := types.MessagePayload{
+		Version:          "1.0",
+		NotificationName: "test",
+		Actions: []types.TemplateAction{
+			{Label: "View workspace", URL: "https://coder.example.com/@bob/ws"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		serverURL      string
+		serverDeadline time.Time
+		serverFn       func(w http.ResponseWriter, r *http.Request)
+
+		expectSuccess   bool
+		expectRetryable bool
+		expectErr       string
+	}{
+		{
+			name: "successful",
+			serverFn: func(w http.ResponseWriter, r *http.Request) {
+				var payload dispatch.TeamsPayload
+				err := json.NewDecoder(r.Body).Decode(&payload)
+				assert.NoError(t, err)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "message", payload.Type)
+				if assert.Len(t, payload.Attachments, 1) {
+					attachment := payload.Attachments[0]
+					assert.Equal(t, "application/vnd.microsoft.card.adaptive", attachment.ContentType)
+					assert.Equal(t, "AdaptiveCard", attachment.Content.Type)
+					if assert.Len(t, attachment.Content.Body, 2) {
+						assert.Equal(t, title, attachment.Content.Body[0].Text)
+						assert.Equal(t, body, attachment.Content.Body[1].Text)
+					}
+					if assert.Len(t, attachment.Content.Actions, 1) {
+						assert.Equal(t, "Action.OpenUrl", attachment.Content.Actions[0].Type)
+						assert.Equal(t, "View workspace", attachment.Content.Actions[0].Title)
+						assert.Equal(t, "https://coder.example.com/@bob/ws", attachment.Content.Actions[0].URL)
+					}
+				}
+
+				w.WriteHeader(http.StatusOK)
+				_, err = w.Write([]byte("1"))
+				assert.NoError(t, err)
+			},
+			expectSuccess: true,
+		},
+		{
+			name: "invalid endpoint",
+			// Build a deliberately invalid URL to fail validation.
+			serverURL:       "invalid .com",
+			expectSuccess:   false,
+			expectErr:       "invalid URL escape",
+			expectRetryable: false,
+		},
+		{
+			name:           "timeout",
+			serverDeadline: time.Now().Add(-time.Hour),
+			serverFn: func(w http.ResponseWriter, r *http.Request) {
+				t.Fatalf("should not get here")
+			},
+			expectSuccess:   false,
+			expectRetryable: true,
+			expectErr:       "request timeout",
+		},
+		{
+			name: "client error",
+			serverFn: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("Bad payload received by generic incoming webhook."))
+			},
+			expectSuccess:   false,
+			expectRetryable: false,
+			expectErr:       "non-2xx response (400)",
+		},
+		{
+			name: "too many requests",
+			serverFn: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			expectSuccess:   false,
+			expectRetryable: true,
+			expectErr:       "non-2xx response (429)",
+		},
+		{
+			name: "server error",
+			serverFn: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			expectSuccess:   false,
+			expectRetryable: true,
+			expectErr:       "non-2xx response (502)",
+		},
+	}
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+
+	// nolint:paralleltest // Irrelevant as of Go v1.22
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctx    context.Context
+				cancel context.CancelFunc
+			)
+
+			if !tc.serverDeadline.IsZero() {
+				ctx, cancel = context.WithDeadline(context.Background(), tc.serverDeadline)
+			} else {
+				ctx, cancel = context.WithTimeout(context.Background(), testutil.WaitLong)
+			}
+			t.Cleanup(cancel)
+
+			var (
+				err      error
+				endpoint *url.URL
+			)
+			if tc.serverURL != "" {
+				endpoint = &url.URL{Host: tc.serverURL}
+			} else {
+				// Mock server to simulate the Teams webhook endpoint.
+				server := httptest.NewServer(http.HandlerFunc(tc.serverFn))
+				defer server.Close()
+
+				endpoint, err = url.Parse(server.URL)
+				require.NoError(t, err)
+			}
+
+			cfg := codersdk.NotificationsTeamsConfig{
+				Endpoint: *serpent.URLOf(endpoint),
+			}
+			handler := dispatch.NewTeamsHandler(cfg, logger.With(slog.F("test", tc.name)))
+			deliveryFn, err := handler.Dispatcher(msgPayload, title, body)
+			require.NoError(t, err)
+
+			retryable, err := deliveryFn(ctx, uuid.New())
+			if tc.expectSuccess {
+				require.NoError(t, err)
+				require.False(t, retryable)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.expectErr)
+			require.Equal(t, tc.expectRetryable, retryable)
+		})
+	}
+}
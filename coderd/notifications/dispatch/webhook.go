@@ -56,6 +56,14 @@ func (w *WebhookHandler) Dispatcher(payload types.MessagePayload, titleTmpl, bod
 	return w.dispatch(payload, title, body, w.cfg.Endpoint.String()), nil
 }
 
+// Validate checks that the webhook endpoint is defined and accepts connections.
+func (w *WebhookHandler) Validate(ctx context.Context) error {
+	if w.cfg.Endpoint.String() == "" {
+		return xerrors.New("webhook endpoint not defined")
+	}
+	return validateEndpoint(ctx, w.cfg.Endpoint.String())
+}
+
 func (w *WebhookHandler) dispatch(msgPayload types.MessagePayload, title, body, endpoint string) DeliveryFunc {
 	return func(ctx context.Context, msgID uuid.UUID) (retryable bool, err error) {
 		// Prepare payload.
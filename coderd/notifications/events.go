@@ -13,6 +13,9 @@ var (
 	TemplateWorkspaceAutoUpdated       = uuid.MustParse("c34a0c09-0704-4cac-bd1c-0c0146811c2b")
 	TemplateWorkspaceMarkedForDeletion = uuid.MustParse("51ce2fdf-c9ca-4be1-8d70-628674f9bc42")
 	TemplateWorkspaceManualBuildFailed = uuid.MustParse("2faeee0f-26cb-4e96-821c-85ccb9f71513")
+	TemplateWorkspaceDrifted           = uuid.MustParse("f3b8a6d2-4c1e-4e7a-9d25-8a1b6c3e5f47")
+
+	TemplateMaintenanceWindowScheduled = uuid.MustParse("d6b7b0a9-8d4e-4c6b-a6e6-7b9f4b3e2c15")
 )
 
 // Account-related events.
@@ -32,3 +35,8 @@ var (
 
 	TemplateWorkspaceBuildsFailedReport = uuid.MustParse("34a20db2-e9cc-4a93-b0e4-8569699d7a00")
 )
+
+// Deployment-related events.
+var (
+	TemplateDeploymentHealthChanged = uuid.MustParse("5b4e8f0c-3a7d-4b9e-9c21-6f8d2e1a7b43")
+)
@@ -124,6 +124,8 @@ func defaultHandlers(cfg codersdk.NotificationsConfig, helpers template.FuncMap,
 	return map[database.NotificationMethod]Handler{
 		database.NotificationMethodSmtp:    dispatch.NewSMTPHandler(cfg.SMTP, helpers, log.Named("dispatcher.smtp")),
 		database.NotificationMethodWebhook: dispatch.NewWebhookHandler(cfg.Webhook, log.Named("dispatcher.webhook")),
+		database.NotificationMethodSlack:   dispatch.NewSlackHandler(cfg.Slack, log.Named("dispatcher.slack")),
+		database.NotificationMethodTeams:   dispatch.NewTeamsHandler(cfg.Teams, log.Named("dispatcher.teams")),
 	}
 }
 
@@ -3,6 +3,7 @@ package notifications_test
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -96,6 +97,133 @@ func TestBufferedUpdates(t *testing.T) {
 	}, testutil.WaitMedium, testutil.IntervalFast)
 }
 
+func TestDigest(t *testing.T) {
+	t.Parallel()
+
+	// setup
+
+	// nolint:gocritic // Unit test.
+	ctx := dbauthz.AsSystemRestricted(testutil.Context(t, testutil.WaitSuperLong))
+	_, _, api := coderdtest.NewWithAPI(t, nil)
+
+	interceptor := &syncInterceptor{Store: api.Database}
+	santa := &santaHandler{}
+
+	// GIVEN: a manager with digests enabled, which will pass or fail notifications based on their "nice" labels
+	cfg := defaultNotificationsConfig(database.NotificationMethodSmtp)
+	cfg.DigestEnabled = true
+	cfg.DigestWindow = serpent.Duration(time.Second)
+	cfg.RetryInterval = serpent.Duration(time.Hour) // Ensure retries don't interfere with the test.
+	mgr, err := notifications.NewManager(cfg, interceptor, defaultHelpers(), createMetrics(), api.Logger.Named("notifications-manager"))
+	require.NoError(t, err)
+	mgr.WithHandlers(map[database.NotificationMethod]notifications.Handler{
+		database.NotificationMethodSmtp: santa,
+	})
+	t.Cleanup(func() {
+		assert.NoError(t, mgr.Stop(ctx))
+	})
+	enq, err := notifications.NewStoreEnqueuer(cfg, interceptor, defaultHelpers(), api.Logger.Named("notifications-enqueuer"), quartz.NewReal())
+	require.NoError(t, err)
+
+	nice := dbgen.User(t, api.Database, database.User{})
+	naughty := dbgen.User(t, api.Database, database.User{})
+
+	// WHEN: multiple notifications of the same template are enqueued for each user within the digest window
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err = enq.Enqueue(ctx, nice.ID, notifications.TemplateWorkspaceDeleted, map[string]string{"nice": "true", "i": strconv.Itoa(i)}, "")
+		require.NoError(t, err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err = enq.Enqueue(ctx, naughty.ID, notifications.TemplateWorkspaceDeleted, map[string]string{"nice": "false", "i": strconv.Itoa(i)}, "")
+		require.NoError(t, err)
+	}
+
+	mgr.Run(ctx)
+
+	// THEN: a single digest is dispatched per user, once the digest window has elapsed
+	require.Eventually(t, func() bool {
+		return santa.nice.Load() == 1 && santa.naughty.Load() == 1
+	}, testutil.WaitMedium, testutil.IntervalFast)
+	require.GreaterOrEqual(t, time.Since(start), cfg.DigestWindow.Value())
+
+	// THEN: each coalesced message has its own status updated in the store
+	require.EventuallyWithT(t, func(ct *assert.CollectT) {
+		if err := interceptor.err.Load(); err != nil {
+			ct.Errorf("bulk update encountered error: %s", err)
+			// Panic when an unexpected error occurs.
+			ct.FailNow()
+		}
+
+		assert.EqualValues(ct, 3, interceptor.sent.Load())
+		assert.EqualValues(ct, 2, interceptor.failed.Load())
+	}, testutil.WaitMedium, testutil.IntervalFast)
+
+	// THEN: no further dispatches occurred
+	require.EqualValues(t, 1, santa.nice.Load())
+	require.EqualValues(t, 1, santa.naughty.Load())
+}
+
+func TestDigestRenderFailure(t *testing.T) {
+	t.Parallel()
+
+	// setup
+
+	// nolint:gocritic // Unit test.
+	ctx := dbauthz.AsSystemRestricted(testutil.Context(t, testutil.WaitSuperLong))
+	_, _, api := coderdtest.NewWithAPI(t, nil)
+
+	interceptor := &syncInterceptor{Store: api.Database}
+	breaker := &brokenPayloadInterceptor{syncInterceptor: interceptor}
+	santa := &santaHandler{}
+
+	// GIVEN: a manager with digests enabled
+	cfg := defaultNotificationsConfig(database.NotificationMethodSmtp)
+	cfg.DigestEnabled = true
+	cfg.DigestWindow = serpent.Duration(time.Second)
+	cfg.RetryInterval = serpent.Duration(time.Hour) // Ensure retries don't interfere with the test.
+	mgr, err := notifications.NewManager(cfg, breaker, defaultHelpers(), createMetrics(), api.Logger.Named("notifications-manager"))
+	require.NoError(t, err)
+	mgr.WithHandlers(map[database.NotificationMethod]notifications.Handler{
+		database.NotificationMethodSmtp: santa,
+	})
+	t.Cleanup(func() {
+		assert.NoError(t, mgr.Stop(ctx))
+	})
+	enq, err := notifications.NewStoreEnqueuer(cfg, interceptor, defaultHelpers(), api.Logger.Named("notifications-enqueuer"), quartz.NewReal())
+	require.NoError(t, err)
+
+	user := dbgen.User(t, api.Database, database.User{})
+
+	// WHEN: three notifications are enqueued within the digest window, of which the second cannot be rendered
+	_, err = enq.Enqueue(ctx, user.ID, notifications.TemplateWorkspaceDeleted, map[string]string{"nice": "true", "i": "0"}, "")
+	require.NoError(t, err)
+	_, err = enq.Enqueue(ctx, user.ID, notifications.TemplateWorkspaceDeleted, map[string]string{"nice": "true", "i": "1", "broken": "true"}, "")
+	require.NoError(t, err)
+	_, err = enq.Enqueue(ctx, user.ID, notifications.TemplateWorkspaceDeleted, map[string]string{"nice": "true", "i": "2"}, "")
+	require.NoError(t, err)
+
+	mgr.Run(ctx)
+
+	// THEN: a digest of the messages that could be rendered is dispatched
+	require.Eventually(t, func() bool {
+		return santa.nice.Load() == 1
+	}, testutil.WaitMedium, testutil.IntervalFast)
+
+	// THEN: only the message that could not be rendered is marked as failed
+	require.EventuallyWithT(t, func(ct *assert.CollectT) {
+		if err := interceptor.err.Load(); err != nil {
+			ct.Errorf("bulk update encountered error: %s", err)
+			// Panic when an unexpected error occurs.
+			ct.FailNow()
+		}
+
+		assert.EqualValues(ct, 2, interceptor.sent.Load())
+		assert.EqualValues(ct, 1, interceptor.failed.Load())
+	}, testutil.WaitMedium, testutil.IntervalFast)
+	require.EqualValues(t, 0, santa.naughty.Load())
+}
+
 func TestBuildPayload(t *testing.T) {
 	t.Parallel()
 
@@ -199,6 +327,22 @@ func (b *syncInterceptor) BulkMarkNotificationMessagesFailed(ctx context.Context
 	return updated, err
 }
 
+// brokenPayloadInterceptor breaks the payload of acquired messages labeled as broken, so that they cannot be rendered.
+type brokenPayloadInterceptor struct {
+	*syncInterceptor
+}
+
+func (b *brokenPayloadInterceptor) AcquireNotificationMessages(ctx context.Context, arg database.AcquireNotificationMessagesParams) ([]database.AcquireNotificationMessagesRow, error) {
+	msgs, err := b.syncInterceptor.AcquireNotificationMessages(ctx, arg)
+	for i, msg := range msgs {
+		var payload types.MessagePayload
+		if json.Unmarshal(msg.Payload, &payload) == nil && payload.Labels["broken"] == "true" {
+			msgs[i].Payload = []byte("{")
+		}
+	}
+	return msgs, err
+}
+
 // santaHandler only dispatches nice messages.
 type santaHandler struct {
 	naughty atomic.Int32
@@ -844,6 +844,17 @@ func TestNotificationTemplatesCanRender(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "TemplateWorkspaceDrifted",
+			id:   notifications.TemplateWorkspaceDrifted,
+			payload: types.MessagePayload{
+				UserName: "Bobby",
+				Labels: map[string]string{
+					"name":      "bobby-workspace",
+					"resources": "- `docker_container.workspace[0]` was deleted\n- `docker_volume.home` was changed",
+				},
+			},
+		},
 		{
 			name: "TemplateWorkspaceBuildsFailedReport",
 			id:   notifications.TemplateWorkspaceBuildsFailedReport,
@@ -895,6 +906,33 @@ func TestNotificationTemplatesCanRender(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "TemplateMaintenanceWindowScheduled",
+			id:   notifications.TemplateMaintenanceWindowScheduled,
+			payload: types.MessagePayload{
+				UserName: "Bobby",
+				Labels: map[string]string{
+					"name":         "weekly-upgrade",
+					"starts_at":    "Sat Oct 5 02:00 UTC",
+					"ends_at":      "Sat Oct 5 04:00 UTC",
+					"block_builds": "true",
+					"message":      "The cluster is upgraded to the latest Kubernetes release.",
+				},
+			},
+		},
+		{
+			name: "TemplateDeploymentHealthChanged",
+			id:   notifications.TemplateDeploymentHealthChanged,
+			payload: types.MessagePayload{
+				UserName: "Bobby",
+				Labels: map[string]string{
+					"section":           "Database",
+					"severity":          "warning",
+					"previous_severity": "ok",
+					"summary":           "Warn: EDB02: Database ping took 20ms, which is over the threshold of 15ms\nSee: https://coder.com/docs/admin/healthcheck#edb02",
+				},
+			},
+		},
 	}
 
 	allTemplates, err := enumerateAllTemplates(t)
@@ -3,6 +3,8 @@ package notifications
 import (
 	"context"
 	"encoding/json"
+	"math"
+	"slices"
 	"sync"
 	"text/template"
 
@@ -151,19 +153,48 @@ func (n *notifier) process(ctx context.Context, success chan<- dispatchResult, f
 		return nil
 	}
 
-	var eg errgroup.Group
+	var (
+		eg      errgroup.Group
+		enabled []database.AcquireNotificationMessagesRow
+	)
 	for _, msg := range msgs {
 		// If a notification template has been disabled by the user after a notification was enqueued, mark it as inhibited
 		if msg.Disabled {
 			failure <- n.newInhibitedDispatch(msg)
 			continue
 		}
+		enabled = append(enabled, msg)
+	}
 
-		// A message failing to be prepared correctly should not affect other messages.
-		deliverFn, err := n.prepare(ctx, msg)
+	for _, batch := range n.batch(enabled) {
+		// A message failing to be rendered should not affect other messages, so it is left out of its digest.
+		rendered := make([]renderedMessage, 0, len(batch))
+		for _, msg := range batch {
+			r, err := n.render(msg)
+			if err != nil {
+				n.log.Warn(ctx, "message rendering failed", slog.F("msg_id", msg.ID), slog.Error(err))
+				failure <- n.newFailedDispatch(msg, err, false)
+				continue
+			}
+			rendered = append(rendered, r)
+		}
+		if len(rendered) == 0 {
+			n.metrics.PendingUpdates.Set(float64(len(success) + len(failure)))
+			continue
+		}
+
+		batch = make([]database.AcquireNotificationMessagesRow, 0, len(rendered))
+		for _, r := range rendered {
+			batch = append(batch, r.msg)
+		}
+
+		// A batch failing to be prepared correctly should not affect other messages.
+		deliverFn, err := n.prepare(ctx, rendered)
 		if err != nil {
-			n.log.Warn(ctx, "dispatcher construction failed", slog.F("msg_id", msg.ID), slog.Error(err))
-			failure <- n.newFailedDispatch(msg, err, false)
+			for _, msg := range batch {
+				n.log.Warn(ctx, "dispatcher construction failed", slog.F("msg_id", msg.ID), slog.Error(err))
+				failure <- n.newFailedDispatch(msg, err, false)
+			}
 
 			n.metrics.PendingUpdates.Set(float64(len(success) + len(failure)))
 			continue
@@ -171,7 +202,7 @@ func (n *notifier) process(ctx context.Context, success chan<- dispatchResult, f
 
 		eg.Go(func() error {
 			// Dispatch must only return an error for exceptional cases, NOT for failed messages.
-			return n.deliver(ctx, msg, deliverFn, success, failure)
+			return n.deliver(ctx, batch, deliverFn, success, failure)
 		})
 	}
 
@@ -188,10 +219,11 @@ func (n *notifier) process(ctx context.Context, success chan<- dispatchResult, f
 // messages until they are dispatched - or until the lease expires (in exceptional cases).
 func (n *notifier) fetch(ctx context.Context) ([]database.AcquireNotificationMessagesRow, error) {
 	msgs, err := n.store.AcquireNotificationMessages(ctx, database.AcquireNotificationMessagesParams{
-		Count:           int32(n.cfg.LeaseCount),
-		MaxAttemptCount: int32(n.cfg.MaxSendAttempts),
-		NotifierID:      n.id,
-		LeaseSeconds:    int32(n.cfg.LeasePeriod.Value().Seconds()),
+		Count:               int32(n.cfg.LeaseCount),
+		MaxAttemptCount:     int32(n.cfg.MaxSendAttempts),
+		NotifierID:          n.id,
+		LeaseSeconds:        int32(n.cfg.LeasePeriod.Value().Seconds()),
+		DigestWindowSeconds: n.digestWindowSeconds(),
 	})
 	if err != nil {
 		return nil, xerrors.Errorf("acquire messages: %w", err)
@@ -200,44 +232,128 @@ func (n *notifier) fetch(ctx context.Context) ([]database.AcquireNotificationMes
 	return msgs, nil
 }
 
-// prepare has two roles:
-// 1. render the title & body templates
-// 2. build a dispatcher from the given message, payload, and these templates - to be used for delivering the notification
-func (n *notifier) prepare(ctx context.Context, msg database.AcquireNotificationMessagesRow) (dispatch.DeliveryFunc, error) {
+// batch groups the given messages into batches which will each be delivered as a single notification.
+// Unless digests are enabled, each message is delivered on its own. When digests are enabled, messages of the same
+// template and method for the same user are coalesced if they were enqueued within the digest window of the first.
+func (n *notifier) batch(msgs []database.AcquireNotificationMessagesRow) [][]database.AcquireNotificationMessagesRow {
+	if !n.cfg.DigestEnabled.Value() {
+		batches := make([][]database.AcquireNotificationMessagesRow, 0, len(msgs))
+		for _, msg := range msgs {
+			batches = append(batches, []database.AcquireNotificationMessagesRow{msg})
+		}
+		return batches
+	}
+
+	type digestKey struct {
+		userID     uuid.UUID
+		templateID uuid.UUID
+		method     database.NotificationMethod
+	}
+
+	// Messages are acquired in order of creation, so the first message of each digest determines its window.
+	var (
+		batches [][]database.AcquireNotificationMessagesRow
+		open    = make(map[digestKey]int)
+	)
+	for _, msg := range msgs {
+		key := digestKey{userID: msg.UserID, templateID: msg.TemplateID, method: msg.Method}
+		if idx, ok := open[key]; ok && msg.CreatedAt.Sub(batches[idx][0].CreatedAt) <= n.cfg.DigestWindow.Value() {
+			batches[idx] = append(batches[idx], msg)
+			continue
+		}
+
+		open[key] = len(batches)
+		batches = append(batches, []database.AcquireNotificationMessagesRow{msg})
+	}
+
+	return batches
+}
+
+// digestWindowSeconds returns how long messages should be held back in order to be coalesced into digests.
+func (n *notifier) digestWindowSeconds() int32 {
+	if !n.cfg.DigestEnabled.Value() {
+		return 0
+	}
+	// Round up so that sub-second windows still hold messages back.
+	return int32(math.Ceil(n.cfg.DigestWindow.Value().Seconds()))
+}
+
+// renderedMessage is a message with its payload unmarshalled and its title & body templates rendered.
+type renderedMessage struct {
+	msg     database.AcquireNotificationMessagesRow
+	payload types.MessagePayload
+	title   string
+	body    string
+}
+
+// prepare builds a dispatcher from the given rendered messages - to be used for delivering the notification.
+//
+// If more than one message is given, the messages are combined into a single digest.
+func (n *notifier) prepare(ctx context.Context, msgs []renderedMessage) (dispatch.DeliveryFunc, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	default:
 	}
 
-	// NOTE: when we change the format of the MessagePayload, we have to bump its version and handle unmarshalling
-	// differently here based on that version.
-	var payload types.MessagePayload
-	err := json.Unmarshal(msg.Payload, &payload)
+	// All messages in a batch share the same method.
+	handler, ok := n.handlers[msgs[0].msg.Method]
+	if !ok {
+		return nil, xerrors.Errorf("failed to resolve handler %q", msgs[0].msg.Method)
+	}
+
+	var (
+		first   = msgs[0].payload
+		actions []types.TemplateAction
+		entries = make([]render.DigestEntry, 0, len(msgs))
+	)
+	for _, msg := range msgs {
+		for _, action := range msg.payload.Actions {
+			if !slices.Contains(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+		entries = append(entries, render.DigestEntry{Title: msg.title, Body: msg.body})
+	}
+
+	if len(entries) == 1 {
+		return handler.Dispatcher(first, entries[0].Title, entries[0].Body)
+	}
+
+	title, body, err := render.Digest(first, entries, n.helpers)
 	if err != nil {
-		return nil, xerrors.Errorf("unmarshal payload: %w", err)
+		return nil, err
 	}
 
-	handler, ok := n.handlers[msg.Method]
-	if !ok {
-		return nil, xerrors.Errorf("failed to resolve handler %q", msg.Method)
+	first.Actions = actions
+	return handler.Dispatcher(first, title, body)
+}
+
+// render unmarshals the given message's payload and renders its title & body templates.
+func (n *notifier) render(msg database.AcquireNotificationMessagesRow) (renderedMessage, error) {
+	r := renderedMessage{msg: msg}
+
+	// NOTE: when we change the format of the MessagePayload, we have to bump its version and handle unmarshalling
+	// differently here based on that version.
+	err := json.Unmarshal(msg.Payload, &r.payload)
+	if err != nil {
+		return r, xerrors.Errorf("unmarshal payload: %w", err)
 	}
 
-	var title, body string
-	if title, err = render.GoTemplate(msg.TitleTemplate, payload, n.helpers); err != nil {
-		return nil, xerrors.Errorf("render title: %w", err)
+	if r.title, err = render.GoTemplate(msg.TitleTemplate, r.payload, n.helpers); err != nil {
+		return r, xerrors.Errorf("render title: %w", err)
 	}
-	if body, err = render.GoTemplate(msg.BodyTemplate, payload, n.helpers); err != nil {
-		return nil, xerrors.Errorf("render body: %w", err)
+	if r.body, err = render.GoTemplate(msg.BodyTemplate, r.payload, n.helpers); err != nil {
+		return r, xerrors.Errorf("render body: %w", err)
 	}
 
-	return handler.Dispatcher(payload, title, body)
+	return r, nil
 }
 
-// deliver sends a given notification message via its defined method.
+// deliver sends the given notification messages via their defined method as a single notification.
 // This method *only* returns an error when a context error occurs; any other error is interpreted as a failure to
-// deliver the notification and as such the message will be marked as failed (to later be optionally retried).
-func (n *notifier) deliver(ctx context.Context, msg database.AcquireNotificationMessagesRow, deliver dispatch.DeliveryFunc, success, failure chan<- dispatchResult) error {
+// deliver the notification and as such all the given messages will be marked as failed (to later be optionally retried).
+func (n *notifier) deliver(ctx context.Context, msgs []database.AcquireNotificationMessagesRow, deliver dispatch.DeliveryFunc, success, failure chan<- dispatchResult) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -246,48 +362,60 @@ func (n *notifier) deliver(ctx context.Context, msg database.AcquireNotification
 
 	ctx, cancel := context.WithTimeout(ctx, n.cfg.DispatchTimeout.Value())
 	defer cancel()
-	logger := n.log.With(slog.F("msg_id", msg.ID), slog.F("method", msg.Method), slog.F("attempt", msg.AttemptCount+1))
 
-	if msg.AttemptCount > 0 {
-		n.metrics.RetryCount.WithLabelValues(string(msg.Method), msg.TemplateID.String()).Inc()
+	// The first message identifies the delivery; with digests disabled there is only ever one message.
+	head := msgs[0]
+	logger := n.log.With(slog.F("msg_id", head.ID), slog.F("method", head.Method), slog.F("attempt", head.AttemptCount+1))
+	if len(msgs) > 1 {
+		logger = logger.With(slog.F("digest_count", len(msgs)))
 	}
 
-	n.metrics.InflightDispatches.WithLabelValues(string(msg.Method), msg.TemplateID.String()).Inc()
-	n.metrics.QueuedSeconds.WithLabelValues(string(msg.Method)).Observe(msg.QueuedSeconds)
+	for _, msg := range msgs {
+		if msg.AttemptCount > 0 {
+			n.metrics.RetryCount.WithLabelValues(string(msg.Method), msg.TemplateID.String()).Inc()
+		}
+
+		n.metrics.InflightDispatches.WithLabelValues(string(msg.Method), msg.TemplateID.String()).Inc()
+		n.metrics.QueuedSeconds.WithLabelValues(string(msg.Method)).Observe(msg.QueuedSeconds)
+	}
 
 	start := n.clock.Now()
-	retryable, err := deliver(ctx, msg.ID)
+	retryable, err := deliver(ctx, head.ID)
 
-	n.metrics.DispatcherSendSeconds.WithLabelValues(string(msg.Method)).Observe(n.clock.Since(start).Seconds())
-	n.metrics.InflightDispatches.WithLabelValues(string(msg.Method), msg.TemplateID.String()).Dec()
+	n.metrics.DispatcherSendSeconds.WithLabelValues(string(head.Method)).Observe(n.clock.Since(start).Seconds())
+	for _, msg := range msgs {
+		n.metrics.InflightDispatches.WithLabelValues(string(msg.Method), msg.TemplateID.String()).Dec()
+	}
 
-	if err != nil {
-		// Don't try to accumulate message responses if the context has been canceled.
-		//
-		// This message's lease will expire in the store and will be requeued.
-		// It's possible this will lead to a message being delivered more than once, and that is why Stop() is preferable
-		// instead of canceling the context.
-		//
-		// In the case of backpressure (i.e. the success/failure channels are full because the database is slow),
-		// we can't append any more updates to the channels otherwise this, too, will block.
-		if xerrors.Is(err, context.Canceled) {
-			return err
-		}
+	// Don't try to accumulate message responses if the context has been canceled.
+	//
+	// These messages' leases will expire in the store and will be requeued.
+	// It's possible this will lead to a message being delivered more than once, and that is why Stop() is preferable
+	// instead of canceling the context.
+	if xerrors.Is(err, context.Canceled) {
+		return err
+	}
 
-		select {
-		case <-ctx.Done():
-			logger.Warn(context.Background(), "cannot record dispatch failure result", slog.Error(ctx.Err()))
-			return ctx.Err()
-		case failure <- n.newFailedDispatch(msg, err, retryable):
-			logger.Warn(ctx, "message dispatch failed", slog.Error(err))
-		}
-	} else {
-		select {
-		case <-ctx.Done():
-			logger.Warn(context.Background(), "cannot record dispatch success result", slog.Error(ctx.Err()))
-			return ctx.Err()
-		case success <- n.newSuccessfulDispatch(msg):
-			logger.Debug(ctx, "message dispatch succeeded")
+	// Each message keeps its own status, so that retries and attempt counts are tracked per message.
+	for _, msg := range msgs {
+		if err != nil {
+			// In the case of backpressure (i.e. the success/failure channels are full because the database is slow),
+			// we can't append any more updates to the channels otherwise this, too, will block.
+			select {
+			case <-ctx.Done():
+				logger.Warn(context.Background(), "cannot record dispatch failure result", slog.Error(ctx.Err()))
+				return ctx.Err()
+			case failure <- n.newFailedDispatch(msg, err, retryable):
+				logger.Warn(ctx, "message dispatch failed", slog.F("digest_msg_id", msg.ID), slog.Error(err))
+			}
+		} else {
+			select {
+			case <-ctx.Done():
+				logger.Warn(context.Background(), "cannot record dispatch success result", slog.Error(ctx.Err()))
+				return ctx.Err()
+			case success <- n.newSuccessfulDispatch(msg):
+				logger.Debug(ctx, "message dispatch succeeded", slog.F("digest_msg_id", msg.ID))
+			}
 		}
 	}
 	n.metrics.PendingUpdates.Set(float64(len(success) + len(failure)))
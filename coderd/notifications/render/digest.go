@@ -0,0 +1,71 @@
+package render
+
+import (
+	"strings"
+	"text/template"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/notifications/types"
+)
+
+// DigestTitleTemplate and DigestBodyTemplate are used to render a single message from multiple notifications of the
+// same template for the same user. The body is rendered as markdown, like all other notification bodies.
+const (
+	DigestTitleTemplate = `{{ .NotificationName }} ({{ len .Entries }} notifications)`
+	DigestBodyTemplate  = `Hi {{ .UserName }},
+
+You have {{ len .Entries }} new "{{ .NotificationName }}" notifications.
+{{ range .Entries }}
+---
+
+**{{ .Title }}**
+
+{{ .Body }}
+{{ end }}`
+)
+
+// DigestEntry is a single notification, with its title and body already rendered, which has been coalesced into a
+// digest.
+type DigestEntry struct {
+	Title string
+	Body  string
+}
+
+// digestPayload is the data passed to the digest templates; it exposes all the fields of the underlying message
+// payload alongside the coalesced entries.
+type digestPayload struct {
+	types.MessagePayload
+	Entries []DigestEntry
+}
+
+// Digest renders the title and body of a digest for the given entries, using the given payload for the common fields.
+func Digest(payload types.MessagePayload, entries []DigestEntry, extraFuncs template.FuncMap) (title, body string, err error) {
+	data := digestPayload{MessagePayload: payload, Entries: entries}
+
+	if title, err = digestTemplate(DigestTitleTemplate, data, extraFuncs); err != nil {
+		return "", "", xerrors.Errorf("render digest title: %w", err)
+	}
+	if body, err = digestTemplate(DigestBodyTemplate, data, extraFuncs); err != nil {
+		return "", "", xerrors.Errorf("render digest body: %w", err)
+	}
+
+	return title, body, nil
+}
+
+func digestTemplate(in string, data digestPayload, extraFuncs template.FuncMap) (string, error) {
+	tmpl, err := template.New("digest").
+		Funcs(extraFuncs).
+		Option("missingkey=invalid").
+		Parse(in)
+	if err != nil {
+		return "", xerrors.Errorf("template parse: %w", err)
+	}
+
+	var out strings.Builder
+	if err = tmpl.Execute(&out, data); err != nil {
+		return "", xerrors.Errorf("template execute: %w", err)
+	}
+
+	return out.String(), nil
+}
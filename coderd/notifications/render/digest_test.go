@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/notifications/render"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+)
+
+func TestDigest(t *testing.T) {
+	t.Parallel()
+
+	payload := types.MessagePayload{
+		NotificationName: "Workspace Deleted",
+		UserName:         "Bobby",
+	}
+	entries := []render.DigestEntry{
+		{Title: "Workspace \"alpha\" deleted", Body: "Your workspace **alpha** was deleted."},
+		{Title: "Workspace \"beta\" deleted", Body: "Your workspace **beta** was deleted."},
+	}
+
+	title, body, err := render.Digest(payload, entries, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Workspace Deleted (2 notifications)", title)
+	require.Equal(t, `Hi Bobby,
+
+You have 2 new "Workspace Deleted" notifications.
+
+---
+
+**Workspace "alpha" deleted**
+
+Your workspace **alpha** was deleted.
+
+---
+
+**Workspace "beta" deleted**
+
+Your workspace **beta** was deleted.
+`, body)
+}
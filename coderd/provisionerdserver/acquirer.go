@@ -40,6 +40,10 @@ const (
 // As a backup to pubsub notifications, each domain is allowed to query periodically once every 30s.
 // This ensures jobs are not stuck permanently if the service that created them fails to publish
 // (e.g. a crash).
+//
+// Jobs can be held back by limits on the concurrent jobs of an organization or user. Since such a job
+// becomes available when another job completes rather than when it is posted, all domains are
+// notified when a job completes if limits are set.
 type Acquirer struct {
 	ctx    context.Context
 	logger slog.Logger
@@ -49,12 +53,26 @@ type Acquirer struct {
 	mu sync.Mutex
 	q  map[dKey]domain
 
+	maxJobsPerOrganization int32
+	maxJobsPerUser         int32
+
 	// testing only
 	backupPollDuration time.Duration
 }
 
 type AcquirerOption func(*Acquirer)
 
+// JobLimits limits the number of jobs that run concurrently for an
+// organization and for the user that initiated them. Zero disables a limit.
+// The limits are enforced when a job is acquired, so concurrent acquirers may
+// exceed them by the number of provisioner daemons.
+func JobLimits(perOrganization, perUser int32) AcquirerOption {
+	return func(a *Acquirer) {
+		a.maxJobsPerOrganization = perOrganization
+		a.maxJobsPerUser = perUser
+	}
+}
+
 func TestingBackupPollDuration(dur time.Duration) AcquirerOption {
 	return func(a *Acquirer) {
 		a.backupPollDuration = dur
@@ -130,8 +148,10 @@ func (a *Acquirer) AcquireJob(
 					UUID:  worker,
 					Valid: true,
 				},
-				Types: pt,
-				Tags:  dbTags,
+				Types:                         pt,
+				Tags:                          dbTags,
+				MaxRunningJobsPerOrganization: a.maxJobsPerOrganization,
+				MaxRunningJobsPerUser:         a.maxJobsPerUser,
 			})
 			if xerrors.Is(err, sql.ErrNoRows) {
 				logger.Debug(ctx, "no job available")
@@ -316,6 +336,27 @@ func (a *Acquirer) subscribe() {
 		bkoff.Reset()
 		a.logger.Debug(a.ctx, "subscribed to job postings")
 
+		if a.maxJobsPerOrganization > 0 || a.maxJobsPerUser > 0 {
+			var cancelCompleted context.CancelFunc
+			err = backoff.Retry(func() error {
+				cancelFn, err := a.ps.SubscribeWithErr(provisionerjobs.EventJobCompleted, a.jobCompleted)
+				if err != nil {
+					a.logger.Warn(a.ctx, "failed to subscribe to job completions", slog.Error(err))
+					return err
+				}
+				cancelCompleted = cancelFn
+				return nil
+			}, bkoff)
+			if err != nil {
+				if a.ctx.Err() == nil {
+					a.logger.Error(a.ctx, "code bug: retry failed before context canceled", slog.Error(err))
+				}
+				return
+			}
+			defer cancelCompleted()
+			a.logger.Debug(a.ctx, "subscribed to job completions")
+		}
+
 		// unblock the outer function from returning
 		subscribed <- struct{}{}
 
@@ -358,6 +399,22 @@ func (a *Acquirer) jobPosted(ctx context.Context, message []byte, err error) {
 	}
 }
 
+// jobCompleted wakes up all domains, since any job held back by the concurrent
+// job limits may be acquirable now.
+func (a *Acquirer) jobCompleted(ctx context.Context, _ []byte, err error) {
+	if xerrors.Is(err, pubsub.ErrDroppedMessages) {
+		a.logger.Warn(a.ctx, "pubsub may have dropped job completions")
+		a.clearOrPendAll()
+		return
+	}
+	if err != nil {
+		a.logger.Warn(a.ctx, "unhandled pubsub error", slog.Error(err))
+		return
+	}
+	a.logger.Debug(ctx, "got job completion")
+	a.clearOrPendAll()
+}
+
 func (a *Acquirer) clearOrPendAll() {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -20,6 +20,7 @@ import (
 	"cdr.dev/slog"
 	"cdr.dev/slog/sloggers/slogtest"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbmem"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
@@ -550,6 +551,85 @@ func TestAcquirer_MatchTags(t *testing.T) {
 	})
 }
 
+// TestAcquirer_Priority tests that jobs are acquired by priority, and that the
+// jobs of users with the fewest running jobs go first among equal priorities.
+func TestAcquirer_Priority(t *testing.T) {
+	t.Parallel()
+	ctx := testutil.Context(t, testutil.WaitShort)
+	db, ps := dbtestutil.NewDB(t)
+	log := slogtest.Make(t, nil).Leveled(slog.LevelDebug)
+	org := dbgen.Organization(t, db, database.Organization{})
+	busyUser, otherUser := uuid.New(), uuid.New()
+	tags := provisionerdserver.Tags{"scope": "organization", "owner": ""}
+
+	// The busy user already has a job running.
+	_ = dbgen.ProvisionerJob(t, db, nil, database.ProvisionerJob{
+		OrganizationID: org.ID,
+		InitiatorID:    busyUser,
+		StartedAt:      sql.NullTime{Time: dbtime.Now(), Valid: true},
+	})
+	now := dbtime.Now()
+	queued := func(initiator uuid.UUID, priority int32, age time.Duration) database.ProvisionerJob {
+		return dbgen.ProvisionerJob(t, db, nil, database.ProvisionerJob{
+			OrganizationID: org.ID,
+			InitiatorID:    initiator,
+			CreatedAt:      now.Add(-age),
+			Tags:           database.StringMap(tags),
+			Priority:       priority,
+		})
+	}
+	templateImport := queued(otherUser, provisionerjobs.PriorityTemplateVersion, 4*time.Minute)
+	autobuild := queued(otherUser, provisionerjobs.PriorityBackground, 3*time.Minute)
+	busyBuild := queued(busyUser, provisionerjobs.PriorityInteractive, 2*time.Minute)
+	otherBuild := queued(otherUser, provisionerjobs.PriorityInteractive, time.Minute)
+
+	acq := provisionerdserver.NewAcquirer(ctx, log, db, ps)
+	for _, want := range []database.ProvisionerJob{otherBuild, busyBuild, autobuild, templateImport} {
+		job, err := acq.AcquireJob(ctx, org.ID, uuid.New(), []database.ProvisionerType{database.ProvisionerTypeEcho}, tags)
+		require.NoError(t, err)
+		require.Equal(t, want.ID, job.ID)
+	}
+}
+
+// TestAcquirer_JobLimits tests that jobs over the concurrent job limit of
+// their initiator are held back until one of their jobs completes.
+func TestAcquirer_JobLimits(t *testing.T) {
+	t.Parallel()
+	ctx := testutil.Context(t, testutil.WaitShort)
+	db, ps := dbtestutil.NewDB(t)
+	log := slogtest.Make(t, nil).Leveled(slog.LevelDebug)
+	org := dbgen.Organization(t, db, database.Organization{})
+	user := uuid.New()
+	tags := provisionerdserver.Tags{"scope": "organization", "owner": ""}
+
+	running := dbgen.ProvisionerJob(t, db, nil, database.ProvisionerJob{
+		OrganizationID: org.ID,
+		InitiatorID:    user,
+		StartedAt:      sql.NullTime{Time: dbtime.Now(), Valid: true},
+	})
+	queued := dbgen.ProvisionerJob(t, db, nil, database.ProvisionerJob{
+		OrganizationID: org.ID,
+		InitiatorID:    user,
+		Tags:           database.StringMap(tags),
+	})
+
+	acq := provisionerdserver.NewAcquirer(ctx, log, db, ps, provisionerdserver.JobLimits(0, 1))
+	acquiree := newTestAcquiree(t, org.ID, uuid.New(), []database.ProvisionerType{database.ProvisionerTypeEcho}, tags)
+	acquiree.startAcquire(ctx, acq)
+	acquiree.requireBlocked()
+
+	err := db.UpdateProvisionerJobWithCompleteByID(ctx, database.UpdateProvisionerJobWithCompleteByIDParams{
+		ID:          running.ID,
+		UpdatedAt:   dbtime.Now(),
+		CompletedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+	err = provisionerjobs.CompleteJob(ps, running)
+	require.NoError(t, err)
+	job := acquiree.success(ctx)
+	require.Equal(t, queued.ID, job.ID)
+}
+
 func postJob(t *testing.T, ps pubsub.Pubsub, pt database.ProvisionerType, tags provisionerdserver.Tags) {
 	t.Helper()
 	msg, err := json.Marshal(provisionerjobs.JobPosting{
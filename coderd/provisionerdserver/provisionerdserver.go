@@ -32,6 +32,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
 	"github.com/coder/coder/v2/coderd/database/pubsub"
 	"github.com/coder/coder/v2/coderd/externalauth"
 	"github.com/coder/coder/v2/coderd/notifications"
@@ -473,110 +474,51 @@ func (s *server) acquireProtoJob(ctx context.Context, job database.ProvisionerJo
 		if err != nil {
 			return nil, failJob(fmt.Sprintf("get owner: %s", err))
 		}
-		var ownerSSHPublicKey, ownerSSHPrivateKey string
-		if ownerSSHKey, err := s.Database.GetGitSSHKey(ctx, owner.ID); err != nil {
-			if !xerrors.Is(err, sql.ErrNoRows) {
-				return nil, failJob(fmt.Sprintf("get owner ssh key: %s", err))
-			}
-		} else {
-			ownerSSHPublicKey = ownerSSHKey.PublicKey
-			ownerSSHPrivateKey = ownerSSHKey.PrivateKey
-		}
-		ownerGroups, err := s.Database.GetGroups(ctx, database.GetGroupsParams{
-			HasMemberID:    owner.ID,
-			OrganizationID: s.OrganizationID,
-		})
-		if err != nil {
-			return nil, failJob(fmt.Sprintf("get owner group names: %s", err))
-		}
-		ownerGroupNames := []string{}
-		for _, group := range ownerGroups {
-			ownerGroupNames = append(ownerGroupNames, group.Group.Name)
-		}
 		err = s.Pubsub.Publish(codersdk.WorkspaceNotifyChannel(workspace.ID), []byte{})
 		if err != nil {
 			return nil, failJob(fmt.Sprintf("publish workspace update: %s", err))
 		}
 
-		var workspaceOwnerOIDCAccessToken string
-		if s.OIDCConfig != nil {
-			workspaceOwnerOIDCAccessToken, err = obtainOIDCAccessToken(ctx, s.Database, s.OIDCConfig, owner.ID)
-			if err != nil {
-				return nil, failJob(fmt.Sprintf("obtain OIDC access token: %s", err))
-			}
+		metadata, externalAuthProviders, err := s.workspaceBuildMetadata(ctx, workspace, owner, template, templateVersion)
+		if err != nil {
+			return nil, failJob(err.Error())
 		}
 
 		var sessionToken string
 		switch workspaceBuild.Transition {
 		case database.WorkspaceTransitionStart:
-			sessionToken, err = s.regenerateSessionToken(ctx, owner, workspace)
+			sessionToken, err = s.regenerateSessionToken(ctx, owner, workspaceSessionTokenName(workspace))
 			if err != nil {
 				return nil, failJob(fmt.Sprintf("regenerate session token: %s", err))
 			}
 		case database.WorkspaceTransitionStop, database.WorkspaceTransitionDelete:
-			err = deleteSessionToken(ctx, s.Database, workspace)
+			err = deleteSessionToken(ctx, s.Database, owner.ID, workspaceSessionTokenName(workspace))
 			if err != nil {
 				return nil, failJob(fmt.Sprintf("delete session token: %s", err))
 			}
 		}
+		if workspaceBuild.Transition == database.WorkspaceTransitionDelete {
+			err = deleteSessionToken(ctx, s.Database, owner.ID, workspaceDryRunSessionTokenName(workspace))
+			if err != nil {
+				return nil, failJob(fmt.Sprintf("delete dry-run session token: %s", err))
+			}
+		}
 
 		transition, err := convertWorkspaceTransition(workspaceBuild.Transition)
 		if err != nil {
 			return nil, failJob(fmt.Sprintf("convert workspace transition: %s", err))
 		}
+		metadata.WorkspaceTransition = transition
+		metadata.WorkspaceOwnerSessionToken = sessionToken
+		metadata.WorkspaceBuildId = workspaceBuild.ID.String()
 
 		workspaceBuildParameters, err := s.Database.GetWorkspaceBuildParameters(ctx, workspaceBuild.ID)
 		if err != nil {
 			return nil, failJob(fmt.Sprintf("get workspace build parameters: %s", err))
 		}
-
-		dbExternalAuthProviders := []database.ExternalAuthProvider{}
-		err = json.Unmarshal(templateVersion.ExternalAuthProviders, &dbExternalAuthProviders)
+		templateVersionParameters, err := s.Database.GetTemplateVersionParameters(ctx, templateVersion.ID)
 		if err != nil {
-			return nil, xerrors.Errorf("failed to deserialize external_auth_providers value: %w", err)
-		}
-
-		externalAuthProviders := make([]*sdkproto.ExternalAuthProvider, 0, len(dbExternalAuthProviders))
-		for _, p := range dbExternalAuthProviders {
-			link, err := s.Database.GetExternalAuthLink(ctx, database.GetExternalAuthLinkParams{
-				ProviderID: p.ID,
-				UserID:     owner.ID,
-			})
-			if errors.Is(err, sql.ErrNoRows) {
-				continue
-			}
-			if err != nil {
-				return nil, failJob(fmt.Sprintf("acquire external auth link: %s", err))
-			}
-			var config *externalauth.Config
-			for _, c := range s.ExternalAuthConfigs {
-				if c.ID != p.ID {
-					continue
-				}
-				config = c
-				break
-			}
-			// We weren't able to find a matching config for the ID!
-			if config == nil {
-				s.Logger.Warn(ctx, "workspace build job is missing external auth provider",
-					slog.F("provider_id", p.ID),
-					slog.F("template_version_id", templateVersion.ID),
-					slog.F("workspace_id", workspaceBuild.WorkspaceID))
-				continue
-			}
-
-			refreshed, err := config.RefreshToken(ctx, s.Database, link)
-			if err != nil && !externalauth.IsInvalidTokenError(err) {
-				return nil, failJob(fmt.Sprintf("refresh external auth link %q: %s", p.ID, err))
-			}
-			if err != nil {
-				// Invalid tokens are skipped
-				continue
-			}
-			externalAuthProviders = append(externalAuthProviders, &sdkproto.ExternalAuthProvider{
-				Id:          p.ID,
-				AccessToken: refreshed.OAuthAccessToken,
-			})
+			return nil, failJob(fmt.Sprintf("get template version parameters: %s", err))
 		}
 
 		protoJob.Type = &proto.AcquiredJob_WorkspaceBuild_{
@@ -584,29 +526,11 @@ func (s *server) acquireProtoJob(ctx context.Context, job database.ProvisionerJo
 				WorkspaceBuildId:      workspaceBuild.ID.String(),
 				WorkspaceName:         workspace.Name,
 				State:                 workspaceBuild.ProvisionerState,
-				RichParameterValues:   convertRichParameterValues(workspaceBuildParameters),
+				RichParameterValues:   convertRichParameterValues(workspaceBuildParameters, templateVersionParameters),
 				VariableValues:        asVariableValues(templateVariables),
 				ExternalAuthProviders: externalAuthProviders,
-				Metadata: &sdkproto.Metadata{
-					CoderUrl:                      s.AccessURL.String(),
-					WorkspaceTransition:           transition,
-					WorkspaceName:                 workspace.Name,
-					WorkspaceOwner:                owner.Username,
-					WorkspaceOwnerEmail:           owner.Email,
-					WorkspaceOwnerName:            owner.Name,
-					WorkspaceOwnerGroups:          ownerGroupNames,
-					WorkspaceOwnerOidcAccessToken: workspaceOwnerOIDCAccessToken,
-					WorkspaceId:                   workspace.ID.String(),
-					WorkspaceOwnerId:              owner.ID.String(),
-					TemplateId:                    template.ID.String(),
-					TemplateName:                  template.Name,
-					TemplateVersion:               templateVersion.Name,
-					WorkspaceOwnerSessionToken:    sessionToken,
-					WorkspaceOwnerSshPublicKey:    ownerSSHPublicKey,
-					WorkspaceOwnerSshPrivateKey:   ownerSSHPrivateKey,
-					WorkspaceBuildId:              workspaceBuild.ID.String(),
-				},
-				LogLevel: input.LogLevel,
+				Metadata:              metadata,
+				LogLevel:              input.LogLevel,
 			},
 		}
 	case database.ProvisionerJobTypeTemplateVersionDryRun:
@@ -624,15 +548,60 @@ func (s *server) acquireProtoJob(ctx context.Context, job database.ProvisionerJo
 		if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
 			return nil, failJob(fmt.Sprintf("get template version variables: %s", err))
 		}
+		templateVersionParameters, err := s.Database.GetTemplateVersionParameters(ctx, templateVersion.ID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get template version parameters: %s", err))
+		}
+
+		metadata := &sdkproto.Metadata{
+			CoderUrl:      s.AccessURL.String(),
+			WorkspaceName: input.WorkspaceName,
+		}
+		var (
+			state                 []byte
+			externalAuthProviders []*sdkproto.ExternalAuthProvider
+		)
+		if input.WorkspaceID != uuid.Nil {
+			// Plan against the state of the workspace with the metadata a
+			// build of the workspace would get, so the resource changes
+			// reflect what building the new version would do.
+			workspace, err := s.Database.GetWorkspaceByID(ctx, input.WorkspaceID)
+			if err != nil {
+				return nil, failJob(fmt.Sprintf("get workspace: %s", err))
+			}
+			workspaceBuild, err := s.Database.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspace.ID)
+			if err != nil {
+				return nil, failJob(fmt.Sprintf("get latest workspace build: %s", err))
+			}
+			owner, err := s.Database.GetUserByID(ctx, workspace.OwnerID)
+			if err != nil {
+				return nil, failJob(fmt.Sprintf("get owner: %s", err))
+			}
+			template, err := s.Database.GetTemplateByID(ctx, workspace.TemplateID)
+			if err != nil {
+				return nil, failJob(fmt.Sprintf("get template: %s", err))
+			}
+			metadata, externalAuthProviders, err = s.workspaceBuildMetadata(ctx, workspace, owner, template, templateVersion)
+			if err != nil {
+				return nil, failJob(err.Error())
+			}
+			metadata.WorkspaceOwnerSessionToken, err = s.regenerateSessionToken(ctx, owner, workspaceDryRunSessionTokenName(workspace))
+			if err != nil {
+				return nil, failJob(fmt.Sprintf("regenerate session token: %s", err))
+			}
+			// The plan stands in for a new build, which gets a new ID.
+			metadata.WorkspaceBuildId = uuid.NewString()
+			state = workspaceBuild.ProvisionerState
+		}
 
 		protoJob.Type = &proto.AcquiredJob_TemplateDryRun_{
 			TemplateDryRun: &proto.AcquiredJob_TemplateDryRun{
-				RichParameterValues: convertRichParameterValues(input.RichParameterValues),
-				VariableValues:      asVariableValues(templateVariables),
-				Metadata: &sdkproto.Metadata{
-					CoderUrl:      s.AccessURL.String(),
-					WorkspaceName: input.WorkspaceName,
-				},
+				RichParameterValues:   convertRichParameterValues(input.RichParameterValues, templateVersionParameters),
+				VariableValues:        asVariableValues(templateVariables),
+				Metadata:              metadata,
+				State:                 state,
+				RefreshOnly:           input.RefreshOnly,
+				ExternalAuthProviders: externalAuthProviders,
 			},
 		}
 	case database.ProvisionerJobTypeTemplateVersionImport:
@@ -960,6 +929,11 @@ func (s *server) FailJob(ctx context.Context, failJob *proto.FailedJob) (*proto.
 		Valid:  failJob.ErrorCode != "",
 	}
 
+	err = s.deleteDryRunSessionToken(ctx, job)
+	if err != nil {
+		return nil, xerrors.Errorf("delete dry-run session token: %w", err)
+	}
+
 	err = s.Database.UpdateProvisionerJobWithCompleteByID(ctx, database.UpdateProvisionerJobWithCompleteByIDParams{
 		ID:          jobID,
 		CompletedAt: job.CompletedAt,
@@ -1053,11 +1027,13 @@ func (s *server) FailJob(ctx context.Context, failJob *proto.FailedJob) (*proto.
 
 				// We pass the below information to the Auditor so that it
 				// can form a friendly string for the user to view in the UI.
+				bag := audit.BaggageFromContext(ctx)
 				buildResourceInfo := audit.AdditionalFields{
-					WorkspaceName: workspace.Name,
-					BuildNumber:   strconv.FormatInt(int64(build.BuildNumber), 10),
-					BuildReason:   database.BuildReason(string(build.Reason)),
-					WorkspaceID:   workspace.ID,
+					WorkspaceName:   workspace.Name,
+					BuildNumber:     strconv.FormatInt(int64(build.BuildNumber), 10),
+					BuildReason:     database.BuildReason(string(build.Reason)),
+					WorkspaceID:     workspace.ID,
+					BulkOperationID: bag.BulkOperationID,
 				}
 
 				wriBytes, err := json.Marshal(buildResourceInfo)
@@ -1065,8 +1041,6 @@ func (s *server) FailJob(ctx context.Context, failJob *proto.FailedJob) (*proto.
 					s.Logger.Error(ctx, "marshal workspace resource info for failed job", slog.Error(err))
 				}
 
-				bag := audit.BaggageFromContext(ctx)
-
 				audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.WorkspaceBuild]{
 					Audit:            *auditor,
 					Log:              s.Logger,
@@ -1093,6 +1067,12 @@ func (s *server) FailJob(ctx context.Context, failJob *proto.FailedJob) (*proto.
 		s.Logger.Error(ctx, "failed to publish end of job logs", slog.F("job_id", jobID), slog.Error(err))
 		return nil, xerrors.Errorf("publish end of job logs: %w", err)
 	}
+	// Acquirers retry jobs held back by concurrent job limits on their backup
+	// poll, so a failure to publish only delays them.
+	err = provisionerjobs.CompleteJob(s.Pubsub, job)
+	if err != nil {
+		s.Logger.Warn(ctx, "failed to publish job completion", slog.F("job_id", jobID), slog.Error(err))
+	}
 	return &proto.Empty{}, nil
 }
 
@@ -1573,11 +1553,13 @@ func (s *server) CompleteJob(ctx context.Context, completed *proto.CompletedJob)
 
 			// We pass the below information to the Auditor so that it
 			// can form a friendly string for the user to view in the UI.
+			bag := audit.BaggageFromContext(ctx)
 			buildResourceInfo := audit.AdditionalFields{
-				WorkspaceName: workspace.Name,
-				BuildNumber:   strconv.FormatInt(int64(workspaceBuild.BuildNumber), 10),
-				BuildReason:   database.BuildReason(string(workspaceBuild.Reason)),
-				WorkspaceID:   workspace.ID,
+				WorkspaceName:   workspace.Name,
+				BuildNumber:     strconv.FormatInt(int64(workspaceBuild.BuildNumber), 10),
+				BuildReason:     database.BuildReason(string(workspaceBuild.Reason)),
+				WorkspaceID:     workspace.ID,
+				BulkOperationID: bag.BulkOperationID,
 			}
 
 			wriBytes, err := json.Marshal(buildResourceInfo)
@@ -1585,8 +1567,6 @@ func (s *server) CompleteJob(ctx context.Context, completed *proto.CompletedJob)
 				s.Logger.Error(ctx, "marshal resource info for successful job", slog.Error(err))
 			}
 
-			bag := audit.BaggageFromContext(ctx)
-
 			audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.WorkspaceBuild]{
 				Audit:            *auditor,
 				Log:              s.Logger,
@@ -1607,7 +1587,18 @@ func (s *server) CompleteJob(ctx context.Context, completed *proto.CompletedJob)
 			return nil, xerrors.Errorf("update workspace: %w", err)
 		}
 	case *proto.CompletedJob_TemplateDryRun_:
-		for _, resource := range jobType.TemplateDryRun.Resources {
+		var input TemplateVersionDryRunJob
+		err = json.Unmarshal(job.Input, &input)
+		if err != nil {
+			return nil, xerrors.Errorf("unmarshal template version dry-run input: %w", err)
+		}
+		// Drift checks run for every running workspace on an interval and
+		// only need the resource changes, so their resources are not kept.
+		resources := jobType.TemplateDryRun.Resources
+		if input.RefreshOnly {
+			resources = nil
+		}
+		for _, resource := range resources {
 			s.Logger.Info(ctx, "inserting template dry-run job resource",
 				slog.F("job_id", job.ID.String()),
 				slog.F("resource_name", resource.Name),
@@ -1618,6 +1609,18 @@ func (s *server) CompleteJob(ctx context.Context, completed *proto.CompletedJob)
 				return nil, xerrors.Errorf("insert resource: %w", err)
 			}
 		}
+		err = insertResourceChanges(ctx, s.Database, jobID, jobType.TemplateDryRun.ResourceChanges)
+		if err != nil {
+			return nil, xerrors.Errorf("insert resource changes: %w", err)
+		}
+		err = s.completeDriftCheck(ctx, jobID, jobType.TemplateDryRun.ResourceChanges)
+		if err != nil {
+			return nil, xerrors.Errorf("complete drift check: %w", err)
+		}
+		err = s.deleteDryRunSessionToken(ctx, job)
+		if err != nil {
+			return nil, xerrors.Errorf("delete dry-run session token: %w", err)
+		}
 
 		err = s.Database.UpdateProvisionerJobWithCompleteByID(ctx, database.UpdateProvisionerJobWithCompleteByIDParams{
 			ID:        jobID,
@@ -1654,6 +1657,12 @@ func (s *server) CompleteJob(ctx context.Context, completed *proto.CompletedJob)
 		s.Logger.Error(ctx, "failed to publish end of job logs", slog.F("job_id", jobID), slog.Error(err))
 		return nil, xerrors.Errorf("publish end of job logs: %w", err)
 	}
+	// Acquirers retry jobs held back by concurrent job limits on their backup
+	// poll, so a failure to publish only delays them.
+	err = provisionerjobs.CompleteJob(s.Pubsub, job)
+	if err != nil {
+		s.Logger.Warn(ctx, "failed to publish job completion", slog.F("job_id", jobID), slog.Error(err))
+	}
 
 	s.Logger.Debug(ctx, "stage CompleteJob done", slog.F("job_id", jobID))
 	return &proto.Empty{}, nil
@@ -1696,12 +1705,101 @@ func (s *server) notifyWorkspaceDeleted(ctx context.Context, workspace database.
 	}
 }
 
+// completeDriftCheck records whether the workspace build of a drift check has
+// drifted. Owners are notified when their workspace starts to drift, if
+// configured.
+func (s *server) completeDriftCheck(ctx context.Context, jobID uuid.UUID, changes []*sdkproto.ResourceChange) error {
+	previous, err := s.Database.GetWorkspaceBuildDriftByJobID(ctx, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The job is not a drift check, or a newer check of the build was
+		// started in the meantime.
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("get workspace build drift: %w", err)
+	}
+	build, err := s.Database.GetWorkspaceBuildByID(ctx, previous.WorkspaceBuildID)
+	if err != nil {
+		return xerrors.Errorf("get workspace build: %w", err)
+	}
+	workspaceID := build.WorkspaceID
+	drift, err := s.Database.UpdateWorkspaceBuildDriftByJobID(ctx, database.UpdateWorkspaceBuildDriftByJobIDParams{
+		CheckedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+		Drifted:   len(changes) > 0,
+		JobID:     jobID,
+	})
+	if err != nil {
+		return xerrors.Errorf("update workspace build drift: %w", err)
+	}
+	err = s.Pubsub.Publish(codersdk.WorkspaceNotifyChannel(workspaceID), []byte{})
+	if err != nil {
+		s.Logger.Warn(ctx, "failed to publish workspace update", slog.F("workspace_id", workspaceID), slog.Error(err))
+	}
+
+	if !drift.Drifted || previous.Drifted || s.DeploymentValues.WorkspaceDrift.Action != codersdk.WorkspaceDriftActionNotify {
+		return nil
+	}
+	workspace, err := s.Database.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return xerrors.Errorf("get workspace: %w", err)
+	}
+	s.notifyWorkspaceDrifted(ctx, workspace, changes)
+	return nil
+}
+
+func (s *server) notifyWorkspaceDrifted(ctx context.Context, workspace database.Workspace, changes []*sdkproto.ResourceChange) {
+	resources := make([]string, 0, len(changes))
+	for _, change := range changes {
+		what := "changed"
+		if change.Action == sdkproto.ResourceChange_DESTROY {
+			what = "deleted"
+		}
+		resources = append(resources, fmt.Sprintf("- `%s` was %s", change.Address, what))
+	}
+
+	if _, err := s.NotificationsEnqueuer.Enqueue(ctx, workspace.OwnerID, notifications.TemplateWorkspaceDrifted,
+		map[string]string{
+			"name":      workspace.Name,
+			"resources": strings.Join(resources, "\n"),
+		}, "provisionerdserver",
+		// Associate this notification with all the related entities.
+		workspace.ID, workspace.OwnerID, workspace.TemplateID, workspace.OrganizationID,
+	); err != nil {
+		s.Logger.Warn(ctx, "failed to notify of workspace drift", slog.Error(err))
+	}
+}
+
 func (s *server) startTrace(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
 	return s.Tracer.Start(ctx, name, append(opts, trace.WithAttributes(
 		semconv.ServiceNameKey.String("coderd.provisionerd"),
 	))...)
 }
 
+func insertResourceChanges(ctx context.Context, db database.Store, jobID uuid.UUID, changes []*sdkproto.ResourceChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	params := database.InsertProvisionerJobResourceChangesParams{
+		JobID:   jobID,
+		Address: make([]string, 0, len(changes)),
+		Type:    make([]string, 0, len(changes)),
+		Name:    make([]string, 0, len(changes)),
+		Action:  make([]database.ProvisionerJobResourceChangeAction, 0, len(changes)),
+	}
+	for _, change := range changes {
+		action, err := convertResourceChangeAction(change.Action)
+		if err != nil {
+			return err
+		}
+		params.Address = append(params.Address, change.Address)
+		params.Type = append(params.Type, change.Type)
+		params.Name = append(params.Name, change.Name)
+		params.Action = append(params.Action, action)
+	}
+	_, err := db.InsertProvisionerJobResourceChanges(ctx, params)
+	return err
+}
+
 func InsertWorkspaceResource(ctx context.Context, db database.Store, jobID uuid.UUID, transition database.WorkspaceTransition, protoResource *sdkproto.Resource, snapshot *telemetry.Snapshot) error {
 	resource, err := db.InsertWorkspaceResource(ctx, database.InsertWorkspaceResourceParams{
 		ID:         uuid.New(),
@@ -1949,15 +2047,149 @@ func InsertWorkspaceResource(ctx context.Context, db database.Store, jobID uuid.
 	return nil
 }
 
+// workspaceBuildMetadata returns the metadata and external auth provider tokens
+// passed to the provisioner when building the workspace with the template
+// version. The transition, session token and build ID depend on the build and
+// are left to the caller.
+func (s *server) workspaceBuildMetadata(ctx context.Context, workspace database.Workspace, owner database.User, template database.Template, templateVersion database.TemplateVersion) (*sdkproto.Metadata, []*sdkproto.ExternalAuthProvider, error) {
+	var ownerSSHPublicKey, ownerSSHPrivateKey string
+	if ownerSSHKey, err := s.Database.GetGitSSHKey(ctx, owner.ID); err != nil {
+		if !xerrors.Is(err, sql.ErrNoRows) {
+			return nil, nil, xerrors.Errorf("get owner ssh key: %w", err)
+		}
+	} else {
+		ownerSSHPublicKey = ownerSSHKey.PublicKey
+		ownerSSHPrivateKey = ownerSSHKey.PrivateKey
+	}
+	ownerGroups, err := s.Database.GetGroups(ctx, database.GetGroupsParams{
+		HasMemberID:    owner.ID,
+		OrganizationID: s.OrganizationID,
+	})
+	if err != nil {
+		return nil, nil, xerrors.Errorf("get owner group names: %w", err)
+	}
+	ownerGroupNames := []string{}
+	for _, group := range ownerGroups {
+		ownerGroupNames = append(ownerGroupNames, group.Group.Name)
+	}
+
+	var workspaceOwnerOIDCAccessToken string
+	if s.OIDCConfig != nil {
+		workspaceOwnerOIDCAccessToken, err = obtainOIDCAccessToken(ctx, s.Database, s.OIDCConfig, owner.ID)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("obtain OIDC access token: %w", err)
+		}
+	}
+
+	dbExternalAuthProviders := []database.ExternalAuthProvider{}
+	err = json.Unmarshal(templateVersion.ExternalAuthProviders, &dbExternalAuthProviders)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("failed to deserialize external_auth_providers value: %w", err)
+	}
+
+	externalAuthProviders := make([]*sdkproto.ExternalAuthProvider, 0, len(dbExternalAuthProviders))
+	for _, p := range dbExternalAuthProviders {
+		link, err := s.Database.GetExternalAuthLink(ctx, database.GetExternalAuthLinkParams{
+			ProviderID: p.ID,
+			UserID:     owner.ID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, xerrors.Errorf("acquire external auth link: %w", err)
+		}
+		var config *externalauth.Config
+		for _, c := range s.ExternalAuthConfigs {
+			if c.ID != p.ID {
+				continue
+			}
+			config = c
+			break
+		}
+		// We weren't able to find a matching config for the ID!
+		if config == nil {
+			s.Logger.Warn(ctx, "provisioner job is missing external auth provider",
+				slog.F("provider_id", p.ID),
+				slog.F("template_version_id", templateVersion.ID),
+				slog.F("workspace_id", workspace.ID))
+			continue
+		}
+
+		refreshed, err := config.RefreshToken(ctx, s.Database, link)
+		if err != nil && !externalauth.IsInvalidTokenError(err) {
+			return nil, nil, xerrors.Errorf("refresh external auth link %q: %w", p.ID, err)
+		}
+		if err != nil {
+			// Invalid tokens are skipped
+			continue
+		}
+		externalAuthProviders = append(externalAuthProviders, &sdkproto.ExternalAuthProvider{
+			Id:          p.ID,
+			AccessToken: refreshed.OAuthAccessToken,
+		})
+	}
+
+	return &sdkproto.Metadata{
+		CoderUrl:                      s.AccessURL.String(),
+		WorkspaceName:                 workspace.Name,
+		WorkspaceOwner:                owner.Username,
+		WorkspaceOwnerEmail:           owner.Email,
+		WorkspaceOwnerName:            owner.Name,
+		WorkspaceOwnerGroups:          ownerGroupNames,
+		WorkspaceOwnerOidcAccessToken: workspaceOwnerOIDCAccessToken,
+		WorkspaceId:                   workspace.ID.String(),
+		WorkspaceOwnerId:              owner.ID.String(),
+		TemplateId:                    template.ID.String(),
+		TemplateName:                  template.Name,
+		TemplateVersion:               templateVersion.Name,
+		WorkspaceOwnerSshPublicKey:    ownerSSHPublicKey,
+		WorkspaceOwnerSshPrivateKey:   ownerSSHPrivateKey,
+	}, externalAuthProviders, nil
+}
+
 func workspaceSessionTokenName(workspace database.Workspace) string {
 	return fmt.Sprintf("%s_%s_session_token", workspace.OwnerID, workspace.ID)
 }
 
-func (s *server) regenerateSessionToken(ctx context.Context, user database.User, workspace database.Workspace) (string, error) {
+// workspaceDryRunSessionTokenName is the name of the session token passed to
+// dry-runs against the workspace. It is separate from the token of the
+// workspace's builds, so that planning doesn't invalidate the token used by
+// the running workspace.
+func workspaceDryRunSessionTokenName(workspace database.Workspace) string {
+	return fmt.Sprintf("%s_%s_dry_run_session_token", workspace.OwnerID, workspace.ID)
+}
+
+// deleteDryRunSessionToken deletes the session token of a dry-run against a
+// workspace once the dry-run has finished, so that planning doesn't leave a
+// token of the workspace owner behind.
+func (s *server) deleteDryRunSessionToken(ctx context.Context, job database.ProvisionerJob) error {
+	if job.Type != database.ProvisionerJobTypeTemplateVersionDryRun {
+		return nil
+	}
+	var input TemplateVersionDryRunJob
+	err := json.Unmarshal(job.Input, &input)
+	if err != nil {
+		return xerrors.Errorf("unmarshal template version dry-run input: %w", err)
+	}
+	if input.WorkspaceID == uuid.Nil {
+		return nil
+	}
+	workspace, err := s.Database.GetWorkspaceByID(ctx, input.WorkspaceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("get workspace: %w", err)
+	}
+	return deleteSessionToken(ctx, s.Database, workspace.OwnerID, workspaceDryRunSessionTokenName(workspace))
+}
+
+func (s *server) regenerateSessionToken(ctx context.Context, user database.User, tokenName string) (string, error) {
 	newkey, sessionToken, err := apikey.Generate(apikey.CreateParams{
 		UserID:          user.ID,
 		LoginType:       user.LoginType,
-		TokenName:       workspaceSessionTokenName(workspace),
+		TokenName:       tokenName,
 		DefaultLifetime: s.DeploymentValues.Sessions.DefaultTokenDuration.Value(),
 		LifetimeSeconds: int64(s.DeploymentValues.Sessions.MaximumTokenDuration.Value().Seconds()),
 	})
@@ -1966,7 +2198,7 @@ func (s *server) regenerateSessionToken(ctx context.Context, user database.User,
 	}
 
 	err = s.Database.InTx(func(tx database.Store) error {
-		err := deleteSessionToken(ctx, tx, workspace)
+		err := deleteSessionToken(ctx, tx, user.ID, tokenName)
 		if err != nil {
 			return xerrors.Errorf("delete session token: %w", err)
 		}
@@ -1984,11 +2216,11 @@ func (s *server) regenerateSessionToken(ctx context.Context, user database.User,
 	return sessionToken, nil
 }
 
-func deleteSessionToken(ctx context.Context, db database.Store, workspace database.Workspace) error {
+func deleteSessionToken(ctx context.Context, db database.Store, userID uuid.UUID, tokenName string) error {
 	err := db.InTx(func(tx database.Store) error {
 		key, err := tx.GetAPIKeyByName(ctx, database.GetAPIKeyByNameParams{
-			UserID:    workspace.OwnerID,
-			TokenName: workspaceSessionTokenName(workspace),
+			UserID:    userID,
+			TokenName: tokenName,
 		})
 		if err == nil {
 			err = tx.DeleteAPIKeyByID(ctx, key.ID)
@@ -2083,12 +2315,22 @@ func convertLogSource(logSource proto.LogSource) (database.LogSource, error) {
 	}
 }
 
-func convertRichParameterValues(workspaceBuildParameters []database.WorkspaceBuildParameter) []*sdkproto.RichParameterValue {
+// convertRichParameterValues converts the parameter values of a build. The
+// values of ephemeral string parameters are marked sensitive, since they're
+// meant for one-off inputs such as passwords, so that provisioners redact them
+// from the job logs. Booleans and numbers aren't secrets, and masking them
+// would mask every "true" in the logs.
+func convertRichParameterValues(workspaceBuildParameters []database.WorkspaceBuildParameter, templateVersionParameters []database.TemplateVersionParameter) []*sdkproto.RichParameterValue {
+	sensitive := make(map[string]bool, len(templateVersionParameters))
+	for _, parameter := range templateVersionParameters {
+		sensitive[parameter.Name] = parameter.Ephemeral && parameter.Type == "string"
+	}
 	protoParameters := make([]*sdkproto.RichParameterValue, len(workspaceBuildParameters))
 	for i, buildParameter := range workspaceBuildParameters {
 		protoParameters[i] = &sdkproto.RichParameterValue{
-			Name:  buildParameter.Name,
-			Value: buildParameter.Value,
+			Name:      buildParameter.Name,
+			Value:     buildParameter.Value,
+			Sensitive: sensitive[buildParameter.Name],
 		}
 	}
 	return protoParameters
@@ -2119,6 +2361,21 @@ func convertWorkspaceTransition(transition database.WorkspaceTransition) (sdkpro
 	}
 }
 
+func convertResourceChangeAction(action sdkproto.ResourceChange_Action) (database.ProvisionerJobResourceChangeAction, error) {
+	switch action {
+	case sdkproto.ResourceChange_CREATE:
+		return database.ProvisionerJobResourceChangeActionCreate, nil
+	case sdkproto.ResourceChange_UPDATE:
+		return database.ProvisionerJobResourceChangeActionUpdate, nil
+	case sdkproto.ResourceChange_REPLACE:
+		return database.ProvisionerJobResourceChangeActionReplace, nil
+	case sdkproto.ResourceChange_DESTROY:
+		return database.ProvisionerJobResourceChangeActionDestroy, nil
+	default:
+		return "", xerrors.Errorf("unrecognized resource change action: %q", action)
+	}
+}
+
 func auditActionFromTransition(transition database.WorkspaceTransition) database.AuditAction {
 	switch transition {
 	case database.WorkspaceTransitionStart:
@@ -2146,9 +2403,15 @@ type WorkspaceProvisionJob struct {
 
 // TemplateVersionDryRunJob is the payload for the "template_version_dry_run" job type.
 type TemplateVersionDryRunJob struct {
-	TemplateVersionID   uuid.UUID                          `json:"template_version_id"`
+	TemplateVersionID uuid.UUID `json:"template_version_id"`
+	// WorkspaceID is set when the dry-run plans against the state of an
+	// existing workspace.
+	WorkspaceID         uuid.UUID                          `json:"workspace_id,omitempty"`
 	WorkspaceName       string                             `json:"workspace_name"`
 	RichParameterValues []database.WorkspaceBuildParameter `json:"rich_parameter_values"`
+	// RefreshOnly is set for the drift checks of workspaces. The resource
+	// changes then describe how the infrastructure drifted from the state.
+	RefreshOnly bool `json:"refresh_only,omitempty"`
 }
 
 func asVariableValues(templateVariables []database.TemplateVersionVariable) []*sdkproto.VariableValue {
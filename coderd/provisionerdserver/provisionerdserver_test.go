@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/url"
 	"strconv"
@@ -427,6 +428,22 @@ func TestAcquireJob(t *testing.T) {
 
 			user := dbgen.User(t, db, database.User{})
 			version := dbgen.TemplateVersion(t, db, database.TemplateVersion{})
+			// Only the values of ephemeral string parameters are secrets.
+			_ = dbgen.TemplateVersionParameter(t, db, database.TemplateVersionParameter{
+				TemplateVersionID: version.ID,
+				Name:              "region",
+			})
+			_ = dbgen.TemplateVersionParameter(t, db, database.TemplateVersionParameter{
+				TemplateVersionID: version.ID,
+				Name:              "otp",
+				Ephemeral:         true,
+			})
+			_ = dbgen.TemplateVersionParameter(t, db, database.TemplateVersionParameter{
+				TemplateVersionID: version.ID,
+				Name:              "rebuild",
+				Type:              "bool",
+				Ephemeral:         true,
+			})
 			file := dbgen.File(t, db, database.File{CreatedBy: user.ID})
 			_ = dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
 				InitiatorID:   user.ID,
@@ -437,6 +454,11 @@ func TestAcquireJob(t *testing.T) {
 				Input: must(json.Marshal(provisionerdserver.TemplateVersionDryRunJob{
 					TemplateVersionID: version.ID,
 					WorkspaceName:     "testing",
+					RichParameterValues: []database.WorkspaceBuildParameter{
+						{Name: "region", Value: "eu-west-1"},
+						{Name: "otp", Value: "482913"},
+						{Name: "rebuild", Value: "true"},
+					},
 				})),
 			})
 
@@ -448,6 +470,11 @@ func TestAcquireJob(t *testing.T) {
 
 			want, err := json.Marshal(&proto.AcquiredJob_TemplateDryRun_{
 				TemplateDryRun: &proto.AcquiredJob_TemplateDryRun{
+					RichParameterValues: []*sdkproto.RichParameterValue{
+						{Name: "region", Value: "eu-west-1"},
+						{Name: "otp", Value: "482913", Sensitive: true},
+						{Name: "rebuild", Value: "true"},
+					},
 					Metadata: &sdkproto.Metadata{
 						CoderUrl:      (&url.URL{}).String(),
 						WorkspaceName: "testing",
@@ -457,6 +484,134 @@ func TestAcquireJob(t *testing.T) {
 			require.NoError(t, err)
 			require.JSONEq(t, string(want), string(got))
 		})
+		t.Run(tc.name+"_TemplateVersionDryRunWorkspace", func(t *testing.T) {
+			t.Parallel()
+			srv, db, ps, pd := setup(t, false, &overrides{
+				externalAuthConfigs: []*externalauth.Config{{
+					ID:                       "github",
+					InstrumentedOAuth2Config: &testutil.OAuth2Config{},
+				}},
+			})
+			ctx := context.Background()
+
+			user := dbgen.User(t, db, database.User{})
+			group := dbgen.Group(t, db, database.Group{
+				Name:           "group",
+				OrganizationID: pd.OrganizationID,
+			})
+			err := db.InsertGroupMember(ctx, database.InsertGroupMemberParams{
+				UserID:  user.ID,
+				GroupID: group.ID,
+			})
+			require.NoError(t, err)
+			sshKey := dbgen.GitSSHKey(t, db, database.GitSSHKey{
+				UserID: user.ID,
+			})
+			dbgen.ExternalAuthLink(t, db, database.ExternalAuthLink{
+				ProviderID: "github",
+				UserID:     user.ID,
+			})
+			template := dbgen.Template(t, db, database.Template{
+				Name:      "template",
+				CreatedBy: user.ID,
+			})
+			version := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+				Name:       "version",
+				TemplateID: uuid.NullUUID{UUID: template.ID, Valid: true},
+				CreatedBy:  user.ID,
+			})
+			err = db.UpdateTemplateVersionExternalAuthProvidersByJobID(ctx, database.UpdateTemplateVersionExternalAuthProvidersByJobIDParams{
+				JobID:                 version.JobID,
+				ExternalAuthProviders: must(json.Marshal([]database.ExternalAuthProvider{{ID: "github"}})),
+				UpdatedAt:             dbtime.Now(),
+			})
+			require.NoError(t, err)
+			workspace := dbgen.Workspace(t, db, database.Workspace{
+				Name:       "workspace",
+				OwnerID:    user.ID,
+				TemplateID: template.ID,
+			})
+			_ = dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+				WorkspaceID:       workspace.ID,
+				TemplateVersionID: version.ID,
+				ProvisionerState:  []byte("state"),
+			})
+			file := dbgen.File(t, db, database.File{CreatedBy: user.ID})
+			_ = dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+				InitiatorID:   user.ID,
+				Provisioner:   database.ProvisionerTypeEcho,
+				StorageMethod: database.ProvisionerStorageMethodFile,
+				FileID:        file.ID,
+				Type:          database.ProvisionerJobTypeTemplateVersionDryRun,
+				Input: must(json.Marshal(provisionerdserver.TemplateVersionDryRunJob{
+					TemplateVersionID: version.ID,
+					WorkspaceID:       workspace.ID,
+				})),
+			})
+
+			job, err := tc.acquire(ctx, srv)
+			require.NoError(t, err)
+
+			got, err := json.Marshal(job.Type)
+			require.NoError(t, err)
+
+			// The dry-run gets its own session token, so that it doesn't
+			// invalidate the token of the running workspace.
+			metadata := job.GetTemplateDryRun().GetMetadata()
+			sessionToken := metadata.GetWorkspaceOwnerSessionToken()
+			require.NotEmpty(t, sessionToken)
+			toks := strings.Split(sessionToken, "-")
+			require.Len(t, toks, 2, "invalid api key")
+			key, err := db.GetAPIKeyByID(ctx, toks[0])
+			require.NoError(t, err)
+			require.Equal(t, user.ID, key.UserID)
+			require.NotEqual(t, fmt.Sprintf("%s_%s_session_token", user.ID, workspace.ID), key.TokenName)
+			buildID := metadata.GetWorkspaceBuildId()
+			require.NotEmpty(t, buildID)
+
+			// The dry-run plans against the state of the workspace, with the
+			// metadata a build of the workspace gets.
+			want, err := json.Marshal(&proto.AcquiredJob_TemplateDryRun_{
+				TemplateDryRun: &proto.AcquiredJob_TemplateDryRun{
+					Metadata: &sdkproto.Metadata{
+						CoderUrl:                    (&url.URL{}).String(),
+						WorkspaceName:               workspace.Name,
+						WorkspaceId:                 workspace.ID.String(),
+						WorkspaceOwner:              user.Username,
+						WorkspaceOwnerId:            user.ID.String(),
+						WorkspaceOwnerEmail:         user.Email,
+						WorkspaceOwnerName:          user.Name,
+						WorkspaceOwnerGroups:        []string{group.Name},
+						WorkspaceOwnerSessionToken:  sessionToken,
+						WorkspaceOwnerSshPublicKey:  sshKey.PublicKey,
+						WorkspaceOwnerSshPrivateKey: sshKey.PrivateKey,
+						WorkspaceBuildId:            buildID,
+						TemplateId:                  template.ID.String(),
+						TemplateName:                template.Name,
+						TemplateVersion:             version.Name,
+					},
+					State: []byte("state"),
+					ExternalAuthProviders: []*sdkproto.ExternalAuthProvider{{
+						Id:          "github",
+						AccessToken: "access_token",
+					}},
+				},
+			})
+			require.NoError(t, err)
+			require.JSONEq(t, string(want), string(got))
+
+			// The session token is deleted once the dry-run completes, so no
+			// token of the owner is left behind.
+			_, err = srv.CompleteJob(ctx, &proto.CompletedJob{
+				JobId: job.JobId,
+				Type: &proto.CompletedJob_TemplateDryRun_{
+					TemplateDryRun: &proto.CompletedJob_TemplateDryRun{},
+				},
+			})
+			require.NoError(t, err)
+			_, err = db.GetAPIKeyByID(ctx, key.ID)
+			require.ErrorIs(t, err, sql.ErrNoRows)
+		})
 		t.Run(tc.name+"_TemplateVersionImport", func(t *testing.T) {
 			t.Parallel()
 			srv, db, ps, _ := setup(t, false, nil)
@@ -948,6 +1103,50 @@ func TestFailJob(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, workspace.ID, additionalFields.WorkspaceID)
 	})
+	t.Run("TemplateDryRunWorkspace", func(t *testing.T) {
+		t.Parallel()
+		srv, db, _, pd := setup(t, false, &overrides{})
+		user := dbgen.User(t, db, database.User{})
+		workspace := dbgen.Workspace(t, db, database.Workspace{
+			OwnerID: user.ID,
+		})
+		key, _ := dbgen.APIKey(t, db, database.APIKey{
+			UserID:    user.ID,
+			LoginType: database.LoginTypeToken,
+			TokenName: fmt.Sprintf("%s_%s_dry_run_session_token", user.ID, workspace.ID),
+		})
+		job, err := db.InsertProvisionerJob(ctx, database.InsertProvisionerJobParams{
+			ID:            uuid.New(),
+			Provisioner:   database.ProvisionerTypeEcho,
+			StorageMethod: database.ProvisionerStorageMethodFile,
+			Type:          database.ProvisionerJobTypeTemplateVersionDryRun,
+			Input: must(json.Marshal(provisionerdserver.TemplateVersionDryRunJob{
+				TemplateVersionID: uuid.New(),
+				WorkspaceID:       workspace.ID,
+			})),
+		})
+		require.NoError(t, err)
+		_, err = db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
+			WorkerID: uuid.NullUUID{
+				UUID:  pd.ID,
+				Valid: true,
+			},
+			Types: []database.ProvisionerType{database.ProvisionerTypeEcho},
+		})
+		require.NoError(t, err)
+
+		_, err = srv.FailJob(ctx, &proto.FailedJob{
+			JobId: job.ID.String(),
+			Type: &proto.FailedJob_TemplateDryRun_{
+				TemplateDryRun: &proto.FailedJob_TemplateDryRun{},
+			},
+		})
+		require.NoError(t, err)
+
+		// The session token of the dry-run is deleted when it fails, too.
+		_, err = db.GetAPIKeyByID(ctx, key.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
 }
 
 func TestCompleteJob(t *testing.T) {
@@ -1366,35 +1565,73 @@ func TestCompleteJob(t *testing.T) {
 	})
 	t.Run("TemplateDryRun", func(t *testing.T) {
 		t.Parallel()
-		srv, db, _, pd := setup(t, false, &overrides{})
-		job, err := db.InsertProvisionerJob(ctx, database.InsertProvisionerJobParams{
-			ID:            uuid.New(),
-			Provisioner:   database.ProvisionerTypeEcho,
-			Type:          database.ProvisionerJobTypeTemplateVersionDryRun,
-			StorageMethod: database.ProvisionerStorageMethodFile,
-		})
-		require.NoError(t, err)
-		_, err = db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
-			WorkerID: uuid.NullUUID{
-				UUID:  pd.ID,
-				Valid: true,
-			},
-			Types: []database.ProvisionerType{database.ProvisionerTypeEcho},
-		})
-		require.NoError(t, err)
 
-		_, err = srv.CompleteJob(ctx, &proto.CompletedJob{
-			JobId: job.ID.String(),
-			Type: &proto.CompletedJob_TemplateDryRun_{
-				TemplateDryRun: &proto.CompletedJob_TemplateDryRun{
-					Resources: []*sdkproto.Resource{{
-						Name: "something",
-						Type: "aws_instance",
-					}},
-				},
-			},
-		})
-		require.NoError(t, err)
+		for _, refreshOnly := range []bool{false, true} {
+			refreshOnly := refreshOnly
+			t.Run(fmt.Sprintf("RefreshOnly=%t", refreshOnly), func(t *testing.T) {
+				t.Parallel()
+				srv, db, _, pd := setup(t, false, &overrides{})
+				input, err := json.Marshal(provisionerdserver.TemplateVersionDryRunJob{
+					TemplateVersionID: uuid.New(),
+					RefreshOnly:       refreshOnly,
+				})
+				require.NoError(t, err)
+				job, err := db.InsertProvisionerJob(ctx, database.InsertProvisionerJobParams{
+					ID:            uuid.New(),
+					Provisioner:   database.ProvisionerTypeEcho,
+					Type:          database.ProvisionerJobTypeTemplateVersionDryRun,
+					StorageMethod: database.ProvisionerStorageMethodFile,
+					Input:         input,
+				})
+				require.NoError(t, err)
+				_, err = db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
+					WorkerID: uuid.NullUUID{
+						UUID:  pd.ID,
+						Valid: true,
+					},
+					Types: []database.ProvisionerType{database.ProvisionerTypeEcho},
+				})
+				require.NoError(t, err)
+
+				_, err = srv.CompleteJob(ctx, &proto.CompletedJob{
+					JobId: job.ID.String(),
+					Type: &proto.CompletedJob_TemplateDryRun_{
+						TemplateDryRun: &proto.CompletedJob_TemplateDryRun{
+							Resources: []*sdkproto.Resource{{
+								Name: "something",
+								Type: "aws_instance",
+							}},
+							ResourceChanges: []*sdkproto.ResourceChange{{
+								Address: "aws_instance.something",
+								Name:    "something",
+								Type:    "aws_instance",
+								Action:  sdkproto.ResourceChange_REPLACE,
+							}},
+						},
+					},
+				})
+				require.NoError(t, err)
+
+				changes, err := db.GetProvisionerJobResourceChangesByJobID(ctx, job.ID)
+				require.NoError(t, err)
+				require.Equal(t, []database.ProvisionerJobResourceChange{{
+					JobID:   job.ID,
+					Address: "aws_instance.something",
+					Type:    "aws_instance",
+					Name:    "something",
+					Action:  database.ProvisionerJobResourceChangeActionReplace,
+				}}, changes)
+
+				// The resources of drift checks are not kept.
+				resources, err := db.GetWorkspaceResourcesByJobID(ctx, job.ID)
+				require.NoError(t, err)
+				if refreshOnly {
+					require.Empty(t, resources)
+				} else {
+					require.Len(t, resources, 1)
+				}
+			})
+		}
 	})
 }
 
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
@@ -19,6 +20,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/database/pubsub"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/codersdk"
@@ -259,10 +261,28 @@ func convertProvisionerJob(pj database.GetProvisionerJobsByIDsWithQueuePositionR
 		job.WorkerID = &provisionerJob.WorkerID.UUID
 	}
 	job.Status = codersdk.ProvisionerJobStatus(pj.ProvisionerJob.JobStatus)
+	job.EstimatedStartAt = estimateProvisionerJobStart(pj)
 
 	return job
 }
 
+// estimateProvisionerJobStart estimates when a queued job starts by assuming
+// that the jobs ahead of it are processed as fast as the running jobs, each
+// taking as long as recently completed jobs on average.
+func estimateProvisionerJobStart(pj database.GetProvisionerJobsByIDsWithQueuePositionRow) *time.Time {
+	if pj.ProvisionerJob.JobStatus != database.ProvisionerJobStatusPending ||
+		pj.QueuePosition <= 0 || pj.AverageDurationSeconds <= 0 {
+		return nil
+	}
+	concurrency := pj.RunningJobs
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	wait := time.Duration(pj.AverageDurationSeconds * float64(pj.QueuePosition) / float64(concurrency) * float64(time.Second))
+	estimate := dbtime.Now().Add(wait)
+	return &estimate
+}
+
 func fetchAndWriteLogs(ctx context.Context, db database.Store, jobID uuid.UUID, after int64, rw http.ResponseWriter) {
 	logs, err := db.GetProvisionerLogsAfterID(ctx, database.GetProvisionerLogsAfterIDParams{
 		JobID:        jobID,
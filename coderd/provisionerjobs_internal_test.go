@@ -143,6 +143,52 @@ func TestConvertProvisionerJob_Unit(t *testing.T) {
 	}
 }
 
+func TestConvertProvisionerJob_EstimatedStartAt(t *testing.T) {
+	t.Parallel()
+
+	pending := database.ProvisionerJob{JobStatus: database.ProvisionerJobStatusPending}
+	running := database.ProvisionerJob{
+		StartedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+		JobStatus: database.ProvisionerJobStatusRunning,
+	}
+
+	t.Run("Queued", func(t *testing.T) {
+		t.Parallel()
+		before := dbtime.Now()
+		// Third in line behind two running jobs that take a minute each.
+		job := convertProvisionerJob(database.GetProvisionerJobsByIDsWithQueuePositionRow{
+			ProvisionerJob:         pending,
+			QueuePosition:          3,
+			QueueSize:              3,
+			RunningJobs:            2,
+			AverageDurationSeconds: 60,
+		})
+		require.NotNil(t, job.EstimatedStartAt)
+		require.WithinRange(t, *job.EstimatedStartAt, before.Add(90*time.Second), dbtime.Now().Add(90*time.Second))
+	})
+
+	t.Run("NoCompletedJobs", func(t *testing.T) {
+		t.Parallel()
+		job := convertProvisionerJob(database.GetProvisionerJobsByIDsWithQueuePositionRow{
+			ProvisionerJob: pending,
+			QueuePosition:  1,
+			QueueSize:      1,
+		})
+		require.Nil(t, job.EstimatedStartAt)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		t.Parallel()
+		job := convertProvisionerJob(database.GetProvisionerJobsByIDsWithQueuePositionRow{
+			ProvisionerJob:         running,
+			QueueSize:              1,
+			RunningJobs:            1,
+			AverageDurationSeconds: 60,
+		})
+		require.Nil(t, job.EstimatedStartAt)
+	})
+}
+
 func Test_logFollower_completeBeforeFollow(t *testing.T) {
 	t.Parallel()
 	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitShort)
@@ -73,9 +73,11 @@ func WorkspaceConverter() *sqltypes.VariableConverter {
 		sqltypes.StringVarMatcher("workspaces.organization_id :: text", []string{"input", "object", "org_owner"}),
 		userOwnerMatcher(),
 	)
+	// The columns are qualified because workspace queries join the templates
+	// table, which has ACL columns of the same name.
 	matcher.RegisterMatcher(
-		sqltypes.AlwaysFalse(groupACLMatcher(matcher)),
-		sqltypes.AlwaysFalse(userACLMatcher(matcher)),
+		ACLGroupMatcher(matcher, "workspaces.group_acl", []string{"input", "object", "acl_group_list"}),
+		ACLGroupMatcher(matcher, "workspaces.user_acl", []string{"input", "object", "acl_user_list"}),
 	)
 
 	return matcher
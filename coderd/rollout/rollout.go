@@ -0,0 +1,80 @@
+// Package rollout decides which workspaces take part in the canary rollout of
+// a template version.
+package rollout
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+)
+
+// Bucket returns the bucket of the workspace in [0, 100). A workspace is in
+// the canary cohort of a rollout if its bucket is below the percentage of the
+// rollout, so raising the percentage only adds workspaces to the cohort.
+func Bucket(workspaceID uuid.UUID) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write(workspaceID[:])
+	return int32(h.Sum32() % 100)
+}
+
+// InCohort returns whether the workspace is in the canary cohort of the
+// rollout. Workspaces owned by members of the group of the rollout are always
+// in the cohort.
+func InCohort(ctx context.Context, db database.Store, rollout database.TemplateVersionRollout, workspace database.Workspace) (bool, error) {
+	if Bucket(workspace.ID) < rollout.Percent {
+		return true, nil
+	}
+	if !rollout.GroupID.Valid {
+		return false, nil
+	}
+	members, err := db.GetGroupMembersByGroupID(ctx, rollout.GroupID.UUID)
+	if err != nil {
+		return false, xerrors.Errorf("get group members: %w", err)
+	}
+	for _, member := range members {
+		if member.UserID == workspace.OwnerID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// TargetVersion returns the template version that autobuild should move the
+// workspace to, or uuid.Nil if the workspace keeps its current version.
+// Workspaces in the canary cohort move to the canary version, and workspaces
+// on a version that was rolled back move to the active version.
+func TargetVersion(ctx context.Context, db database.Store, template database.Template, workspace database.Workspace, currentVersionID uuid.UUID) (uuid.UUID, error) {
+	canary, err := db.GetActiveTemplateVersionRolloutByTemplateID(ctx, template.ID)
+	if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, xerrors.Errorf("get active rollout: %w", err)
+	}
+	if err == nil {
+		inCohort, err := InCohort(ctx, db, canary, workspace)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if inCohort {
+			return canary.TemplateVersionID, nil
+		}
+	}
+
+	if currentVersionID == template.ActiveVersionID {
+		return uuid.Nil, nil
+	}
+	latest, err := db.GetLatestTemplateVersionRolloutByTemplateVersionID(ctx, currentVersionID)
+	if xerrors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		return uuid.Nil, xerrors.Errorf("get rollout of current version: %w", err)
+	}
+	if latest.Status == database.TemplateVersionRolloutStatusRolledBack {
+		return template.ActiveVersionID, nil
+	}
+	return uuid.Nil, nil
+}
@@ -0,0 +1,102 @@
+package rollout_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbmem"
+	"github.com/coder/coder/v2/coderd/rollout"
+)
+
+func TestBucket(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	require.Equal(t, rollout.Bucket(id), rollout.Bucket(id), "bucket must be stable")
+
+	// Buckets should be spread roughly evenly, so that a percentage selects
+	// roughly that share of workspaces.
+	var below int
+	for i := 0; i < 10000; i++ {
+		bucket := rollout.Bucket(uuid.New())
+		require.GreaterOrEqual(t, bucket, int32(0))
+		require.Less(t, bucket, int32(100))
+		if bucket < 10 {
+			below++
+		}
+	}
+	require.InDelta(t, 1000, below, 200)
+}
+
+func TestTargetVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := dbmem.New()
+	org := dbgen.Organization(t, db, database.Organization{})
+	owner := dbgen.User(t, db, database.User{})
+	member := dbgen.User(t, db, database.User{})
+	active := dbgen.TemplateVersion(t, db, database.TemplateVersion{OrganizationID: org.ID, CreatedBy: owner.ID})
+	tpl := dbgen.Template(t, db, database.Template{OrganizationID: org.ID, ActiveVersionID: active.ID, CreatedBy: owner.ID})
+	canary := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+		OrganizationID: org.ID,
+		TemplateID:     uuid.NullUUID{UUID: tpl.ID, Valid: true},
+		CreatedBy:      owner.ID,
+	})
+	group := dbgen.Group(t, db, database.Group{OrganizationID: org.ID})
+	dbgen.GroupMember(t, db, database.GroupMemberTable{GroupID: group.ID, UserID: member.ID})
+
+	// A workspace owned by a group member, and one that is not in the
+	// cohort by bucket or group.
+	memberWorkspace := database.Workspace{ID: workspaceIDInBucketRange(t, 50, 100), OwnerID: member.ID, TemplateID: tpl.ID}
+	otherWorkspace := database.Workspace{ID: workspaceIDInBucketRange(t, 50, 100), OwnerID: owner.ID, TemplateID: tpl.ID}
+	bucketWorkspace := database.Workspace{ID: workspaceIDInBucketRange(t, 0, 10), OwnerID: owner.ID, TemplateID: tpl.ID}
+
+	// Without a rollout workspaces keep their version.
+	target, err := rollout.TargetVersion(ctx, db, tpl, bucketWorkspace, active.ID)
+	require.NoError(t, err)
+	require.Equal(t, uuid.Nil, target)
+
+	r := dbgen.TemplateVersionRollout(t, db, database.TemplateVersionRollout{
+		TemplateID:        tpl.ID,
+		TemplateVersionID: canary.ID,
+		Percent:           10,
+		GroupID:           uuid.NullUUID{UUID: group.ID, Valid: true},
+		CreatedBy:         owner.ID,
+	})
+
+	for _, ws := range []database.Workspace{bucketWorkspace, memberWorkspace} {
+		target, err = rollout.TargetVersion(ctx, db, tpl, ws, active.ID)
+		require.NoError(t, err)
+		require.Equal(t, canary.ID, target)
+	}
+	target, err = rollout.TargetVersion(ctx, db, tpl, otherWorkspace, active.ID)
+	require.NoError(t, err)
+	require.Equal(t, uuid.Nil, target)
+
+	// After a rollback, workspaces on the canary version move back to the
+	// active version.
+	_, err = db.UpdateTemplateVersionRolloutStatusByID(ctx, database.UpdateTemplateVersionRolloutStatusByIDParams{
+		ID:     r.ID,
+		Status: database.TemplateVersionRolloutStatusRolledBack,
+	})
+	require.NoError(t, err)
+	target, err = rollout.TargetVersion(ctx, db, tpl, bucketWorkspace, canary.ID)
+	require.NoError(t, err)
+	require.Equal(t, active.ID, target)
+}
+
+func workspaceIDInBucketRange(t *testing.T, low, high int32) uuid.UUID {
+	t.Helper()
+	for {
+		id := uuid.New()
+		if bucket := rollout.Bucket(id); bucket >= low && bucket < high {
+			return id
+		}
+	}
+}
@@ -113,6 +113,30 @@ func VerifyTemplateAutostartRequirement(days uint8) error {
 	return nil
 }
 
+// TemplateIdlePolicy dictates which workspace activity bumps the workspace's
+// deadline.
+type TemplateIdlePolicy struct {
+	// Enabled only bumps the deadline when there is user input in SSH or
+	// reconnecting PTY sessions, app requests or, if CPUThresholdPercent is
+	// set, CPU load. When disabled, any open connection to the workspace
+	// bumps the deadline.
+	Enabled bool
+	// CPUThresholdPercent is the CPU usage above which the workspace is
+	// considered in use. A value of 0 disables CPU-based detection.
+	CPUThresholdPercent int32
+}
+
+// VerifyTemplateIdlePolicy returns an error if the idle policy is invalid.
+func VerifyTemplateIdlePolicy(policy TemplateIdlePolicy) error {
+	if policy.CPUThresholdPercent < 0 {
+		return xerrors.New("invalid idle cpu threshold, negative")
+	}
+	if policy.CPUThresholdPercent > 100 {
+		return xerrors.New("invalid idle cpu threshold, greater than 100")
+	}
+	return nil
+}
+
 type TemplateScheduleOptions struct {
 	UserAutostartEnabled bool
 	UserAutostopEnabled  bool
@@ -120,6 +144,8 @@ type TemplateScheduleOptions struct {
 	// ActivityBump dictates the duration to bump the workspace's deadline by if
 	// Coder detects activity from the user. A value of 0 means no bumping.
 	ActivityBump time.Duration
+	// IdlePolicy dictates which activity bumps the workspace's deadline.
+	IdlePolicy TemplateIdlePolicy
 	// AutostopRequirement dictates when the workspace must be restarted. This
 	// used to be handled by MaxTTL.
 	AutostopRequirement TemplateAutostopRequirement
@@ -179,6 +205,10 @@ func (*agplTemplateScheduleStore) Get(ctx context.Context, db database.Store, te
 		UserAutostopEnabled:  true,
 		DefaultTTL:           time.Duration(tpl.DefaultTTL),
 		ActivityBump:         time.Duration(tpl.ActivityBump),
+		IdlePolicy: TemplateIdlePolicy{
+			Enabled:             tpl.IdleDetectionEnabled,
+			CPUThresholdPercent: tpl.IdleCPUThresholdPercent,
+		},
 		// Disregard the values in the database, since AutostopRequirement,
 		// FailureTTL, TimeTilDormant, and TimeTilDormantAutoDelete are enterprise features.
 		AutostartRequirement: TemplateAutostartRequirement{
@@ -201,18 +231,28 @@ func (*agplTemplateScheduleStore) Set(ctx context.Context, db database.Store, tp
 	ctx, span := tracing.StartSpan(ctx)
 	defer span.End()
 
-	if int64(opts.DefaultTTL) == tpl.DefaultTTL && int64(opts.ActivityBump) == tpl.ActivityBump {
+	if int64(opts.DefaultTTL) == tpl.DefaultTTL &&
+		int64(opts.ActivityBump) == tpl.ActivityBump &&
+		opts.IdlePolicy.Enabled == tpl.IdleDetectionEnabled &&
+		opts.IdlePolicy.CPUThresholdPercent == tpl.IdleCPUThresholdPercent {
 		// Avoid updating the UpdatedAt timestamp if nothing will be changed.
 		return tpl, nil
 	}
 
+	err := VerifyTemplateIdlePolicy(opts.IdlePolicy)
+	if err != nil {
+		return database.Template{}, xerrors.Errorf("verify idle policy: %w", err)
+	}
+
 	var template database.Template
-	err := db.InTx(func(db database.Store) error {
+	err = db.InTx(func(db database.Store) error {
 		err := db.UpdateTemplateScheduleByID(ctx, database.UpdateTemplateScheduleByIDParams{
-			ID:           tpl.ID,
-			UpdatedAt:    dbtime.Now(),
-			DefaultTTL:   int64(opts.DefaultTTL),
-			ActivityBump: int64(opts.ActivityBump),
+			ID:                      tpl.ID,
+			UpdatedAt:               dbtime.Now(),
+			DefaultTTL:              int64(opts.DefaultTTL),
+			ActivityBump:            int64(opts.ActivityBump),
+			IdleDetectionEnabled:    opts.IdlePolicy.Enabled,
+			IdleCPUThresholdPercent: opts.IdlePolicy.CPUThresholdPercent,
 			// Don't allow changing these settings, but keep the value in the DB (to
 			// avoid clearing settings if the license has an issue).
 			AutostopRequirementDaysOfWeek: tpl.AutostopRequirementDaysOfWeek,
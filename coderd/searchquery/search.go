@@ -52,6 +52,55 @@ func AuditLogs(ctx context.Context, db database.Store, query string) (database.G
 	return filter, parser.Errors
 }
 
+func ConnectionLogs(ctx context.Context, db database.Store, query string) (database.GetConnectionLogsOffsetParams, []codersdk.ValidationError) {
+	// Always lowercase for all searches.
+	query = strings.ToLower(query)
+	values, errors := searchTerms(query, func(term string, values url.Values) error {
+		// It is a workspace name, and maybe includes an owner
+		parts := splitQueryParameterByDelimiter(term, '/', false)
+		switch len(parts) {
+		case 1:
+			values.Add("workspace", parts[0])
+		case 2:
+			values.Add("workspace_owner", parts[0])
+			values.Add("workspace", parts[1])
+		default:
+			return xerrors.Errorf("Query element %q can only contain 1 '/'", term)
+		}
+		return nil
+	})
+	if len(errors) > 0 {
+		return database.GetConnectionLogsOffsetParams{}, errors
+	}
+
+	const dateLayout = "2006-01-02"
+	parser := httpapi.NewQueryParamParser()
+	filter := database.GetConnectionLogsOffsetParams{
+		OrganizationID:  parseOrganization(ctx, db, parser, values, "organization"),
+		WorkspaceOwner:  parser.String(values, "", "workspace_owner"),
+		WorkspaceID:     parser.UUID(values, uuid.Nil, "workspace_id"),
+		WorkspaceName:   parser.String(values, "", "workspace"),
+		Type:            string(httpapi.ParseCustom(parser, values, "", "type", httpapi.ParseEnum[database.ConnectionType])),
+		Username:        parser.String(values, "", "username"),
+		ConnectedAfter:  parser.Time(values, time.Time{}, "connected_after", dateLayout),
+		ConnectedBefore: parser.Time(values, time.Time{}, "connected_before", dateLayout),
+		Status: string(httpapi.ParseCustom(parser, values, "", "status", func(v string) (string, error) {
+			switch v {
+			case "", "ongoing", "completed":
+				return v, nil
+			default:
+				return "", xerrors.Errorf("%q is not a valid status, expected \"ongoing\" or \"completed\"", v)
+			}
+		})),
+	}
+	if !filter.ConnectedBefore.IsZero() {
+		filter.ConnectedBefore = filter.ConnectedBefore.Add(23*time.Hour + 59*time.Minute + 59*time.Second)
+	}
+
+	parser.ErrorExcessParams(values)
+	return filter, parser.Errors
+}
+
 func Users(query string) (database.GetUsersParams, []codersdk.ValidationError) {
 	// Always lowercase for all searches.
 	query = strings.ToLower(query)
@@ -369,6 +369,86 @@ func TestSearchAudit(t *testing.T) {
 	}
 }
 
+func TestSearchConnectionLogs(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name                  string
+		Query                 string
+		Expected              database.GetConnectionLogsOffsetParams
+		ExpectedErrorContains string
+	}{
+		{
+			Name:     "Empty",
+			Query:    "",
+			Expected: database.GetConnectionLogsOffsetParams{},
+		},
+		{
+			Name:  "Workspace",
+			Query: "dev",
+			Expected: database.GetConnectionLogsOffsetParams{
+				WorkspaceName: "dev",
+			},
+		},
+		{
+			Name:  "OwnerWorkspace",
+			Query: "Alice/dev",
+			Expected: database.GetConnectionLogsOffsetParams{
+				WorkspaceOwner: "alice",
+				WorkspaceName:  "dev",
+			},
+		},
+		{
+			Name:  "Filters",
+			Query: "type:ssh username:bob status:ongoing connected_after:2024-01-02 connected_before:2024-01-03",
+			Expected: database.GetConnectionLogsOffsetParams{
+				Type:            "ssh",
+				Username:        "bob",
+				Status:          "ongoing",
+				ConnectedAfter:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				ConnectedBefore: time.Date(2024, 1, 3, 23, 59, 59, 0, time.UTC),
+			},
+		},
+		// Failures
+		{
+			Name:                  "ExtraSlash",
+			Query:                 "alice/dev/extra",
+			ExpectedErrorContains: "can only contain 1 '/'",
+		},
+		{
+			Name:                  "InvalidType",
+			Query:                 "type:telnet",
+			ExpectedErrorContains: "type",
+		},
+		{
+			Name:                  "InvalidStatus",
+			Query:                 "status:paused",
+			ExpectedErrorContains: "not a valid status",
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			// Do not use a real database, this is only used for an
+			// organization lookup.
+			db := dbmem.New()
+			values, errs := searchquery.ConnectionLogs(context.Background(), db, c.Query)
+			if c.ExpectedErrorContains != "" {
+				require.True(t, len(errs) > 0, "expect some errors")
+				var s strings.Builder
+				for _, err := range errs {
+					_, _ = s.WriteString(fmt.Sprintf("%s: %s\n", err.Field, err.Detail))
+				}
+				require.Contains(t, s.String(), c.ExpectedErrorContains)
+			} else {
+				require.Len(t, errs, 0, "expected no error")
+				require.Equal(t, c.Expected, values, "expected values")
+			}
+		})
+	}
+}
+
 func TestSearchUsers(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -1042,6 +1042,38 @@ func TestPatchTemplateMeta(t *testing.T) {
 		assert.Equal(t, updated.Icon, "")
 	})
 
+	t.Run("IdlePolicy", func(t *testing.T) {
+		t.Parallel()
+
+		client := coderdtest.New(t, nil)
+		user := coderdtest.CreateFirstUser(t, client)
+		version := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, nil)
+		template := coderdtest.CreateTemplate(t, client, user.OrganizationID, version.ID)
+		require.False(t, template.IdleDetectionEnabled)
+		require.Zero(t, template.IdleCPUThresholdPercent)
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		updated, err := client.UpdateTemplateMeta(ctx, template.ID, codersdk.UpdateTemplateMeta{
+			DefaultTTLMillis:        template.DefaultTTLMillis,
+			ActivityBumpMillis:      template.ActivityBumpMillis,
+			IdleDetectionEnabled:    true,
+			IdleCPUThresholdPercent: 40,
+		})
+		require.NoError(t, err)
+		assert.True(t, updated.IdleDetectionEnabled)
+		assert.EqualValues(t, 40, updated.IdleCPUThresholdPercent)
+
+		_, err = client.UpdateTemplateMeta(ctx, template.ID, codersdk.UpdateTemplateMeta{
+			IdleDetectionEnabled:    true,
+			IdleCPUThresholdPercent: 120,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Len(t, apiErr.Validations, 1)
+		assert.Equal(t, "idle_cpu_threshold_percent", apiErr.Validations[0].Field)
+	})
+
 	t.Run("AutostopRequirement", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,365 @@
+package coderd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Get template version rollout
+// @ID get-template-version-rollout
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Success 200 {object} codersdk.TemplateVersionRollout
+// @Router /templates/{template}/rollout [get]
+func (api *API) templateVersionRollout(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	template := httpmw.TemplateParam(r)
+
+	rollout, ok := api.activeTemplateVersionRollout(rw, r, template)
+	if !ok {
+		return
+	}
+
+	resp, err := api.convertTemplateVersionRollout(ctx, rollout, template.ActiveVersionID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Create template version rollout
+// @ID create-template-version-rollout
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Param request body codersdk.CreateTemplateVersionRolloutRequest true "Create template version rollout request"
+// @Success 201 {object} codersdk.TemplateVersionRollout
+// @Router /templates/{template}/rollout [post]
+func (api *API) postTemplateVersionRollout(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx               = r.Context()
+		template          = httpmw.TemplateParam(r)
+		apiKey            = httpmw.APIKey(r)
+		auditor           = *api.Auditor.Load()
+		aReq, commitAudit = audit.InitRequest[database.TemplateVersionRollout](rw, &audit.RequestParams{
+			Audit:          auditor,
+			Log:            api.Logger,
+			Request:        r,
+			Action:         database.AuditActionCreate,
+			OrganizationID: template.OrganizationID,
+		})
+	)
+	defer commitAudit()
+
+	if !api.Authorize(r, policy.ActionUpdate, template) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.CreateTemplateVersionRolloutRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if req.Percent == 0 && req.GroupID == nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid template version rollout.",
+			Validations: []codersdk.ValidationError{
+				{Field: "percent", Detail: "Either percent or group_id must be set"},
+			},
+		})
+		return
+	}
+
+	version, err := api.Database.GetTemplateVersionByID(ctx, req.TemplateVersionID)
+	if httpapi.Is404Error(err) || (err == nil && version.TemplateID.UUID != template.ID) {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "The provided template version doesn't belong to the specified template.",
+		})
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	if version.ID == template.ActiveVersionID {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "The provided template version is already the active version.",
+		})
+		return
+	}
+	if version.Archived {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "The provided template version is archived.",
+		})
+		return
+	}
+	job, err := api.Database.GetProvisionerJobByID(ctx, version.JobID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	if job.JobStatus != database.ProvisionerJobStatusSucceeded {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Only versions that have been built successfully can be rolled out.",
+			Detail:  fmt.Sprintf("Attempted to roll out a version with a %s build", job.JobStatus),
+		})
+		return
+	}
+
+	var groupID uuid.NullUUID
+	if req.GroupID != nil {
+		group, err := api.Database.GetGroupByID(ctx, *req.GroupID)
+		if err != nil && !httpapi.Is404Error(err) {
+			httpapi.InternalServerError(rw, err)
+			return
+		}
+		if err != nil || group.OrganizationID != template.OrganizationID {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: fmt.Sprintf("Group %q not found in organization.", req.GroupID.String()),
+				Validations: []codersdk.ValidationError{
+					{Field: "group_id", Detail: "Group not found in organization"},
+				},
+			})
+			return
+		}
+		groupID = uuid.NullUUID{UUID: group.ID, Valid: true}
+	}
+
+	now := dbtime.Now()
+	rollout, err := api.Database.InsertTemplateVersionRollout(ctx, database.InsertTemplateVersionRolloutParams{
+		ID:                uuid.New(),
+		TemplateID:        template.ID,
+		TemplateVersionID: version.ID,
+		Percent:           req.Percent,
+		GroupID:           groupID,
+		CreatedBy:         apiKey.UserID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+	if database.IsUniqueViolation(err, database.UniqueTemplateVersionRolloutsTemplateIDCanaryIndex) {
+		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+			Message: "Template already has a canary rollout.",
+			Detail:  "Promote or roll back the current canary rollout first.",
+		})
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	aReq.New = rollout
+
+	resp, err := api.convertTemplateVersionRollout(ctx, rollout, template.ActiveVersionID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusCreated, resp)
+}
+
+// @Summary Promote template version rollout
+// @ID promote-template-version-rollout
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Success 200 {object} codersdk.TemplateVersionRollout
+// @Router /templates/{template}/rollout/promote [post]
+func (api *API) postPromoteTemplateVersionRollout(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx               = r.Context()
+		template          = httpmw.TemplateParam(r)
+		auditor           = *api.Auditor.Load()
+		aReq, commitAudit = audit.InitRequest[database.Template](rw, &audit.RequestParams{
+			Audit:          auditor,
+			Log:            api.Logger,
+			Request:        r,
+			Action:         database.AuditActionWrite,
+			OrganizationID: template.OrganizationID,
+		})
+	)
+	defer commitAudit()
+	aReq.Old = template
+
+	if !api.Authorize(r, policy.ActionUpdate, template) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	rollout, ok := api.activeTemplateVersionRollout(rw, r, template)
+	if !ok {
+		return
+	}
+
+	err := api.Database.InTx(func(tx database.Store) error {
+		now := dbtime.Now()
+		err := tx.UpdateTemplateActiveVersionByID(ctx, database.UpdateTemplateActiveVersionByIDParams{
+			ID:              template.ID,
+			ActiveVersionID: rollout.TemplateVersionID,
+			UpdatedAt:       now,
+		})
+		if err != nil {
+			return xerrors.Errorf("update active version: %w", err)
+		}
+		rollout, err = tx.UpdateTemplateVersionRolloutStatusByID(ctx, database.UpdateTemplateVersionRolloutStatusByIDParams{
+			ID:        rollout.ID,
+			Status:    database.TemplateVersionRolloutStatusPromoted,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			return xerrors.Errorf("update rollout status: %w", err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	newTemplate := template
+	newTemplate.ActiveVersionID = rollout.TemplateVersionID
+	aReq.New = newTemplate
+
+	api.publishTemplateUpdate(ctx, template.ID)
+
+	// Compare against the previous active version, since the promoted
+	// version is now the active one.
+	resp, err := api.convertTemplateVersionRollout(ctx, rollout, template.ActiveVersionID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Roll back template version rollout
+// @ID roll-back-template-version-rollout
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Success 200 {object} codersdk.TemplateVersionRollout
+// @Router /templates/{template}/rollout/rollback [post]
+func (api *API) postRollBackTemplateVersionRollout(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx               = r.Context()
+		template          = httpmw.TemplateParam(r)
+		auditor           = *api.Auditor.Load()
+		aReq, commitAudit = audit.InitRequest[database.TemplateVersionRollout](rw, &audit.RequestParams{
+			Audit:          auditor,
+			Log:            api.Logger,
+			Request:        r,
+			Action:         database.AuditActionWrite,
+			OrganizationID: template.OrganizationID,
+		})
+	)
+	defer commitAudit()
+
+	if !api.Authorize(r, policy.ActionUpdate, template) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	rollout, ok := api.activeTemplateVersionRollout(rw, r, template)
+	if !ok {
+		return
+	}
+	aReq.Old = rollout
+
+	rollout, err := api.Database.UpdateTemplateVersionRolloutStatusByID(ctx, database.UpdateTemplateVersionRolloutStatusByIDParams{
+		ID:        rollout.ID,
+		Status:    database.TemplateVersionRolloutStatusRolledBack,
+		UpdatedAt: dbtime.Now(),
+	})
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	aReq.New = rollout
+
+	resp, err := api.convertTemplateVersionRollout(ctx, rollout, template.ActiveVersionID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// activeTemplateVersionRollout returns the canary rollout of the template, or
+// writes a 404 if the template has none.
+func (api *API) activeTemplateVersionRollout(rw http.ResponseWriter, r *http.Request, template database.Template) (database.TemplateVersionRollout, bool) {
+	ctx := r.Context()
+	rollout, err := api.Database.GetActiveTemplateVersionRolloutByTemplateID(ctx, template.ID)
+	if httpapi.Is404Error(err) {
+		httpapi.Write(ctx, rw, http.StatusNotFound, codersdk.Response{
+			Message: "Template has no canary rollout.",
+		})
+		return database.TemplateVersionRollout{}, false
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return database.TemplateVersionRollout{}, false
+	}
+	return rollout, true
+}
+
+// convertTemplateVersionRollout converts the rollout with the build stats of
+// its version and of activeVersionID since the rollout started.
+func (api *API) convertTemplateVersionRollout(ctx context.Context, rollout database.TemplateVersionRollout, activeVersionID uuid.UUID) (codersdk.TemplateVersionRollout, error) {
+	version, err := api.Database.GetTemplateVersionByID(ctx, rollout.TemplateVersionID)
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, xerrors.Errorf("get template version: %w", err)
+	}
+	canaryStats, err := api.Database.GetWorkspaceBuildStatsByTemplateVersionID(ctx, database.GetWorkspaceBuildStatsByTemplateVersionIDParams{
+		TemplateVersionID: rollout.TemplateVersionID,
+		Since:             rollout.CreatedAt,
+	})
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, xerrors.Errorf("get canary build stats: %w", err)
+	}
+	activeStats, err := api.Database.GetWorkspaceBuildStatsByTemplateVersionID(ctx, database.GetWorkspaceBuildStatsByTemplateVersionIDParams{
+		TemplateVersionID: activeVersionID,
+		Since:             rollout.CreatedAt,
+	})
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, xerrors.Errorf("get active build stats: %w", err)
+	}
+
+	resp := codersdk.TemplateVersionRollout{
+		ID:                  rollout.ID,
+		TemplateID:          rollout.TemplateID,
+		TemplateVersionID:   rollout.TemplateVersionID,
+		TemplateVersionName: version.Name,
+		Status:              codersdk.TemplateVersionRolloutStatus(rollout.Status),
+		Percent:             rollout.Percent,
+		CreatedByID:         rollout.CreatedBy,
+		CreatedAt:           rollout.CreatedAt,
+		UpdatedAt:           rollout.UpdatedAt,
+		CanaryBuilds: codersdk.TemplateVersionRolloutBuildStats{
+			TotalBuilds:  canaryStats.TotalBuilds,
+			FailedBuilds: canaryStats.FailedBuilds,
+		},
+		ActiveBuilds: codersdk.TemplateVersionRolloutBuildStats{
+			TotalBuilds:  activeStats.TotalBuilds,
+			FailedBuilds: activeStats.FailedBuilds,
+		},
+	}
+	if rollout.GroupID.Valid {
+		resp.GroupID = &rollout.GroupID.UUID
+	}
+	return resp, nil
+}
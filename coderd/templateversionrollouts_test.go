@@ -0,0 +1,152 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestTemplateVersionRollout(t *testing.T) {
+	t.Parallel()
+
+	auditor := audit.NewMock()
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true, Auditor: auditor})
+	owner := coderdtest.CreateFirstUser(t, client)
+	templateAdmin, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.RoleTemplateAdmin())
+	member, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+
+	// setup creates a template with an active version and a second version
+	// to roll out.
+	setup := func(t *testing.T) (codersdk.Template, codersdk.TemplateVersion) {
+		t.Helper()
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		canary := coderdtest.UpdateTemplateVersion(t, client, owner.OrganizationID, nil, template.ID)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, canary.ID)
+		return template, canary
+	}
+
+	t.Run("Promote", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		template, canary := setup(t)
+
+		rollout, err := templateAdmin.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percent:           25,
+		})
+		require.NoError(t, err)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusCanary, rollout.Status)
+		require.Equal(t, canary.Name, rollout.TemplateVersionName)
+		require.EqualValues(t, 25, rollout.Percent)
+
+		// Only one canary at a time.
+		_, err = templateAdmin.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percent:           50,
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusConflict, sdkErr.StatusCode())
+
+		// Builds of the canary version are counted for the canary cohort.
+		workspace := coderdtest.CreateWorkspace(t, member, template.ID, func(cwr *codersdk.CreateWorkspaceRequest) {
+			cwr.TemplateID = uuid.Nil
+			cwr.TemplateVersionID = canary.ID
+		})
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+		rollout, err = member.TemplateVersionRollout(ctx, template.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, rollout.CanaryBuilds.TotalBuilds)
+		require.EqualValues(t, 0, rollout.CanaryBuilds.FailedBuilds)
+		require.EqualValues(t, 0, rollout.ActiveBuilds.TotalBuilds)
+
+		// Members cannot promote.
+		_, err = member.PromoteTemplateVersionRollout(ctx, template.ID)
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusForbidden, sdkErr.StatusCode())
+
+		rollout, err = templateAdmin.PromoteTemplateVersionRollout(ctx, template.ID)
+		require.NoError(t, err)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusPromoted, rollout.Status)
+
+		template, err = client.Template(ctx, template.ID)
+		require.NoError(t, err)
+		require.Equal(t, canary.ID, template.ActiveVersionID)
+
+		_, err = client.TemplateVersionRollout(ctx, template.ID)
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusNotFound, sdkErr.StatusCode())
+	})
+
+	t.Run("RollBack", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		template, canary := setup(t)
+		created, err := templateAdmin.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percent:           10,
+		})
+		require.NoError(t, err)
+		require.True(t, auditor.Contains(t, database.AuditLog{
+			ResourceType: database.ResourceTypeTemplateVersionRollout,
+			ResourceID:   created.ID,
+			Action:       database.AuditActionCreate,
+		}))
+
+		rollout, err := templateAdmin.RollBackTemplateVersionRollout(ctx, template.ID)
+		require.NoError(t, err)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusRolledBack, rollout.Status)
+		require.True(t, auditor.Contains(t, database.AuditLog{
+			ResourceType: database.ResourceTypeTemplateVersionRollout,
+			ResourceID:   rollout.ID,
+			Action:       database.AuditActionWrite,
+		}))
+
+		// The active version is unchanged and a new canary can be started.
+		updated, err := client.Template(ctx, template.ID)
+		require.NoError(t, err)
+		require.Equal(t, template.ActiveVersionID, updated.ActiveVersionID)
+		_, err = templateAdmin.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percent:           10,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		template, canary := setup(t)
+
+		var sdkErr *codersdk.Error
+		for _, req := range []codersdk.CreateTemplateVersionRolloutRequest{
+			// Neither a percentage nor a group.
+			{TemplateVersionID: canary.ID},
+			// Already the active version.
+			{TemplateVersionID: template.ActiveVersionID, Percent: 10},
+			{TemplateVersionID: canary.ID, Percent: 101},
+		} {
+			_, err := templateAdmin.CreateTemplateVersionRollout(ctx, template.ID, req)
+			require.ErrorAs(t, err, &sdkErr)
+			require.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+		}
+
+		_, err := member.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percent:           10,
+		})
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusForbidden, sdkErr.StatusCode())
+	})
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
 	"github.com/coder/coder/v2/coderd/externalauth"
@@ -437,6 +438,37 @@ func (api *API) postTemplateVersionDryRun(rw http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if req.WorkspaceID != uuid.Nil {
+		workspace, err := api.Database.GetWorkspaceByID(ctx, req.WorkspaceID)
+		if httpapi.Is404Error(err) {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: fmt.Sprintf("Workspace %q not found.", req.WorkspaceID),
+			})
+			return
+		}
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching workspace.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		// Planning against the state of a workspace is only useful to
+		// those that may build it. The plan gets the secrets of the
+		// workspace owner, so users the workspace is shared with may not
+		// start one.
+		if !api.Authorize(r, policy.ActionUpdate, workspace.RBACObjectWithoutACL()) {
+			httpapi.ResourceNotFound(rw)
+			return
+		}
+		if !templateVersion.TemplateID.Valid || templateVersion.TemplateID.UUID != workspace.TemplateID {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "The template version must belong to the template of the workspace.",
+			})
+			return
+		}
+	}
+
 	job, err := api.Database.GetProvisionerJobByID(ctx, templateVersion.JobID)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -465,6 +497,7 @@ func (api *API) postTemplateVersionDryRun(rw http.ResponseWriter, r *http.Reques
 	// request.
 	input, err := json.Marshal(provisionerdserver.TemplateVersionDryRunJob{
 		TemplateVersionID:   templateVersion.ID,
+		WorkspaceID:         req.WorkspaceID,
 		WorkspaceName:       req.WorkspaceName,
 		RichParameterValues: richParameterValues,
 	})
@@ -504,6 +537,7 @@ func (api *API) postTemplateVersionDryRun(rw http.ResponseWriter, r *http.Reques
 			Valid:      true,
 			RawMessage: metadataRaw,
 		},
+		Priority: provisionerjobs.PriorityTemplateVersion,
 	})
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -561,6 +595,49 @@ func (api *API) templateVersionDryRunResources(rw http.ResponseWriter, r *http.R
 	api.provisionerJobResources(rw, r, job.ProvisionerJob)
 }
 
+// @Summary Get template version dry-run resource changes by job ID
+// @ID get-template-version-dry-run-resource-changes-by-job-id
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param templateversion path string true "Template version ID" format(uuid)
+// @Param jobID path string true "Job ID" format(uuid)
+// @Success 200 {array} codersdk.WorkspaceResourceChange
+// @Router /templateversions/{templateversion}/dry-run/{jobID}/resource-changes [get]
+func (api *API) templateVersionDryRunResourceChanges(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	job, ok := api.fetchTemplateVersionDryRunJob(rw, r)
+	if !ok {
+		return
+	}
+	if !job.ProvisionerJob.CompletedAt.Valid {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Job hasn't completed!",
+		})
+		return
+	}
+
+	changes, err := api.Database.GetProvisionerJobResourceChangesByJobID(ctx, job.ProvisionerJob.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching resource changes.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, db2sdk.List(changes, convertWorkspaceResourceChange))
+}
+
+func convertWorkspaceResourceChange(change database.ProvisionerJobResourceChange) codersdk.WorkspaceResourceChange {
+	return codersdk.WorkspaceResourceChange{
+		Address: change.Address,
+		Type:    change.Type,
+		Name:    change.Name,
+		Action:  codersdk.WorkspaceResourceChangeAction(change.Action),
+	}
+}
+
 // @Summary Get template version dry-run logs by job ID
 // @ID get-template-version-dry-run-logs-by-job-id
 // @Security CoderSessionToken
@@ -1479,6 +1556,7 @@ func (api *API) postTemplateVersionsByOrganization(rw http.ResponseWriter, r *ht
 				Valid:      true,
 				RawMessage: traceMetadataRaw,
 			},
+			Priority: provisionerjobs.PriorityTemplateVersion,
 		})
 		if err != nil {
 			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -750,6 +750,65 @@ func TestTemplateVersionDryRun(t *testing.T) {
 		require.Equal(t, resource.Type, resources[0].Type)
 	})
 
+	t.Run("Workspace", func(t *testing.T) {
+		t.Parallel()
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		user := coderdtest.CreateFirstUser(t, client)
+		version := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, &echo.Responses{
+			Parse:          echo.ParseComplete,
+			ProvisionApply: echo.ApplyComplete,
+			ProvisionPlan: []*proto.Response{{
+				Type: &proto.Response_Plan{
+					Plan: &proto.PlanComplete{
+						ResourceChanges: []*proto.ResourceChange{{
+							Address: "docker_container.workspace[0]",
+							Type:    "docker_container",
+							Name:    "workspace",
+							Action:  proto.ResourceChange_REPLACE,
+						}},
+					},
+				},
+			}},
+		})
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, user.OrganizationID, version.ID)
+		workspace := coderdtest.CreateWorkspace(t, client, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		job, err := client.CreateTemplateVersionDryRun(ctx, version.ID, codersdk.CreateTemplateVersionDryRunRequest{
+			WorkspaceID: workspace.ID,
+		})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			job, err := client.TemplateVersionDryRun(ctx, version.ID, job.ID)
+			return assert.NoError(t, err) && job.Status == codersdk.ProvisionerJobSucceeded
+		}, testutil.WaitShort, testutil.IntervalFast)
+
+		changes, err := client.TemplateVersionDryRunResourceChanges(ctx, version.ID, job.ID)
+		require.NoError(t, err)
+		require.Equal(t, []codersdk.WorkspaceResourceChange{{
+			Address: "docker_container.workspace[0]",
+			Type:    "docker_container",
+			Name:    "workspace",
+			Action:  codersdk.WorkspaceResourceChangeActionReplace,
+		}}, changes)
+		require.True(t, changes[0].Action.Destructive())
+
+		// A version of another template can't be planned against the
+		// workspace.
+		otherVersion := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, otherVersion.ID)
+		_, err = client.CreateTemplateVersionDryRun(ctx, otherVersion.ID, codersdk.CreateTemplateVersionDryRunRequest{
+			WorkspaceID: workspace.ID,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
 	t.Run("ImportNotFinished", func(t *testing.T) {
 		t.Parallel()
 		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
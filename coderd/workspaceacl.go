@@ -0,0 +1,312 @@
+package coderd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Get workspace ACL
+// @ID get-workspace-acl
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceACL
+// @Router /workspaces/{workspace}/acl [get]
+func (api *API) workspaceACL(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	// The caller might not be allowed to read the users and groups the
+	// workspace is shared with, but they can read the ACL if they got this
+	// far, so we let them see who is on it.
+	// nolint:gocritic
+	sysCtx := dbauthz.AsSystemRestricted(ctx)
+
+	users := make([]codersdk.WorkspaceUser, 0, len(workspace.UserACL))
+	if len(workspace.UserACL) > 0 {
+		ids := make([]uuid.UUID, 0, len(workspace.UserACL))
+		for id := range workspace.UserACL {
+			if userID, err := uuid.Parse(id); err == nil {
+				ids = append(ids, userID)
+			}
+		}
+		dbUsers, err := api.Database.GetUsersByIDs(sysCtx, ids)
+		if err != nil {
+			httpapi.InternalServerError(rw, err)
+			return
+		}
+		for _, user := range dbUsers {
+			users = append(users, codersdk.WorkspaceUser{
+				MinimalUser: codersdk.MinimalUser{
+					ID:        user.ID,
+					Username:  user.Username,
+					AvatarURL: user.AvatarURL,
+				},
+				Role: convertToWorkspaceRole(workspace.UserACL[user.ID.String()]),
+			})
+		}
+		slices.SortFunc(users, func(a, b codersdk.WorkspaceUser) int {
+			return strings.Compare(a.Username, b.Username)
+		})
+	}
+
+	groups := make([]codersdk.WorkspaceGroup, 0, len(workspace.GroupACL))
+	for id, actions := range workspace.GroupACL {
+		groupID, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		group, err := api.Database.GetGroupByID(sysCtx, groupID)
+		if httpapi.Is404Error(err) {
+			// Deleted groups stay in the ACL until it is updated.
+			continue
+		}
+		if err != nil {
+			httpapi.InternalServerError(rw, err)
+			return
+		}
+		groups = append(groups, codersdk.WorkspaceGroup{
+			ID:          group.ID,
+			Name:        group.Name,
+			DisplayName: group.DisplayName,
+			AvatarURL:   group.AvatarURL,
+			Role:        convertToWorkspaceRole(actions),
+		})
+	}
+	slices.SortFunc(groups, func(a, b codersdk.WorkspaceGroup) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.WorkspaceACL{
+		Users:  users,
+		Groups: groups,
+	})
+}
+
+// @Summary Update workspace ACL
+// @ID update-workspace-acl
+// @Security CoderSessionToken
+// @Accept json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Param request body codersdk.UpdateWorkspaceACL true "Update workspace ACL request"
+// @Success 204
+// @Router /workspaces/{workspace}/acl [patch]
+func (api *API) patchWorkspaceACL(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx               = r.Context()
+		workspace         = httpmw.WorkspaceParam(r)
+		auditor           = api.Auditor.Load()
+		aReq, commitAudit = audit.InitRequest[database.Workspace](rw, &audit.RequestParams{
+			Audit:          *auditor,
+			Log:            api.Logger,
+			Request:        r,
+			Action:         database.AuditActionWrite,
+			OrganizationID: workspace.OrganizationID,
+		})
+	)
+	defer commitAudit()
+	aReq.Old = workspace
+
+	// Users the workspace is shared with may update it, but not share it
+	// further.
+	if !api.Authorize(r, policy.ActionUpdate, workspace.RBACObjectWithoutACL()) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.UpdateWorkspaceACL
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	userRoles, validErrs := resolveWorkspaceACLRoles(ctx, api.Database, workspace, req.UserRoles, "user_roles", true)
+	groupRoles, groupErrs := resolveWorkspaceACLRoles(ctx, api.Database, workspace, req.GroupRoles, "group_roles", false)
+	validErrs = append(validErrs, groupErrs...)
+	if len(validErrs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid request to update workspace ACL.",
+			Validations: validErrs,
+		})
+		return
+	}
+
+	var updated database.Workspace
+	err := api.Database.InTx(func(tx database.Store) error {
+		var err error
+		updated, err = tx.GetWorkspaceByID(ctx, workspace.ID)
+		if err != nil {
+			return xerrors.Errorf("get workspace by ID: %w", err)
+		}
+
+		updated.UserACL = applyWorkspaceRoles(updated.UserACL, userRoles)
+		updated.GroupACL = applyWorkspaceRoles(updated.GroupACL, groupRoles)
+		err = tx.UpdateWorkspaceACLByID(ctx, database.UpdateWorkspaceACLByIDParams{
+			ID:       updated.ID,
+			UserACL:  updated.UserACL,
+			GroupACL: updated.GroupACL,
+		})
+		if err != nil {
+			return xerrors.Errorf("update workspace ACL by ID: %w", err)
+		}
+		return nil
+	}, nil)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	aReq.New = updated
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// applyWorkspaceRoles returns a copy of the ACL with the roles applied. An
+// empty role removes the entry.
+func applyWorkspaceRoles(acl database.WorkspaceACL, roles map[string]codersdk.WorkspaceRole) database.WorkspaceACL {
+	updated := make(database.WorkspaceACL, len(acl))
+	for id, actions := range acl {
+		updated[id] = actions
+	}
+	for id, role := range roles {
+		if role == codersdk.WorkspaceRoleDeleted {
+			delete(updated, id)
+			continue
+		}
+		updated[id] = convertSDKWorkspaceRole(role)
+	}
+	return updated
+}
+
+// resolveWorkspaceACLRoles validates the roles of the request and resolves
+// the users or groups they refer to by name or ID. The returned roles are
+// keyed by ID.
+func resolveWorkspaceACLRoles(ctx context.Context, db database.Store, workspace database.Workspace, roles map[string]codersdk.WorkspaceRole, field string, isUser bool) (map[string]codersdk.WorkspaceRole, []codersdk.ValidationError) {
+	// Members may not be allowed to read the users and groups they share
+	// their workspace with, so they are resolved with full read access.
+	// nolint:gocritic
+	ctx = dbauthz.AsSystemRestricted(ctx)
+	var (
+		resolved  = make(map[string]codersdk.WorkspaceRole, len(roles))
+		validErrs []codersdk.ValidationError
+	)
+	for k, role := range roles {
+		if convertSDKWorkspaceRole(role) == nil && role != codersdk.WorkspaceRoleDeleted {
+			validErrs = append(validErrs, codersdk.ValidationError{Field: field, Detail: fmt.Sprintf("Role %q is not a valid workspace role.", role)})
+			continue
+		}
+
+		id, err := uuid.Parse(k)
+		// Removing an entry by ID needs no further checks, so that users and
+		// groups that no longer exist can be cleaned up.
+		if err == nil && role == codersdk.WorkspaceRoleDeleted {
+			resolved[id.String()] = role
+			continue
+		}
+
+		if isUser {
+			var user database.User
+			if err == nil {
+				user, err = db.GetUserByID(ctx, id)
+			} else {
+				user, err = db.GetUserByEmailOrUsername(ctx, database.GetUserByEmailOrUsernameParams{Username: k})
+			}
+			if err != nil || user.Deleted {
+				validErrs = append(validErrs, codersdk.ValidationError{Field: field, Detail: fmt.Sprintf("User %q does not exist.", k)})
+				continue
+			}
+			if role == codersdk.WorkspaceRoleDeleted {
+				resolved[user.ID.String()] = role
+				continue
+			}
+			if user.ID == workspace.OwnerID {
+				validErrs = append(validErrs, codersdk.ValidationError{Field: field, Detail: "The workspace owner cannot be added to the ACL."})
+				continue
+			}
+			members, err := db.OrganizationMembers(ctx, database.OrganizationMembersParams{
+				OrganizationID: workspace.OrganizationID,
+				UserID:         user.ID,
+			})
+			if err != nil || len(members) == 0 {
+				validErrs = append(validErrs, codersdk.ValidationError{Field: field, Detail: fmt.Sprintf("User %q is not a member of the organization of the workspace.", k)})
+				continue
+			}
+			resolved[user.ID.String()] = role
+		} else {
+			var group database.Group
+			if err == nil {
+				group, err = db.GetGroupByID(ctx, id)
+			} else {
+				group, err = db.GetGroupByOrgAndName(ctx, database.GetGroupByOrgAndNameParams{
+					OrganizationID: workspace.OrganizationID,
+					Name:           k,
+				})
+			}
+			if err != nil || group.OrganizationID != workspace.OrganizationID {
+				validErrs = append(validErrs, codersdk.ValidationError{Field: field, Detail: fmt.Sprintf("Group %q does not exist in the organization of the workspace.", k)})
+				continue
+			}
+			resolved[group.ID.String()] = role
+		}
+	}
+
+	return resolved, validErrs
+}
+
+var (
+	workspaceRoleUseActions = []policy.Action{
+		policy.ActionRead,
+		policy.ActionSSH,
+		policy.ActionApplicationConnect,
+	}
+	workspaceRoleAdminActions = []policy.Action{
+		policy.ActionRead,
+		policy.ActionUpdate,
+		policy.ActionSSH,
+		policy.ActionApplicationConnect,
+		policy.ActionWorkspaceStart,
+		policy.ActionWorkspaceStop,
+	}
+)
+
+func convertToWorkspaceRole(actions []policy.Action) codersdk.WorkspaceRole {
+	switch {
+	case slices.Equal(actions, workspaceRoleAdminActions):
+		return codersdk.WorkspaceRoleAdmin
+	case slices.Equal(actions, workspaceRoleUseActions):
+		return codersdk.WorkspaceRoleUse
+	}
+
+	return ""
+}
+
+func convertSDKWorkspaceRole(role codersdk.WorkspaceRole) []policy.Action {
+	switch role {
+	case codersdk.WorkspaceRoleAdmin:
+		return workspaceRoleAdminActions
+	case codersdk.WorkspaceRoleUse:
+		return workspaceRoleUseActions
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceACL(t *testing.T) {
+	t.Parallel()
+
+	client, store := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+	memberClient, member := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+	sharedClient, shared := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+	otherClient, other := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+
+	newWorkspace := func(t *testing.T) database.Workspace {
+		t.Helper()
+		return dbfake.WorkspaceBuild(t, store, database.Workspace{
+			OrganizationID: owner.OrganizationID,
+			OwnerID:        member.ID,
+		}).WithAgent().Do().Workspace
+	}
+
+	// permissions checks the actions the shared user may perform on the
+	// workspace.
+	permissions := func(t *testing.T, workspace database.Workspace) codersdk.AuthorizationResponse {
+		t.Helper()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		checks := map[string]codersdk.AuthorizationCheck{}
+		for _, action := range []codersdk.RBACAction{codersdk.ActionRead, codersdk.ActionSSH, codersdk.ActionApplicationConnect, codersdk.ActionUpdate} {
+			checks[string(action)] = codersdk.AuthorizationCheck{
+				Object: codersdk.AuthorizationObject{
+					ResourceType: codersdk.ResourceWorkspace,
+					ResourceID:   workspace.ID.String(),
+				},
+				Action: action,
+			}
+		}
+		resp, err := sharedClient.AuthCheck(ctx, codersdk.AuthorizationRequest{Checks: checks})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("Use", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		workspace := newWorkspace(t)
+
+		_, err := sharedClient.Workspace(ctx, workspace.ID)
+		require.Error(t, err)
+
+		err = memberClient.UpdateWorkspaceACL(ctx, workspace.ID, codersdk.UpdateWorkspaceACL{
+			UserRoles: map[string]codersdk.WorkspaceRole{
+				shared.ID.String(): codersdk.WorkspaceRoleUse,
+			},
+		})
+		require.NoError(t, err)
+
+		acl, err := sharedClient.WorkspaceACL(ctx, workspace.ID)
+		require.NoError(t, err)
+		require.Len(t, acl.Users, 1)
+		require.Equal(t, shared.ID, acl.Users[0].ID)
+		require.Equal(t, codersdk.WorkspaceRoleUse, acl.Users[0].Role)
+		require.Empty(t, acl.Groups)
+
+		require.Equal(t, codersdk.AuthorizationResponse{
+			string(codersdk.ActionRead):               true,
+			string(codersdk.ActionSSH):                true,
+			string(codersdk.ActionApplicationConnect): true,
+			string(codersdk.ActionUpdate):             false,
+		}, permissions(t, workspace))
+
+		// Shared workspaces are listed for the user they are shared with.
+		res, err := sharedClient.Workspaces(ctx, codersdk.WorkspaceFilter{})
+		require.NoError(t, err)
+		require.Len(t, res.Workspaces, 1)
+		require.Equal(t, workspace.ID, res.Workspaces[0].ID)
+
+		// Users with the use role may not manage the ACL.
+		err = sharedClient.UpdateWorkspaceACL(ctx, workspace.ID, codersdk.UpdateWorkspaceACL{
+			UserRoles: map[string]codersdk.WorkspaceRole{
+				shared.ID.String(): codersdk.WorkspaceRoleAdmin,
+			},
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+
+		// Other users are unaffected.
+		_, err = otherClient.Workspace(ctx, workspace.ID)
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+
+		err = memberClient.UpdateWorkspaceACL(ctx, workspace.ID, codersdk.UpdateWorkspaceACL{
+			UserRoles: map[string]codersdk.WorkspaceRole{
+				shared.ID.String(): codersdk.WorkspaceRoleDeleted,
+			},
+		})
+		require.NoError(t, err)
+		_, err = sharedClient.Workspace(ctx, workspace.ID)
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+
+	t.Run("Admin", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		workspace := newWorkspace(t)
+
+		err := memberClient.UpdateWorkspaceACL(ctx, workspace.ID, codersdk.UpdateWorkspaceACL{
+			UserRoles: map[string]codersdk.WorkspaceRole{
+				shared.ID.String(): codersdk.WorkspaceRoleAdmin,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, codersdk.AuthorizationResponse{
+			string(codersdk.ActionRead):               true,
+			string(codersdk.ActionSSH):                true,
+			string(codersdk.ActionApplicationConnect): true,
+			string(codersdk.ActionUpdate):             true,
+		}, permissions(t, workspace))
+
+		// Admins may update the workspace, but not share it further or
+		// change who it is shared with.
+		for _, req := range []codersdk.UpdateWorkspaceACL{
+			{UserRoles: map[string]codersdk.WorkspaceRole{other.ID.String(): codersdk.WorkspaceRoleAdmin}},
+			{UserRoles: map[string]codersdk.WorkspaceRole{shared.ID.String(): codersdk.WorkspaceRoleDeleted}},
+		} {
+			err = sharedClient.UpdateWorkspaceACL(ctx, workspace.ID, req)
+			var apiErr *codersdk.Error
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+		}
+		acl, err := memberClient.WorkspaceACL(ctx, workspace.ID)
+		require.NoError(t, err)
+		require.Len(t, acl.Users, 1)
+		require.Equal(t, shared.ID, acl.Users[0].ID)
+		require.Equal(t, codersdk.WorkspaceRoleAdmin, acl.Users[0].Role)
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		workspace := newWorkspace(t)
+		group := dbgen.Group(t, store, database.Group{OrganizationID: owner.OrganizationID})
+		dbgen.GroupMember(t, store, database.GroupMemberTable{GroupID: group.ID, UserID: shared.ID})
+
+		err := memberClient.UpdateWorkspaceACL(ctx, workspace.ID, codersdk.UpdateWorkspaceACL{
+			GroupRoles: map[string]codersdk.WorkspaceRole{
+				group.ID.String(): codersdk.WorkspaceRoleUse,
+			},
+		})
+		require.NoError(t, err)
+
+		acl, err := memberClient.WorkspaceACL(ctx, workspace.ID)
+		require.NoError(t, err)
+		require.Len(t, acl.Groups, 1)
+		require.Equal(t, group.ID, acl.Groups[0].ID)
+		require.Equal(t, codersdk.WorkspaceRoleUse, acl.Groups[0].Role)
+
+		require.Equal(t, codersdk.AuthorizationResponse{
+			string(codersdk.ActionRead):               true,
+			string(codersdk.ActionSSH):                true,
+			string(codersdk.ActionApplicationConnect): true,
+			string(codersdk.ActionUpdate):             false,
+		}, permissions(t, workspace))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		workspace := newWorkspace(t)
+
+		for _, req := range []codersdk.UpdateWorkspaceACL{
+			{UserRoles: map[string]codersdk.WorkspaceRole{member.ID.String(): codersdk.WorkspaceRoleUse}},
+			{UserRoles: map[string]codersdk.WorkspaceRole{shared.ID.String(): "owner"}},
+			{UserRoles: map[string]codersdk.WorkspaceRole{"not-a-uuid": codersdk.WorkspaceRoleUse}},
+			{GroupRoles: map[string]codersdk.WorkspaceRole{shared.ID.String(): codersdk.WorkspaceRoleUse}},
+		} {
+			err := memberClient.UpdateWorkspaceACL(ctx, workspace.ID, req)
+			var apiErr *codersdk.Error
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		}
+	})
+}
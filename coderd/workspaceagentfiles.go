@@ -0,0 +1,180 @@
+package coderd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/coder/coder/v2/coderd/database/db2sdk"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+)
+
+// @Summary List directory in workspace agent
+// @Description Relative paths are resolved against the home directory of the workspace agent user.
+// @ID list-directory-in-workspace-agent
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Agents
+// @Param workspaceagent path string true "Workspace agent ID" format(uuid)
+// @Param path query string true "Directory path"
+// @Success 200 {object} codersdk.WorkspaceAgentDirectory
+// @Router /workspaceagents/{workspaceagent}/files/list [get]
+func (api *API) workspaceAgentListDirectory(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	agentConn, release, ok := api.workspaceAgentFilesConn(rw, r)
+	if !ok {
+		return
+	}
+	defer release()
+
+	dir, err := agentConn.ListDirectory(ctx, r.URL.Query().Get("path"))
+	if err != nil {
+		writeAgentFilesError(ctx, rw, "Internal error listing directory.", err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, dir)
+}
+
+// @Summary Read file from workspace agent
+// @Description Range requests are supported to read part of the file.
+// @ID read-file-from-workspace-agent
+// @Security CoderSessionToken
+// @Tags Agents
+// @Param workspaceagent path string true "Workspace agent ID" format(uuid)
+// @Param path query string true "File path"
+// @Param Range header string false "Byte range to read"
+// @Success 200
+// @Success 206
+// @Router /workspaceagents/{workspaceagent}/files [get]
+func (api *API) workspaceAgentReadFile(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	agentConn, release, ok := api.workspaceAgentFilesConn(rw, r)
+	if !ok {
+		return
+	}
+	defer release()
+
+	res, err := agentConn.ReadFile(ctx, r.URL.Query().Get("path"), r.Header.Get("Range"))
+	if err != nil {
+		writeAgentFilesError(ctx, rw, "Internal error reading file.", err)
+		return
+	}
+	defer res.Body.Close()
+
+	for _, header := range []string{"Accept-Ranges", "Content-Length", "Content-Range", "Content-Type", "Last-Modified"} {
+		if v := res.Header.Get(header); v != "" {
+			rw.Header().Set(header, v)
+		}
+	}
+	rw.WriteHeader(res.StatusCode)
+	_, _ = io.Copy(rw, res.Body)
+}
+
+// @Summary Write file to workspace agent
+// @Description The request body is written to the file, which is created if it does not exist.
+// @Description Existing content from offset onwards is replaced, which allows interrupted uploads to be resumed.
+// @ID write-file-to-workspace-agent
+// @Security CoderSessionToken
+// @Accept application/octet-stream
+// @Produce json
+// @Tags Agents
+// @Param workspaceagent path string true "Workspace agent ID" format(uuid)
+// @Param path query string true "File path"
+// @Param offset query int false "Offset to write from"
+// @Param request body string true "File content"
+// @Success 200 {object} codersdk.WorkspaceAgentFileInfo
+// @Router /workspaceagents/{workspaceagent}/files [put]
+func (api *API) workspaceAgentWriteFile(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	agentConn, release, ok := api.workspaceAgentFilesConn(rw, r)
+	if !ok {
+		return
+	}
+	defer release()
+
+	var offset int64
+	if s := r.URL.Query().Get("offset"); s != "" {
+		var err error
+		offset, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || offset < 0 {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Query param \"offset\" must be a non-negative integer.",
+			})
+			return
+		}
+	}
+
+	info, err := agentConn.WriteFile(ctx, r.URL.Query().Get("path"), offset, r.Body)
+	if err != nil {
+		writeAgentFilesError(ctx, rw, "Internal error writing file.", err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, info)
+}
+
+// workspaceAgentFilesConn authorizes access to the files of a workspace agent
+// and dials it. File access is equivalent to SSH access, so the same
+// permission is required.
+func (api *API) workspaceAgentFilesConn(rw http.ResponseWriter, r *http.Request) (*workspacesdk.AgentConn, func(), bool) {
+	ctx := r.Context()
+	workspace := httpmw.WorkspaceParam(r)
+	workspaceAgent := httpmw.WorkspaceAgentParam(r)
+	if !api.Authorize(r, policy.ActionSSH, workspace) {
+		httpapi.ResourceNotFound(rw)
+		return nil, nil, false
+	}
+
+	apiAgent, err := db2sdk.WorkspaceAgent(
+		api.DERPMap(), *api.TailnetCoordinator.Load(), workspaceAgent, nil, nil, nil, api.AgentInactiveDisconnectTimeout,
+		api.DeploymentValues.AgentFallbackTroubleshootingURL.String(),
+	)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error reading workspace agent.",
+			Detail:  err.Error(),
+		})
+		return nil, nil, false
+	}
+	if apiAgent.Status != codersdk.WorkspaceAgentConnected {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Agent state is %q, it must be in the %q state.", apiAgent.Status, codersdk.WorkspaceAgentConnected),
+		})
+		return nil, nil, false
+	}
+
+	// If the agent is unreachable, dialing will hang. Transfers themselves
+	// may take much longer, so the timeout only applies to the dial.
+	dialCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	agentConn, release, err := api.agentProvider.AgentConn(dialCtx, workspaceAgent.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error dialing workspace agent.",
+			Detail:  err.Error(),
+		})
+		return nil, nil, false
+	}
+	return agentConn, release, true
+}
+
+// writeAgentFilesError relays errors returned by the agent, such as a missing
+// file, with their original status code.
+func writeAgentFilesError(ctx context.Context, rw http.ResponseWriter, msg string, err error) {
+	var sdkErr *codersdk.Error
+	if errors.As(err, &sdkErr) {
+		httpapi.Write(ctx, rw, sdkErr.StatusCode(), sdkErr.Response)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+		Message: msg,
+		Detail:  err.Error(),
+	})
+}
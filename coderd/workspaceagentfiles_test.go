@@ -0,0 +1,156 @@
+package coderd_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent"
+	"github.com/coder/coder/v2/agent/agentssh"
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceAgentFiles(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T, blockFileTransfer bool) (*codersdk.Client, *codersdk.Client, uuid.UUID) {
+		t.Helper()
+
+		client, db := coderdtest.NewWithDatabase(t, nil)
+		user := coderdtest.CreateFirstUser(t, client)
+		otherClient, _ := coderdtest.CreateAnotherUser(t, client, user.OrganizationID)
+		r := dbfake.WorkspaceBuild(t, db, database.Workspace{
+			OrganizationID: user.OrganizationID,
+			OwnerID:        user.UserID,
+		}).WithAgent().Do()
+		_ = agenttest.New(t, client.URL, r.AgentToken, func(o *agent.Options) {
+			o.BlockFileTransfer = blockFileTransfer
+		})
+		resources := coderdtest.AwaitWorkspaceAgents(t, client, r.Workspace.ID)
+		return client, otherClient, resources[0].Agents[0].ID
+	}
+
+	client, otherClient, agentID := setup(t, false)
+
+	t.Run("WriteAndRead", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		path := filepath.Join(t.TempDir(), "file.txt")
+		info, err := client.WorkspaceAgentWriteFile(ctx, agentID, path, 0, strings.NewReader("hello world"))
+		require.NoError(t, err)
+		require.Equal(t, "file.txt", info.Name)
+		require.Equal(t, path, info.Path)
+		require.EqualValues(t, 11, info.Size)
+		require.False(t, info.IsDir)
+
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(got))
+
+		body, err := client.WorkspaceAgentReadFile(ctx, agentID, path, 0)
+		require.NoError(t, err)
+		data, err := io.ReadAll(body)
+		_ = body.Close()
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(data))
+
+		body, err = client.WorkspaceAgentReadFile(ctx, agentID, path, 6)
+		require.NoError(t, err)
+		data, err = io.ReadAll(body)
+		_ = body.Close()
+		require.NoError(t, err)
+		require.Equal(t, "world", string(data))
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		path := filepath.Join(t.TempDir(), "file.bin")
+		// Simulate an interrupted upload that left a partial write behind.
+		require.NoError(t, os.WriteFile(path, []byte("0123garbage"), 0o600))
+
+		info, err := client.WorkspaceAgentWriteFile(ctx, agentID, path, 4, bytes.NewReader([]byte("456789")))
+		require.NoError(t, err)
+		require.EqualValues(t, 10, info.Size)
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "0123456789", string(got))
+
+		_, err = client.WorkspaceAgentWriteFile(ctx, agentID, path, 100, strings.NewReader("x"))
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("ListDirectory", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("bb"), 0o600))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "a"), 0o700))
+
+		listing, err := client.WorkspaceAgentListDirectory(ctx, agentID, dir)
+		require.NoError(t, err)
+		require.Equal(t, dir, listing.Path)
+		require.Len(t, listing.Files, 2)
+		require.Equal(t, "a", listing.Files[0].Name)
+		require.True(t, listing.Files[0].IsDir)
+		require.Equal(t, "b.txt", listing.Files[1].Name)
+		require.Equal(t, filepath.Join(dir, "b.txt"), listing.Files[1].Path)
+		require.EqualValues(t, 2, listing.Files[1].Size)
+
+		_, err = client.WorkspaceAgentListDirectory(ctx, agentID, filepath.Join(dir, "b.txt"))
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		_, err := client.WorkspaceAgentReadFile(ctx, agentID, filepath.Join(t.TempDir(), "missing"), 0)
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		_, err := otherClient.WorkspaceAgentListDirectory(ctx, agentID, t.TempDir())
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+
+	t.Run("BlockFileTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		client, _, agentID := setup(t, true)
+		ctx := testutil.Context(t, testutil.WaitLong)
+		path := filepath.Join(t.TempDir(), "file.txt")
+		_, err := client.WorkspaceAgentWriteFile(ctx, agentID, path, 0, strings.NewReader("hello"))
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+		require.Equal(t, agentssh.BlockedFileTransferErrorMessage, apiErr.Message)
+		require.NoFileExists(t, path)
+	})
+}
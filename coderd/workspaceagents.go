@@ -110,7 +110,8 @@ func (api *API) workspaceAgent(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
-	owner, err := api.Database.GetUserByID(ctx, workspace.OwnerID)
+	// nolint:gocritic // Users a workspace is shared with may not be allowed to read its owner.
+	owner, err := api.Database.GetUserByID(dbauthz.AsSystemRestricted(ctx), workspace.OwnerID)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error fetching workspace owner.",
@@ -887,6 +888,29 @@ func (api *API) workspaceAgentClientCoordinate(rw http.ResponseWriter, r *http.R
 
 	go httpapi.Heartbeat(ctx, conn)
 
+	// Remember who owns the peer so that the connections the agent reports
+	// from its tailnet address can be attributed to a user.
+	if apiKey, ok := httpmw.APIKeyOptional(r); ok {
+		//nolint:gocritic // The peer mapping is internal bookkeeping.
+		sysCtx := dbauthz.AsSystemRestricted(ctx)
+		err = api.Database.UpsertWorkspaceAgentClientPeer(sysCtx, database.UpsertWorkspaceAgentClientPeerParams{
+			PeerID:    peerID,
+			AgentID:   workspaceAgent.ID,
+			UserID:    apiKey.UserID,
+			CreatedAt: dbtime.Now(),
+		})
+		if err != nil {
+			api.Logger.Warn(ctx, "failed to record workspace agent client peer", slog.Error(err))
+		} else {
+			defer func() {
+				err := api.Database.DeleteWorkspaceAgentClientPeer(context.WithoutCancel(sysCtx), peerID)
+				if err != nil {
+					api.Logger.Warn(ctx, "failed to delete workspace agent client peer", slog.Error(err))
+				}
+			}()
+		}
+	}
+
 	defer conn.Close(websocket.StatusNormalClosure, "")
 	err = api.TailnetClientService.ServeClient(ctx, version, wsNetConn, peerID, workspaceAgent.ID)
 	if err != nil && !xerrors.Is(err, io.EOF) && !xerrors.Is(err, context.Canceled) {
@@ -158,6 +158,7 @@ func (api *API) workspaceAgentRPC(rw http.ResponseWriter, r *http.Request) {
 		DerpMapUpdateFrequency:    api.Options.DERPMapUpdateFrequency,
 		ExternalAuthConfigs:       api.ExternalAuthConfigs,
 		Experiments:               api.Experiments,
+		SessionRecording:          api.DeploymentValues.SessionRecording,
 
 		// Optional:
 		WorkspaceID:          build.WorkspaceID, // saves the extra lookup later
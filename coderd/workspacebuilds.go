@@ -410,7 +410,8 @@ func (api *API) postWorkspaceBuilds(rw http.ResponseWriter, r *http.Request) {
 		api.Logger.Error(ctx, "failed to post provisioner job to pubsub", slog.Error(err))
 	}
 
-	users, err := api.Database.GetUsersByIDs(ctx, []uuid.UUID{
+	// nolint:gocritic // Users a workspace is shared with may not be allowed to read its owner.
+	users, err := api.Database.GetUsersByIDs(dbauthz.AsSystemRestricted(ctx), []uuid.UUID{
 		workspace.OwnerID,
 		workspaceBuild.InitiatorID,
 	})
@@ -664,7 +665,10 @@ func (api *API) workspaceBuildsData(ctx context.Context, workspaces []database.W
 	for _, workspace := range workspaces {
 		userIDs = append(userIDs, workspace.OwnerID)
 	}
-	users, err := api.Database.GetUsersByIDs(ctx, userIDs)
+	// Users a workspace is shared with may not be allowed to read its owner,
+	// but they can read the workspace if they got this far.
+	// nolint:gocritic
+	users, err := api.Database.GetUsersByIDs(dbauthz.AsSystemRestricted(ctx), userIDs)
 	if err != nil {
 		return workspaceBuildsData{}, xerrors.Errorf("get users: %w", err)
 	}
@@ -0,0 +1,197 @@
+package coderd
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/searchquery"
+	"github.com/coder/coder/v2/coderd/util/ptr"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Create workspace bulk operation
+// @Description Builds all workspaces matching a search query. Builds are
+// @Description enqueued in the background at a limited rate and authorized
+// @Description as the requester.
+// @ID create-workspace-bulk-operation
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Workspaces
+// @Param request body codersdk.CreateWorkspaceBulkOperationRequest true "Create workspace bulk operation request"
+// @Success 201 {object} codersdk.WorkspaceBulkOperation
+// @Router /workspacebulkoperations [post]
+func (api *API) postWorkspaceBulkOperation(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx               = r.Context()
+		apiKey            = httpmw.APIKey(r)
+		auditor           = *api.Auditor.Load()
+		aReq, commitAudit = audit.InitRequest[database.WorkspaceBulkOperation](rw, &audit.RequestParams{
+			Audit:   auditor,
+			Log:     api.Logger,
+			Request: r,
+			Action:  database.AuditActionCreate,
+		})
+	)
+	defer commitAudit()
+
+	var req codersdk.CreateWorkspaceBulkOperationRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	filter, errs := searchquery.Workspaces(ctx, api.Database, req.Search, codersdk.Pagination{}, api.AgentInactiveDisconnectTimeout)
+	if len(errs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid workspace search query.",
+			Validations: errs,
+		})
+		return
+	}
+	if filter.OwnerUsername == "me" {
+		filter.OwnerID = apiKey.UserID
+		filter.OwnerUsername = ""
+	}
+
+	// The filter includes workspaces shared with the user through the
+	// workspace ACLs.
+	prepared, err := api.HTTPAuth.AuthorizeSQLFilter(r, policy.ActionRead, rbac.ResourceWorkspace.Type)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error preparing sql filter.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	rows, err := api.Database.GetAuthorizedWorkspaces(ctx, filter, prepared)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspaces.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if len(rows) == 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "No workspaces match the search query.",
+		})
+		return
+	}
+	workspaceIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		workspaceIDs = append(workspaceIDs, row.ID)
+	}
+
+	var op database.WorkspaceBulkOperation
+	err = api.Database.InTx(func(tx database.Store) error {
+		now := dbtime.Now()
+		op, err = tx.InsertWorkspaceBulkOperation(ctx, database.InsertWorkspaceBulkOperationParams{
+			ID:             uuid.New(),
+			InitiatorID:    apiKey.UserID,
+			InitiatorScope: apiKey.Scope,
+			Action:         database.WorkspaceBulkOperationAction(req.Action),
+			Search:         req.Search,
+			CreatedAt:      now,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.InsertWorkspaceBulkOperationWorkspaces(ctx, database.InsertWorkspaceBulkOperationWorkspacesParams{
+			BulkOperationID: op.ID,
+			WorkspaceIds:    workspaceIDs,
+			UpdatedAt:       now,
+		})
+	}, nil)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	aReq.New = op
+
+	api.bulkBuildRunner.Start(op.ID)
+
+	resp, err := api.convertWorkspaceBulkOperation(r, op)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusCreated, resp)
+}
+
+// @Summary Get workspace bulk operation
+// @ID get-workspace-bulk-operation
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspacebulkoperation path string true "Workspace bulk operation ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceBulkOperation
+// @Router /workspacebulkoperations/{workspacebulkoperation} [get]
+func (api *API) workspaceBulkOperation(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, ok := httpmw.ParseUUIDParam(rw, r, "workspacebulkoperation")
+	if !ok {
+		return
+	}
+
+	op, err := api.Database.GetWorkspaceBulkOperationByID(ctx, id)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	resp, err := api.convertWorkspaceBulkOperation(r, op)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+func (api *API) convertWorkspaceBulkOperation(r *http.Request, op database.WorkspaceBulkOperation) (codersdk.WorkspaceBulkOperation, error) {
+	rows, err := api.Database.GetWorkspaceBulkOperationWorkspacesByBulkOperationID(r.Context(), op.ID)
+	if err != nil {
+		return codersdk.WorkspaceBulkOperation{}, err
+	}
+
+	resp := codersdk.WorkspaceBulkOperation{
+		ID:          op.ID,
+		InitiatorID: op.InitiatorID,
+		Action:      codersdk.WorkspaceBulkOperationAction(op.Action),
+		Search:      op.Search,
+		CreatedAt:   op.CreatedAt,
+		Workspaces:  make([]codersdk.WorkspaceBulkOperationWorkspace, 0, len(rows)),
+	}
+	if op.CompletedAt.Valid {
+		resp.CompletedAt = ptr.Ref(op.CompletedAt.Time)
+	}
+	for _, row := range rows {
+		ws := codersdk.WorkspaceBulkOperationWorkspace{
+			WorkspaceID:        row.WorkspaceID,
+			WorkspaceName:      row.WorkspaceName,
+			WorkspaceOwnerName: row.WorkspaceOwnerUsername,
+			Status:             codersdk.WorkspaceBulkOperationWorkspaceStatus(row.Status),
+			Message:            row.Message,
+			UpdatedAt:          row.UpdatedAt,
+		}
+		if row.WorkspaceBuildID.Valid {
+			ws.WorkspaceBuildID = ptr.Ref(row.WorkspaceBuildID.UUID)
+		}
+		if row.JobStatus.Valid {
+			ws.JobStatus = ptr.Ref(codersdk.ProvisionerJobStatus(row.JobStatus.ProvisionerJobStatus))
+		}
+		resp.Workspaces = append(resp.Workspaces, ws)
+	}
+	return resp, nil
+}
@@ -0,0 +1,190 @@
+package coderd_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceBulkOperation(t *testing.T) {
+	t.Parallel()
+	otel.SetTextMapPropagator(
+		propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		),
+	)
+
+	// awaitDone polls the operation until all of its builds completed.
+	awaitDone := func(ctx context.Context, t *testing.T, client *codersdk.Client, id uuid.UUID) codersdk.WorkspaceBulkOperation {
+		t.Helper()
+		var op codersdk.WorkspaceBulkOperation
+		require.Eventually(t, func() bool {
+			var err error
+			op, err = client.WorkspaceBulkOperation(ctx, id)
+			return err == nil && op.Done()
+		}, testutil.WaitLong, testutil.IntervalMedium)
+		return op
+	}
+
+	t.Run("Stop", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		auditor := audit.NewMock()
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true, Auditor: auditor})
+		owner := coderdtest.CreateFirstUser(t, client)
+		member, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		first := coderdtest.CreateWorkspace(t, member, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, first.LatestBuild.ID)
+		second := coderdtest.CreateWorkspace(t, client, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, second.LatestBuild.ID)
+		auditor.ResetLogs()
+
+		op, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Action: codersdk.WorkspaceBulkOperationActionStop,
+			Search: "template:" + template.Name,
+		})
+		require.NoError(t, err)
+		require.Len(t, op.Workspaces, 2)
+
+		op = awaitDone(ctx, t, client, op.ID)
+		for _, ws := range op.Workspaces {
+			require.Equal(t, codersdk.WorkspaceBulkOperationWorkspaceStatusEnqueued, ws.Status, ws.Message)
+			require.NotNil(t, ws.WorkspaceBuildID)
+			require.Equal(t, codersdk.ProvisionerJobSucceeded, *ws.JobStatus)
+		}
+		for _, id := range []uuid.UUID{first.ID, second.ID} {
+			workspace, err := client.Workspace(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, codersdk.WorkspaceTransitionStop, workspace.LatestBuild.Transition)
+		}
+
+		// The operation is audited once, and each build refers to it.
+		var builds int
+		require.True(t, auditor.Contains(t, database.AuditLog{
+			ResourceType: database.ResourceTypeWorkspaceBulkOperation,
+			ResourceID:   op.ID,
+			Action:       database.AuditActionCreate,
+		}))
+		for _, log := range auditor.AuditLogs() {
+			if log.ResourceType != database.ResourceTypeWorkspaceBuild {
+				continue
+			}
+			var fields audit.AdditionalFields
+			require.NoError(t, json.Unmarshal(log.AdditionalFields, &fields))
+			require.Equal(t, op.ID.String(), fields.BulkOperationID)
+			builds++
+		}
+		require.Equal(t, 2, builds)
+
+		// Stopping again skips both workspaces.
+		op, err = client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Action: codersdk.WorkspaceBulkOperationActionStop,
+			Search: "template:" + template.Name,
+		})
+		require.NoError(t, err)
+		op = awaitDone(ctx, t, client, op.ID)
+		for _, ws := range op.Workspaces {
+			require.Equal(t, codersdk.WorkspaceBulkOperationWorkspaceStatusSkipped, ws.Status)
+			require.Nil(t, ws.WorkspaceBuildID)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		outdated := coderdtest.CreateWorkspace(t, client, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, outdated.LatestBuild.ID)
+
+		newVersion := coderdtest.UpdateTemplateVersion(t, client, owner.OrganizationID, nil, template.ID)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, newVersion.ID)
+		err := client.UpdateActiveTemplateVersion(ctx, template.ID, codersdk.UpdateActiveTemplateVersion{ID: newVersion.ID})
+		require.NoError(t, err)
+		current := coderdtest.CreateWorkspace(t, client, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, current.LatestBuild.ID)
+
+		op, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Action: codersdk.WorkspaceBulkOperationActionUpdate,
+			Search: "template:" + template.Name,
+		})
+		require.NoError(t, err)
+		op = awaitDone(ctx, t, client, op.ID)
+		statuses := map[uuid.UUID]codersdk.WorkspaceBulkOperationWorkspaceStatus{}
+		for _, ws := range op.Workspaces {
+			statuses[ws.WorkspaceID] = ws.Status
+		}
+		require.Equal(t, codersdk.WorkspaceBulkOperationWorkspaceStatusEnqueued, statuses[outdated.ID])
+		require.Equal(t, codersdk.WorkspaceBulkOperationWorkspaceStatusSkipped, statuses[current.ID])
+
+		workspace, err := client.Workspace(ctx, outdated.ID)
+		require.NoError(t, err)
+		require.Equal(t, newVersion.ID, workspace.LatestBuild.TemplateVersionID)
+	})
+
+	t.Run("OnlyReadableWorkspaces", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+		member, memberUser := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		own := coderdtest.CreateWorkspace(t, member, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, own.LatestBuild.ID)
+		other := coderdtest.CreateWorkspace(t, client, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, other.LatestBuild.ID)
+
+		op, err := member.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Action: codersdk.WorkspaceBulkOperationActionStop,
+			Search: "template:" + template.Name,
+		})
+		require.NoError(t, err)
+		require.Len(t, op.Workspaces, 1)
+		require.Equal(t, own.ID, op.Workspaces[0].WorkspaceID)
+		require.Equal(t, memberUser.ID, op.InitiatorID)
+		awaitDone(ctx, t, member, op.ID)
+
+		// Other users cannot read the operation.
+		otherMember, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+		_, err = otherMember.WorkspaceBulkOperation(ctx, op.ID)
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusNotFound, sdkErr.StatusCode())
+	})
+
+	t.Run("NoMatches", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitShort)
+		client := coderdtest.New(t, nil)
+		_ = coderdtest.CreateFirstUser(t, client)
+
+		_, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Action: codersdk.WorkspaceBulkOperationActionStart,
+			Search: "template:doesnotexist",
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+	})
+}
@@ -115,6 +115,7 @@ func (api *API) workspace(rw http.ResponseWriter, r *http.Request) {
 		data.templates[0],
 		owner.Username,
 		owner.AvatarURL,
+		data.drifts[workspace.ID],
 		api.Options.AllowWorkspaceRenames,
 	)
 	if err != nil {
@@ -164,7 +165,8 @@ func (api *API) workspaces(rw http.ResponseWriter, r *http.Request) {
 		filter.OwnerUsername = ""
 	}
 
-	// Workspaces do not have ACL columns.
+	// Shared workspaces are matched through the user_acl and group_acl
+	// columns.
 	prepared, err := api.HTTPAuth.AuthorizeSQLFilter(r, policy.ActionRead, rbac.ResourceWorkspace.Type)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -322,6 +324,7 @@ func (api *API) workspaceByOwnerAndName(rw http.ResponseWriter, r *http.Request)
 		data.templates[0],
 		owner.Username,
 		owner.AvatarURL,
+		data.drifts[workspace.ID],
 		api.Options.AllowWorkspaceRenames,
 	)
 	if err != nil {
@@ -453,6 +456,7 @@ func createWorkspace(
 	req codersdk.CreateWorkspaceRequest,
 	rw http.ResponseWriter,
 	r *http.Request,
+	opts ...wsbuilder.Option,
 ) {
 	// If we were given a `TemplateVersionID`, we need to determine the `TemplateID` from it.
 	templateID := req.TemplateID
@@ -654,6 +658,9 @@ func createWorkspace(
 		if req.TemplateVersionID != uuid.Nil {
 			builder = builder.VersionID(req.TemplateVersionID)
 		}
+		for _, opt := range opts {
+			builder = opt(builder)
+		}
 
 		workspaceBuild, provisionerJob, err = builder.Build(
 			ctx,
@@ -724,6 +731,7 @@ func createWorkspace(
 		template,
 		owner.Username,
 		owner.AvatarURL,
+		unknownWorkspaceDrift(),
 		api.Options.AllowWorkspaceRenames,
 	)
 	if err != nil {
@@ -1118,6 +1126,7 @@ func (api *API) putWorkspaceDormant(rw http.ResponseWriter, r *http.Request) {
 		data.templates[0],
 		owner.Username,
 		owner.AvatarURL,
+		data.drifts[workspace.ID],
 		api.Options.AllowWorkspaceRenames,
 	)
 	if err != nil {
@@ -1340,7 +1349,7 @@ func (api *API) postWorkspaceUsage(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = api.statsReporter.ReportAgentStats(ctx, dbtime.Now(), workspace, agent, template.Name, stat)
+	err = api.statsReporter.ReportAgentStats(ctx, dbtime.Now(), workspace, agent, template.Name, template.IdleDetectionEnabled, stat)
 	if err != nil {
 		httpapi.InternalServerError(rw, err)
 		return
@@ -1677,6 +1686,7 @@ func (api *API) watchWorkspace(rw http.ResponseWriter, r *http.Request) {
 			data.templates[0],
 			owner.Username,
 			owner.AvatarURL,
+			data.drifts[workspace.ID],
 			api.Options.AllowWorkspaceRenames,
 		)
 		if err != nil {
@@ -1793,6 +1803,7 @@ type workspaceData struct {
 	templates    []database.Template
 	builds       []codersdk.WorkspaceBuild
 	users        []database.User
+	drifts       map[uuid.UUID]codersdk.WorkspaceDrift
 	allowRenames bool
 }
 
@@ -1844,14 +1855,81 @@ func (api *API) workspaceData(ctx context.Context, workspaces []database.Workspa
 		return workspaceData{}, xerrors.Errorf("convert workspace builds: %w", err)
 	}
 
+	drifts, err := api.workspaceDrifts(ctx, builds)
+	if err != nil {
+		return workspaceData{}, xerrors.Errorf("get workspace drifts: %w", err)
+	}
+
 	return workspaceData{
 		templates:    templates,
 		builds:       apiBuilds,
 		users:        data.users,
+		drifts:       drifts,
 		allowRenames: api.Options.AllowWorkspaceRenames,
 	}, nil
 }
 
+// workspaceDrifts returns the drift status of the given builds by workspace
+// ID.
+func (api *API) workspaceDrifts(ctx context.Context, builds []database.WorkspaceBuild) (map[uuid.UUID]codersdk.WorkspaceDrift, error) {
+	buildIDs := make([]uuid.UUID, 0, len(builds))
+	workspaceIDByBuildID := make(map[uuid.UUID]uuid.UUID, len(builds))
+	drifts := make(map[uuid.UUID]codersdk.WorkspaceDrift, len(builds))
+	for _, build := range builds {
+		buildIDs = append(buildIDs, build.ID)
+		workspaceIDByBuildID[build.ID] = build.WorkspaceID
+		drifts[build.WorkspaceID] = unknownWorkspaceDrift()
+	}
+
+	// The builds have already been authorized.
+	// nolint:gocritic
+	rows, err := api.Database.GetWorkspaceBuildDriftsByWorkspaceBuildIDs(dbauthz.AsSystemRestricted(ctx), buildIDs)
+	if err != nil {
+		return nil, xerrors.Errorf("get workspace build drifts: %w", err)
+	}
+	jobIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		if row.CheckedJobID.Valid && row.Drifted {
+			jobIDs = append(jobIDs, row.CheckedJobID.UUID)
+		}
+	}
+	// nolint:gocritic
+	changes, err := api.Database.GetProvisionerJobResourceChangesByJobIDs(dbauthz.AsSystemRestricted(ctx), jobIDs)
+	if err != nil {
+		return nil, xerrors.Errorf("get provisioner job resource changes: %w", err)
+	}
+	changesByJobID := map[uuid.UUID][]codersdk.WorkspaceResourceChange{}
+	for _, change := range changes {
+		changesByJobID[change.JobID] = append(changesByJobID[change.JobID], convertWorkspaceResourceChange(change))
+	}
+
+	for _, row := range rows {
+		if !row.CheckedAt.Valid {
+			continue
+		}
+		drift := codersdk.WorkspaceDrift{
+			Status:    codersdk.WorkspaceDriftStatusInSync,
+			CheckedAt: &row.CheckedAt.Time,
+			Resources: []codersdk.WorkspaceResourceChange{},
+		}
+		if row.Drifted {
+			drift.Status = codersdk.WorkspaceDriftStatusDrifted
+			if resources, ok := changesByJobID[row.CheckedJobID.UUID]; ok {
+				drift.Resources = resources
+			}
+		}
+		drifts[workspaceIDByBuildID[row.WorkspaceBuildID]] = drift
+	}
+	return drifts, nil
+}
+
+func unknownWorkspaceDrift() codersdk.WorkspaceDrift {
+	return codersdk.WorkspaceDrift{
+		Status:    codersdk.WorkspaceDriftStatusUnknown,
+		Resources: []codersdk.WorkspaceResourceChange{},
+	}
+}
+
 func convertWorkspaces(requesterID uuid.UUID, workspaces []database.Workspace, data workspaceData) ([]codersdk.Workspace, error) {
 	buildByWorkspaceID := map[uuid.UUID]codersdk.WorkspaceBuild{}
 	for _, workspaceBuild := range data.builds {
@@ -1893,6 +1971,7 @@ func convertWorkspaces(requesterID uuid.UUID, workspaces []database.Workspace, d
 			template,
 			owner.Username,
 			owner.AvatarURL,
+			data.drifts[workspace.ID],
 			data.allowRenames,
 		)
 		if err != nil {
@@ -1911,6 +1990,7 @@ func convertWorkspace(
 	template database.Template,
 	username string,
 	avatarURL string,
+	drift codersdk.WorkspaceDrift,
 	allowRenames bool,
 ) (codersdk.Workspace, error) {
 	if requesterID == uuid.Nil {
@@ -1978,6 +2058,7 @@ func convertWorkspace(
 			Healthy:       len(failingAgents) == 0,
 			FailingAgents: failingAgents,
 		},
+		Drift:            drift,
 		AutomaticUpdates: codersdk.AutomaticUpdates(workspace.AutomaticUpdates),
 		AllowRenames:     allowRenames,
 		Favorite:         requesterFavorite,
@@ -0,0 +1,113 @@
+package coderd
+
+import (
+	"net/http"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Get workspace session recordings
+// @ID get-workspace-session-recordings
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Success 200 {array} codersdk.WorkspaceSessionRecording
+// @Router /workspaces/{workspace}/recordings [get]
+func (api *API) workspaceSessionRecordings(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	if !api.authorizeWorkspaceSessionRecordings(r, workspace) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	rows, err := api.Database.GetWorkspaceSessionRecordingsByWorkspaceID(ctx, workspace.ID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace session recordings.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	recordings := make([]codersdk.WorkspaceSessionRecording, 0, len(rows))
+	for _, row := range rows {
+		recordings = append(recordings, codersdk.WorkspaceSessionRecording{
+			ID:            row.ID,
+			WorkspaceID:   row.WorkspaceID,
+			AgentID:       row.AgentID,
+			Type:          codersdk.WorkspaceSessionRecordingType(row.Type),
+			Command:       row.Command,
+			StartedAt:     row.StartedAt,
+			EndedAt:       row.EndedAt,
+			InputRecorded: row.InputRecorded,
+			Truncated:     row.Truncated,
+			Size:          row.Size,
+		})
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, recordings)
+}
+
+// @Summary Get workspace session recording data
+// @Description The recording is returned in the asciicast v2 format.
+// @ID get-workspace-session-recording-data
+// @Security CoderSessionToken
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Param recording path string true "Recording ID" format(uuid)
+// @Success 200
+// @Router /workspaces/{workspace}/recordings/{recording} [get]
+func (api *API) workspaceSessionRecordingData(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	if !api.authorizeWorkspaceSessionRecordings(r, workspace) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	recordingID, ok := httpmw.ParseUUIDParam(rw, r, "recording")
+	if !ok {
+		return
+	}
+
+	recording, err := api.Database.GetWorkspaceSessionRecordingByID(ctx, recordingID)
+	if httpapi.Is404Error(err) || (err == nil && recording.WorkspaceID != workspace.ID) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace session recording.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/x-asciicast")
+	rw.Header().Set("Content-Disposition", "attachment; filename="+recording.ID.String()+".cast")
+	rw.WriteHeader(http.StatusOK)
+	_, _ = rw.Write(recording.Data)
+}
+
+// authorizeWorkspaceSessionRecordings returns true if the caller may read the
+// session recordings of the workspace. Recordings contain everything typed
+// into the workspace, so users it is shared with may not read them, even if
+// they may update it.
+func (api *API) authorizeWorkspaceSessionRecordings(r *http.Request, workspace database.Workspace) bool {
+	return api.Authorize(r, policy.ActionUpdate, workspace.RBACObjectWithoutACL())
+}
@@ -0,0 +1,125 @@
+package coderd_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceSessionRecordings(t *testing.T) {
+	t.Parallel()
+
+	ownerClient, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	client, member := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+	otherClient, _ := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+	sharedClient, shared := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+
+	r := dbfake.WorkspaceBuild(t, db, database.Workspace{
+		OwnerID:        member.ID,
+		OrganizationID: owner.OrganizationID,
+	}).Do()
+	agentID := uuid.New()
+
+	now := dbtime.Now()
+	older := dbgen.WorkspaceSessionRecording(t, db, database.WorkspaceSessionRecording{
+		WorkspaceID: r.Workspace.ID,
+		AgentID:     agentID,
+		StartedAt:   now.Add(-time.Hour),
+	})
+	newer := dbgen.WorkspaceSessionRecording(t, db, database.WorkspaceSessionRecording{
+		WorkspaceID:   r.Workspace.ID,
+		AgentID:       agentID,
+		Type:          database.WorkspaceSessionRecordingTypeReconnectingPty,
+		Command:       "htop",
+		StartedAt:     now.Add(-time.Minute),
+		InputRecorded: true,
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		recordings, err := client.WorkspaceSessionRecordings(ctx, r.Workspace.ID)
+		require.NoError(t, err)
+		require.Len(t, recordings, 2)
+		require.Equal(t, newer.ID, recordings[0].ID)
+		require.Equal(t, codersdk.WorkspaceSessionRecordingTypeReconnectingPTY, recordings[0].Type)
+		require.Equal(t, "htop", recordings[0].Command)
+		require.True(t, recordings[0].InputRecorded)
+		require.EqualValues(t, len(newer.Data), recordings[0].Size)
+		require.Equal(t, older.ID, recordings[1].ID)
+		require.Equal(t, codersdk.WorkspaceSessionRecordingTypeSSH, recordings[1].Type)
+	})
+
+	t.Run("Data", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		body, err := client.WorkspaceSessionRecordingData(ctx, r.Workspace.ID, older.ID)
+		require.NoError(t, err)
+		defer body.Close()
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.Equal(t, older.Data, data)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		_, err := client.WorkspaceSessionRecordingData(ctx, r.Workspace.ID, uuid.New())
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		t.Parallel()
+
+		// Members cannot see the workspaces of other members, and so cannot
+		// see their recordings either.
+		ctx := testutil.Context(t, testutil.WaitShort)
+		_, err := otherClient.WorkspaceSessionRecordings(ctx, r.Workspace.ID)
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+	t.Run("Shared", func(t *testing.T) {
+		t.Parallel()
+
+		// Sharing a workspace lets the user connect to it, and with the admin
+		// role update it, but not watch what others typed into it.
+		for _, role := range []codersdk.WorkspaceRole{codersdk.WorkspaceRoleUse, codersdk.WorkspaceRoleAdmin} {
+			ctx := testutil.Context(t, testutil.WaitShort)
+			err := client.UpdateWorkspaceACL(ctx, r.Workspace.ID, codersdk.UpdateWorkspaceACL{
+				UserRoles: map[string]codersdk.WorkspaceRole{
+					shared.ID.String(): role,
+				},
+			})
+			require.NoError(t, err)
+			_, err = sharedClient.Workspace(ctx, r.Workspace.ID)
+			require.NoError(t, err)
+
+			var apiErr *codersdk.Error
+			_, err = sharedClient.WorkspaceSessionRecordings(ctx, r.Workspace.ID)
+			require.ErrorAs(t, err, &apiErr, role)
+			require.Equal(t, http.StatusForbidden, apiErr.StatusCode(), role)
+			_, err = sharedClient.WorkspaceSessionRecordingData(ctx, r.Workspace.ID, older.ID)
+			require.ErrorAs(t, err, &apiErr, role)
+			require.Equal(t, http.StatusForbidden, apiErr.StatusCode(), role)
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package coderd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/db2sdk"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/wsbuilder"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Get workspace snapshot
+// @Description The Terraform state of the build is only included if the
+// @Description user is allowed to update the template of the workspace.
+// @ID get-workspace-snapshot
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceSnapshot
+// @Router /workspaces/{workspace}/snapshot [get]
+func (api *API) workspaceSnapshot(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	workspace := httpmw.WorkspaceParam(r)
+
+	build, err := api.Database.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching latest workspace build.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	owner, err := api.Database.GetUserByID(ctx, workspace.OwnerID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace owner.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	template, err := api.Database.GetTemplateByID(ctx, workspace.TemplateID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	templateVersion, err := api.Database.GetTemplateVersionByID(ctx, build.TemplateVersionID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template version.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	parameters, err := api.Database.GetWorkspaceBuildParameters(ctx, build.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace build parameters.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	agents, err := api.Database.GetWorkspaceAgentsInLatestBuildByWorkspaceID(ctx, workspace.ID)
+	if err != nil && !httpapi.Is404Error(err) {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace agents.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	agentMetadata := make([]codersdk.WorkspaceSnapshotAgentMetadata, 0)
+	for _, agent := range agents {
+		metadata, err := api.Database.GetWorkspaceAgentMetadata(ctx, database.GetWorkspaceAgentMetadataParams{
+			WorkspaceAgentID: agent.ID,
+		})
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching workspace agent metadata.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		for _, md := range metadata {
+			agentMetadata = append(agentMetadata, codersdk.WorkspaceSnapshotAgentMetadata{
+				AgentName:   agent.Name,
+				Key:         md.Key,
+				DisplayName: md.DisplayName,
+				Value:       md.Value,
+				Error:       md.Error,
+				CollectedAt: md.CollectedAt,
+			})
+		}
+	}
+
+	snapshot := codersdk.WorkspaceSnapshot{
+		Version:             codersdk.WorkspaceSnapshotVersion,
+		CreatedAt:           dbtime.Now(),
+		WorkspaceID:         workspace.ID,
+		WorkspaceName:       workspace.Name,
+		OwnerName:           owner.Username,
+		OrganizationID:      workspace.OrganizationID,
+		TemplateID:          template.ID,
+		TemplateName:        template.Name,
+		TemplateVersionID:   templateVersion.ID,
+		TemplateVersionName: templateVersion.Name,
+		BuildNumber:         build.BuildNumber,
+		Transition:          codersdk.WorkspaceTransition(build.Transition),
+		RichParameterValues: db2sdk.WorkspaceBuildParameters(parameters),
+		AgentMetadata:       agentMetadata,
+	}
+	// The state may contain secrets, so it follows the same rules as
+	// fetching the state of a build.
+	if api.Authorize(r, policy.ActionUpdate, template.RBACObject()) {
+		snapshot.State = build.ProvisionerState
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, snapshot)
+}
+
+// @Summary Restore workspace snapshot
+// @Description Creates a new workspace from a snapshot using the template
+// @Description version and parameters of the snapshotted build. Restoring
+// @Description the Terraform state requires permission to update the template.
+// @ID restore-workspace-snapshot
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Workspaces
+// @Param user path string true "Username, UUID, or me"
+// @Param request body codersdk.RestoreWorkspaceSnapshotRequest true "Restore workspace snapshot request"
+// @Success 201 {object} codersdk.Workspace
+// @Router /users/{user}/workspaces/restore [post]
+func (api *API) postRestoreWorkspaceSnapshot(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx     = r.Context()
+		apiKey  = httpmw.APIKey(r)
+		auditor = api.Auditor.Load()
+		user    = httpmw.UserParam(r)
+	)
+
+	aReq, commitAudit := audit.InitRequest[database.Workspace](rw, &audit.RequestParams{
+		Audit:   *auditor,
+		Log:     api.Logger,
+		Request: r,
+		Action:  database.AuditActionCreate,
+		AdditionalFields: audit.AdditionalFields{
+			WorkspaceOwner: user.Username,
+		},
+	})
+	defer commitAudit()
+
+	var req codersdk.RestoreWorkspaceSnapshotRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if req.Snapshot.Version != codersdk.WorkspaceSnapshotVersion {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Unsupported snapshot version %d.", req.Snapshot.Version),
+			Detail:  fmt.Sprintf("Only version %d snapshots can be restored.", codersdk.WorkspaceSnapshotVersion),
+		})
+		return
+	}
+
+	var opts []wsbuilder.Option
+	if req.RestoreState {
+		if len(req.Snapshot.State) == 0 {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "The snapshot does not contain any state to restore.",
+				Validations: []codersdk.ValidationError{{
+					Field:  "restore_state",
+					Detail: "snapshot state is empty",
+				}},
+			})
+			return
+		}
+		opts = append(opts, func(b wsbuilder.Builder) wsbuilder.Builder {
+			return b.State(req.Snapshot.State)
+		})
+	}
+
+	owner := workspaceOwner{
+		ID:        user.ID,
+		Username:  user.Username,
+		AvatarURL: user.AvatarURL,
+	}
+	createWorkspace(ctx, aReq, apiKey.UserID, api, owner, codersdk.CreateWorkspaceRequest{
+		TemplateVersionID:   req.Snapshot.TemplateVersionID,
+		Name:                req.Name,
+		RichParameterValues: req.Snapshot.RichParameterValues,
+	}, rw, r, opts...)
+}
@@ -0,0 +1,163 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/provisioner/echo"
+	"github.com/coder/coder/v2/provisionersdk/proto"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceSnapshot(t *testing.T) {
+	t.Parallel()
+
+	const parameterName = "region"
+	wantState := []byte("some kinda state")
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	member, memberUser := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+	version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, &echo.Responses{
+		Parse: echo.ParseComplete,
+		ProvisionPlan: []*proto.Response{{
+			Type: &proto.Response_Plan{
+				Plan: &proto.PlanComplete{
+					Parameters: []*proto.RichParameter{{
+						Name:     parameterName,
+						Type:     "string",
+						Mutable:  true,
+						Required: true,
+					}},
+				},
+			},
+		}},
+		ProvisionApply: []*proto.Response{{
+			Type: &proto.Response_Apply{
+				Apply: &proto.ApplyComplete{
+					State: wantState,
+				},
+			},
+		}},
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+	wantParameters := []codersdk.WorkspaceBuildParameter{{Name: parameterName, Value: "eu-west"}}
+	workspace := coderdtest.CreateWorkspace(t, member, template.ID, func(cwr *codersdk.CreateWorkspaceRequest) {
+		cwr.RichParameterValues = wantParameters
+	})
+	coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+
+	t.Run("Export", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		snapshot, err := member.WorkspaceSnapshot(ctx, workspace.ID)
+		require.NoError(t, err)
+		require.Equal(t, codersdk.WorkspaceSnapshotVersion, snapshot.Version)
+		require.Equal(t, workspace.ID, snapshot.WorkspaceID)
+		require.Equal(t, workspace.Name, snapshot.WorkspaceName)
+		require.Equal(t, memberUser.Username, snapshot.OwnerName)
+		require.Equal(t, template.ID, snapshot.TemplateID)
+		require.Equal(t, version.ID, snapshot.TemplateVersionID)
+		require.Equal(t, int32(1), snapshot.BuildNumber)
+		require.Equal(t, codersdk.WorkspaceTransitionStart, snapshot.Transition)
+		require.Equal(t, wantParameters, snapshot.RichParameterValues)
+		// Members may not read the state of the template.
+		require.Empty(t, snapshot.State)
+
+		snapshot, err = client.WorkspaceSnapshot(ctx, workspace.ID)
+		require.NoError(t, err)
+		require.Equal(t, wantState, snapshot.State)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		snapshot, err := member.WorkspaceSnapshot(ctx, workspace.ID)
+		require.NoError(t, err)
+
+		restored, err := member.RestoreWorkspaceSnapshot(ctx, codersdk.Me, codersdk.RestoreWorkspaceSnapshotRequest{
+			Name:     "restored",
+			Snapshot: snapshot,
+		})
+		require.NoError(t, err)
+		require.Equal(t, memberUser.ID, restored.OwnerID)
+		require.Equal(t, version.ID, restored.LatestBuild.TemplateVersionID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, restored.LatestBuild.ID)
+
+		parameters, err := member.WorkspaceBuildParameters(ctx, restored.LatestBuild.ID)
+		require.NoError(t, err)
+		require.Equal(t, wantParameters, parameters)
+	})
+
+	t.Run("RestoreState", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		snapshot, err := client.WorkspaceSnapshot(ctx, workspace.ID)
+		require.NoError(t, err)
+
+		restored, err := client.RestoreWorkspaceSnapshot(ctx, codersdk.Me, codersdk.RestoreWorkspaceSnapshotRequest{
+			Name:         "restored-state",
+			Snapshot:     snapshot,
+			RestoreState: true,
+		})
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, restored.LatestBuild.ID)
+
+		state, err := client.WorkspaceBuildState(ctx, restored.LatestBuild.ID)
+		require.NoError(t, err)
+		require.Equal(t, wantState, state)
+
+		// Members may not provide custom state, even if they somehow obtained
+		// a snapshot that contains it.
+		_, err = member.RestoreWorkspaceSnapshot(ctx, codersdk.Me, codersdk.RestoreWorkspaceSnapshotRequest{
+			Name:         "member-state",
+			Snapshot:     snapshot,
+			RestoreState: true,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+	})
+
+	t.Run("NoState", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		snapshot, err := member.WorkspaceSnapshot(ctx, workspace.ID)
+		require.NoError(t, err)
+
+		_, err = member.RestoreWorkspaceSnapshot(ctx, codersdk.Me, codersdk.RestoreWorkspaceSnapshotRequest{
+			Name:         "no-state",
+			Snapshot:     snapshot,
+			RestoreState: true,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("UnsupportedVersion", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		snapshot, err := member.WorkspaceSnapshot(ctx, workspace.ID)
+		require.NoError(t, err)
+		snapshot.Version = codersdk.WorkspaceSnapshotVersion + 1
+
+		_, err = member.RestoreWorkspaceSnapshot(ctx, codersdk.Me, codersdk.RestoreWorkspaceSnapshotRequest{
+			Name:     "future",
+			Snapshot: snapshot,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		require.Contains(t, apiErr.Message, "Unsupported snapshot version")
+	})
+}
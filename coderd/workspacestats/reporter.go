@@ -115,30 +115,103 @@ func (r *Reporter) ReportAppStats(ctx context.Context, stats []workspaceapps.Sta
 		return xerrors.Errorf("insert workspace app stats failed: %w", err)
 	}
 
+	// App requests reach the agent over a tailnet connection, so they already
+	// bump the deadline through the agent's connection count. With an idle
+	// policy connections are ignored, so bump from the requests instead.
+	if r.opts.TemplateScheduleStore == nil {
+		return nil
+	}
+	workspaces, err := r.idleDetectionWorkspaces(ctx, appRequestWorkspaceIDs(stats))
+	if err != nil {
+		r.opts.Logger.Error(ctx, "failed to get workspaces for app stats activity bump", slog.Error(err))
+		return nil
+	}
+	now := dbtime.Now()
+	for _, workspace := range workspaces {
+		r.activityBump(ctx, now, workspace, func(policy schedule.TemplateIdlePolicy) bool {
+			return policy.Enabled
+		})
+	}
+
 	return nil
 }
 
-func (r *Reporter) ReportAgentStats(ctx context.Context, now time.Time, workspace database.Workspace, workspaceAgent database.WorkspaceAgent, templateName string, stats *agentproto.Stats) error {
-	if stats.ConnectionCount > 0 {
-		var nextAutostart time.Time
-		if workspace.AutostartSchedule.String != "" {
-			templateSchedule, err := (*(r.opts.TemplateScheduleStore.Load())).Get(ctx, r.opts.Database, workspace.TemplateID)
-			// If the template schedule fails to load, just default to bumping
-			// without the next transition and log it.
-			if err != nil {
-				r.opts.Logger.Error(ctx, "failed to load template schedule bumping activity, defaulting to bumping by 60min",
-					slog.F("workspace_id", workspace.ID),
-					slog.F("template_id", workspace.TemplateID),
-					slog.Error(err),
-				)
-			} else {
-				next, allowed := schedule.NextAutostart(now, workspace.AutostartSchedule.String, templateSchedule)
-				if allowed {
-					nextAutostart = next
-				}
-			}
+// idleDetectionWorkspaces returns the workspaces with the given IDs whose
+// template has idle detection enabled. Workspaces and templates are each
+// loaded in one query, so that a flush doesn't look up every workspace that
+// received requests.
+func (r *Reporter) idleDetectionWorkspaces(ctx context.Context, ids []uuid.UUID) ([]database.Workspace, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := r.opts.Database.GetWorkspaces(ctx, database.GetWorkspacesParams{
+		WorkspaceIds: ids,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("get workspaces: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	workspaces := database.ConvertWorkspaceRows(rows)
+	templateIDs := make([]uuid.UUID, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		templateIDs = append(templateIDs, workspace.TemplateID)
+	}
+	templates, err := r.opts.Database.GetTemplatesWithFilter(ctx, database.GetTemplatesWithFilterParams{
+		IDs: slice.Unique(templateIDs),
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("get templates: %w", err)
+	}
+	idleDetection := make(map[uuid.UUID]bool, len(templates))
+	for _, template := range templates {
+		idleDetection[template.ID] = template.IdleDetectionEnabled
+	}
+	enabled := make([]database.Workspace, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		if idleDetection[workspace.TemplateID] {
+			enabled = append(enabled, workspace)
+		}
+	}
+	return enabled, nil
+}
+
+// appRequestWorkspaceIDs returns the IDs of the workspaces that received app
+// requests. Long-lived sessions such as websockets are reported again on
+// every flush while they stay open, so only short-lived sessions, which are
+// rolled up into reports spanning the rollup window, count as requests.
+func appRequestWorkspaceIDs(stats []workspaceapps.StatsReport) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, stat := range stats {
+		if stat.Requests > 0 && stat.SessionEndedAt.Sub(stat.SessionStartedAt) <= workspaceapps.DefaultStatsCollectorRollupWindow {
+			ids = append(ids, stat.WorkspaceID)
+		}
+	}
+	return slice.Unique(ids)
+}
+
+// ReportAgentStats records the stats of a workspace agent. The template's
+// idle policy is only loaded if templateIdleDetection is set, so that
+// templates without one don't pay for a lookup on every report.
+func (r *Reporter) ReportAgentStats(ctx context.Context, now time.Time, workspace database.Workspace, workspaceAgent database.WorkspaceAgent, templateName string, templateIdleDetection bool, stats *agentproto.Stats) error {
+	switch {
+	case templateIdleDetection:
+		if stats.ConnectionCount > 0 || stats.SessionInputCount > 0 || stats.CpuUsagePercent > 0 {
+			r.activityBump(ctx, now, workspace, func(policy schedule.TemplateIdlePolicy) bool {
+				return agentStatsActive(policy, stats)
+			})
+		}
+	case stats.ConnectionCount > 0:
+		// Without an idle policy every connected workspace is bumped, and the
+		// template schedule is only needed to find the next autostart.
+		if workspace.AutostartSchedule.String == "" {
+			ActivityBumpWorkspace(ctx, r.opts.Logger.Named("activity_bump"), r.opts.Database, workspace.ID, time.Time{})
+		} else {
+			r.activityBump(ctx, now, workspace, func(schedule.TemplateIdlePolicy) bool {
+				return true
+			})
 		}
-		ActivityBumpWorkspace(ctx, r.opts.Logger.Named("activity_bump"), r.opts.Database, workspace.ID, nextAutostart)
 	}
 
 	var errGroup errgroup.Group
@@ -190,6 +263,46 @@ func (r *Reporter) ReportAgentStats(ctx context.Context, now time.Time, workspac
 	return nil
 }
 
+// agentStatsActive reports whether the agent stats show that the workspace
+// is in use according to the template's idle policy.
+func agentStatsActive(policy schedule.TemplateIdlePolicy, stats *agentproto.Stats) bool {
+	if !policy.Enabled {
+		return stats.ConnectionCount > 0
+	}
+	if stats.SessionInputCount > 0 {
+		return true
+	}
+	return policy.CPUThresholdPercent > 0 && stats.CpuUsagePercent >= float64(policy.CPUThresholdPercent)
+}
+
+// activityBump bumps the workspace's deadline if active reports that the
+// workspace is in use according to the template's idle policy.
+func (r *Reporter) activityBump(ctx context.Context, now time.Time, workspace database.Workspace, active func(policy schedule.TemplateIdlePolicy) bool) {
+	templateSchedule, err := (*(r.opts.TemplateScheduleStore.Load())).Get(ctx, r.opts.Database, workspace.TemplateID)
+	// If the template schedule fails to load, fall back to the default idle
+	// policy and bump without the next transition, and log it.
+	if err != nil {
+		r.opts.Logger.Error(ctx, "failed to load template schedule bumping activity, defaulting to bumping by 60min",
+			slog.F("workspace_id", workspace.ID),
+			slog.F("template_id", workspace.TemplateID),
+			slog.Error(err),
+		)
+		templateSchedule = schedule.TemplateScheduleOptions{}
+	}
+	if !active(templateSchedule.IdlePolicy) {
+		return
+	}
+
+	var nextAutostart time.Time
+	if err == nil && workspace.AutostartSchedule.String != "" {
+		next, allowed := schedule.NextAutostart(now, workspace.AutostartSchedule.String, templateSchedule)
+		if allowed {
+			nextAutostart = next
+		}
+	}
+	ActivityBumpWorkspace(ctx, r.opts.Logger.Named("activity_bump"), r.opts.Database, workspace.ID, nextAutostart)
+}
+
 type UpdateTemplateWorkspacesLastUsedAtFunc func(ctx context.Context, db database.Store, templateID uuid.UUID, lastUsedAt time.Time) error
 
 func UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, db database.Store, templateID uuid.UUID, lastUsedAt time.Time) error {
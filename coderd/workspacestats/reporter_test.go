@@ -0,0 +1,107 @@
+package workspacestats_test
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbmock"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/coderd/workspaceapps"
+	"github.com/coder/coder/v2/coderd/workspacestats"
+)
+
+func TestReportAppStats_IdlePolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dbM = dbmock.NewMockStore(gomock.NewController(t))
+		now = dbtime.Now()
+
+		// Short-lived sessions are rolled up into a report spanning the
+		// rollup window.
+		rolledUp = database.Workspace{ID: uuid.New(), TemplateID: uuid.New()}
+		// Long-lived sessions are reported on every flush while they stay
+		// open, so they don't count as requests.
+		longLived = database.Workspace{ID: uuid.New(), TemplateID: uuid.New()}
+		// Templates without idle detection don't need their schedule loaded.
+		noIdleDetection = database.Workspace{ID: uuid.New(), TemplateID: uuid.New()}
+	)
+
+	var store schedule.TemplateScheduleStore = schedule.MockTemplateScheduleStore{
+		GetFn: func(_ context.Context, _ database.Store, templateID uuid.UUID) (schedule.TemplateScheduleOptions, error) {
+			require.Equal(t, rolledUp.TemplateID, templateID)
+			return schedule.TemplateScheduleOptions{
+				IdlePolicy: schedule.TemplateIdlePolicy{Enabled: true},
+			}, nil
+		},
+	}
+	var storePtr atomic.Pointer[schedule.TemplateScheduleStore]
+	storePtr.Store(&store)
+
+	reporter := workspacestats.NewReporter(workspacestats.ReporterOptions{
+		Database:              dbM,
+		Logger:                slogtest.Make(t, nil),
+		TemplateScheduleStore: &storePtr,
+		AppStatBatchSize:      workspaceapps.DefaultStatsDBReporterBatchSize,
+	})
+
+	dbM.EXPECT().InTx(gomock.Any(), gomock.Any()).DoAndReturn(func(f func(database.Store) error, _ *sql.TxOptions) error {
+		return f(dbM)
+	})
+	dbM.EXPECT().InsertWorkspaceAppStats(gomock.Any(), gomock.Any()).Return(nil)
+	dbM.EXPECT().BatchUpdateWorkspaceLastUsedAt(gomock.Any(), gomock.Any()).Return(nil)
+	dbM.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg database.GetWorkspacesParams) ([]database.GetWorkspacesRow, error) {
+		require.ElementsMatch(t, []uuid.UUID{rolledUp.ID, noIdleDetection.ID}, arg.WorkspaceIds)
+		return []database.GetWorkspacesRow{
+			{ID: rolledUp.ID, TemplateID: rolledUp.TemplateID},
+			{ID: noIdleDetection.ID, TemplateID: noIdleDetection.TemplateID},
+		}, nil
+	})
+	dbM.EXPECT().GetTemplatesWithFilter(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg database.GetTemplatesWithFilterParams) ([]database.Template, error) {
+		require.ElementsMatch(t, []uuid.UUID{rolledUp.TemplateID, noIdleDetection.TemplateID}, arg.IDs)
+		return []database.Template{
+			{ID: rolledUp.TemplateID, IdleDetectionEnabled: true},
+			{ID: noIdleDetection.TemplateID},
+		}, nil
+	})
+	dbM.EXPECT().ActivityBumpWorkspace(gomock.Any(), database.ActivityBumpWorkspaceParams{
+		WorkspaceID:   rolledUp.ID,
+		NextAutostart: time.Time{}.UTC(),
+	}).Return(nil)
+
+	start := now.Truncate(workspaceapps.DefaultStatsCollectorRollupWindow)
+	err := reporter.ReportAppStats(context.Background(), []workspaceapps.StatsReport{
+		{
+			WorkspaceID:      rolledUp.ID,
+			SessionID:        uuid.New(),
+			SessionStartedAt: start,
+			SessionEndedAt:   start.Add(workspaceapps.DefaultStatsCollectorRollupWindow),
+			Requests:         7,
+		},
+		{
+			WorkspaceID:      noIdleDetection.ID,
+			SessionID:        uuid.New(),
+			SessionStartedAt: start,
+			SessionEndedAt:   start.Add(workspaceapps.DefaultStatsCollectorRollupWindow),
+			Requests:         3,
+		},
+		{
+			WorkspaceID:      longLived.ID,
+			SessionID:        uuid.New(),
+			SessionStartedAt: now.Add(-time.Hour),
+			SessionEndedAt:   now,
+			Requests:         1,
+		},
+	})
+	require.NoError(t, err)
+}
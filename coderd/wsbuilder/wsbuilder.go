@@ -24,8 +24,11 @@ import (
 	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
 	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/maintenance"
 	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/coderd/tracing"
@@ -53,6 +56,7 @@ type Builder struct {
 	richParameterValues []codersdk.WorkspaceBuildParameter
 	initiator           uuid.UUID
 	reason              database.BuildReason
+	priority            *int32
 
 	// used during build, makes function arguments less verbose
 	ctx   context.Context
@@ -160,6 +164,14 @@ func (b Builder) Reason(r database.BuildReason) Builder {
 	return b
 }
 
+// Priority overrides the priority of the provisioner job, which defaults to
+// interactive for builds initiated by a user and to background otherwise.
+func (b Builder) Priority(p int32) Builder {
+	// nolint: revive
+	b.priority = &p
+	return b
+}
+
 func (b Builder) RichParameterValues(p []codersdk.WorkspaceBuildParameter) Builder {
 	// nolint: revive
 	b.richParameterValues = p
@@ -266,6 +278,10 @@ func (b *Builder) buildTx(authFunc func(action policy.Action, object rbac.Object
 	if err != nil {
 		return nil, nil, err
 	}
+	err = b.checkMaintenanceWindows()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	template, err := b.getTemplate()
 	if err != nil {
@@ -287,6 +303,13 @@ func (b *Builder) buildTx(authFunc func(action policy.Action, object rbac.Object
 	if b.reason == "" {
 		b.reason = database.BuildReasonInitiator
 	}
+	priority := provisionerjobs.PriorityBackground
+	if b.reason == database.BuildReasonInitiator {
+		priority = provisionerjobs.PriorityInteractive
+	}
+	if b.priority != nil {
+		priority = *b.priority
+	}
 
 	workspaceBuildID := uuid.New()
 	input, err := json.Marshal(provisionerdserver.WorkspaceProvisionJob{
@@ -327,6 +350,7 @@ func (b *Builder) buildTx(authFunc func(action policy.Action, object rbac.Object
 			Valid:      true,
 			RawMessage: traceMetadataRaw,
 		},
+		Priority: priority,
 	})
 	if err != nil {
 		return nil, nil, BuildError{http.StatusInternalServerError, "insert provisioner job", err}
@@ -868,6 +892,30 @@ func (b *Builder) checkTemplateJobStatus() error {
 	return nil
 }
 
+// checkMaintenanceWindows rejects the build if it starts the workspace and a
+// maintenance window that blocks builds is in effect for the workspace.
+// Workspaces can still be stopped and deleted during maintenance.
+func (b *Builder) checkMaintenanceWindows() error {
+	if b.trans != database.WorkspaceTransitionStart {
+		return nil
+	}
+	// Builds are blocked for everyone, regardless of whether they can read the
+	// maintenance windows of the organization.
+	//nolint:gocritic // Reading maintenance windows is a system function.
+	windows, err := b.store.GetMaintenanceWindowsByOrganizationID(dbauthz.AsSystemRestricted(b.ctx), b.workspace.OrganizationID)
+	if err != nil {
+		return BuildError{http.StatusInternalServerError, "failed to fetch maintenance windows", err}
+	}
+	for _, occurrence := range maintenance.ForWorkspace(windows, b.workspace.OrganizationID, b.workspace.TemplateID, dbtime.Now()) {
+		if !occurrence.Window.BlockBuilds {
+			continue
+		}
+		msg := fmt.Sprintf("Workspace builds are blocked by the maintenance window %q until %s.", occurrence.Window.Name, occurrence.End.Format(time.RFC3339))
+		return BuildError{http.StatusServiceUnavailable, msg, xerrors.New(msg)}
+	}
+	return nil
+}
+
 func (b *Builder) checkRunningBuild() error {
 	job, err := b.getLastBuildJob()
 	if xerrors.Is(err, sql.ErrNoRows) {
@@ -21,6 +21,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbmock"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
 	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/coderd/wsbuilder"
 	"github.com/coder/coder/v2/codersdk"
@@ -58,6 +59,7 @@ func TestBuilder_NoOptions(t *testing.T) {
 		withTemplate,
 		withInactiveVersion(nil),
 		withLastBuildFound,
+		withNoMaintenanceWindows,
 		withRichParameters(nil),
 		withParameterSchemas(inactiveJobID, nil),
 		withWorkspaceTags(inactiveVersionID, nil),
@@ -66,6 +68,7 @@ func TestBuilder_NoOptions(t *testing.T) {
 		expectProvisionerJob(func(job database.InsertProvisionerJobParams) {
 			asrt.Equal(userID, job.InitiatorID)
 			asrt.Equal(inactiveFileID, job.FileID)
+			asrt.Equal(provisionerjobs.PriorityInteractive, job.Priority)
 			input := provisionerdserver.WorkspaceProvisionJob{}
 			err := json.Unmarshal(job.Input, &input)
 			req.NoError(err)
@@ -111,6 +114,7 @@ func TestBuilder_Initiator(t *testing.T) {
 		withTemplate,
 		withInactiveVersion(nil),
 		withLastBuildFound,
+		withNoMaintenanceWindows,
 		withRichParameters(nil),
 		withParameterSchemas(inactiveJobID, nil),
 		withWorkspaceTags(inactiveVersionID, nil),
@@ -154,6 +158,7 @@ func TestBuilder_Baggage(t *testing.T) {
 		withTemplate,
 		withInactiveVersion(nil),
 		withLastBuildFound,
+		withNoMaintenanceWindows,
 		withRichParameters(nil),
 		withParameterSchemas(inactiveJobID, nil),
 		withWorkspaceTags(inactiveVersionID, nil),
@@ -189,12 +194,14 @@ func TestBuilder_Reason(t *testing.T) {
 		withTemplate,
 		withInactiveVersion(nil),
 		withLastBuildFound,
+		withNoMaintenanceWindows,
 		withRichParameters(nil),
 		withParameterSchemas(inactiveJobID, nil),
 		withWorkspaceTags(inactiveVersionID, nil),
 
 		// Outputs
-		expectProvisionerJob(func(_ database.InsertProvisionerJobParams) {
+		expectProvisionerJob(func(job database.InsertProvisionerJobParams) {
+			asrt.Equal(provisionerjobs.PriorityBackground, job.Priority)
 		}),
 		withInTx,
 		expectBuild(func(bld database.InsertWorkspaceBuildParams) {
@@ -224,6 +231,7 @@ func TestBuilder_ActiveVersion(t *testing.T) {
 		withTemplate,
 		withActiveVersion(nil),
 		withLastBuildNotFound,
+		withNoMaintenanceWindows,
 		withParameterSchemas(activeJobID, nil),
 		withWorkspaceTags(activeVersionID, nil),
 		// previous rich parameters are not queried because there is no previous build.
@@ -251,6 +259,64 @@ func TestBuilder_ActiveVersion(t *testing.T) {
 	req.NoError(err)
 }
 
+func TestBuilder_MaintenanceWindow(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mDB := expectDB(t,
+		withTemplate,
+		withLastBuildFound,
+		func(mTx *dbmock.MockStore) {
+			mTx.EXPECT().GetTemplateVersionByID(gomock.Any(), inactiveVersionID).
+				Times(1).
+				Return(database.TemplateVersion{
+					ID:             inactiveVersionID,
+					TemplateID:     uuid.NullUUID{UUID: templateID, Valid: true},
+					OrganizationID: orgID,
+					JobID:          inactiveJobID,
+				}, nil)
+			mTx.EXPECT().GetProvisionerJobByID(gomock.Any(), inactiveJobID).
+				Times(1).
+				Return(database.ProvisionerJob{
+					ID:          inactiveJobID,
+					JobStatus:   database.ProvisionerJobStatusSucceeded,
+					CompletedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+				}, nil)
+			mTx.EXPECT().GetMaintenanceWindowsByOrganizationID(gomock.Any(), orgID).
+				Times(1).
+				Return([]database.MaintenanceWindow{
+					// Windows of other templates do not apply.
+					{
+						OrganizationID: orgID,
+						TemplateID:     uuid.NullUUID{UUID: uuid.New(), Valid: true},
+						Name:           "other",
+						Schedule:       "CRON_TZ=UTC * * * * *",
+						Duration:       int64(time.Hour),
+						BlockBuilds:    true,
+					},
+					{
+						OrganizationID: orgID,
+						Name:           "upgrade",
+						Schedule:       "CRON_TZ=UTC * * * * *",
+						Duration:       int64(time.Hour),
+						BlockBuilds:    true,
+					},
+				}, nil)
+		},
+	)
+
+	ws := database.Workspace{ID: workspaceID, TemplateID: templateID, OwnerID: userID, OrganizationID: orgID}
+	uut := wsbuilder.New(ws, database.WorkspaceTransitionStart)
+	_, _, err := uut.Build(ctx, mDB, nil, audit.WorkspaceBuildBaggage{})
+	var buildErr wsbuilder.BuildError
+	req.ErrorAs(err, &buildErr)
+	req.Equal(http.StatusServiceUnavailable, buildErr.Status)
+	req.Contains(buildErr.Message, `maintenance window "upgrade"`)
+}
+
 func TestWorkspaceBuildWithTags(t *testing.T) {
 	t.Parallel()
 
@@ -311,6 +377,7 @@ func TestWorkspaceBuildWithTags(t *testing.T) {
 		withTemplate,
 		withInactiveVersion(richParameters),
 		withLastBuildFound,
+		withNoMaintenanceWindows,
 		withRichParameters(nil),
 		withParameterSchemas(inactiveJobID, nil),
 		withWorkspaceTags(inactiveVersionID, workspaceTags),
@@ -401,6 +468,7 @@ func TestWorkspaceBuildWithRichParameters(t *testing.T) {
 			withTemplate,
 			withInactiveVersion(richParameters),
 			withLastBuildFound,
+			withNoMaintenanceWindows,
 			withRichParameters(initialBuildParameters),
 			withParameterSchemas(inactiveJobID, nil),
 			withWorkspaceTags(inactiveVersionID, nil),
@@ -445,6 +513,7 @@ func TestWorkspaceBuildWithRichParameters(t *testing.T) {
 			withTemplate,
 			withInactiveVersion(richParameters),
 			withLastBuildFound,
+			withNoMaintenanceWindows,
 			withRichParameters(initialBuildParameters),
 			withParameterSchemas(inactiveJobID, nil),
 			withWorkspaceTags(inactiveVersionID, nil),
@@ -495,6 +564,7 @@ func TestWorkspaceBuildWithRichParameters(t *testing.T) {
 			withTemplate,
 			withInactiveVersion(richParameters),
 			withLastBuildFound,
+			withNoMaintenanceWindows,
 			withRichParameters(nil),
 			withParameterSchemas(inactiveJobID, schemas),
 			withWorkspaceTags(inactiveVersionID, nil),
@@ -526,6 +596,7 @@ func TestWorkspaceBuildWithRichParameters(t *testing.T) {
 			withTemplate,
 			withInactiveVersion(richParameters),
 			withLastBuildFound,
+			withNoMaintenanceWindows,
 			withRichParameters(initialBuildParameters),
 			withParameterSchemas(inactiveJobID, nil),
 			withWorkspaceTags(inactiveVersionID, nil),
@@ -576,6 +647,7 @@ func TestWorkspaceBuildWithRichParameters(t *testing.T) {
 			withTemplate,
 			withActiveVersion(version2params),
 			withLastBuildFound,
+			withNoMaintenanceWindows,
 			withRichParameters(initialBuildParameters),
 			withParameterSchemas(activeJobID, nil),
 			withWorkspaceTags(activeVersionID, nil),
@@ -637,6 +709,7 @@ func TestWorkspaceBuildWithRichParameters(t *testing.T) {
 			withTemplate,
 			withActiveVersion(version2params),
 			withLastBuildFound,
+			withNoMaintenanceWindows,
 			withRichParameters(initialBuildParameters),
 			withParameterSchemas(activeJobID, nil),
 			withWorkspaceTags(activeVersionID, nil),
@@ -696,6 +769,7 @@ func TestWorkspaceBuildWithRichParameters(t *testing.T) {
 			withTemplate,
 			withActiveVersion(version2params),
 			withLastBuildFound,
+			withNoMaintenanceWindows,
 			withRichParameters(initialBuildParameters),
 			withParameterSchemas(activeJobID, nil),
 			withWorkspaceTags(activeVersionID, nil),
@@ -849,6 +923,12 @@ func withInactiveVersion(params []database.TemplateVersionParameter) func(mTx *d
 	}
 }
 
+func withNoMaintenanceWindows(mTx *dbmock.MockStore) {
+	mTx.EXPECT().GetMaintenanceWindowsByOrganizationID(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil, nil)
+}
+
 func withLastBuildFound(mTx *dbmock.MockStore) {
 	mTx.EXPECT().GetLatestWorkspaceBuildByWorkspaceID(gomock.Any(), workspaceID).
 		Times(1).
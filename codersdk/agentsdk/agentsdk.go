@@ -108,6 +108,15 @@ type Manifest struct {
 	DisableDirectConnections bool                                         `json:"disable_direct_connections"`
 	Metadata                 []codersdk.WorkspaceAgentMetadataDescription `json:"metadata"`
 	Scripts                  []codersdk.WorkspaceAgentScript              `json:"scripts"`
+	SessionRecording         SessionRecordingPolicy                       `json:"session_recording"`
+}
+
+// SessionRecordingPolicy tells the agent whether and how to record terminal
+// sessions.
+type SessionRecordingPolicy struct {
+	Enabled     bool  `json:"enabled"`
+	RecordInput bool  `json:"record_input"`
+	MaxSize     int64 `json:"max_size"`
 }
 
 type LogSource struct {
@@ -177,6 +186,26 @@ func (c *Client) ConnectRPC21(ctx context.Context) (proto.DRPCAgentClient21, err
 	return proto.NewDRPCAgentClient(conn), nil
 }
 
+// ConnectRPC22 returns a dRPC client to the Agent API v2.2.  It is useful when you want to be
+// maximally compatible with Coderd Release Versions from 2.13+
+func (c *Client) ConnectRPC22(ctx context.Context) (proto.DRPCAgentClient22, error) {
+	conn, err := c.connectRPCVersion(ctx, apiversion.New(2, 2))
+	if err != nil {
+		return nil, err
+	}
+	return proto.NewDRPCAgentClient(conn), nil
+}
+
+// ConnectRPC23 returns a dRPC client to the Agent API v2.3.  It is useful when you want to be
+// maximally compatible with Coderd Release Versions that support session recordings
+func (c *Client) ConnectRPC23(ctx context.Context) (proto.DRPCAgentClient23, error) {
+	conn, err := c.connectRPCVersion(ctx, apiversion.New(2, 3))
+	if err != nil {
+		return nil, err
+	}
+	return proto.NewDRPCAgentClient(conn), nil
+}
+
 // ConnectRPC connects to the workspace agent API and tailnet API
 func (c *Client) ConnectRPC(ctx context.Context) (drpc.Conn, error) {
 	return c.connectRPCVersion(ctx, proto.CurrentVersion)
@@ -48,6 +48,7 @@ func ManifestFromProto(manifest *proto.Manifest) (Manifest, error) {
 		MOTDFile:                 manifest.MotdPath,
 		DisableDirectConnections: manifest.DisableDirectConnections,
 		Metadata:                 MetadataDescriptionsFromProto(manifest.Metadata),
+		SessionRecording:         SessionRecordingPolicyFromProto(manifest.SessionRecording),
 	}, nil
 }
 
@@ -73,9 +74,29 @@ func ProtoFromManifest(manifest Manifest) (*proto.Manifest, error) {
 		Scripts:                  ProtoFromScripts(manifest.Scripts),
 		Apps:                     apps,
 		Metadata:                 ProtoFromMetadataDescriptions(manifest.Metadata),
+		SessionRecording:         ProtoFromSessionRecordingPolicy(manifest.SessionRecording),
 	}, nil
 }
 
+func SessionRecordingPolicyFromProto(policy *proto.SessionRecordingPolicy) SessionRecordingPolicy {
+	if policy == nil {
+		return SessionRecordingPolicy{}
+	}
+	return SessionRecordingPolicy{
+		Enabled:     policy.Enabled,
+		RecordInput: policy.RecordInput,
+		MaxSize:     policy.MaxSize,
+	}
+}
+
+func ProtoFromSessionRecordingPolicy(policy SessionRecordingPolicy) *proto.SessionRecordingPolicy {
+	return &proto.SessionRecordingPolicy{
+		Enabled:     policy.Enabled,
+		RecordInput: policy.RecordInput,
+		MaxSize:     policy.MaxSize,
+	}
+}
+
 func MetadataDescriptionsFromProto(descriptions []*proto.WorkspaceAgentMetadata_Description) []codersdk.WorkspaceAgentMetadataDescription {
 	ret := make([]codersdk.WorkspaceAgentMetadataDescription, len(descriptions))
 	for i, description := range descriptions {
@@ -126,6 +126,11 @@ func TestManifest(t *testing.T) {
 				Timeout:          time.Second * 4,
 			},
 		},
+		SessionRecording: agentsdk.SessionRecordingPolicy{
+			Enabled:     true,
+			RecordInput: true,
+			MaxSize:     1 << 20,
+		},
 	}
 	p, err := agentsdk.ProtoFromManifest(manifest)
 	require.NoError(t, err)
@@ -148,6 +153,7 @@ func TestManifest(t *testing.T) {
 	require.Equal(t, manifest.DisableDirectConnections, back.DisableDirectConnections)
 	require.Equal(t, manifest.Metadata, back.Metadata)
 	require.Equal(t, manifest.Scripts, back.Scripts)
+	require.Equal(t, manifest.SessionRecording, back.SessionRecording)
 }
 
 func TestSubsystems(t *testing.T) {
@@ -3,6 +3,7 @@ package codersdk
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/netip"
 	"strings"
@@ -34,6 +35,8 @@ const (
 	ResourceTypeCustomRole              ResourceType = "custom_role"
 	ResourceTypeOrganizationMember                   = "organization_member"
 	ResourceTypeNotificationTemplate                 = "notification_template"
+	ResourceTypeWorkspaceBulkOperation  ResourceType = "workspace_bulk_operation"
+	ResourceTypeTemplateVersionRollout  ResourceType = "template_version_rollout"
 )
 
 func (r ResourceType) FriendlyString() string {
@@ -78,6 +81,10 @@ func (r ResourceType) FriendlyString() string {
 		return "organization member"
 	case ResourceTypeNotificationTemplate:
 		return "notification template"
+	case ResourceTypeWorkspaceBulkOperation:
+		return "workspace bulk operation"
+	case ResourceTypeTemplateVersionRollout:
+		return "template version rollout"
 	default:
 		return "unknown"
 	}
@@ -164,6 +171,21 @@ type AuditLogResponse struct {
 	Count     int64      `json:"count"`
 }
 
+type AuditLogExportFormat string
+
+const (
+	// AuditLogExportFormatJSONL writes one JSON encoded AuditLog per line.
+	AuditLogExportFormatJSONL AuditLogExportFormat = "jsonl"
+	// AuditLogExportFormatCSV writes a header row followed by one row per
+	// audit log.
+	AuditLogExportFormatCSV AuditLogExportFormat = "csv"
+)
+
+type AuditLogExportRequest struct {
+	SearchQuery string               `json:"q,omitempty"`
+	Format      AuditLogExportFormat `json:"format,omitempty"`
+}
+
 type CreateTestAuditLogRequest struct {
 	Action           AuditAction     `json:"action,omitempty" enums:"create,write,delete,start,stop"`
 	ResourceType     ResourceType    `json:"resource_type,omitempty" enums:"template,template_version,user,workspace,workspace_build,git_ssh_key,auditable_group"`
@@ -203,6 +225,27 @@ func (c *Client) AuditLogs(ctx context.Context, req AuditLogsRequest) (AuditLogR
 	return logRes, nil
 }
 
+// ExportAuditLogs streams every audit log matching the search query, newest
+// first. The caller must close the returned reader.
+func (c *Client) ExportAuditLogs(ctx context.Context, req AuditLogExportRequest) (io.ReadCloser, error) {
+	res, err := c.Request(ctx, http.MethodGet, "/api/v2/audit/export", nil, func(r *http.Request) {
+		q := r.URL.Query()
+		q.Set("q", req.SearchQuery)
+		if req.Format != "" {
+			q.Set("format", string(req.Format))
+		}
+		r.URL.RawQuery = q.Encode()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, ReadBodyAsError(res)
+	}
+	return res.Body, nil
+}
+
 // CreateTestAuditLog creates a fake audit log. Only owners of the organization
 // can perform this action. It's used for testing purposes.
 func (c *Client) CreateTestAuditLog(ctx context.Context, req CreateTestAuditLogRequest) error {
@@ -0,0 +1,81 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ConnectionType string
+
+const (
+	ConnectionTypeSSH             ConnectionType = "ssh"
+	ConnectionTypeVSCode          ConnectionType = "vscode"
+	ConnectionTypeJetBrains       ConnectionType = "jetbrains"
+	ConnectionTypeReconnectingPTY ConnectionType = "reconnecting_pty"
+	ConnectionTypePortForward     ConnectionType = "port_forward"
+	ConnectionTypeApp             ConnectionType = "app"
+)
+
+// ConnectionLog is a connection to a workspace agent, as reported by the
+// agent.
+type ConnectionLog struct {
+	ID           uuid.UUID           `json:"id" format:"uuid"`
+	ConnectTime  time.Time           `json:"connect_time" format:"date-time"`
+	Organization MinimalOrganization `json:"organization"`
+
+	WorkspaceOwnerID       uuid.UUID      `json:"workspace_owner_id" format:"uuid"`
+	WorkspaceOwnerUsername string         `json:"workspace_owner_username"`
+	WorkspaceID            uuid.UUID      `json:"workspace_id" format:"uuid"`
+	WorkspaceName          string         `json:"workspace_name"`
+	AgentName              string         `json:"agent_name"`
+	Type                   ConnectionType `json:"type" enums:"ssh,vscode,jetbrains,reconnecting_pty,port_forward,app"`
+	IP                     netip.Addr     `json:"ip"`
+	// User is the user that connected. It is nil when the connection could
+	// not be attributed to a user.
+	User *MinimalUser `json:"user,omitempty"`
+
+	// DisconnectTime is nil while the connection is ongoing.
+	DisconnectTime   *time.Time `json:"disconnect_time,omitempty" format:"date-time"`
+	Code             *int32     `json:"code,omitempty"`
+	DisconnectReason string     `json:"disconnect_reason,omitempty"`
+}
+
+type ConnectionLogsRequest struct {
+	SearchQuery string `json:"q,omitempty"`
+	Pagination
+}
+
+type ConnectionLogResponse struct {
+	ConnectionLogs []ConnectionLog `json:"connection_logs"`
+	Count          int64           `json:"count"`
+}
+
+// ConnectionLogs retrieves connection logs from the given page.
+func (c *Client) ConnectionLogs(ctx context.Context, req ConnectionLogsRequest) (ConnectionLogResponse, error) {
+	res, err := c.Request(ctx, http.MethodGet, "/api/v2/connectionlogs", nil, req.Pagination.asRequestOption(), func(r *http.Request) {
+		q := r.URL.Query()
+		q.Set("q", req.SearchQuery)
+		r.URL.RawQuery = q.Encode()
+	})
+	if err != nil {
+		return ConnectionLogResponse{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return ConnectionLogResponse{}, ReadBodyAsError(res)
+	}
+
+	var logRes ConnectionLogResponse
+	err = json.NewDecoder(res.Body).Decode(&logRes)
+	if err != nil {
+		return ConnectionLogResponse{}, err
+	}
+
+	return logRes, nil
+}
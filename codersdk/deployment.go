@@ -391,6 +391,9 @@ type DeploymentValues struct {
 	CLIUpgradeMessage               serpent.String                       `json:"cli_upgrade_message,omitempty" typescript:",notnull"`
 	TermsOfServiceURL               serpent.String                       `json:"terms_of_service_url,omitempty" typescript:",notnull"`
 	Notifications                   NotificationsConfig                  `json:"notifications,omitempty" typescript:",notnull"`
+	AuditLogging                    AuditLoggingConfig                   `json:"audit_logging,omitempty" typescript:",notnull"`
+	SessionRecording                SessionRecordingConfig               `json:"session_recording,omitempty" typescript:",notnull"`
+	WorkspaceDrift                  WorkspaceDriftConfig                 `json:"workspace_drift,omitempty" typescript:",notnull"`
 
 	Config      serpent.YAMLConfigPath `json:"config,omitempty" typescript:",notnull"`
 	WriteConfig serpent.Bool           `json:"write_config,omitempty" typescript:",notnull"`
@@ -604,12 +607,17 @@ type ExternalAuthConfig struct {
 
 type ProvisionerConfig struct {
 	// Daemons is the number of built-in terraform provisioners.
-	Daemons             serpent.Int64       `json:"daemons" typescript:",notnull"`
-	DaemonTypes         serpent.StringArray `json:"daemon_types" typescript:",notnull"`
-	DaemonPollInterval  serpent.Duration    `json:"daemon_poll_interval" typescript:",notnull"`
-	DaemonPollJitter    serpent.Duration    `json:"daemon_poll_jitter" typescript:",notnull"`
-	ForceCancelInterval serpent.Duration    `json:"force_cancel_interval" typescript:",notnull"`
-	DaemonPSK           serpent.String      `json:"daemon_psk" typescript:",notnull"`
+	Daemons                 serpent.Int64       `json:"daemons" typescript:",notnull"`
+	DaemonTypes             serpent.StringArray `json:"daemon_types" typescript:",notnull"`
+	DaemonPollInterval      serpent.Duration    `json:"daemon_poll_interval" typescript:",notnull"`
+	DaemonPollJitter        serpent.Duration    `json:"daemon_poll_jitter" typescript:",notnull"`
+	ForceCancelInterval     serpent.Duration    `json:"force_cancel_interval" typescript:",notnull"`
+	DaemonPSK               serpent.String      `json:"daemon_psk" typescript:",notnull"`
+	MaxJobsPerOrganization  serpent.Int64       `json:"max_jobs_per_organization" typescript:",notnull"`
+	MaxJobsPerUser          serpent.Int64       `json:"max_jobs_per_user" typescript:",notnull"`
+	TerraformFlavor         string              `json:"terraform_flavor" typescript:",notnull"`
+	TerraformInstallMirror  serpent.String      `json:"terraform_install_mirror" typescript:",notnull"`
+	TerraformProviderMirror serpent.String      `json:"terraform_provider_mirror" typescript:",notnull"`
 }
 
 type RateLimitConfig struct {
@@ -646,6 +654,7 @@ type UserQuietHoursScheduleConfig struct {
 type HealthcheckConfig struct {
 	Refresh           serpent.Duration `json:"refresh" typescript:",notnull"`
 	ThresholdDatabase serpent.Duration `json:"threshold_database" typescript:",notnull"`
+	ThresholdPubsub   serpent.Duration `json:"threshold_pubsub" typescript:",notnull"`
 }
 
 type NotificationsConfig struct {
@@ -676,14 +685,22 @@ type NotificationsConfig struct {
 	// How often to query the database for queued notifications.
 	FetchInterval serpent.Duration `json:"fetch_interval"`
 
-	// Which delivery method to use (available options: 'smtp', 'webhook').
+	// Which delivery method to use (available options: 'smtp', 'webhook', 'slack', 'teams').
 	Method serpent.String `json:"method"`
 	// How long to wait while a notification is being sent before giving up.
 	DispatchTimeout serpent.Duration `json:"dispatch_timeout"`
+	// Whether to coalesce multiple notifications of the same template for the same user into a single digest message.
+	DigestEnabled serpent.Bool `json:"digest_enabled"`
+	// How long to wait for further notifications to coalesce into a digest, measured from the first notification.
+	DigestWindow serpent.Duration `json:"digest_window"`
 	// SMTP settings.
 	SMTP NotificationsEmailConfig `json:"email" typescript:",notnull"`
 	// Webhook settings.
 	Webhook NotificationsWebhookConfig `json:"webhook" typescript:",notnull"`
+	// Slack settings.
+	Slack NotificationsSlackConfig `json:"slack" typescript:",notnull"`
+	// Microsoft Teams settings.
+	Teams NotificationsTeamsConfig `json:"teams" typescript:",notnull"`
 }
 
 type NotificationsEmailConfig struct {
@@ -741,6 +758,93 @@ type NotificationsWebhookConfig struct {
 	Endpoint serpent.URL `json:"endpoint" typescript:",notnull"`
 }
 
+type NotificationsSlackConfig struct {
+	// The incoming webhook URL to which messages will be sent. When a bot token is configured, this overrides the
+	// URL of the chat.postMessage API.
+	Endpoint serpent.URL `json:"endpoint" typescript:",notnull"`
+	// The bot token used to authenticate against the Slack Web API. When set, messages are sent via chat.postMessage
+	// instead of an incoming webhook.
+	BotToken serpent.String `json:"bot_token" typescript:",notnull"`
+	// The channel to which messages will be posted when using a bot token.
+	Channel serpent.String `json:"channel" typescript:",notnull"`
+}
+
+type NotificationsTeamsConfig struct {
+	// The incoming webhook URL to which Adaptive Card messages will be sent.
+	Endpoint serpent.URL `json:"endpoint" typescript:",notnull"`
+}
+
+// AuditLoggingConfig configures additional backends to which audit logs are streamed.
+type AuditLoggingConfig struct {
+	// Syslog settings.
+	Syslog AuditLoggingSyslogConfig `json:"syslog" typescript:",notnull"`
+	// HTTP settings.
+	HTTP AuditLoggingHTTPConfig `json:"http" typescript:",notnull"`
+}
+
+type AuditLoggingSyslogConfig struct {
+	// The address (host:port) of the syslog server. Leave empty to disable syslog streaming.
+	Address serpent.String `json:"address" typescript:",notnull"`
+	// The transport over which messages are sent (available options: 'udp', 'tcp', 'tls').
+	Protocol string `json:"protocol" typescript:",notnull"`
+	// The APP-NAME of the emitted messages.
+	AppName serpent.String `json:"app_name" typescript:",notnull"`
+	// CAFile specifies the location of the CA certificate used to verify the syslog server when using TLS.
+	CAFile serpent.String `json:"ca_file" typescript:",notnull"`
+	// InsecureSkipVerify skips verification of the syslog server's certificate when using TLS.
+	InsecureSkipVerify serpent.Bool `json:"insecure_skip_verify" typescript:",notnull"`
+}
+
+type AuditLoggingHTTPConfig struct {
+	// The URL to which batches of audit logs are sent with an HTTP POST request. Leave empty to disable HTTP streaming.
+	Endpoint serpent.URL `json:"endpoint" typescript:",notnull"`
+	// Additional headers (in the form 'Name=value') to send with each request, e.g. for authentication.
+	Headers serpent.StringArray `json:"headers" typescript:",notnull"`
+	// The maximum number of audit logs sent in a single request.
+	BatchSize serpent.Int64 `json:"batch_size" typescript:",notnull"`
+	// How often pending audit logs are sent, if a batch has not been filled sooner.
+	FlushInterval serpent.Duration `json:"flush_interval" typescript:",notnull"`
+	// How many times a failed request is retried before its batch is spooled to disk.
+	MaxRetries serpent.Int64 `json:"max_retries" typescript:",notnull"`
+	// How many audit logs are buffered in memory while waiting to be sent.
+	BufferSize serpent.Int64 `json:"buffer_size" typescript:",notnull"`
+	// The directory in which undeliverable audit logs are spooled until the endpoint is available again.
+	SpoolDirectory serpent.String `json:"spool_directory" typescript:",notnull"`
+}
+
+// SessionRecordingConfig configures the recording of terminal sessions in
+// workspaces.
+type SessionRecordingConfig struct {
+	// Enabled records the output of SSH and reconnecting PTY sessions.
+	Enabled serpent.Bool `json:"enabled" typescript:",notnull"`
+	// RecordInput additionally records what is typed into the sessions.
+	RecordInput serpent.Bool `json:"record_input" typescript:",notnull"`
+	// MaxSize is the maximum size of a single recording in bytes. Recordings
+	// are truncated once they reach this size.
+	MaxSize serpent.Int64 `json:"max_size" typescript:",notnull"`
+}
+
+// WorkspaceDriftConfig configures the detection of running workspaces whose
+// infrastructure no longer matches their Terraform state.
+type WorkspaceDriftConfig struct {
+	// CheckInterval is the time between two drift checks of a running
+	// workspace. Drift detection is disabled if it is zero.
+	CheckInterval serpent.Duration `json:"check_interval" typescript:",notnull"`
+	// Action is what is done when a workspace has drifted (available options:
+	// 'none', 'notify', 'reconcile').
+	Action string `json:"action" typescript:",notnull"`
+}
+
+const (
+	// WorkspaceDriftActionNone only reports the drift on the workspace.
+	WorkspaceDriftActionNone = "none"
+	// WorkspaceDriftActionNotify notifies the owner of a drifted workspace.
+	WorkspaceDriftActionNotify = "notify"
+	// WorkspaceDriftActionReconcile starts a drifted workspace, which
+	// recreates or restores the drifted resources.
+	WorkspaceDriftActionReconcile = "reconcile"
+)
+
 const (
 	annotationFormatDuration = "format_duration"
 	annotationEnterpriseKey  = "enterprise"
@@ -950,6 +1054,45 @@ when required by your organization's security policy.`,
 			Parent: &deploymentGroupNotifications,
 			YAML:   "webhook",
 		}
+		deploymentGroupNotificationsSlack = serpent.Group{
+			Name:        "Slack",
+			Parent:      &deploymentGroupNotifications,
+			Description: "Configure how Slack notifications are sent.",
+			YAML:        "slack",
+		}
+		deploymentGroupNotificationsTeams = serpent.Group{
+			Name:        "Microsoft Teams",
+			Parent:      &deploymentGroupNotifications,
+			Description: "Configure how Microsoft Teams notifications are sent.",
+			YAML:        "teams",
+		}
+		deploymentGroupAuditLogging = serpent.Group{
+			Name:        "Audit Logging",
+			YAML:        "auditLogging",
+			Description: "Configure additional destinations to which audit logs are streamed.",
+		}
+		deploymentGroupAuditLoggingSyslog = serpent.Group{
+			Name:        "Syslog",
+			Parent:      &deploymentGroupAuditLogging,
+			Description: "Stream audit logs to a syslog server using RFC 5424.",
+			YAML:        "syslog",
+		}
+		deploymentGroupAuditLoggingHTTP = serpent.Group{
+			Name:        "HTTP",
+			Parent:      &deploymentGroupAuditLogging,
+			Description: "Stream batches of audit logs as JSON to an HTTP endpoint.",
+			YAML:        "http",
+		}
+		deploymentGroupSessionRecording = serpent.Group{
+			Name:        "Session Recording",
+			YAML:        "sessionRecording",
+			Description: "Record terminal sessions in workspaces in asciicast format.",
+		}
+		deploymentGroupWorkspaceDrift = serpent.Group{
+			Name:        "Workspace Drift",
+			YAML:        "workspaceDrift",
+			Description: "Detect running workspaces whose infrastructure was changed or deleted outside of Coder.",
+		}
 	)
 
 	httpAddress := serpent.Option{
@@ -1892,6 +2035,54 @@ when required by your organization's security policy.`,
 			Group:       &deploymentGroupProvisioning,
 			Annotations: serpent.Annotations{}.Mark(annotationSecretKey, "true"),
 		},
+		{
+			Name:        "Max Jobs Per Organization",
+			Description: "Maximum number of provisioner jobs that run concurrently for an organization. Further jobs of the organization stay queued until one of its jobs completes. Set to 0 to disable the limit.",
+			Flag:        "provisioner-max-jobs-per-organization",
+			Env:         "CODER_PROVISIONER_MAX_JOBS_PER_ORGANIZATION",
+			Default:     "0",
+			Value:       &c.Provisioner.MaxJobsPerOrganization,
+			Group:       &deploymentGroupProvisioning,
+			YAML:        "maxJobsPerOrganization",
+		},
+		{
+			Name:        "Max Jobs Per User",
+			Description: "Maximum number of provisioner jobs that run concurrently for the user that initiated them. Further jobs of the user stay queued until one of their jobs completes. Set to 0 to disable the limit.",
+			Flag:        "provisioner-max-jobs-per-user",
+			Env:         "CODER_PROVISIONER_MAX_JOBS_PER_USER",
+			Default:     "0",
+			Value:       &c.Provisioner.MaxJobsPerUser,
+			Group:       &deploymentGroupProvisioning,
+			YAML:        "maxJobsPerUser",
+		},
+		{
+			Name:        "Terraform Flavor",
+			Description: "The distribution of the Terraform CLI that the built-in provisioners run. Set to opentofu to run OpenTofu instead of Terraform.",
+			Flag:        "provisioner-terraform-flavor",
+			Env:         "CODER_PROVISIONER_TERRAFORM_FLAVOR",
+			Default:     "terraform",
+			Value:       serpent.EnumOf(&c.Provisioner.TerraformFlavor, "terraform", "opentofu"),
+			Group:       &deploymentGroupProvisioning,
+			YAML:        "terraformFlavor",
+		},
+		{
+			Name:        "Terraform Install Mirror",
+			Description: "URL or local directory to install the Terraform CLI from if it is not available on the system, e.g. in air-gapped deployments. Releases must be laid out like on releases.hashicorp.com/terraform for Terraform, or like on the GitHub releases of OpenTofu.",
+			Flag:        "provisioner-terraform-install-mirror",
+			Env:         "CODER_PROVISIONER_TERRAFORM_INSTALL_MIRROR",
+			Value:       &c.Provisioner.TerraformInstallMirror,
+			Group:       &deploymentGroupProvisioning,
+			YAML:        "terraformInstallMirror",
+		},
+		{
+			Name:        "Terraform Provider Mirror",
+			Description: "Local directory that the built-in provisioners install providers from instead of their registries, e.g. in air-gapped deployments. Populate it with `coder provisioner mirror`.",
+			Flag:        "provisioner-terraform-provider-mirror",
+			Env:         "CODER_PROVISIONER_TERRAFORM_PROVIDER_MIRROR",
+			Value:       &c.Provisioner.TerraformProviderMirror,
+			Group:       &deploymentGroupProvisioning,
+			YAML:        "terraformProviderMirror",
+		},
 		// RateLimit settings
 		{
 			Name:        "Disable All Rate Limits",
@@ -2424,10 +2615,21 @@ Write out the current server config as YAML to stdout.`,
 			YAML:        "thresholdDatabase",
 			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
 		},
+		{
+			Name:        "Health Check Threshold: Pubsub",
+			Description: "The threshold for the pubsub health check. If the median round-trip latency of a message published and received through the pubsub exceeds this threshold over 3 attempts, the pubsub is considered unhealthy. The default value is 100ms.",
+			Flag:        "health-check-threshold-pubsub",
+			Env:         "CODER_HEALTH_CHECK_THRESHOLD_PUBSUB",
+			Default:     (100 * time.Millisecond).String(),
+			Value:       &c.Healthcheck.ThresholdPubsub,
+			Group:       &deploymentGroupIntrospectionHealthcheck,
+			YAML:        "thresholdPubsub",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
 		// Notifications Options
 		{
 			Name:        "Notifications: Method",
-			Description: "Which delivery method to use (available options: 'smtp', 'webhook').",
+			Description: "Which delivery method to use (available options: 'smtp', 'webhook', 'slack', 'teams').",
 			Flag:        "notifications-method",
 			Env:         "CODER_NOTIFICATIONS_METHOD",
 			Value:       &c.Notifications.Method,
@@ -2446,6 +2648,29 @@ Write out the current server config as YAML to stdout.`,
 			YAML:        "dispatchTimeout",
 			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
 		},
+		{
+			Name: "Notifications: Digest Enabled",
+			Description: "Coalesce multiple notifications of the same template for the same user into a single digest " +
+				"message. Notifications are held back for the duration of the digest window.",
+			Flag:    "notifications-digest-enabled",
+			Env:     "CODER_NOTIFICATIONS_DIGEST_ENABLED",
+			Value:   &c.Notifications.DigestEnabled,
+			Default: "false",
+			Group:   &deploymentGroupNotifications,
+			YAML:    "digestEnabled",
+		},
+		{
+			Name: "Notifications: Digest Window",
+			Description: "How long to wait for further notifications to coalesce into a digest, measured from the " +
+				"first notification. Only applies when digests are enabled.",
+			Flag:        "notifications-digest-window",
+			Env:         "CODER_NOTIFICATIONS_DIGEST_WINDOW",
+			Value:       &c.Notifications.DigestWindow,
+			Default:     (time.Minute * 15).String(),
+			Group:       &deploymentGroupNotifications,
+			YAML:        "digestWindow",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
 		{
 			Name:        "Notifications: Email: From Address",
 			Description: "The sender's address to use.",
@@ -2584,6 +2809,44 @@ Write out the current server config as YAML to stdout.`,
 			Group:       &deploymentGroupNotificationsWebhook,
 			YAML:        "endpoint",
 		},
+		{
+			Name: "Notifications: Slack: Endpoint",
+			Description: "The incoming webhook URL to which Slack messages will be sent. When a bot token is set, " +
+				"this overrides the URL of the chat.postMessage API.",
+			Flag:  "notifications-slack-endpoint",
+			Env:   "CODER_NOTIFICATIONS_SLACK_ENDPOINT",
+			Value: &c.Notifications.Slack.Endpoint,
+			Group: &deploymentGroupNotificationsSlack,
+			YAML:  "endpoint",
+		},
+		{
+			Name: "Notifications: Slack: Bot Token",
+			Description: "The bot token used to post messages via the Slack Web API. When set, messages are sent " +
+				"with chat.postMessage to the configured channel instead of an incoming webhook.",
+			Flag:        "notifications-slack-bot-token",
+			Env:         "CODER_NOTIFICATIONS_SLACK_BOT_TOKEN",
+			Value:       &c.Notifications.Slack.BotToken,
+			Group:       &deploymentGroupNotificationsSlack,
+			Annotations: serpent.Annotations{}.Mark(annotationSecretKey, "true"),
+		},
+		{
+			Name:        "Notifications: Slack: Channel",
+			Description: "The channel to which Slack messages will be posted when using a bot token.",
+			Flag:        "notifications-slack-channel",
+			Env:         "CODER_NOTIFICATIONS_SLACK_CHANNEL",
+			Value:       &c.Notifications.Slack.Channel,
+			Group:       &deploymentGroupNotificationsSlack,
+			YAML:        "channel",
+		},
+		{
+			Name:        "Notifications: Microsoft Teams: Endpoint",
+			Description: "The incoming webhook URL to which Microsoft Teams messages will be sent.",
+			Flag:        "notifications-teams-endpoint",
+			Env:         "CODER_NOTIFICATIONS_TEAMS_ENDPOINT",
+			Value:       &c.Notifications.Teams.Endpoint,
+			Group:       &deploymentGroupNotificationsTeams,
+			YAML:        "endpoint",
+		},
 		{
 			Name:        "Notifications: Max Send Attempts",
 			Description: "The upper limit of attempts to send a notification.",
@@ -2674,6 +2937,186 @@ Write out the current server config as YAML to stdout.`,
 			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
 			Hidden:      true, // Hidden because most operators should not need to modify this.
 		},
+		// Audit Logging Options
+		{
+			Name:        "Audit Logging: Syslog: Address",
+			Description: "The address (host:port) of the syslog server to which audit logs are streamed. Leave empty to disable syslog streaming.",
+			Flag:        "audit-logging-syslog-address",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_ADDRESS",
+			Value:       &c.AuditLogging.Syslog.Address,
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "address",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: Syslog: Protocol",
+			Description: "The transport over which syslog messages are sent.",
+			Flag:        "audit-logging-syslog-protocol",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_PROTOCOL",
+			Value:       serpent.EnumOf(&c.AuditLogging.Syslog.Protocol, "udp", "tcp", "tls"),
+			Default:     "tcp",
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "protocol",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: Syslog: App Name",
+			Description: "The APP-NAME of the syslog messages.",
+			Flag:        "audit-logging-syslog-app-name",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_APP_NAME",
+			Value:       &c.AuditLogging.Syslog.AppName,
+			Default:     "coder",
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "appName",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: Syslog: CA File",
+			Description: "The CA certificate used to verify the syslog server when using TLS. Uses the system certificate pool if empty.",
+			Flag:        "audit-logging-syslog-ca-file",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_CA_FILE",
+			Value:       &c.AuditLogging.Syslog.CAFile,
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "caFile",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: Syslog: Insecure Skip Verify",
+			Description: "Skip verification of the syslog server's certificate when using TLS (insecure).",
+			Flag:        "audit-logging-syslog-insecure-skip-verify",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_INSECURE_SKIP_VERIFY",
+			Value:       &c.AuditLogging.Syslog.InsecureSkipVerify,
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "insecureSkipVerify",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Endpoint",
+			Description: "The URL to which batches of audit logs are sent as JSON with an HTTP POST request. Leave empty to disable HTTP streaming.",
+			Flag:        "audit-logging-http-endpoint",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_ENDPOINT",
+			Value:       &c.AuditLogging.HTTP.Endpoint,
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "endpoint",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Headers",
+			Description: "Additional headers, in the form 'Name=value', to send with each request (e.g. for authentication).",
+			Flag:        "audit-logging-http-headers",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_HEADERS",
+			Value:       &c.AuditLogging.HTTP.Headers,
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true").Mark(annotationSecretKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Batch Size",
+			Description: "The maximum number of audit logs sent in a single request.",
+			Flag:        "audit-logging-http-batch-size",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_BATCH_SIZE",
+			Value:       &c.AuditLogging.HTTP.BatchSize,
+			Default:     "100",
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "batchSize",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Flush Interval",
+			Description: "How often pending audit logs are sent, if a batch has not been filled sooner.",
+			Flag:        "audit-logging-http-flush-interval",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_FLUSH_INTERVAL",
+			Value:       &c.AuditLogging.HTTP.FlushInterval,
+			Default:     (time.Second * 5).String(),
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "flushInterval",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true").Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Max Retries",
+			Description: "How many times a failed request is retried, with exponential backoff, before its batch is spooled to disk.",
+			Flag:        "audit-logging-http-max-retries",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_MAX_RETRIES",
+			Value:       &c.AuditLogging.HTTP.MaxRetries,
+			Default:     "3",
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "maxRetries",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name: "Audit Logging: HTTP: Buffer Size",
+			Description: "How many audit logs are buffered in memory while waiting to be sent. Once the buffer is full, " +
+				"audit logs are spooled to disk if a spool directory is configured, otherwise they are dropped.",
+			Flag:        "audit-logging-http-buffer-size",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_BUFFER_SIZE",
+			Value:       &c.AuditLogging.HTTP.BufferSize,
+			Default:     "1000",
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "bufferSize",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name: "Audit Logging: HTTP: Spool Directory",
+			Description: "The directory in which undeliverable audit logs are spooled until the endpoint is available again. " +
+				"Leave empty to disable spooling.",
+			Flag:        "audit-logging-http-spool-directory",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_SPOOL_DIRECTORY",
+			Value:       &c.AuditLogging.HTTP.SpoolDirectory,
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "spoolDirectory",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		// Session Recording Options
+		{
+			Name:        "Session Recording: Enabled",
+			Description: "Record the output of SSH and reconnecting PTY (web terminal) sessions in workspaces. Recordings can be listed and replayed with \"coder recordings\".",
+			Flag:        "session-recording",
+			Env:         "CODER_SESSION_RECORDING",
+			Value:       &c.SessionRecording.Enabled,
+			Default:     "false",
+			Group:       &deploymentGroupSessionRecording,
+			YAML:        "enabled",
+		},
+		{
+			Name:        "Session Recording: Record Input",
+			Description: "Also record what is typed into recorded sessions. Note that this may include passwords typed at prompts.",
+			Flag:        "session-recording-input",
+			Env:         "CODER_SESSION_RECORDING_INPUT",
+			Value:       &c.SessionRecording.RecordInput,
+			Default:     "false",
+			Group:       &deploymentGroupSessionRecording,
+			YAML:        "recordInput",
+		},
+		{
+			Name:        "Session Recording: Max Size",
+			Description: "The maximum size of a single recording in bytes. Recordings are truncated once they reach this size.",
+			Flag:        "session-recording-max-size",
+			Env:         "CODER_SESSION_RECORDING_MAX_SIZE",
+			Value:       &c.SessionRecording.MaxSize,
+			Default:     strconv.Itoa(64 << 20),
+			Group:       &deploymentGroupSessionRecording,
+			YAML:        "maxSize",
+		},
+		{
+			Name:        "Workspace Drift: Check Interval",
+			Description: "How often the infrastructure of each running workspace is compared with its Terraform state. Every check runs a refresh-only plan as a provisioner job. Set to 0 to disable drift detection.",
+			Flag:        "workspace-drift-check-interval",
+			Env:         "CODER_WORKSPACE_DRIFT_CHECK_INTERVAL",
+			Value:       &c.WorkspaceDrift.CheckInterval,
+			Default:     "0",
+			Group:       &deploymentGroupWorkspaceDrift,
+			YAML:        "checkInterval",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Workspace Drift: Action",
+			Description: "What to do when a workspace has drifted. \"none\" only reports the drift on the workspace, \"notify\" also notifies the workspace owner, and \"reconcile\" starts the workspace to recreate or restore the drifted resources.",
+			Flag:        "workspace-drift-action",
+			Env:         "CODER_WORKSPACE_DRIFT_ACTION",
+			Value:       serpent.EnumOf(&c.WorkspaceDrift.Action, WorkspaceDriftActionNone, WorkspaceDriftActionNotify, WorkspaceDriftActionReconcile),
+			Default:     WorkspaceDriftActionNotify,
+			Group:       &deploymentGroupWorkspaceDrift,
+			YAML:        "action",
+		},
 	}
 
 	return opts
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,6 +36,9 @@ const (
 	HealthSectionDatabase           HealthSection = "Database"
 	HealthSectionWorkspaceProxy     HealthSection = "WorkspaceProxy"
 	HealthSectionProvisionerDaemons HealthSection = "ProvisionerDaemons"
+	HealthSectionPubsub             HealthSection = "Pubsub"
+	HealthSectionNotifications      HealthSection = "Notifications"
+	HealthSectionExternalAuth       HealthSection = "ExternalAuth"
 )
 
 var HealthSections = []HealthSection{
@@ -44,6 +48,9 @@ var HealthSections = []HealthSection{
 	HealthSectionDatabase,
 	HealthSectionWorkspaceProxy,
 	HealthSectionProvisionerDaemons,
+	HealthSectionPubsub,
+	HealthSectionNotifications,
+	HealthSectionExternalAuth,
 }
 
 type HealthSettings struct {
@@ -96,6 +103,39 @@ func (c *HealthClient) PutHealthSettings(ctx context.Context, settings HealthSet
 	return nil
 }
 
+type HealthcheckHistoryRequest struct {
+	// Section limits the results to a single section of the report.
+	Section HealthSection `json:"section,omitempty"`
+	// Limit is the maximum number of results returned, 100 by default.
+	Limit int `json:"limit,omitempty"`
+}
+
+// HealthcheckHistory returns the results of past healthchecks, most recent
+// first.
+func (c *HealthClient) HealthcheckHistory(ctx context.Context, req HealthcheckHistoryRequest) ([]HealthcheckSectionResult, error) {
+	var opts []codersdk.RequestOption
+	opts = append(opts, func(r *http.Request) {
+		q := r.URL.Query()
+		if req.Section != "" {
+			q.Set("section", string(req.Section))
+		}
+		if req.Limit > 0 {
+			q.Set("limit", strconv.Itoa(req.Limit))
+		}
+		r.URL.RawQuery = q.Encode()
+	})
+	res, err := c.client.Request(ctx, http.MethodGet, "/api/v2/debug/health/history", nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, codersdk.ReadBodyAsError(res)
+	}
+	var results []HealthcheckSectionResult
+	return results, json.NewDecoder(res.Body).Decode(&results)
+}
+
 // HealthcheckReport contains information about the health status of a Coder deployment.
 type HealthcheckReport struct {
 	// Time is the time the report was generated at.
@@ -112,6 +152,9 @@ type HealthcheckReport struct {
 	Database           DatabaseReport           `json:"database"`
 	WorkspaceProxy     WorkspaceProxyReport     `json:"workspace_proxy"`
 	ProvisionerDaemons ProvisionerDaemonsReport `json:"provisioner_daemons"`
+	Pubsub             PubsubReport             `json:"pubsub"`
+	Notifications      NotificationsReport      `json:"notifications"`
+	ExternalAuth       ExternalAuthReport       `json:"external_auth"`
 
 	// The Coder version of the server that the report was generated on.
 	CoderVersion string `json:"coder_version"`
@@ -123,12 +166,26 @@ func (r *HealthcheckReport) Summarize(docsURL string) []string {
 	msgs = append(msgs, r.AccessURL.Summarize("Access URL:", docsURL)...)
 	msgs = append(msgs, r.Database.Summarize("Database:", docsURL)...)
 	msgs = append(msgs, r.DERP.Summarize("DERP:", docsURL)...)
+	msgs = append(msgs, r.ExternalAuth.Summarize("External Auth:", docsURL)...)
+	msgs = append(msgs, r.Notifications.Summarize("Notifications:", docsURL)...)
 	msgs = append(msgs, r.ProvisionerDaemons.Summarize("Provisioner Daemons:", docsURL)...)
+	msgs = append(msgs, r.Pubsub.Summarize("Pubsub:", docsURL)...)
 	msgs = append(msgs, r.Websocket.Summarize("Websocket:", docsURL)...)
 	msgs = append(msgs, r.WorkspaceProxy.Summarize("Workspace Proxies:", docsURL)...)
 	return msgs
 }
 
+// HealthcheckSectionResult is the result of a single section of a past
+// healthcheck report.
+type HealthcheckSectionResult struct {
+	// Time is the time the report was generated at.
+	Time     time.Time        `json:"time" format:"date-time"`
+	Section  HealthSection    `json:"section"`
+	Severity health.Severity  `json:"severity" enums:"ok,warning,error"`
+	Warnings []health.Message `json:"warnings"`
+	Error    *string          `json:"error"`
+}
+
 // BaseReport holds fields common to various health reports.
 type BaseReport struct {
 	Error     *string          `json:"error"`
@@ -251,6 +308,46 @@ type ProvisionerDaemonsReportItem struct {
 	Warnings                   []health.Message `json:"warnings"`
 }
 
+// PubsubReport shows the round-trip latency of a message published and received
+// through the pubsub.
+type PubsubReport struct {
+	BaseReport
+	Latency     string `json:"latency"`
+	LatencyMS   int64  `json:"latency_ms"`
+	ThresholdMS int64  `json:"threshold_ms"`
+}
+
+// NotificationsReport shows whether the configured notification delivery
+// methods are able to deliver messages.
+type NotificationsReport struct {
+	BaseReport
+	// Enabled is false if the notifications experiment is not enabled, in which
+	// case no delivery methods are checked.
+	Enabled bool                      `json:"enabled"`
+	Items   []NotificationsReportItem `json:"items"`
+}
+
+type NotificationsReportItem struct {
+	Method string `json:"method"`
+	// Default is true if this is the deployment's default delivery method.
+	Default  bool             `json:"default"`
+	Warnings []health.Message `json:"warnings"`
+}
+
+// ExternalAuthReport shows whether the token and validation endpoints of each
+// external auth provider are reachable and accept the configured client
+// credentials.
+type ExternalAuthReport struct {
+	BaseReport
+	Items []ExternalAuthReportItem `json:"items"`
+}
+
+type ExternalAuthReportItem struct {
+	ID       string           `json:"id"`
+	Type     string           `json:"type"`
+	Warnings []health.Message `json:"warnings"`
+}
+
 // WebsocketReport shows if the configured access URL allows establishing WebSocket connections.
 type WebsocketReport struct {
 	// Healthy is deprecated and left for backward compatibility purposes, use `Severity` instead.
@@ -0,0 +1,127 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+)
+
+// MaintenanceWindow is a recurring window during which the autobuild of the
+// workspaces of an organization or template is paused.
+type MaintenanceWindow struct {
+	ID             uuid.UUID `json:"id" format:"uuid"`
+	OrganizationID uuid.UUID `json:"organization_id" format:"uuid"`
+	// TemplateID limits the window to the workspaces of a template. The
+	// window applies to all workspaces of the organization if it is empty.
+	TemplateID *uuid.UUID `json:"template_id,omitempty" format:"uuid"`
+	Name       string     `json:"name"`
+	// Message is shown to users in the announcement banner and notification
+	// of the window.
+	Message string `json:"message"`
+	// Schedule is a weekly cron expression of the start of the window, e.g.
+	// "CRON_TZ=Europe/Berlin 0 2 * * 6".
+	Schedule       string `json:"schedule"`
+	DurationMillis int64  `json:"duration_ms"`
+	// NoticeMillis is how long before the start of the window users are
+	// notified and the announcement banner is shown.
+	NoticeMillis int64 `json:"notice_ms"`
+	// BlockBuilds rejects builds that start workspaces during the window.
+	BlockBuilds bool      `json:"block_builds"`
+	CreatedAt   time.Time `json:"created_at" format:"date-time"`
+	UpdatedAt   time.Time `json:"updated_at" format:"date-time"`
+}
+
+type CreateMaintenanceWindowRequest struct {
+	TemplateID     *uuid.UUID `json:"template_id,omitempty" format:"uuid"`
+	Name           string     `json:"name" validate:"required"`
+	Message        string     `json:"message,omitempty"`
+	Schedule       string     `json:"schedule" validate:"required"`
+	DurationMillis int64      `json:"duration_ms" validate:"required"`
+	NoticeMillis   int64      `json:"notice_ms,omitempty"`
+	BlockBuilds    bool       `json:"block_builds,omitempty"`
+}
+
+type UpdateMaintenanceWindowRequest struct {
+	Name           string `json:"name" validate:"required"`
+	Message        string `json:"message"`
+	Schedule       string `json:"schedule" validate:"required"`
+	DurationMillis int64  `json:"duration_ms" validate:"required"`
+	NoticeMillis   int64  `json:"notice_ms"`
+	BlockBuilds    bool   `json:"block_builds"`
+}
+
+// MaintenanceWindows lists the maintenance windows of an organization.
+func (c *Client) MaintenanceWindows(ctx context.Context, organizationID uuid.UUID) ([]MaintenanceWindow, error) {
+	res, err := c.Request(ctx, http.MethodGet,
+		fmt.Sprintf("/api/v2/organizations/%s/maintenance-windows", organizationID.String()),
+		nil,
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var windows []MaintenanceWindow
+	return windows, json.NewDecoder(res.Body).Decode(&windows)
+}
+
+// CreateMaintenanceWindow creates a maintenance window in an organization.
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, organizationID uuid.UUID, req CreateMaintenanceWindowRequest) (MaintenanceWindow, error) {
+	res, err := c.Request(ctx, http.MethodPost,
+		fmt.Sprintf("/api/v2/organizations/%s/maintenance-windows", organizationID.String()),
+		req,
+	)
+	if err != nil {
+		return MaintenanceWindow{}, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return MaintenanceWindow{}, ReadBodyAsError(res)
+	}
+	var window MaintenanceWindow
+	return window, json.NewDecoder(res.Body).Decode(&window)
+}
+
+// UpdateMaintenanceWindow updates a maintenance window of an organization.
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, organizationID, id uuid.UUID, req UpdateMaintenanceWindowRequest) (MaintenanceWindow, error) {
+	res, err := c.Request(ctx, http.MethodPatch,
+		fmt.Sprintf("/api/v2/organizations/%s/maintenance-windows/%s", organizationID.String(), id.String()),
+		req,
+	)
+	if err != nil {
+		return MaintenanceWindow{}, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return MaintenanceWindow{}, ReadBodyAsError(res)
+	}
+	var window MaintenanceWindow
+	return window, json.NewDecoder(res.Body).Decode(&window)
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window of an organization.
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, organizationID, id uuid.UUID) error {
+	res, err := c.Request(ctx, http.MethodDelete,
+		fmt.Sprintf("/api/v2/organizations/%s/maintenance-windows/%s", organizationID.String(), id.String()),
+		nil,
+	)
+	if err != nil {
+		return xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
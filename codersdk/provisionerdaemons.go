@@ -98,6 +98,10 @@ type ProvisionerJob struct {
 	Tags          map[string]string    `json:"tags"`
 	QueuePosition int                  `json:"queue_position"`
 	QueueSize     int                  `json:"queue_size"`
+	// EstimatedStartAt is when a queued job is expected to start, based on
+	// its queue position and the duration of recently completed jobs. It is
+	// omitted if there is no estimate.
+	EstimatedStartAt *time.Time `json:"estimated_start_at,omitempty" format:"date-time"`
 }
 
 // ProvisionerJobLog represents the provisioner log entry annotated with source and level.
@@ -35,6 +35,11 @@ type Template struct {
 	Icon               string                 `json:"icon"`
 	DefaultTTLMillis   int64                  `json:"default_ttl_ms"`
 	ActivityBumpMillis int64                  `json:"activity_bump_ms"`
+	// IdleDetectionEnabled only bumps workspace deadlines on user input, app
+	// requests and, if IdleCPUThresholdPercent is set, CPU load, instead of
+	// on any open connection.
+	IdleDetectionEnabled    bool  `json:"idle_detection_enabled"`
+	IdleCPUThresholdPercent int32 `json:"idle_cpu_threshold_percent"`
 	// AutostopRequirement and AutostartRequirement are enterprise features. Its
 	// value is only used if your license is entitled to use the advanced template
 	// scheduling feature.
@@ -214,6 +219,13 @@ type UpdateTemplateMeta struct {
 	// duration for all workspaces created from this template. Defaults to 1h
 	// but can be set to 0 to disable activity bumping.
 	ActivityBumpMillis int64 `json:"activity_bump_ms,omitempty"`
+	// IdleDetectionEnabled only bumps workspace deadlines on user input, app
+	// requests and CPU load instead of on any open connection.
+	IdleDetectionEnabled bool `json:"idle_detection_enabled,omitempty"`
+	// IdleCPUThresholdPercent is the CPU usage above which a workspace is
+	// considered in use when idle detection is enabled. Set to 0 to ignore
+	// CPU load.
+	IdleCPUThresholdPercent int32 `json:"idle_cpu_threshold_percent,omitempty"`
 	// AutostopRequirement and AutostartRequirement can only be set if your license
 	// includes the advanced template scheduling feature. If you attempt to set this
 	// value while unlicensed, it will be ignored.
@@ -0,0 +1,105 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+)
+
+type TemplateVersionRolloutStatus string
+
+const (
+	TemplateVersionRolloutStatusCanary     TemplateVersionRolloutStatus = "canary"
+	TemplateVersionRolloutStatusPromoted   TemplateVersionRolloutStatus = "promoted"
+	TemplateVersionRolloutStatusRolledBack TemplateVersionRolloutStatus = "rolled_back"
+)
+
+// TemplateVersionRollout is a staged rollout of a template version. While the
+// rollout is a canary, autobuild starts the workspaces of the canary cohort
+// with the version of the rollout instead of the active version.
+type TemplateVersionRollout struct {
+	ID                  uuid.UUID                    `json:"id" format:"uuid"`
+	TemplateID          uuid.UUID                    `json:"template_id" format:"uuid"`
+	TemplateVersionID   uuid.UUID                    `json:"template_version_id" format:"uuid"`
+	TemplateVersionName string                       `json:"template_version_name"`
+	Status              TemplateVersionRolloutStatus `json:"status" enums:"canary,promoted,rolled_back"`
+	// Percent is the percentage of the workspaces of the template in the
+	// canary cohort.
+	Percent int32 `json:"percent"`
+	// GroupID is the group whose members' workspaces are in the canary cohort
+	// regardless of the percentage.
+	GroupID     *uuid.UUID `json:"group_id,omitempty" format:"uuid"`
+	CreatedByID uuid.UUID  `json:"created_by_id" format:"uuid"`
+	CreatedAt   time.Time  `json:"created_at" format:"date-time"`
+	UpdatedAt   time.Time  `json:"updated_at" format:"date-time"`
+	// CanaryBuilds are the completed builds of the version of the rollout
+	// since the rollout started.
+	CanaryBuilds TemplateVersionRolloutBuildStats `json:"canary_builds"`
+	// ActiveBuilds are the completed builds of the active version since the
+	// rollout started, to compare the canary against.
+	ActiveBuilds TemplateVersionRolloutBuildStats `json:"active_builds"`
+}
+
+type TemplateVersionRolloutBuildStats struct {
+	TotalBuilds  int64 `json:"total_builds"`
+	FailedBuilds int64 `json:"failed_builds"`
+}
+
+// FailureRate returns the share of failed builds, or zero if there are no
+// builds.
+func (s TemplateVersionRolloutBuildStats) FailureRate() float64 {
+	if s.TotalBuilds == 0 {
+		return 0
+	}
+	return float64(s.FailedBuilds) / float64(s.TotalBuilds)
+}
+
+// CreateTemplateVersionRolloutRequest starts the canary rollout of a template
+// version. At least one of Percent and GroupID must be set.
+type CreateTemplateVersionRolloutRequest struct {
+	TemplateVersionID uuid.UUID  `json:"template_version_id" validate:"required" format:"uuid"`
+	Percent           int32      `json:"percent" validate:"min=0,max=100"`
+	GroupID           *uuid.UUID `json:"group_id,omitempty" format:"uuid"`
+}
+
+// TemplateVersionRollout returns the canary rollout of a template.
+func (c *Client) TemplateVersionRollout(ctx context.Context, templateID uuid.UUID) (TemplateVersionRollout, error) {
+	return c.templateVersionRolloutRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v2/templates/%s/rollout", templateID), nil, http.StatusOK)
+}
+
+// CreateTemplateVersionRollout starts the canary rollout of a template version.
+func (c *Client) CreateTemplateVersionRollout(ctx context.Context, templateID uuid.UUID, req CreateTemplateVersionRolloutRequest) (TemplateVersionRollout, error) {
+	return c.templateVersionRolloutRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v2/templates/%s/rollout", templateID), req, http.StatusCreated)
+}
+
+// PromoteTemplateVersionRollout makes the version of the canary rollout of a
+// template the active version.
+func (c *Client) PromoteTemplateVersionRollout(ctx context.Context, templateID uuid.UUID) (TemplateVersionRollout, error) {
+	return c.templateVersionRolloutRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v2/templates/%s/rollout/promote", templateID), nil, http.StatusOK)
+}
+
+// RollBackTemplateVersionRollout ends the canary rollout of a template. The
+// workspaces of the canary cohort move back to the active version when they
+// are next started by autobuild.
+func (c *Client) RollBackTemplateVersionRollout(ctx context.Context, templateID uuid.UUID) (TemplateVersionRollout, error) {
+	return c.templateVersionRolloutRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v2/templates/%s/rollout/rollback", templateID), nil, http.StatusOK)
+}
+
+func (c *Client) templateVersionRolloutRequest(ctx context.Context, method, path string, body interface{}, expectedStatus int) (TemplateVersionRollout, error) {
+	res, err := c.Request(ctx, method, path, body)
+	if err != nil {
+		return TemplateVersionRollout{}, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != expectedStatus {
+		return TemplateVersionRollout{}, ReadBodyAsError(res)
+	}
+	var rollout TemplateVersionRollout
+	return rollout, json.NewDecoder(res.Body).Decode(&rollout)
+}
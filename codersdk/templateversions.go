@@ -186,11 +186,39 @@ func (c *Client) TemplateVersionLogsAfter(ctx context.Context, version uuid.UUID
 // CreateTemplateVersionDryRunRequest defines the request parameters for
 // CreateTemplateVersionDryRun.
 type CreateTemplateVersionDryRunRequest struct {
-	WorkspaceName       string                    `json:"workspace_name"`
+	WorkspaceName string `json:"workspace_name"`
+	// WorkspaceID plans the template version against the state of an
+	// existing workspace of the same template. The resource changes of the
+	// dry-run then describe what updating the workspace would do.
+	WorkspaceID         uuid.UUID                 `json:"workspace_id,omitempty" format:"uuid"`
 	RichParameterValues []WorkspaceBuildParameter `json:"rich_parameter_values"`
 	UserVariableValues  []VariableValue           `json:"user_variable_values,omitempty"`
 }
 
+type WorkspaceResourceChangeAction string
+
+const (
+	WorkspaceResourceChangeActionCreate  WorkspaceResourceChangeAction = "create"
+	WorkspaceResourceChangeActionUpdate  WorkspaceResourceChangeAction = "update"
+	WorkspaceResourceChangeActionReplace WorkspaceResourceChangeAction = "replace"
+	WorkspaceResourceChangeActionDestroy WorkspaceResourceChangeAction = "destroy"
+)
+
+// Destructive returns true if the action deletes the existing resource,
+// along with any data it holds.
+func (a WorkspaceResourceChangeAction) Destructive() bool {
+	return a == WorkspaceResourceChangeActionReplace || a == WorkspaceResourceChangeActionDestroy
+}
+
+// WorkspaceResourceChange is a change to a single resource, planned by a
+// template version dry-run against the state of an existing workspace.
+type WorkspaceResourceChange struct {
+	Address string                        `json:"address"`
+	Type    string                        `json:"type"`
+	Name    string                        `json:"name"`
+	Action  WorkspaceResourceChangeAction `json:"action" enums:"create,update,replace,destroy"`
+}
+
 // CreateTemplateVersionDryRun begins a dry-run provisioner job against the
 // given template version with the given parameter values.
 func (c *Client) CreateTemplateVersionDryRun(ctx context.Context, version uuid.UUID, req CreateTemplateVersionDryRunRequest) (ProvisionerJob, error) {
@@ -239,6 +267,23 @@ func (c *Client) TemplateVersionDryRunResources(ctx context.Context, version, jo
 	return resources, json.NewDecoder(res.Body).Decode(&resources)
 }
 
+// TemplateVersionDryRunResourceChanges returns the resource changes of a
+// finished template version dry-run job that was planned against a
+// workspace.
+func (c *Client) TemplateVersionDryRunResourceChanges(ctx context.Context, version, job uuid.UUID) ([]WorkspaceResourceChange, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/templateversions/%s/dry-run/%s/resource-changes", version, job), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+
+	var changes []WorkspaceResourceChange
+	return changes, json.NewDecoder(res.Body).Decode(&changes)
+}
+
 // TemplateVersionDryRunLogsAfter streams logs for a template version dry-run
 // that occurred after a specific log ID.
 func (c *Client) TemplateVersionDryRunLogsAfter(ctx context.Context, version, job uuid.UUID, after int64) (<-chan ProvisionerJobLog, io.Closer, error) {
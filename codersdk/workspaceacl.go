@@ -0,0 +1,82 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceRole is the level of access a user or group is granted to a
+// workspace that is shared with them.
+type WorkspaceRole string
+
+const (
+	// WorkspaceRoleAdmin allows starting, stopping and updating the workspace,
+	// in addition to using it.
+	WorkspaceRoleAdmin WorkspaceRole = "admin"
+	// WorkspaceRoleUse allows connecting to the workspace over SSH and
+	// accessing its apps and ports.
+	WorkspaceRoleUse WorkspaceRole = "use"
+	// WorkspaceRoleDeleted removes the user or group from the ACL.
+	WorkspaceRoleDeleted WorkspaceRole = ""
+)
+
+// WorkspaceACL lists the users and groups a workspace is shared with.
+type WorkspaceACL struct {
+	Users  []WorkspaceUser  `json:"users"`
+	Groups []WorkspaceGroup `json:"groups"`
+}
+
+type WorkspaceUser struct {
+	MinimalUser
+	Role WorkspaceRole `json:"role" enums:"admin,use"`
+}
+
+type WorkspaceGroup struct {
+	ID          uuid.UUID     `json:"id" format:"uuid"`
+	Name        string        `json:"name"`
+	DisplayName string        `json:"display_name"`
+	AvatarURL   string        `json:"avatar_url" format:"uri"`
+	Role        WorkspaceRole `json:"role" enums:"admin,use"`
+}
+
+type UpdateWorkspaceACL struct {
+	// UserRoles is a mapping of user ID or username to role. An empty role
+	// removes the user from the ACL.
+	UserRoles map[string]WorkspaceRole `json:"user_roles,omitempty" example:"4df59e74-c027-470b-ab4d-cbba8963a5e9:use,alice:admin"`
+	// GroupRoles is a mapping of group ID or name to role. Names are looked
+	// up in the organization of the workspace. An empty role removes the
+	// group from the ACL.
+	GroupRoles map[string]WorkspaceRole `json:"group_roles,omitempty" example:"8bd26b20-f3e8-48be-a903-46bb920cf671:admin"`
+}
+
+// WorkspaceACL returns the users and groups the workspace is shared with.
+func (c *Client) WorkspaceACL(ctx context.Context, workspaceID uuid.UUID) (WorkspaceACL, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaces/%s/acl", workspaceID), nil)
+	if err != nil {
+		return WorkspaceACL{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceACL{}, ReadBodyAsError(res)
+	}
+	var acl WorkspaceACL
+	return acl, json.NewDecoder(res.Body).Decode(&acl)
+}
+
+// UpdateWorkspaceACL shares the workspace with, or stops sharing it with, the
+// users and groups of the request.
+func (c *Client) UpdateWorkspaceACL(ctx context.Context, workspaceID uuid.UUID, req UpdateWorkspaceACL) error {
+	res, err := c.Request(ctx, http.MethodPatch, fmt.Sprintf("/api/v2/workspaces/%s/acl", workspaceID), req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceAgentFileInfo describes a file or directory in a workspace.
+type WorkspaceAgentFileInfo struct {
+	Name string `json:"name"`
+	// Path is the absolute path of the file in the workspace.
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	// Mode is the file mode in the format of ls, e.g. "-rw-r--r--".
+	Mode    string    `json:"mode"`
+	ModTime time.Time `json:"mod_time" format:"date-time"`
+	IsDir   bool      `json:"is_dir"`
+}
+
+// WorkspaceAgentDirectory is the content of a directory in a workspace.
+type WorkspaceAgentDirectory struct {
+	// Path is the absolute path of the directory in the workspace.
+	Path  string                   `json:"path"`
+	Files []WorkspaceAgentFileInfo `json:"files"`
+}
+
+// WorkspaceAgentListDirectory lists the content of a directory in a
+// workspace. Relative paths are resolved against the home directory of the
+// workspace user.
+func (c *Client) WorkspaceAgentListDirectory(ctx context.Context, agentID uuid.UUID, path string) (WorkspaceAgentDirectory, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaceagents/%s/files/list", agentID), nil,
+		WithQueryParam("path", path),
+	)
+	if err != nil {
+		return WorkspaceAgentDirectory{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceAgentDirectory{}, ReadBodyAsError(res)
+	}
+	var dir WorkspaceAgentDirectory
+	return dir, json.NewDecoder(res.Body).Decode(&dir)
+}
+
+// WorkspaceAgentReadFile streams the content of a file in a workspace,
+// starting at offset. The caller must close the returned reader.
+func (c *Client) WorkspaceAgentReadFile(ctx context.Context, agentID uuid.UUID, path string, offset int64) (io.ReadCloser, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaceagents/%s/files", agentID), nil,
+		WithQueryParam("path", path),
+		func(r *http.Request) {
+			if offset > 0 {
+				r.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusPartialContent {
+		defer res.Body.Close()
+		return nil, ReadBodyAsError(res)
+	}
+	return res.Body, nil
+}
+
+// WorkspaceAgentWriteFile streams content into a file in a workspace. The
+// file is created if it does not exist. Any existing content from offset
+// onwards is replaced, which allows interrupted uploads to be resumed.
+func (c *Client) WorkspaceAgentWriteFile(ctx context.Context, agentID uuid.UUID, path string, offset int64, content io.Reader) (WorkspaceAgentFileInfo, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/v2/workspaceagents/%s/files", agentID), content,
+		WithQueryParam("path", path),
+		WithQueryParam("offset", strconv.FormatInt(offset, 10)),
+		func(r *http.Request) {
+			r.Header.Set("Content-Type", "application/octet-stream")
+		},
+	)
+	if err != nil {
+		return WorkspaceAgentFileInfo{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceAgentFileInfo{}, ReadBodyAsError(res)
+	}
+	var info WorkspaceAgentFileInfo
+	return info, json.NewDecoder(res.Body).Decode(&info)
+}
@@ -46,6 +46,10 @@ const (
 	// "autostop" is used when a build to stop a workspace is triggered by Autostop.
 	// The initiator id/username in this case is the workspace owner and can be ignored.
 	BuildReasonAutostop BuildReason = "autostop"
+	// "drift" is used when a build to start a workspace is triggered because
+	// its resources were changed or deleted outside of Coder.
+	// The initiator id/username in this case is the workspace owner and can be ignored.
+	BuildReasonDrift BuildReason = "drift"
 )
 
 // WorkspaceBuild is an at-point representation of a workspace state.
@@ -66,7 +70,7 @@ type WorkspaceBuild struct {
 	InitiatorID             uuid.UUID           `json:"initiator_id" format:"uuid"`
 	InitiatorUsername       string              `json:"initiator_name"`
 	Job                     ProvisionerJob      `json:"job"`
-	Reason                  BuildReason         `db:"reason" json:"reason" enums:"initiator,autostart,autostop"`
+	Reason                  BuildReason         `db:"reason" json:"reason" enums:"initiator,autostart,autostop,drift"`
 	Resources               []WorkspaceResource `json:"resources"`
 	Deadline                NullTime            `json:"deadline,omitempty" format:"date-time"`
 	MaxDeadline             NullTime            `json:"max_deadline,omitempty" format:"date-time"`
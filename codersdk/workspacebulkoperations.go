@@ -0,0 +1,119 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+)
+
+type WorkspaceBulkOperationAction string
+
+const (
+	WorkspaceBulkOperationActionStart  WorkspaceBulkOperationAction = "start"
+	WorkspaceBulkOperationActionStop   WorkspaceBulkOperationAction = "stop"
+	WorkspaceBulkOperationActionUpdate WorkspaceBulkOperationAction = "update"
+	WorkspaceBulkOperationActionDelete WorkspaceBulkOperationAction = "delete"
+)
+
+type WorkspaceBulkOperationWorkspaceStatus string
+
+const (
+	// WorkspaceBulkOperationWorkspaceStatusPending means the build of the
+	// workspace has not been enqueued yet.
+	WorkspaceBulkOperationWorkspaceStatusPending WorkspaceBulkOperationWorkspaceStatus = "pending"
+	// WorkspaceBulkOperationWorkspaceStatusEnqueued means a build of the
+	// workspace was enqueued. The job status of the build reports its
+	// progress.
+	WorkspaceBulkOperationWorkspaceStatusEnqueued WorkspaceBulkOperationWorkspaceStatus = "enqueued"
+	// WorkspaceBulkOperationWorkspaceStatusSkipped means the workspace did
+	// not need a build, e.g. it was already stopped.
+	WorkspaceBulkOperationWorkspaceStatusSkipped WorkspaceBulkOperationWorkspaceStatus = "skipped"
+	// WorkspaceBulkOperationWorkspaceStatusFailed means the build of the
+	// workspace could not be enqueued.
+	WorkspaceBulkOperationWorkspaceStatusFailed WorkspaceBulkOperationWorkspaceStatus = "failed"
+)
+
+// CreateWorkspaceBulkOperationRequest builds all workspaces matching a
+// workspace search query, e.g. "template:docker outdated:true".
+type CreateWorkspaceBulkOperationRequest struct {
+	Action WorkspaceBulkOperationAction `json:"action" validate:"required,oneof=start stop update delete" enums:"start,stop,update,delete"`
+	Search string                       `json:"search" validate:"required"`
+}
+
+// WorkspaceBulkOperation is the build of many workspaces. Builds are enqueued
+// in the background at a limited rate to protect provisioner capacity.
+type WorkspaceBulkOperation struct {
+	ID          uuid.UUID                    `json:"id" format:"uuid"`
+	InitiatorID uuid.UUID                    `json:"initiator_id" format:"uuid"`
+	Action      WorkspaceBulkOperationAction `json:"action" enums:"start,stop,update,delete"`
+	Search      string                       `json:"search"`
+	CreatedAt   time.Time                    `json:"created_at" format:"date-time"`
+	// CompletedAt is set once the builds of all workspaces were enqueued,
+	// skipped or failed to enqueue. Enqueued builds may still be running.
+	CompletedAt *time.Time                        `json:"completed_at,omitempty" format:"date-time"`
+	Workspaces  []WorkspaceBulkOperationWorkspace `json:"workspaces"`
+}
+
+// Done reports whether all builds of the operation were enqueued and have
+// completed.
+func (o WorkspaceBulkOperation) Done() bool {
+	if o.CompletedAt == nil {
+		return false
+	}
+	for _, ws := range o.Workspaces {
+		if ws.JobStatus != nil && ws.JobStatus.Active() {
+			return false
+		}
+	}
+	return true
+}
+
+type WorkspaceBulkOperationWorkspace struct {
+	WorkspaceID        uuid.UUID                             `json:"workspace_id" format:"uuid"`
+	WorkspaceName      string                                `json:"workspace_name"`
+	WorkspaceOwnerName string                                `json:"workspace_owner_name"`
+	Status             WorkspaceBulkOperationWorkspaceStatus `json:"status" enums:"pending,enqueued,skipped,failed"`
+	// Message is the reason the workspace was skipped or its build failed to
+	// enqueue.
+	Message          string     `json:"message,omitempty"`
+	WorkspaceBuildID *uuid.UUID `json:"workspace_build_id,omitempty" format:"uuid"`
+	// JobStatus is the status of the provisioner job of the enqueued build.
+	JobStatus *ProvisionerJobStatus `json:"job_status,omitempty" enums:"pending,running,succeeded,canceling,canceled,failed"`
+	UpdatedAt time.Time             `json:"updated_at" format:"date-time"`
+}
+
+// CreateWorkspaceBulkOperation builds all workspaces matching a search query.
+func (c *Client) CreateWorkspaceBulkOperation(ctx context.Context, req CreateWorkspaceBulkOperationRequest) (WorkspaceBulkOperation, error) {
+	res, err := c.Request(ctx, http.MethodPost, "/api/v2/workspacebulkoperations", req)
+	if err != nil {
+		return WorkspaceBulkOperation{}, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return WorkspaceBulkOperation{}, ReadBodyAsError(res)
+	}
+	var op WorkspaceBulkOperation
+	return op, json.NewDecoder(res.Body).Decode(&op)
+}
+
+// WorkspaceBulkOperation returns a workspace bulk operation and the progress
+// of the build of each of its workspaces.
+func (c *Client) WorkspaceBulkOperation(ctx context.Context, id uuid.UUID) (WorkspaceBulkOperation, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspacebulkoperations/%s", id), nil)
+	if err != nil {
+		return WorkspaceBulkOperation{}, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceBulkOperation{}, ReadBodyAsError(res)
+	}
+	var op WorkspaceBulkOperation
+	return op, json.NewDecoder(res.Body).Decode(&op)
+}
@@ -59,7 +59,10 @@ type Workspace struct {
 	DormantAt *time.Time `json:"dormant_at" format:"date-time"`
 	// Health shows the health of the workspace and information about
 	// what is causing an unhealthy status.
-	Health           WorkspaceHealth  `json:"health"`
+	Health WorkspaceHealth `json:"health"`
+	// Drift shows whether the infrastructure of the workspace was changed
+	// or deleted outside of Coder since its latest build.
+	Drift            WorkspaceDrift   `json:"drift"`
 	AutomaticUpdates AutomaticUpdates `json:"automatic_updates" enums:"always,never"`
 	AllowRenames     bool             `json:"allow_renames"`
 	Favorite         bool             `json:"favorite"`
@@ -74,6 +77,25 @@ type WorkspaceHealth struct {
 	FailingAgents []uuid.UUID `json:"failing_agents" format:"uuid"` // FailingAgents lists the IDs of the agents that are failing, if any.
 }
 
+type WorkspaceDriftStatus string
+
+const (
+	// WorkspaceDriftStatusUnknown means the latest build of the workspace
+	// has not been checked for drift yet.
+	WorkspaceDriftStatusUnknown WorkspaceDriftStatus = "unknown"
+	WorkspaceDriftStatusInSync  WorkspaceDriftStatus = "in_sync"
+	WorkspaceDriftStatusDrifted WorkspaceDriftStatus = "drifted"
+)
+
+type WorkspaceDrift struct {
+	Status WorkspaceDriftStatus `json:"status" enums:"unknown,in_sync,drifted"`
+	// CheckedAt is the time the latest drift check completed.
+	CheckedAt *time.Time `json:"checked_at,omitempty" format:"date-time"`
+	// Resources lists the resources that were changed or deleted outside of
+	// Coder, if any.
+	Resources []WorkspaceResourceChange `json:"resources"`
+}
+
 type WorkspacesRequest struct {
 	SearchQuery string `json:"q,omitempty"`
 	Pagination
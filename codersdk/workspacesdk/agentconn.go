@@ -259,6 +259,66 @@ func (c *AgentConn) Netcheck(ctx context.Context) (healthsdk.AgentNetcheckReport
 	return resp, json.NewDecoder(res.Body).Decode(&resp)
 }
 
+// ListDirectory lists the content of a directory in the workspace.
+func (c *AgentConn) ListDirectory(ctx context.Context, path string) (codersdk.WorkspaceAgentDirectory, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodGet, "/api/v0/files/list", nil, codersdk.WithQueryParam("path", path))
+	if err != nil {
+		return codersdk.WorkspaceAgentDirectory{}, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return codersdk.WorkspaceAgentDirectory{}, codersdk.ReadBodyAsError(res)
+	}
+
+	var resp codersdk.WorkspaceAgentDirectory
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// ReadFile requests the content of a file in the workspace. If rangeHeader is
+// set, it is sent as the Range header to request part of the file. The
+// returned response has a status of either 200 or 206, and the caller must
+// close its body.
+func (c *AgentConn) ReadFile(ctx context.Context, path string, rangeHeader string) (*http.Response, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodGet, "/api/v0/files", nil, codersdk.WithQueryParam("path", path), func(r *http.Request) {
+		if rangeHeader != "" {
+			r.Header.Set("Range", rangeHeader)
+		}
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("do request: %w", err)
+	}
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusPartialContent {
+		defer res.Body.Close()
+		return nil, codersdk.ReadBodyAsError(res)
+	}
+	return res, nil
+}
+
+// WriteFile streams content into a file in the workspace, replacing any
+// existing content from offset onwards.
+func (c *AgentConn) WriteFile(ctx context.Context, path string, offset int64, content io.Reader) (codersdk.WorkspaceAgentFileInfo, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodPut, "/api/v0/files", content,
+		codersdk.WithQueryParam("path", path),
+		codersdk.WithQueryParam("offset", strconv.FormatInt(offset, 10)),
+	)
+	if err != nil {
+		return codersdk.WorkspaceAgentFileInfo{}, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return codersdk.WorkspaceAgentFileInfo{}, codersdk.ReadBodyAsError(res)
+	}
+
+	var resp codersdk.WorkspaceAgentFileInfo
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
 // DebugMagicsock makes a request to the workspace agent's magicsock debug endpoint.
 func (c *AgentConn) DebugMagicsock(ctx context.Context) ([]byte, error) {
 	ctx, span := tracing.StartSpan(ctx)
@@ -337,7 +397,7 @@ func (c *AgentConn) PrometheusMetrics(ctx context.Context) ([]byte, error) {
 }
 
 // apiRequest makes a request to the workspace agent's HTTP API server.
-func (c *AgentConn) apiRequest(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+func (c *AgentConn) apiRequest(ctx context.Context, method, path string, body io.Reader, opts ...codersdk.RequestOption) (*http.Response, error) {
 	ctx, span := tracing.StartSpan(ctx)
 	defer span.End()
 
@@ -348,6 +408,9 @@ func (c *AgentConn) apiRequest(ctx context.Context, method, path string, body io
 	if err != nil {
 		return nil, xerrors.Errorf("new http api request to %q: %w", url, err)
 	}
+	for _, opt := range opts {
+		opt(req)
+	}
 
 	return c.apiClient().Do(req)
 }
@@ -0,0 +1,71 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceSessionRecordingType string
+
+const (
+	WorkspaceSessionRecordingTypeSSH             WorkspaceSessionRecordingType = "ssh"
+	WorkspaceSessionRecordingTypeReconnectingPTY WorkspaceSessionRecordingType = "reconnecting_pty"
+)
+
+// WorkspaceSessionRecording describes a recorded terminal session. The
+// recording itself is in the asciicast v2 format and can be downloaded with
+// WorkspaceSessionRecordingData.
+type WorkspaceSessionRecording struct {
+	ID          uuid.UUID                     `json:"id" format:"uuid"`
+	WorkspaceID uuid.UUID                     `json:"workspace_id" format:"uuid"`
+	AgentID     uuid.UUID                     `json:"agent_id" format:"uuid"`
+	Type        WorkspaceSessionRecordingType `json:"type" enums:"ssh,reconnecting_pty"`
+	// Command is the command the session was started with. It is empty for
+	// login shells.
+	Command   string    `json:"command"`
+	StartedAt time.Time `json:"started_at" format:"date-time"`
+	EndedAt   time.Time `json:"ended_at" format:"date-time"`
+	// InputRecorded is true if the recording includes what was typed into the
+	// session.
+	InputRecorded bool `json:"input_recorded"`
+	// Truncated is true if the recording reached the maximum size before the
+	// session ended.
+	Truncated bool `json:"truncated"`
+	// Size is the size of the recording in bytes.
+	Size int64 `json:"size"`
+}
+
+// WorkspaceSessionRecordings returns the recorded terminal sessions of a
+// workspace, newest first.
+func (c *Client) WorkspaceSessionRecordings(ctx context.Context, workspaceID uuid.UUID) ([]WorkspaceSessionRecording, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaces/%s/recordings", workspaceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var recordings []WorkspaceSessionRecording
+	return recordings, json.NewDecoder(res.Body).Decode(&recordings)
+}
+
+// WorkspaceSessionRecordingData returns a recorded terminal session in the
+// asciicast v2 format. The caller must close the returned reader.
+func (c *Client) WorkspaceSessionRecordingData(ctx context.Context, workspaceID, recordingID uuid.UUID) (io.ReadCloser, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaces/%s/recordings/%s", workspaceID, recordingID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, ReadBodyAsError(res)
+	}
+	return res.Body, nil
+}
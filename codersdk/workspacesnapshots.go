@@ -0,0 +1,90 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceSnapshotVersion is the current version of the workspace snapshot
+// format. Snapshots with a different version cannot be restored.
+const WorkspaceSnapshotVersion = 1
+
+// WorkspaceSnapshot captures the latest build of a workspace so that it can
+// be restored as a new workspace.
+type WorkspaceSnapshot struct {
+	Version             int                       `json:"version"`
+	CreatedAt           time.Time                 `json:"created_at" format:"date-time"`
+	WorkspaceID         uuid.UUID                 `json:"workspace_id" format:"uuid"`
+	WorkspaceName       string                    `json:"workspace_name"`
+	OwnerName           string                    `json:"owner_name"`
+	OrganizationID      uuid.UUID                 `json:"organization_id" format:"uuid"`
+	TemplateID          uuid.UUID                 `json:"template_id" format:"uuid"`
+	TemplateName        string                    `json:"template_name"`
+	TemplateVersionID   uuid.UUID                 `json:"template_version_id" format:"uuid"`
+	TemplateVersionName string                    `json:"template_version_name"`
+	BuildNumber         int32                     `json:"build_number"`
+	Transition          WorkspaceTransition       `json:"transition" enums:"start,stop,delete"`
+	RichParameterValues []WorkspaceBuildParameter `json:"rich_parameter_values"`
+	// AgentMetadata is the metadata last reported by the agents of the
+	// workspace. It is informational only and is not restored.
+	AgentMetadata []WorkspaceSnapshotAgentMetadata `json:"agent_metadata"`
+	// State is the Terraform state of the build. It is only included for
+	// users that are allowed to manage the template, as it may contain
+	// secrets.
+	State []byte `json:"state,omitempty"`
+}
+
+// WorkspaceSnapshotAgentMetadata is a metadata value reported by an agent.
+type WorkspaceSnapshotAgentMetadata struct {
+	AgentName   string    `json:"agent_name"`
+	Key         string    `json:"key"`
+	DisplayName string    `json:"display_name"`
+	Value       string    `json:"value"`
+	Error       string    `json:"error,omitempty"`
+	CollectedAt time.Time `json:"collected_at" format:"date-time"`
+}
+
+// RestoreWorkspaceSnapshotRequest creates a new workspace from a snapshot.
+type RestoreWorkspaceSnapshotRequest struct {
+	Name     string            `json:"name" validate:"workspace_name,required"`
+	Snapshot WorkspaceSnapshot `json:"snapshot"`
+	// RestoreState imports the Terraform state of the snapshot into the new
+	// workspace, so that it adopts the resources of the snapshotted build
+	// instead of provisioning new ones. Only template managers may restore
+	// state.
+	RestoreState bool `json:"restore_state,omitempty"`
+}
+
+// WorkspaceSnapshot returns a snapshot of the latest build of a workspace.
+func (c *Client) WorkspaceSnapshot(ctx context.Context, workspaceID uuid.UUID) (WorkspaceSnapshot, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaces/%s/snapshot", workspaceID), nil)
+	if err != nil {
+		return WorkspaceSnapshot{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceSnapshot{}, ReadBodyAsError(res)
+	}
+	var snapshot WorkspaceSnapshot
+	return snapshot, json.NewDecoder(res.Body).Decode(&snapshot)
+}
+
+// RestoreWorkspaceSnapshot creates a new workspace for the given user from a
+// snapshot.
+func (c *Client) RestoreWorkspaceSnapshot(ctx context.Context, user string, req RestoreWorkspaceSnapshotRequest) (Workspace, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/users/%s/workspaces/restore", user), req)
+	if err != nil {
+		return Workspace{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return Workspace{}, ReadBodyAsError(res)
+	}
+	var workspace Workspace
+	return workspace, json.NewDecoder(res.Body).Decode(&workspace)
+}
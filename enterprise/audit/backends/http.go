@@ -0,0 +1,385 @@
+package backends
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+const (
+	httpSpoolExtension    = ".jsonl"
+	httpRequestTimeout    = 30 * time.Second
+	httpInitialRetryDelay = 500 * time.Millisecond
+	httpMaxRetryDelay     = 30 * time.Second
+)
+
+// HTTPOptions configures the HTTP backend.
+type HTTPOptions struct {
+	// Endpoint is the URL to which batches of audit logs are POSTed as a
+	// JSON array of Events.
+	Endpoint string
+	// Headers are added to every request.
+	Headers http.Header
+	// BatchSize is the maximum number of audit logs sent per request.
+	BatchSize int
+	// FlushInterval is how often a partial batch is sent, and how often
+	// spooled batches are replayed.
+	FlushInterval time.Duration
+	// MaxRetries is how many times a failed request is retried before its
+	// batch is spooled.
+	MaxRetries int
+	// BufferSize is the number of audit logs which can be queued in memory.
+	// Once the buffer is full, audit logs are spooled directly.
+	BufferSize int
+	// SpoolDirectory is where undeliverable batches are written. If empty,
+	// undeliverable batches are dropped.
+	SpoolDirectory string
+	// Client is the HTTP client used to send requests. Defaults to a client
+	// with a timeout.
+	Client *http.Client
+}
+
+type httpBackend struct {
+	log  slog.Logger
+	opts HTTPOptions
+
+	queue     chan Event
+	closed    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewHTTP creates a backend which streams batches of audit logs as JSON to an
+// HTTP endpoint. Audit logs are queued in memory and sent by a background
+// goroutine, so that a slow or unavailable endpoint never blocks the request
+// which produced the audit log. Failed requests are retried with exponential
+// backoff; batches which still cannot be delivered are spooled to disk and
+// replayed once the endpoint is available again.
+//
+// The returned backend implements io.Closer, which flushes any queued audit
+// logs.
+func NewHTTP(logger slog.Logger, opts HTTPOptions) (audit.Backend, error) {
+	if opts.Endpoint == "" {
+		return nil, xerrors.New("http endpoint not defined")
+	}
+	if opts.BatchSize <= 0 {
+		return nil, xerrors.New("batch size must be positive")
+	}
+	if opts.FlushInterval <= 0 {
+		return nil, xerrors.New("flush interval must be positive")
+	}
+	if opts.BufferSize < 0 || opts.MaxRetries < 0 {
+		return nil, xerrors.New("buffer size and max retries must not be negative")
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: httpRequestTimeout}
+	}
+	if opts.SpoolDirectory != "" {
+		err := os.MkdirAll(opts.SpoolDirectory, 0o700)
+		if err != nil {
+			return nil, xerrors.Errorf("create spool directory: %w", err)
+		}
+	}
+
+	b := &httpBackend{
+		log:    logger,
+		opts:   opts,
+		queue:  make(chan Event, opts.BufferSize),
+		closed: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go b.run()
+	return b, nil
+}
+
+func (*httpBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *httpBackend) Export(ctx context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	ev := newEvent(alog, details)
+
+	select {
+	case <-b.closed:
+		return xerrors.New("http audit backend is closed")
+	default:
+	}
+
+	select {
+	case b.queue <- ev:
+		return nil
+	default:
+	}
+
+	// The buffer is full: the endpoint cannot keep up, so apply backpressure
+	// by spooling rather than blocking the caller.
+	if b.opts.SpoolDirectory == "" {
+		return xerrors.New("http audit backend buffer is full")
+	}
+	b.log.Warn(ctx, "audit log buffer full, spooling audit log", slog.F("audit_log_id", alog.ID))
+	return b.spool([]Event{ev})
+}
+
+// Close stops accepting audit logs and attempts to deliver any queued audit
+// logs, spooling those which cannot be delivered.
+func (b *httpBackend) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+	})
+	<-b.done
+	return nil
+}
+
+func (b *httpBackend) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.opts.FlushInterval)
+	defer ticker.Stop()
+
+	ctx := context.Background()
+	batch := make([]Event, 0, b.opts.BatchSize)
+	for {
+		select {
+		case <-b.closed:
+			// Drain the queue, making a single attempt for each batch since
+			// the server is shutting down.
+			for {
+				select {
+				case ev := <-b.queue:
+					batch = append(batch, ev)
+					if len(batch) < b.opts.BatchSize {
+						continue
+					}
+					b.flush(ctx, batch, 0)
+					batch = batch[:0]
+					continue
+				default:
+				}
+				break
+			}
+			b.flush(ctx, batch, 0)
+			return
+		case ev := <-b.queue:
+			batch = append(batch, ev)
+			if len(batch) < b.opts.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+			b.replay(ctx)
+		}
+
+		b.flush(ctx, batch, b.opts.MaxRetries)
+		batch = batch[:0]
+	}
+}
+
+// flush sends the batch, spooling it if it cannot be delivered.
+func (b *httpBackend) flush(ctx context.Context, batch []Event, retries int) {
+	if len(batch) == 0 {
+		return
+	}
+
+	err := b.sendWithRetries(ctx, batch, retries)
+	if err == nil {
+		return
+	}
+
+	if b.opts.SpoolDirectory == "" {
+		b.log.Error(ctx, "failed to deliver audit logs, dropping batch", slog.F("count", len(batch)), slog.Error(err))
+		return
+	}
+	b.log.Warn(ctx, "failed to deliver audit logs, spooling batch", slog.F("count", len(batch)), slog.Error(err))
+	if err := b.spool(batch); err != nil {
+		b.log.Error(ctx, "failed to spool audit logs, dropping batch", slog.F("count", len(batch)), slog.Error(err))
+	}
+}
+
+func (b *httpBackend) sendWithRetries(ctx context.Context, batch []Event, retries int) error {
+	delay := httpInitialRetryDelay
+	for attempt := 0; ; attempt++ {
+		retryable, err := b.send(ctx, batch)
+		if err == nil || !retryable || attempt >= retries {
+			return err
+		}
+
+		b.log.Debug(ctx, "audit log delivery failed, retrying", slog.F("attempt", attempt+1), slog.F("delay", delay), slog.Error(err))
+		select {
+		case <-b.closed:
+			return xerrors.Errorf("backend closed while retrying: %w", err)
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, httpMaxRetryDelay)
+	}
+}
+
+// send delivers the batch in a single request. The returned boolean indicates
+// whether a failure may succeed if retried.
+func (b *httpBackend) send(ctx context.Context, batch []Event) (retryable bool, err error) {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return false, xerrors.Errorf("marshal batch: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, xerrors.Errorf("create HTTP request: %w", err)
+	}
+	for name, values := range b.opts.Headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.opts.Client.Do(req)
+	if err != nil {
+		return true, xerrors.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode/100 != 2 {
+		retryable = resp.StatusCode == http.StatusTooManyRequests ||
+			resp.StatusCode == http.StatusRequestTimeout ||
+			resp.StatusCode >= http.StatusInternalServerError
+		return retryable, xerrors.Errorf("non-2xx response (%d)", resp.StatusCode)
+	}
+
+	return false, nil
+}
+
+// spool writes the events to a new file in the spool directory. Files are
+// written under a temporary name and renamed so that replay never observes a
+// partially written file.
+func (b *httpBackend) spool(events []Event) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, ev := range events {
+		if err := enc.Encode(ev); err != nil {
+			return xerrors.Errorf("encode event: %w", err)
+		}
+	}
+
+	// Names sort in the order in which they were spooled.
+	name := fmt.Sprintf("%020d-%s%s", time.Now().UnixNano(), uuid.NewString(), httpSpoolExtension)
+	path := filepath.Join(b.opts.SpoolDirectory, name)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o600); err != nil {
+		return xerrors.Errorf("write spool file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return xerrors.Errorf("rename spool file: %w", err)
+	}
+	return nil
+}
+
+// replay attempts to deliver spooled batches in the order they were spooled,
+// stopping at the first failure since the endpoint is likely still
+// unavailable.
+func (b *httpBackend) replay(ctx context.Context) {
+	if b.opts.SpoolDirectory == "" {
+		return
+	}
+
+	entries, err := os.ReadDir(b.opts.SpoolDirectory)
+	if err != nil {
+		b.log.Error(ctx, "failed to read spool directory", slog.Error(err))
+		return
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), httpSpoolExtension) {
+			files = append(files, entry.Name())
+		}
+	}
+	slices.Sort(files)
+
+	var pending []Event
+	var pendingFiles []string
+	for _, name := range files {
+		select {
+		case <-b.closed:
+			return
+		default:
+		}
+
+		path := filepath.Join(b.opts.SpoolDirectory, name)
+		events, err := readSpoolFile(path)
+		if err != nil {
+			b.log.Error(ctx, "failed to read spool file, removing", slog.F("path", path), slog.Error(err))
+			_ = os.Remove(path)
+			continue
+		}
+
+		// Combine small spool files (e.g. those written under backpressure)
+		// into full batches.
+		if len(pending) > 0 && len(pending)+len(events) > b.opts.BatchSize {
+			if !b.replayBatch(ctx, pending, pendingFiles) {
+				return
+			}
+			pending, pendingFiles = nil, nil
+		}
+		pending = append(pending, events...)
+		pendingFiles = append(pendingFiles, path)
+	}
+	b.replayBatch(ctx, pending, pendingFiles)
+}
+
+func (b *httpBackend) replayBatch(ctx context.Context, events []Event, files []string) bool {
+	if len(events) == 0 {
+		return true
+	}
+
+	if _, err := b.send(ctx, events); err != nil {
+		b.log.Debug(ctx, "failed to replay spooled audit logs", slog.F("count", len(events)), slog.Error(err))
+		return false
+	}
+	for _, path := range files {
+		if err := os.Remove(path); err != nil {
+			b.log.Error(ctx, "failed to remove replayed spool file", slog.F("path", path), slog.Error(err))
+		}
+	}
+	return true
+}
+
+func readSpoolFile(path string) ([]Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16<<20)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var ev Event
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			return nil, xerrors.Errorf("decode event: %w", err)
+		}
+		events = append(events, ev)
+	}
+	return events, scanner.Err()
+}
@@ -0,0 +1,227 @@
+package backends_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestHTTPBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Batches", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		sink := newHTTPSink(t)
+		backend, err := backends.NewHTTP(slogtest.Make(t, nil), backends.HTTPOptions{
+			Endpoint:      sink.URL,
+			Headers:       http.Header{"Authorization": []string{"Bearer secret"}},
+			BatchSize:     2,
+			FlushInterval: time.Hour,
+			BufferSize:    10,
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		first, second := audittest.RandomLog(), audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, first, audit.BackendDetails{}))
+		require.NoError(t, backend.Export(ctx, second, audit.BackendDetails{}))
+
+		batch := testutil.RequireRecvCtx(ctx, t, sink.batches)
+		require.Len(t, batch, 2)
+		require.Equal(t, first.ID, batch[0].ID)
+		require.Equal(t, second.ID, batch[1].ID)
+		require.Equal(t, "Bearer secret", sink.lastAuth.Load())
+	})
+
+	t.Run("FlushInterval", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		sink := newHTTPSink(t)
+		backend, err := backends.NewHTTP(slogtest.Make(t, nil), backends.HTTPOptions{
+			Endpoint:      sink.URL,
+			BatchSize:     100,
+			FlushInterval: testutil.IntervalFast,
+			BufferSize:    10,
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		alog := audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+
+		batch := testutil.RequireRecvCtx(ctx, t, sink.batches)
+		require.Len(t, batch, 1)
+		require.Equal(t, alog.ID, batch[0].ID)
+	})
+
+	t.Run("Retries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		sink := newHTTPSink(t)
+		sink.failures.Store(1)
+		backend, err := backends.NewHTTP(slogtest.Make(t, nil), backends.HTTPOptions{
+			Endpoint:      sink.URL,
+			BatchSize:     1,
+			FlushInterval: time.Hour,
+			MaxRetries:    3,
+			BufferSize:    10,
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		alog := audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+
+		batch := testutil.RequireRecvCtx(ctx, t, sink.batches)
+		require.Equal(t, alog.ID, batch[0].ID)
+		require.EqualValues(t, 2, sink.requests.Load())
+	})
+
+	t.Run("SpoolAndReplay", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		sink := newHTTPSink(t)
+		sink.failures.Store(1 << 30)
+		spool := t.TempDir()
+		backend, err := backends.NewHTTP(slogtest.Make(t, nil), backends.HTTPOptions{
+			Endpoint:       sink.URL,
+			BatchSize:      1,
+			FlushInterval:  testutil.IntervalFast,
+			BufferSize:     10,
+			SpoolDirectory: spool,
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		// The endpoint is unavailable, so the batch is spooled.
+		alog := audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+		require.Eventually(t, func() bool {
+			return spooled(t, spool) == 1
+		}, testutil.WaitShort, testutil.IntervalFast)
+
+		// Once the endpoint recovers, the spooled batch is replayed.
+		sink.failures.Store(0)
+		batch := testutil.RequireRecvCtx(ctx, t, sink.batches)
+		require.Equal(t, alog.ID, batch[0].ID)
+		require.Eventually(t, func() bool {
+			return spooled(t, spool) == 0
+		}, testutil.WaitShort, testutil.IntervalFast)
+	})
+
+	t.Run("Backpressure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		sink := newHTTPSink(t)
+		sink.blocking.Store(true)
+		backend, err := backends.NewHTTP(slogtest.Make(t, nil), backends.HTTPOptions{
+			Endpoint:      sink.URL,
+			BatchSize:     1,
+			FlushInterval: time.Hour,
+			BufferSize:    1,
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		// Exporting never blocks; once the request is stuck and the buffer
+		// is full, audit logs are rejected since there is no spool.
+		require.Eventually(t, func() bool {
+			err = backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{})
+			return err != nil
+		}, testutil.WaitShort, testutil.IntervalFast)
+		require.ErrorContains(t, err, "buffer is full")
+		close(sink.unblock)
+	})
+
+	t.Run("CloseFlushes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		sink := newHTTPSink(t)
+		backend, err := backends.NewHTTP(slogtest.Make(t, nil), backends.HTTPOptions{
+			Endpoint:      sink.URL,
+			BatchSize:     100,
+			FlushInterval: time.Hour,
+			BufferSize:    10,
+		})
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+		}
+		require.NoError(t, backend.(io.Closer).Close())
+
+		batch := testutil.RequireRecvCtx(ctx, t, sink.batches)
+		require.Len(t, batch, 3)
+		require.Error(t, backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+	})
+}
+
+type httpSink struct {
+	*httptest.Server
+
+	batches  chan []backends.Event
+	requests atomic.Int32
+	failures atomic.Int32
+	lastAuth atomic.Value
+	blocking atomic.Bool
+	unblock  chan struct{}
+}
+
+// newHTTPSink starts a server which receives batches, failing with a 503 for
+// as long as failures is positive.
+func newHTTPSink(t *testing.T) *httpSink {
+	t.Helper()
+
+	sink := &httpSink{batches: make(chan []backends.Event, 10), unblock: make(chan struct{})}
+	sink.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sink.requests.Add(1)
+		if sink.blocking.Load() {
+			<-sink.unblock
+		}
+		if sink.failures.Load() > 0 {
+			sink.failures.Add(-1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var batch []backends.Event
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&batch)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sink.lastAuth.Store(r.Header.Get("Authorization"))
+		sink.batches <- batch
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(sink.Close)
+	return sink
+}
+
+func spooled(t *testing.T, dir string) int {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	return len(entries)
+}
@@ -0,0 +1,285 @@
+package backends
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+const (
+	// syslogFacilityLogAudit is the "log audit" facility defined by RFC 5424.
+	syslogFacilityLogAudit = 13
+	syslogSeverityWarning  = 4
+	syslogSeverityInfo     = 6
+
+	syslogNilValue     = "-"
+	syslogTimeFormat   = "2006-01-02T15:04:05.000000Z07:00"
+	syslogWriteTimeout = 5 * time.Second
+
+	// syslogDefaultBufferSize is the default number of audit logs which can
+	// be queued in memory.
+	syslogDefaultBufferSize = 1024
+)
+
+// SyslogOptions configures the syslog backend.
+type SyslogOptions struct {
+	// Address is the host:port of the syslog server.
+	Address string
+	// Protocol is one of "udp", "tcp" or "tls".
+	Protocol string
+	// AppName is the APP-NAME of each message.
+	AppName string
+	// Hostname is the HOSTNAME of each message. Defaults to the hostname
+	// reported by the kernel.
+	Hostname string
+	// TLSConfig is used when the protocol is "tls".
+	TLSConfig *tls.Config
+	// BufferSize is the number of audit logs which can be queued in memory.
+	// Once the buffer is full, audit logs are dropped. Defaults to 1024.
+	BufferSize int
+}
+
+type syslogBackend struct {
+	log  slog.Logger
+	opts SyslogOptions
+
+	queue     chan []byte
+	closed    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// conn is only used by the run goroutine.
+	conn net.Conn
+}
+
+// NewSyslog creates a backend which streams audit logs to a syslog server
+// using the RFC 5424 format. Messages sent over TCP and TLS are framed using
+// octet counting (RFC 6587 & RFC 5425).
+//
+// Messages are queued in memory and sent by a background goroutine, so that
+// a slow or unavailable syslog server never blocks the request which produced
+// the audit log. The connection is established lazily and re-established
+// after a failed write, so the syslog server being unavailable does not
+// prevent startup.
+//
+// The returned backend implements io.Closer, which sends any queued audit
+// logs.
+func NewSyslog(logger slog.Logger, opts SyslogOptions) (audit.Backend, error) {
+	switch opts.Protocol {
+	case "udp", "tcp", "tls":
+	default:
+		return nil, xerrors.Errorf("unsupported syslog protocol %q", opts.Protocol)
+	}
+	if opts.Address == "" {
+		return nil, xerrors.New("syslog address not defined")
+	}
+	if opts.Hostname == "" {
+		opts.Hostname, _ = os.Hostname()
+	}
+	if opts.BufferSize < 0 {
+		return nil, xerrors.New("buffer size must not be negative")
+	}
+	if opts.BufferSize == 0 {
+		opts.BufferSize = syslogDefaultBufferSize
+	}
+
+	b := &syslogBackend{
+		log:    logger,
+		opts:   opts,
+		queue:  make(chan []byte, opts.BufferSize),
+		closed: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go b.run()
+	return b, nil
+}
+
+func (*syslogBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *syslogBackend) Export(_ context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	msg, err := b.format(alog, details)
+	if err != nil {
+		return xerrors.Errorf("format syslog message: %w", err)
+	}
+
+	select {
+	case <-b.closed:
+		return xerrors.New("syslog audit backend is closed")
+	default:
+	}
+
+	select {
+	case b.queue <- msg:
+		return nil
+	default:
+		// The syslog server cannot keep up, so drop the message rather than
+		// blocking the caller.
+		return xerrors.New("syslog audit backend buffer is full")
+	}
+}
+
+func (b *syslogBackend) run() {
+	defer close(b.done)
+	defer b.closeConn()
+
+	ctx := context.Background()
+	for {
+		select {
+		case <-b.closed:
+			// Send the queued messages until a write fails, since every
+			// failed write may take up to the write timeout.
+			for {
+				select {
+				case msg := <-b.queue:
+					if err := b.send(ctx, msg); err != nil {
+						b.log.Warn(ctx, "failed to send queued audit logs to syslog on close",
+							slog.F("dropped", len(b.queue)+1), slog.Error(err))
+						return
+					}
+					continue
+				default:
+				}
+				return
+			}
+		case msg := <-b.queue:
+			if err := b.send(ctx, msg); err != nil {
+				b.log.Warn(ctx, "failed to send audit log to syslog", slog.Error(err))
+			}
+		}
+	}
+}
+
+// send writes the message, retrying once on a fresh connection since the
+// server may have closed an idle connection since the last write.
+func (b *syslogBackend) send(ctx context.Context, msg []byte) error {
+	for attempt := 0; ; attempt++ {
+		err := b.write(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		b.closeConn()
+		if attempt > 0 {
+			return xerrors.Errorf("write syslog message: %w", err)
+		}
+		b.log.Debug(ctx, "syslog write failed, reconnecting", slog.Error(err))
+	}
+}
+
+func (b *syslogBackend) write(ctx context.Context, msg []byte) error {
+	if b.conn == nil {
+		conn, err := b.dial(ctx)
+		if err != nil {
+			return err
+		}
+		b.conn = conn
+	}
+
+	if b.opts.Protocol != "udp" {
+		msg = append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+	}
+
+	err := b.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+	if err != nil {
+		return xerrors.Errorf("set write deadline: %w", err)
+	}
+	_, err = b.conn.Write(msg)
+	return err
+}
+
+func (b *syslogBackend) dial(ctx context.Context) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, syslogWriteTimeout)
+	defer cancel()
+
+	if b.opts.Protocol == "tls" {
+		dialer := &tls.Dialer{Config: b.opts.TLSConfig}
+		conn, err := dialer.DialContext(ctx, "tcp", b.opts.Address)
+		if err != nil {
+			return nil, xerrors.Errorf("dial syslog server: %w", err)
+		}
+		return conn, nil
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, b.opts.Protocol, b.opts.Address)
+	if err != nil {
+		return nil, xerrors.Errorf("dial syslog server: %w", err)
+	}
+	return conn, nil
+}
+
+func (b *syslogBackend) closeConn() {
+	if b.conn != nil {
+		_ = b.conn.Close()
+		b.conn = nil
+	}
+}
+
+// Close stops accepting audit logs, sends any queued audit logs and closes
+// the connection to the syslog server.
+func (b *syslogBackend) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+	})
+	<-b.done
+	return nil
+}
+
+// format renders the audit log as an RFC 5424 message:
+//
+//	<PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+//
+// The MSGID is the audit action, and the MSG is the JSON-encoded Event.
+func (b *syslogBackend) format(alog database.AuditLog, details audit.BackendDetails) ([]byte, error) {
+	body, err := json.Marshal(newEvent(alog, details))
+	if err != nil {
+		return nil, err
+	}
+
+	severity := syslogSeverityInfo
+	if alog.StatusCode >= 400 {
+		severity = syslogSeverityWarning
+	}
+
+	header := fmt.Sprintf("<%d>1 %s %s %s %s %s %s ",
+		syslogFacilityLogAudit*8+severity,
+		alog.Time.UTC().Format(syslogTimeFormat),
+		syslogHeaderField(b.opts.Hostname, 255),
+		syslogHeaderField(b.opts.AppName, 48),
+		syslogHeaderField(fmt.Sprint(os.Getpid()), 128),
+		syslogHeaderField(string(alog.Action), 32),
+		syslogNilValue,
+	)
+	return append([]byte(header), body...), nil
+}
+
+// syslogHeaderField sanitizes a header field, which may only contain
+// printable US-ASCII characters (excluding spaces) and is length-limited.
+func syslogHeaderField(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, s)
+	if s == "" {
+		return syslogNilValue
+	}
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+	return s
+}
@@ -0,0 +1,240 @@
+package backends_test
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+	"github.com/coder/coder/v2/testutil"
+)
+
+// syslogHeader matches an RFC 5424 header with the "log audit" facility.
+var syslogHeader = regexp.MustCompile(`^<(\d+)>1 \S+ test-host coder \d+ (\S+) - `)
+
+func TestSyslogBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("UDP", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+
+		backend, err := backends.NewSyslog(slogtest.Make(t, nil), backends.SyslogOptions{
+			Address:  conn.LocalAddr().String(),
+			Protocol: "udp",
+			AppName:  "coder",
+			Hostname: "test-host",
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		alog := audittest.RandomLog()
+		err = backend.Export(ctx, alog, audit.BackendDetails{})
+		require.NoError(t, err)
+
+		buf := make([]byte, 64<<10)
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		requireSyslogMessage(t, string(buf[:n]), alog.ID, "110")
+	})
+
+	t.Run("TCP", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer ln.Close()
+		msgs := acceptSyslog(t, ln)
+
+		backend, err := backends.NewSyslog(slogtest.Make(t, nil), backends.SyslogOptions{
+			Address:  ln.Addr().String(),
+			Protocol: "tcp",
+			AppName:  "coder",
+			Hostname: "test-host",
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		// A failed request is logged with a higher severity.
+		first, second := audittest.RandomLog(), audittest.RandomLog()
+		second.StatusCode = http.StatusForbidden
+		require.NoError(t, backend.Export(ctx, first, audit.BackendDetails{}))
+		require.NoError(t, backend.Export(ctx, second, audit.BackendDetails{}))
+
+		requireSyslogMessage(t, testutil.RequireRecvCtx(ctx, t, msgs), first.ID, "110")
+		requireSyslogMessage(t, testutil.RequireRecvCtx(ctx, t, msgs), second.ID, "108")
+	})
+
+	t.Run("TLS", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		// Borrow the test certificate from httptest.
+		srv := httptest.NewUnstartedServer(nil)
+		srv.StartTLS()
+		srv.Close()
+		ln, err := tls.Listen("tcp", "127.0.0.1:0", srv.TLS)
+		require.NoError(t, err)
+		defer ln.Close()
+		msgs := acceptSyslog(t, ln)
+
+		backend, err := backends.NewSyslog(slogtest.Make(t, nil), backends.SyslogOptions{
+			Address:  ln.Addr().String(),
+			Protocol: "tls",
+			AppName:  "coder",
+			Hostname: "test-host",
+			TLSConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				RootCAs:    srv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs,
+				ServerName: "example.com",
+			},
+		})
+		require.NoError(t, err)
+		defer backend.(io.Closer).Close()
+
+		alog := audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+		requireSyslogMessage(t, testutil.RequireRecvCtx(ctx, t, msgs), alog.ID, "110")
+	})
+
+	t.Run("UnresponsiveServer", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		// The server accepts connections but never completes the TLS
+		// handshake, so every dial blocks until it times out.
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer ln.Close()
+		conns := make(chan net.Conn, 10)
+		go func() {
+			for {
+				conn, err := ln.Accept()
+				if err != nil {
+					return
+				}
+				conns <- conn
+			}
+		}()
+
+		backend, err := backends.NewSyslog(slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}), backends.SyslogOptions{
+			Address:    ln.Addr().String(),
+			Protocol:   "tls",
+			AppName:    "coder",
+			Hostname:   "test-host",
+			BufferSize: 1,
+			TLSConfig:  &tls.Config{MinVersion: tls.VersionTLS12, ServerName: "example.com"},
+		})
+		require.NoError(t, err)
+
+		// The first audit log is taken off the buffer and blocks the
+		// backend in the TLS handshake.
+		require.NoError(t, backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+		conn := testutil.RequireRecvCtx(ctx, t, conns)
+
+		// Exports never wait for the server. Once the buffer is full, audit
+		// logs are dropped.
+		exported := make(chan error, 1)
+		go func() {
+			err := backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{})
+			if err == nil {
+				err = backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{})
+			}
+			exported <- err
+		}()
+		require.ErrorContains(t, testutil.RequireRecvCtx(ctx, t, exported), "buffer is full")
+
+		// Stop the server so that writes fail fast. Closing gives up on the
+		// remaining audit logs after a failed write.
+		_ = ln.Close()
+		_ = conn.Close()
+	drain:
+		for {
+			select {
+			case conn := <-conns:
+				_ = conn.Close()
+			default:
+				break drain
+			}
+		}
+		require.NoError(t, backend.(io.Closer).Close())
+		err = backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{})
+		require.ErrorContains(t, err, "closed")
+	})
+
+	t.Run("InvalidProtocol", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := backends.NewSyslog(slogtest.Make(t, nil), backends.SyslogOptions{
+			Address:  "127.0.0.1:514",
+			Protocol: "http",
+		})
+		require.ErrorContains(t, err, "unsupported syslog protocol")
+	})
+}
+
+// acceptSyslog accepts a single connection and decodes octet-counted frames.
+func acceptSyslog(t *testing.T, ln net.Listener) <-chan string {
+	t.Helper()
+
+	msgs := make(chan string, 10)
+	go func() {
+		conn, err := ln.Accept()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		for {
+			length, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			if !assert.NoError(t, err) {
+				return
+			}
+			msg := make([]byte, n)
+			if _, err := io.ReadFull(r, msg); !assert.NoError(t, err) {
+				return
+			}
+			msgs <- string(msg)
+		}
+	}()
+	return msgs
+}
+
+func requireSyslogMessage(t *testing.T, msg string, id uuid.UUID, pri string) {
+	t.Helper()
+
+	match := syslogHeader.FindStringSubmatch(msg)
+	require.NotNil(t, match, "invalid syslog message: %s", msg)
+	require.Equal(t, pri, match[1])
+	require.Equal(t, "delete", match[2])
+
+	var ev backends.Event
+	require.NoError(t, json.Unmarshal([]byte(msg[len(match[0]):]), &ev))
+	require.Equal(t, id, ev.ID)
+	require.Equal(t, "127.0.0.1", ev.IP)
+}
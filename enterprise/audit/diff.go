@@ -151,6 +151,8 @@ func convertDiffType(left, right any) (newLeft, newRight any, changed bool) {
 		return vl, vr, true
 	case database.TemplateACL:
 		return fmt.Sprintf("%+v", left), fmt.Sprintf("%+v", right), true
+	case database.WorkspaceACL:
+		return fmt.Sprintf("%+v", left), fmt.Sprintf("%+v", right), true
 	case database.CustomRolePermissions:
 		// String representation is much easier to visually inspect
 		leftArr := make([]string, 0)
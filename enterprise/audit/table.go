@@ -16,15 +16,17 @@ import (
 // AuditableResources map (below) as our documentation - generated in scripts/auditdocgen/main.go -
 // depends upon it.
 var AuditActionMap = map[string][]codersdk.AuditAction{
-	"GitSSHKey":       {codersdk.AuditActionCreate},
-	"Template":        {codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"TemplateVersion": {codersdk.AuditActionCreate, codersdk.AuditActionWrite},
-	"User":            {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"Workspace":       {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"WorkspaceBuild":  {codersdk.AuditActionStart, codersdk.AuditActionStop},
-	"Group":           {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"APIKey":          {codersdk.AuditActionLogin, codersdk.AuditActionLogout, codersdk.AuditActionRegister, codersdk.AuditActionCreate, codersdk.AuditActionDelete},
-	"License":         {codersdk.AuditActionCreate, codersdk.AuditActionDelete},
+	"GitSSHKey":              {codersdk.AuditActionCreate},
+	"Template":               {codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"TemplateVersion":        {codersdk.AuditActionCreate, codersdk.AuditActionWrite},
+	"User":                   {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"Workspace":              {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"WorkspaceBuild":         {codersdk.AuditActionStart, codersdk.AuditActionStop},
+	"Group":                  {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"APIKey":                 {codersdk.AuditActionLogin, codersdk.AuditActionLogout, codersdk.AuditActionRegister, codersdk.AuditActionCreate, codersdk.AuditActionDelete},
+	"License":                {codersdk.AuditActionCreate, codersdk.AuditActionDelete},
+	"WorkspaceBulkOperation": {codersdk.AuditActionCreate},
+	"TemplateVersionRollout": {codersdk.AuditActionCreate, codersdk.AuditActionWrite},
 }
 
 type Action string
@@ -111,6 +113,8 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"deprecated":                        ActionTrack,
 		"max_port_sharing_level":            ActionTrack,
 		"activity_bump":                     ActionTrack,
+		"idle_detection_enabled":            ActionTrack,
+		"idle_cpu_threshold_percent":        ActionTrack,
 	},
 	&database.TemplateVersion{}: {
 		"id":                      ActionTrack,
@@ -162,6 +166,8 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"deleting_at":        ActionTrack,
 		"automatic_updates":  ActionTrack,
 		"favorite":           ActionTrack,
+		"user_acl":           ActionTrack,
+		"group_acl":          ActionTrack,
 	},
 	&database.WorkspaceBuild{}: {
 		"id":                      ActionIgnore,
@@ -282,6 +288,26 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"method":         ActionTrack,
 		"kind":           ActionTrack,
 	},
+	&database.WorkspaceBulkOperation{}: {
+		"id":              ActionIgnore,
+		"initiator_id":    ActionTrack,
+		"initiator_scope": ActionTrack,
+		"action":          ActionTrack,
+		"search":          ActionTrack,
+		"created_at":      ActionIgnore,
+		"completed_at":    ActionIgnore, // Set in the background once all builds are enqueued.
+	},
+	&database.TemplateVersionRollout{}: {
+		"id":                  ActionIgnore,
+		"template_id":         ActionTrack,
+		"template_version_id": ActionTrack,
+		"status":              ActionTrack,
+		"percent":             ActionTrack,
+		"group_id":            ActionTrack,
+		"created_by":          ActionTrack,
+		"created_at":          ActionIgnore,
+		"updated_at":          ActionIgnore,
+	},
 }
 
 // auditMap converts a map of struct pointers to a map of struct names as
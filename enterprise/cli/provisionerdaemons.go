@@ -13,6 +13,7 @@ func (r *RootCmd) provisionerDaemons() *serpent.Command {
 		Children: []*serpent.Command{
 			r.provisionerDaemonStart(),
 			r.provisionerKeys(),
+			r.provisionerMirror(),
 		},
 	}
 
@@ -50,6 +50,10 @@ func (r *RootCmd) provisionerDaemonStart() *serpent.Command {
 		provisionerKey string
 		verbose        bool
 
+		terraformFlavor         string
+		terraformInstallMirror  string
+		terraformProviderMirror string
+
 		prometheusEnable  bool
 		prometheusAddress string
 	)
@@ -190,7 +194,10 @@ func (r *RootCmd) provisionerDaemonStart() *serpent.Command {
 						Logger:        logger.Named("terraform"),
 						WorkDirectory: tempDir,
 					},
-					CachePath: cacheDir,
+					CachePath:      cacheDir,
+					Flavor:         terraform.Flavor(terraformFlavor),
+					InstallMirror:  terraformInstallMirror,
+					ProviderMirror: terraformProviderMirror,
 				})
 				if err != nil && !xerrors.Is(err, context.Canceled) {
 					select {
@@ -331,6 +338,25 @@ func (r *RootCmd) provisionerDaemonStart() *serpent.Command {
 			Value:       serpent.StringOf(&name),
 			Default:     "",
 		},
+		{
+			Flag:        "terraform-flavor",
+			Env:         "CODER_PROVISIONER_DAEMON_TERRAFORM_FLAVOR",
+			Description: "The distribution of the Terraform CLI to run. Set to opentofu to run OpenTofu instead of Terraform.",
+			Value:       serpent.EnumOf(&terraformFlavor, string(terraform.FlavorTerraform), string(terraform.FlavorOpenTofu)),
+			Default:     string(terraform.FlavorTerraform),
+		},
+		{
+			Flag:        "terraform-install-mirror",
+			Env:         "CODER_PROVISIONER_DAEMON_TERRAFORM_INSTALL_MIRROR",
+			Description: "URL or local directory to install the Terraform CLI from if it is not available on the system.",
+			Value:       serpent.StringOf(&terraformInstallMirror),
+		},
+		{
+			Flag:        "terraform-provider-mirror",
+			Env:         "CODER_PROVISIONER_DAEMON_TERRAFORM_PROVIDER_MIRROR",
+			Description: "Local directory to install providers from instead of their registries. Populate it with `coder provisioner mirror`.",
+			Value:       serpent.StringOf(&terraformProviderMirror),
+		},
 		{
 			Flag:        "verbose",
 			Env:         "CODER_PROVISIONER_DAEMON_VERBOSE",
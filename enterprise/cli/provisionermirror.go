@@ -0,0 +1,84 @@
+//go:build !slim
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	agpl "github.com/coder/coder/v2/cli"
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/provisioner/terraform"
+	"github.com/coder/pretty"
+	"github.com/coder/serpent"
+)
+
+func (*RootCmd) provisionerMirror() *serpent.Command {
+	var (
+		directory string
+		platforms []string
+	)
+	cmd := &serpent.Command{
+		Use:   "mirror <mirror-directory>",
+		Short: "Populate a filesystem provider mirror from the dependency lock file of a template",
+		Long: "Provisioners install providers from the mirror instead of their registries when it is configured with " +
+			"--terraform-provider-mirror, so that templates can be built without network access.\n" +
+			agpl.FormatExamples(
+				agpl.Example{
+					Description: "Mirror the providers of the template in the current directory",
+					Command:     "coder provisioner mirror /opt/providers --platform linux_amd64",
+				},
+			),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			lockFilePath := filepath.Join(directory, terraform.LockFileName)
+			lockFile, err := os.ReadFile(lockFilePath)
+			if err != nil {
+				return xerrors.Errorf("read lock file: %w", err)
+			}
+
+			mirrored, err := terraform.MirrorProviders(inv.Context(), slog.Make(), terraform.MirrorOptions{
+				LockFile:  lockFile,
+				Dir:       inv.Args[0],
+				Platforms: platforms,
+			})
+			if err != nil {
+				return xerrors.Errorf("mirror providers from %s: %w", lockFilePath, err)
+			}
+
+			for _, mp := range mirrored {
+				status := "Mirrored"
+				if mp.Cached {
+					status = "Already mirrored"
+				}
+				_, _ = fmt.Fprintf(inv.Stdout, "%s %s v%s (%s)\n",
+					status, pretty.Sprint(cliui.DefaultStyles.Keyword, mp.Address), mp.Version, mp.Platform)
+			}
+			return nil
+		},
+	}
+
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:          "directory",
+			FlagShorthand: "d",
+			Description:   "Directory of the template, which must contain a " + terraform.LockFileName + " file.",
+			Default:       ".",
+			Value:         serpent.StringOf(&directory),
+		},
+		{
+			Flag:        "platform",
+			Env:         "CODER_PROVISIONER_MIRROR_PLATFORMS",
+			Description: "Platforms to mirror providers for, in the form os_arch. Defaults to the current platform.",
+			Value:       serpent.StringArrayOf(&platforms),
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+//go:build slim
+
+package cli
+
+import (
+	agplcli "github.com/coder/coder/v2/cli"
+	"github.com/coder/serpent"
+)
+
+func (*RootCmd) provisionerMirror() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "mirror",
+		Short: "Populate a filesystem provider mirror from the dependency lock file of a template",
+		// We accept RawArgs so all commands and flags are accepted.
+		RawArgs: true,
+		Hidden:  true,
+		Handler: func(inv *serpent.Invocation) error {
+			agplcli.SlimUnsupported(inv.Stderr, "provisionerd mirror")
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cli_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/pty/ptytest"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestProvisionerMirror(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NoLockFile", func(t *testing.T) {
+		t.Parallel()
+
+		inv, _ := newCLI(t, "provisionerd", "mirror", t.TempDir(), "--directory", t.TempDir())
+		err := inv.Run()
+		require.ErrorContains(t, err, "read lock file")
+	})
+
+	t.Run("AlreadyMirrored", func(t *testing.T) {
+		t.Parallel()
+
+		// Packages in the mirror that match the lock file aren't downloaded
+		// again, so this doesn't need network access.
+		mirror := t.TempDir()
+		pkg := []byte("fake provider package")
+		pkgDir := filepath.Join(mirror, "registry.terraform.io", "coder", "coder")
+		require.NoError(t, os.MkdirAll(pkgDir, 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(pkgDir, "terraform-provider-coder_0.23.0_linux_amd64.zip"), pkg, 0o600))
+
+		sum := sha256.Sum256(pkg)
+		templateDir := t.TempDir()
+		lockFile := fmt.Sprintf(`provider "registry.terraform.io/coder/coder" {
+  version     = "0.23.0"
+  constraints = "~> 0.23.0"
+  hashes = [
+    "zh:%s",
+  ]
+}
+`, hex.EncodeToString(sum[:]))
+		require.NoError(t, os.WriteFile(filepath.Join(templateDir, ".terraform.lock.hcl"), []byte(lockFile), 0o600))
+
+		inv, _ := newCLI(t, "provisionerd", "mirror", mirror, "--directory", templateDir, "--platform", "linux_amd64")
+		pty := ptytest.New(t).Attach(inv)
+		ctx := testutil.Context(t, testutil.WaitShort)
+		errC := make(chan error, 1)
+		go func() {
+			errC <- inv.WithContext(ctx).Run()
+		}()
+		pty.ExpectMatch("Already mirrored registry.terraform.io/coder/coder v0.23.0 (linux_amd64)")
+		require.NoError(t, <-errC)
+	})
+}
@@ -4,17 +4,26 @@ package cli
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"encoding/base64"
 	"errors"
 	"io"
+	"net"
+	"net/http"
 	"net/url"
+	"os"
+	"strings"
 
 	"golang.org/x/xerrors"
 	"tailscale.com/derp"
 	"tailscale.com/types/key"
 
+	"cdr.dev/slog"
+
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/cryptorand"
 	"github.com/coder/coder/v2/enterprise/audit"
 	"github.com/coder/coder/v2/enterprise/audit/backends"
@@ -74,11 +83,17 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 			options.DERPServer.SetMeshKey(meshKey)
 		}
 
+		streamingBackends, closeAuditBackends, err := auditStreamingBackends(options.Logger, options.DeploymentValues.AuditLogging)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("configure audit logging: %w", err)
+		}
 		options.Auditor = audit.NewAuditor(
 			options.Database,
 			audit.DefaultFilter,
-			backends.NewPostgres(options.Database, true),
-			backends.NewSlog(options.Logger),
+			append([]audit.Backend{
+				backends.NewPostgres(options.Database, true),
+				backends.NewSlog(options.Logger),
+			}, streamingBackends...)...,
 		)
 
 		options.TrialGenerator = trialer.New(options.Database, "https://v2-licensor.coder.com/trial", coderd.Keys)
@@ -96,6 +111,7 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 			ProvisionerDaemonPSK:      options.DeploymentValues.Provisioner.DaemonPSK.Value(),
 
 			CheckInactiveUsersCancelFunc: dormancy.CheckInactiveUsers(ctx, options.Logger, options.Database),
+			CloseAuditBackendsFunc:       closeAuditBackends,
 		}
 
 		if encKeys := options.DeploymentValues.ExternalTokenEncryptionKeys.Value(); len(encKeys) != 0 {
@@ -126,3 +142,89 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 	)
 	return cmd
 }
+
+// auditStreamingBackends creates the configured audit backends which stream
+// audit logs to external systems. The returned function closes them.
+func auditStreamingBackends(logger slog.Logger, cfg codersdk.AuditLoggingConfig) ([]audit.Backend, func(), error) {
+	var (
+		streaming []audit.Backend
+		closers   []io.Closer
+	)
+	closeAll := func() {
+		for _, c := range closers {
+			_ = c.Close()
+		}
+	}
+	add := func(b audit.Backend) {
+		streaming = append(streaming, b)
+		if c, ok := b.(io.Closer); ok {
+			closers = append(closers, c)
+		}
+	}
+
+	if addr := cfg.Syslog.Address.String(); addr != "" {
+		var tlsConfig *tls.Config
+		if cfg.Syslog.Protocol == "tls" {
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, nil, xerrors.Errorf("parse syslog address: %w", err)
+			}
+			tlsConfig = &tls.Config{
+				MinVersion:         tls.VersionTLS12,
+				ServerName:         host,
+				InsecureSkipVerify: cfg.Syslog.InsecureSkipVerify.Value(), //nolint:gosec // Explicitly configured by the operator.
+			}
+			if caFile := cfg.Syslog.CAFile.String(); caFile != "" {
+				caPEM, err := os.ReadFile(caFile)
+				if err != nil {
+					return nil, nil, xerrors.Errorf("read syslog CA file: %w", err)
+				}
+				tlsConfig.RootCAs = x509.NewCertPool()
+				if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+					return nil, nil, xerrors.Errorf("no certificates found in syslog CA file %q", caFile)
+				}
+			}
+		}
+
+		b, err := backends.NewSyslog(logger.Named("audit.syslog"), backends.SyslogOptions{
+			Address:   addr,
+			Protocol:  cfg.Syslog.Protocol,
+			AppName:   cfg.Syslog.AppName.String(),
+			TLSConfig: tlsConfig,
+		})
+		if err != nil {
+			return nil, nil, xerrors.Errorf("create syslog audit backend: %w", err)
+		}
+		add(b)
+	}
+
+	if endpoint := cfg.HTTP.Endpoint.String(); endpoint != "" {
+		headers := http.Header{}
+		for i, h := range cfg.HTTP.Headers.Value() {
+			name, value, ok := strings.Cut(h, "=")
+			if !ok || strings.TrimSpace(name) == "" {
+				closeAll()
+				// The value is omitted since headers usually contain credentials.
+				return nil, nil, xerrors.Errorf("invalid audit logging HTTP header at index %d, must be in the form 'Name=value'", i)
+			}
+			headers.Add(strings.TrimSpace(name), value)
+		}
+
+		b, err := backends.NewHTTP(logger.Named("audit.http"), backends.HTTPOptions{
+			Endpoint:       endpoint,
+			Headers:        headers,
+			BatchSize:      int(cfg.HTTP.BatchSize.Value()),
+			FlushInterval:  cfg.HTTP.FlushInterval.Value(),
+			MaxRetries:     int(cfg.HTTP.MaxRetries.Value()),
+			BufferSize:     int(cfg.HTTP.BufferSize.Value()),
+			SpoolDirectory: cfg.HTTP.SpoolDirectory.String(),
+		})
+		if err != nil {
+			closeAll()
+			return nil, nil, xerrors.Errorf("create HTTP audit backend: %w", err)
+		}
+		add(b)
+	}
+
+	return streaming, closeAll, nil
+}
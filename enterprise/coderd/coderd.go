@@ -17,6 +17,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/entitlements"
 	"github.com/coder/coder/v2/coderd/idpsync"
+	"github.com/coder/coder/v2/coderd/maintenance"
 	agplportsharing "github.com/coder/coder/v2/coderd/portsharing"
 	"github.com/coder/coder/v2/coderd/rbac/policy"
 	"github.com/coder/coder/v2/enterprise/coderd/enidpsync"
@@ -562,6 +563,9 @@ type Options struct {
 	ProvisionerDaemonPSK string
 
 	CheckInactiveUsersCancelFunc func()
+	// CloseAuditBackendsFunc flushes and closes any audit backends which
+	// stream audit logs to external systems.
+	CloseAuditBackendsFunc func()
 }
 
 type API struct {
@@ -614,7 +618,13 @@ func (api *API) Close() error {
 	if api.Options.CheckInactiveUsersCancelFunc != nil {
 		api.Options.CheckInactiveUsersCancelFunc()
 	}
-	return api.AGPL.Close()
+	err := api.AGPL.Close()
+	// Audit backends are closed last so that audit logs produced while
+	// shutting down are still delivered.
+	if api.Options.CloseAuditBackendsFunc != nil {
+		api.Options.CloseAuditBackendsFunc()
+	}
+	return err
 }
 
 func (api *API) updateEntitlements(ctx context.Context) error {
@@ -805,15 +815,15 @@ func (api *API) updateEntitlements(ctx context.Context) error {
 
 	if initial, changed, enabled := featureChanged(codersdk.FeatureAppearance); shouldUpdate(initial, changed, enabled) {
 		if enabled {
-			f := newAppearanceFetcher(
+			f := maintenance.NewAppearanceFetcher(newAppearanceFetcher(
 				api.Database,
 				api.DeploymentValues.Support.Links.Value,
 				api.DeploymentValues.DocsURL.String(),
 				buildinfo.Version(),
-			)
+			), api.Database)
 			api.AGPL.AppearanceFetcher.Store(&f)
 		} else {
-			f := appearance.NewDefaultFetcher(api.DeploymentValues.DocsURL.String())
+			f := maintenance.NewAppearanceFetcher(appearance.NewDefaultFetcher(api.DeploymentValues.DocsURL.String()), api.Database)
 			api.AGPL.AppearanceFetcher.Store(&f)
 		}
 	}
@@ -85,6 +85,10 @@ func (*EnterpriseTemplateScheduleStore) Get(ctx context.Context, db database.Sto
 		UserAutostopEnabled:  tpl.AllowUserAutostop,
 		DefaultTTL:           time.Duration(tpl.DefaultTTL),
 		ActivityBump:         time.Duration(tpl.ActivityBump),
+		IdlePolicy: agpl.TemplateIdlePolicy{
+			Enabled:             tpl.IdleDetectionEnabled,
+			CPUThresholdPercent: tpl.IdleCPUThresholdPercent,
+		},
 		AutostopRequirement: agpl.TemplateAutostopRequirement{
 			DaysOfWeek: uint8(tpl.AutostopRequirementDaysOfWeek),
 			Weeks:      tpl.AutostopRequirementWeeks,
@@ -112,6 +116,8 @@ func (s *EnterpriseTemplateScheduleStore) Set(ctx context.Context, db database.S
 
 	if int64(opts.DefaultTTL) == tpl.DefaultTTL &&
 		int64(opts.ActivityBump) == tpl.ActivityBump &&
+		opts.IdlePolicy.Enabled == tpl.IdleDetectionEnabled &&
+		opts.IdlePolicy.CPUThresholdPercent == tpl.IdleCPUThresholdPercent &&
 		int16(opts.AutostopRequirement.DaysOfWeek) == tpl.AutostopRequirementDaysOfWeek &&
 		opts.AutostartRequirement.DaysOfWeek == tpl.AutostartAllowedDays() &&
 		opts.AutostopRequirement.Weeks == tpl.AutostopRequirementWeeks &&
@@ -134,6 +140,11 @@ func (s *EnterpriseTemplateScheduleStore) Set(ctx context.Context, db database.S
 		return database.Template{}, xerrors.Errorf("verify autostart requirement: %w", err)
 	}
 
+	err = agpl.VerifyTemplateIdlePolicy(opts.IdlePolicy)
+	if err != nil {
+		return database.Template{}, xerrors.Errorf("verify idle policy: %w", err)
+	}
+
 	var (
 		template          database.Template
 		markedForDeletion []database.Workspace
@@ -157,6 +168,8 @@ func (s *EnterpriseTemplateScheduleStore) Set(ctx context.Context, db database.S
 			FailureTTL:               int64(opts.FailureTTL),
 			TimeTilDormant:           int64(opts.TimeTilDormant),
 			TimeTilDormantAutoDelete: int64(opts.TimeTilDormantAutoDelete),
+			IdleDetectionEnabled:     opts.IdlePolicy.Enabled,
+			IdleCPUThresholdPercent:  opts.IdlePolicy.CPUThresholdPercent,
 		})
 		if err != nil {
 			return xerrors.Errorf("update template schedule: %w", err)
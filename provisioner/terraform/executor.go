@@ -33,10 +33,15 @@ type executor struct {
 	logger     slog.Logger
 	server     *server
 	mut        *sync.Mutex
+	flavor     Flavor
 	binaryPath string
-	// cachePath and workdir must not be used by multiple processes at once.
+	// cachePath is the plugin cache, which may be shared with other
+	// provisioners. It must only be written to while holding its lock.
 	cachePath string
-	workdir   string
+	// cliConfigPath is the Terraform CLI configuration to use, if any.
+	cliConfigPath string
+	// workdir must not be used by multiple processes at once.
+	workdir string
 	// used to capture execution times at various stages
 	timings *timingAggregator
 }
@@ -50,6 +55,9 @@ func (e *executor) basicEnv() []string {
 	if e.cachePath != "" && runtime.GOOS == "linux" {
 		env = append(env, "TF_PLUGIN_CACHE_DIR="+e.cachePath)
 	}
+	if e.cliConfigPath != "" {
+		env = append(env, "TF_CLI_CONFIG_FILE="+e.cliConfigPath)
+	}
 	return env
 }
 
@@ -160,11 +168,13 @@ func (e *executor) checkMinVersion(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	if !v.GreaterThanOrEqual(minTerraformVersion) {
+	minVersion, _ := e.flavor.versionConstraints()
+	if !v.GreaterThanOrEqual(minVersion) {
 		return xerrors.Errorf(
-			"terraform version %q is too old. required >= %q",
+			"%s version %q is too old. required >= %q",
+			e.flavor.command(),
 			v.String(),
-			minTerraformVersion.String())
+			minVersion.String())
 	}
 	return nil
 }
@@ -234,7 +244,7 @@ func getStateFilePath(workdir string) string {
 }
 
 // revive:disable-next-line:flag-parameter
-func (e *executor) plan(ctx, killCtx context.Context, env, vars []string, logr logSink, destroy bool) (*proto.PlanComplete, error) {
+func (e *executor) plan(ctx, killCtx context.Context, env, vars []string, logr logSink, destroy, refreshOnly bool) (*proto.PlanComplete, error) {
 	ctx, span := e.server.startTrace(ctx, tracing.FuncName())
 	defer span.End()
 
@@ -253,6 +263,9 @@ func (e *executor) plan(ctx, killCtx context.Context, env, vars []string, logr l
 	if destroy {
 		args = append(args, "-destroy")
 	}
+	if refreshOnly {
+		args = append(args, "-refresh-only")
+	}
 	for _, variable := range vars {
 		args = append(args, "-var", variable)
 	}
@@ -275,7 +288,7 @@ func (e *executor) plan(ctx, killCtx context.Context, env, vars []string, logr l
 	graphTimings := newTimingAggregator(database.ProvisionerJobTimingStageGraph)
 	graphTimings.ingest(createGraphTimingsEvent(timingGraphStart))
 
-	state, err := e.planResources(ctx, killCtx, planfilePath)
+	state, err := e.planResources(ctx, killCtx, planfilePath, refreshOnly)
 	if err != nil {
 		graphTimings.ingest(createGraphTimingsEvent(timingGraphErrored))
 		return nil, err
@@ -288,6 +301,7 @@ func (e *executor) plan(ctx, killCtx context.Context, env, vars []string, logr l
 		Resources:             state.Resources,
 		ExternalAuthProviders: state.ExternalAuthProviders,
 		Timings:               append(e.timings.aggregate(), graphTimings.aggregate()...),
+		ResourceChanges:       state.ResourceChanges,
 	}, nil
 }
 
@@ -309,7 +323,7 @@ func onlyDataResources(sm tfjson.StateModule) tfjson.StateModule {
 }
 
 // planResources must only be called while the lock is held.
-func (e *executor) planResources(ctx, killCtx context.Context, planfilePath string) (*State, error) {
+func (e *executor) planResources(ctx, killCtx context.Context, planfilePath string, refreshOnly bool) (*State, error) {
 	ctx, span := e.server.startTrace(ctx, tracing.FuncName())
 	defer span.End()
 
@@ -340,9 +354,51 @@ func (e *executor) planResources(ctx, killCtx context.Context, planfilePath stri
 	if err != nil {
 		return nil, err
 	}
+	changes := plan.ResourceChanges
+	if refreshOnly {
+		// A refresh-only plan proposes no changes. The differences between
+		// the remote objects and the state are reported as drift instead.
+		changes = plan.ResourceDrift
+	}
+	state.ResourceChanges = convertResourceChanges(changes)
 	return state, nil
 }
 
+// convertResourceChanges summarizes the managed resource changes in a plan.
+// Data sources are read on every plan and resources without changes are
+// omitted, so an empty result means the apply won't touch the
+// infrastructure.
+func convertResourceChanges(changes []*tfjson.ResourceChange) []*proto.ResourceChange {
+	converted := make([]*proto.ResourceChange, 0, len(changes))
+	for _, rc := range changes {
+		if rc == nil || rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+		var action proto.ResourceChange_Action
+		actions := rc.Change.Actions
+		switch {
+		case actions.Replace():
+			action = proto.ResourceChange_REPLACE
+		case actions.Create():
+			action = proto.ResourceChange_CREATE
+		case actions.Update():
+			action = proto.ResourceChange_UPDATE
+		case actions.Delete():
+			action = proto.ResourceChange_DESTROY
+		default:
+			// No-op and read actions don't change anything.
+			continue
+		}
+		converted = append(converted, &proto.ResourceChange{
+			Address: rc.Address,
+			Type:    rc.Type,
+			Name:    rc.Name,
+			Action:  action,
+		})
+	}
+	return converted
+}
+
 // showPlan must only be called while the lock is held.
 func (e *executor) showPlan(ctx, killCtx context.Context, planfilePath string) (*tfjson.Plan, error) {
 	ctx, span := e.server.startTrace(ctx, tracing.FuncName())
@@ -368,7 +424,9 @@ func (e *executor) graph(ctx, killCtx context.Context) (string, error) {
 		return "", err
 	}
 	args := []string{"graph"}
-	if ver.GreaterThanOrEqual(version170) {
+	// OpenTofu versions are not comparable to Terraform versions, but all
+	// of them support -type.
+	if e.flavor.orDefault() == FlavorOpenTofu || ver.GreaterThanOrEqual(version170) {
 		args = append(args, "-type=plan")
 	}
 	var out strings.Builder
@@ -643,7 +701,7 @@ func extractTimingSpan(log *terraformProvisionLog) (time.Time, *timingSpan, erro
 		return time.Time{}, nil, xerrors.Errorf("unexpected timing kind: %q", log.Type)
 	}
 
-	ts, err := time.Parse("2006-01-02T15:04:05.000000Z07:00", log.Timestamp)
+	ts, err := parseLogTimestamp(log.Timestamp)
 	if err != nil {
 		// TODO: log
 		ts = time.Now()
@@ -657,6 +715,17 @@ func extractTimingSpan(log *terraformProvisionLog) (time.Time, *timingSpan, erro
 	}, nil
 }
 
+// parseLogTimestamp parses the timestamp of a JSON log line. Terraform and
+// OpenTofu log most lines with microseconds, but some (e.g. init_output) are
+// only logged with seconds.
+func parseLogTimestamp(timestamp string) (time.Time, error) {
+	ts, err := time.Parse("2006-01-02T15:04:05.000000Z07:00", timestamp)
+	if err != nil {
+		return time.Parse(time.RFC3339Nano, timestamp)
+	}
+	return ts, nil
+}
+
 func convertTerraformLogLevel(logLevel string, sink logSink) proto.LogLevel {
 	switch strings.ToLower(logLevel) {
 	case "trace":
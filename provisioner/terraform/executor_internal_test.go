@@ -2,6 +2,7 @@ package terraform
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	tfjson "github.com/hashicorp/terraform-json"
@@ -173,3 +174,34 @@ func TestOnlyDataResources(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertResourceChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(mode tfjson.ResourceMode, address string, actions ...tfjson.Action) *tfjson.ResourceChange {
+		typ, name, _ := strings.Cut(address, ".")
+		return &tfjson.ResourceChange{
+			Address: address,
+			Mode:    mode,
+			Type:    typ,
+			Name:    name,
+			Change:  &tfjson.Change{Actions: actions},
+		}
+	}
+
+	got := convertResourceChanges([]*tfjson.ResourceChange{
+		change(tfjson.ManagedResourceMode, "docker_volume.home", tfjson.ActionNoop),
+		change(tfjson.ManagedResourceMode, "docker_container.workspace", tfjson.ActionCreate),
+		change(tfjson.ManagedResourceMode, "coder_agent.main", tfjson.ActionUpdate),
+		change(tfjson.ManagedResourceMode, "docker_image.main", tfjson.ActionDelete, tfjson.ActionCreate),
+		change(tfjson.ManagedResourceMode, "docker_network.old", tfjson.ActionDelete),
+		change(tfjson.DataResourceMode, "coder_workspace.me", tfjson.ActionRead),
+	})
+
+	require.Equal(t, []*proto.ResourceChange{
+		{Address: "docker_container.workspace", Type: "docker_container", Name: "workspace", Action: proto.ResourceChange_CREATE},
+		{Address: "coder_agent.main", Type: "coder_agent", Name: "main", Action: proto.ResourceChange_UPDATE},
+		{Address: "docker_image.main", Type: "docker_image", Name: "main", Action: proto.ResourceChange_REPLACE},
+		{Address: "docker_network.old", Type: "docker_network", Name: "old", Action: proto.ResourceChange_DESTROY},
+	}, got)
+}
@@ -0,0 +1,113 @@
+package terraform
+
+import (
+	"runtime"
+
+	"github.com/hashicorp/go-version"
+)
+
+// Flavor is a distribution of the Terraform CLI that the provisioner runs.
+type Flavor string
+
+const (
+	// FlavorTerraform is HashiCorp Terraform.
+	FlavorTerraform Flavor = "terraform"
+	// FlavorOpenTofu is OpenTofu, the open source fork of Terraform.
+	FlavorOpenTofu Flavor = "opentofu"
+)
+
+var (
+	// OpenTofuVersion is the version of OpenTofu used internally
+	// when OpenTofu is not available on the system.
+	OpenTofuVersion = version.Must(version.NewVersion("1.8.3"))
+
+	// OpenTofu forked from Terraform 1.5, and 1.6.0 is its first stable
+	// release.
+	minOpenTofuVersion = version.Must(version.NewVersion("1.6.0"))
+	maxOpenTofuVersion = version.Must(version.NewVersion("1.8.9")) // use .9 to automatically allow patch releases
+
+	// defaultOpenTofuMirror is where OpenTofu is installed from if no mirror
+	// is configured. It follows the layout of GitHub releases.
+	defaultOpenTofuMirror = "https://github.com/opentofu/opentofu/releases/download"
+)
+
+// Flavors returns all supported flavors.
+func Flavors() []Flavor {
+	return []Flavor{FlavorTerraform, FlavorOpenTofu}
+}
+
+// Valid returns whether the flavor is supported.
+func (f Flavor) Valid() bool {
+	switch f {
+	case FlavorTerraform, FlavorOpenTofu:
+		return true
+	default:
+		return false
+	}
+}
+
+// orDefault returns Terraform if the flavor is empty.
+func (f Flavor) orDefault() Flavor {
+	if f == "" {
+		return FlavorTerraform
+	}
+	return f
+}
+
+// DisplayName is the name of the flavor used in logs and errors.
+func (f Flavor) DisplayName() string {
+	if f.orDefault() == FlavorOpenTofu {
+		return "OpenTofu"
+	}
+	return "Terraform"
+}
+
+// command is the name of the executable of the flavor, without extension.
+func (f Flavor) command() string {
+	if f.orDefault() == FlavorOpenTofu {
+		return "tofu"
+	}
+	return "terraform"
+}
+
+// binaryName is the file name of the executable of the flavor.
+func (f Flavor) binaryName() string {
+	if runtime.GOOS == "windows" {
+		return f.command() + ".exe"
+	}
+	return f.command()
+}
+
+// Version is the version of the flavor installed when no usable binary is
+// available on the system.
+func (f Flavor) Version() *version.Version {
+	if f.orDefault() == FlavorOpenTofu {
+		return OpenTofuVersion
+	}
+	return TerraformVersion
+}
+
+// versionConstraints returns the minimum supported version of the flavor and
+// the version from which newer releases are untested.
+func (f Flavor) versionConstraints() (minVersion, maxVersion *version.Version) {
+	if f.orDefault() == FlavorOpenTofu {
+		return minOpenTofuVersion, maxOpenTofuVersion
+	}
+	return minTerraformVersion, maxTerraformVersion
+}
+
+// release returns the directory of the given version relative to a mirror,
+// and the file names of its release archive and checksums file.
+func (f Flavor) release(v *version.Version) (dir, archive, checksums string) {
+	if f.orDefault() == FlavorOpenTofu {
+		// e.g. v1.8.3/tofu_1.8.3_linux_amd64.zip
+		return "v" + v.String(),
+			"tofu_" + v.String() + "_" + runtime.GOOS + "_" + runtime.GOARCH + ".zip",
+			"tofu_" + v.String() + "_SHA256SUMS"
+	}
+	// e.g. 1.9.2/terraform_1.9.2_linux_amd64.zip, as on
+	// releases.hashicorp.com/terraform.
+	return v.String(),
+		"terraform_" + v.String() + "_" + runtime.GOOS + "_" + runtime.GOARCH + ".zip",
+		"terraform_" + v.String() + "_SHA256SUMS"
+}
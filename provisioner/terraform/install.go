@@ -1,11 +1,20 @@
 package terraform
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/gofrs/flock"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/hc-install/product"
@@ -28,9 +37,21 @@ var (
 	terraformMinorVersionMismatch = xerrors.New("Terraform binary minor version mismatch.")
 )
 
+// InstallOptions configures Install.
+type InstallOptions struct {
+	// Flavor is the distribution to install. Defaults to Terraform.
+	Flavor Flavor
+	// Mirror is the URL or local directory that releases are installed from.
+	// Terraform releases must be laid out like on
+	// https://releases.hashicorp.com/terraform, and OpenTofu releases like on
+	// its GitHub releases page. If empty, Terraform is installed from
+	// HashiCorp and OpenTofu from GitHub.
+	Mirror string
+}
+
 // Install implements a thread-safe, idempotent Terraform Install
 // operation.
-func Install(ctx context.Context, log slog.Logger, dir string, wantVersion *version.Version) (string, error) {
+func Install(ctx context.Context, log slog.Logger, dir string, wantVersion *version.Version, opts InstallOptions) (string, error) {
 	err := os.MkdirAll(dir, 0o750)
 	if err != nil {
 		return "", err
@@ -47,27 +68,54 @@ func Install(ctx context.Context, log slog.Logger, dir string, wantVersion *vers
 	}
 	defer lock.Close()
 
-	binPath := filepath.Join(dir, product.Terraform.BinaryName())
+	binPath := filepath.Join(dir, opts.Flavor.binaryName())
 
 	hasVersion, err := versionFromBinaryPath(ctx, binPath)
 	if err == nil && hasVersion.Equal(wantVersion) {
 		return binPath, err
 	}
 
-	installer := &releases.ExactVersion{
-		InstallDir: dir,
-		Product:    product.Terraform,
-		Version:    TerraformVersion,
-	}
-	installer.SetLogger(slog.Stdlib(ctx, log, slog.LevelDebug))
 	log.Debug(
 		ctx,
-		"installing terraform",
+		"installing "+opts.Flavor.command(),
 		slog.F("prev_version", hasVersion),
 		slog.F("dir", dir),
-		slog.F("version", TerraformVersion),
+		slog.F("version", wantVersion),
+		slog.F("mirror", opts.Mirror),
 	)
 
+	if opts.Flavor.orDefault() != FlavorTerraform || opts.Mirror != "" {
+		mirror := opts.Mirror
+		if mirror == "" {
+			mirror = defaultOpenTofuMirror
+		}
+		var signingKey openpgp.EntityList
+		if opts.Flavor.orDefault() == FlavorOpenTofu {
+			// The GitHub releases of OpenTofu don't include its key, so it is
+			// read from get.opentofu.org unless a mirror is configured.
+			keyMirror := mirror
+			if opts.Mirror == "" {
+				keyMirror = openTofuSigningKeyMirror
+			}
+			signingKey, err = readSigningKey(ctx, keyMirror, openTofuSigningKeyName, openTofuSigningKeyFingerprint)
+			if err != nil {
+				return "", xerrors.Errorf("read signing key from %s: %w", keyMirror, err)
+			}
+		}
+		err = installFromMirror(ctx, opts.Flavor, mirror, wantVersion, binPath, signingKey)
+		if err != nil {
+			return "", xerrors.Errorf("install from %s: %w", mirror, err)
+		}
+		return binPath, nil
+	}
+
+	installer := &releases.ExactVersion{
+		InstallDir: dir,
+		Product:    product.Terraform,
+		Version:    wantVersion,
+	}
+	installer.SetLogger(slog.Stdlib(ctx, log, slog.LevelDebug))
+
 	path, err := installer.Install(ctx)
 	if err != nil {
 		return "", xerrors.Errorf("install: %w", err)
@@ -81,3 +129,168 @@ func Install(ctx context.Context, log slog.Logger, dir string, wantVersion *vers
 
 	return path, nil
 }
+
+// maxReleaseSize limits the size of release archives downloaded from a
+// mirror.
+const maxReleaseSize = 256 << 20
+
+const (
+	// openTofuSigningKeyFingerprint pins the key that OpenTofu signs the
+	// checksums files of its releases with. See
+	// https://opentofu.org/docs/intro/install/standalone/.
+	openTofuSigningKeyFingerprint = "E3E6E43D84CB852EADB0051D0C0AF313E5FD9F80"
+	// openTofuSigningKeyName is the file name of the armored key, relative to
+	// openTofuSigningKeyMirror or the root of a configured mirror.
+	openTofuSigningKeyName   = "opentofu.asc"
+	openTofuSigningKeyMirror = "https://get.opentofu.org"
+)
+
+// installFromMirror installs the binary of a release from a mirror to
+// binPath. The archive is verified against the checksums file of the release
+// on the same mirror. If signingKey is set, the checksums file must have a
+// detached signature by that key, which guards against a compromised mirror
+// as well as corrupted downloads.
+func installFromMirror(ctx context.Context, flavor Flavor, mirror string, v *version.Version, binPath string, signingKey openpgp.EntityList) error {
+	dir, archiveName, checksumsName := flavor.release(v)
+	checksums, err := readFromMirror(ctx, mirror, dir+"/"+checksumsName)
+	if err != nil {
+		return xerrors.Errorf("read checksums: %w", err)
+	}
+	if signingKey != nil {
+		signature, err := readFromMirror(ctx, mirror, dir+"/"+checksumsName+".gpgsig")
+		if err != nil {
+			return xerrors.Errorf("read checksums signature: %w", err)
+		}
+		err = verifySignature(signingKey, checksums, signature)
+		if err != nil {
+			return xerrors.Errorf("verify signature of %s: %w", checksumsName, err)
+		}
+	}
+	archive, err := readFromMirror(ctx, mirror, dir+"/"+archiveName)
+	if err != nil {
+		return xerrors.Errorf("read archive: %w", err)
+	}
+
+	wantSum, ok := findChecksum(checksums, archiveName)
+	if !ok {
+		return xerrors.Errorf("no checksum for %s", archiveName)
+	}
+	sum := sha256.Sum256(archive)
+	if gotSum := hex.EncodeToString(sum[:]); gotSum != wantSum {
+		return xerrors.Errorf("checksum mismatch for %s: got %s, want %s", archiveName, gotSum, wantSum)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return xerrors.Errorf("open archive: %w", err)
+	}
+	for _, f := range zr.File {
+		if f.Name != flavor.binaryName() {
+			continue
+		}
+		return extractBinary(f, binPath)
+	}
+	return xerrors.Errorf("%s not found in %s", flavor.binaryName(), archiveName)
+}
+
+// readFromMirror reads a file of a mirror, which is either an HTTP(S) URL or
+// a local directory.
+func readFromMirror(ctx context.Context, mirror, name string) ([]byte, error) {
+	u, err := url.Parse(mirror)
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(mirror, "/")+"/"+name, nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, xerrors.Errorf("get %s: unexpected status %s", req.URL, res.Status)
+		}
+		return io.ReadAll(io.LimitReader(res.Body, maxReleaseSize))
+	}
+
+	dir := mirror
+	if err == nil && u.Scheme == "file" {
+		dir = u.Path
+	}
+	return os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+}
+
+// readSigningKey reads an armored public key from a mirror and checks that
+// its primary key has the given fingerprint, so that a mirror cannot
+// substitute its own key.
+func readSigningKey(ctx context.Context, mirror, name, fingerprint string) (openpgp.EntityList, error) {
+	armored, err := readFromMirror(ctx, mirror, name)
+	if err != nil {
+		return nil, err
+	}
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(armored))
+	if err != nil {
+		return nil, xerrors.Errorf("parse %s: %w", name, err)
+	}
+	for _, entity := range keyring {
+		if strings.EqualFold(hex.EncodeToString(entity.PrimaryKey.Fingerprint), fingerprint) {
+			return openpgp.EntityList{entity}, nil
+		}
+	}
+	return nil, xerrors.Errorf("%s does not contain the key with fingerprint %s", name, fingerprint)
+}
+
+// verifySignature checks a detached signature of data, which may be binary or
+// armored.
+func verifySignature(signingKey openpgp.EntityList, data, signature []byte) error {
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(signature), []byte("-----BEGIN")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(signingKey, bytes.NewReader(data), bytes.NewReader(signature), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(signingKey, bytes.NewReader(data), bytes.NewReader(signature), nil)
+	}
+	return err
+}
+
+// findChecksum returns the SHA256 checksum of a file from a checksums file in
+// the format of sha256sum.
+func findChecksum(checksums []byte, name string) (string, bool) {
+	for _, line := range strings.Split(string(checksums), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == name {
+			return strings.ToLower(fields[0]), true
+		}
+	}
+	return "", false
+}
+
+// extractBinary writes the archived file to binPath. The file is written next
+// to binPath first, so binPath is never left half-written.
+func extractBinary(f *zip.File, binPath string) error {
+	r, err := f.Open()
+	if err != nil {
+		return xerrors.Errorf("open %s: %w", f.Name, err)
+	}
+	defer r.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(binPath), filepath.Base(binPath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = io.Copy(tmp, io.LimitReader(r, maxReleaseSize))
+	if err != nil {
+		_ = tmp.Close()
+		return xerrors.Errorf("extract %s: %w", f.Name, err)
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	// #nosec G302 -- the binary must be executable.
+	err = os.Chmod(tmp.Name(), 0o755)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), binPath)
+}
@@ -0,0 +1,157 @@
+package terraform
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestInstallFromMirror(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("Dummy tofu executable on Windows requires sh which isn't very practical.")
+	}
+
+	log := slogtest.Make(t, nil)
+	tofuVersion := version.Must(version.NewVersion("1.8.3"))
+	signingKey := newSigningKey(t)
+	otherKey := newSigningKey(t)
+
+	// writeMirror lays out an OpenTofu release like on its GitHub releases
+	// page, with a fake binary that reports the version. The checksums file
+	// is signed by signer, if set.
+	writeMirror := func(t *testing.T, corrupt bool, signer *openpgp.Entity) string {
+		t.Helper()
+
+		var archive bytes.Buffer
+		zw := zip.NewWriter(&archive)
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: "tofu", Method: zip.Deflate})
+		require.NoError(t, err)
+		_, err = fmt.Fprintf(w, "#!/bin/sh\necho '{\"terraform_version\": \"%s\"}'\n", tofuVersion)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+
+		archiveName := fmt.Sprintf("tofu_%s_%s_%s.zip", tofuVersion, runtime.GOOS, runtime.GOARCH)
+		sum := sha256.Sum256(archive.Bytes())
+		if corrupt {
+			sum[0]++
+		}
+		checksums := fmt.Sprintf("%s  %s\n%s  tofu_%s_other.zip\n", hex.EncodeToString(sum[:]), archiveName, hex.EncodeToString(make([]byte, 32)), tofuVersion)
+		checksumsName := fmt.Sprintf("tofu_%s_SHA256SUMS", tofuVersion)
+
+		mirror := t.TempDir()
+		dir := filepath.Join(mirror, "v"+tofuVersion.String())
+		require.NoError(t, os.MkdirAll(dir, 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, archiveName), archive.Bytes(), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, checksumsName), []byte(checksums), 0o600))
+		if signer != nil {
+			var signature bytes.Buffer
+			require.NoError(t, openpgp.DetachSign(&signature, signer, bytes.NewReader([]byte(checksums)), nil))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, checksumsName+".gpgsig"), signature.Bytes(), 0o600))
+		}
+		return mirror
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		dir := t.TempDir()
+		binPath := filepath.Join(dir, "tofu")
+		mirror := writeMirror(t, false, signingKey)
+
+		err := installFromMirror(ctx, FlavorOpenTofu, mirror, tofuVersion, binPath, openpgp.EntityList{signingKey})
+		require.NoError(t, err)
+
+		out, err := exec.Command(binPath, "version", "-json").Output()
+		require.NoError(t, err)
+		require.Contains(t, string(out), tofuVersion.String())
+
+		// The installed binary is reused without reading the mirror.
+		binInfo, err := os.Stat(binPath)
+		require.NoError(t, err)
+		require.NoError(t, os.RemoveAll(mirror))
+		installed, err := Install(ctx, log, dir, tofuVersion, InstallOptions{Flavor: FlavorOpenTofu, Mirror: mirror})
+		require.NoError(t, err)
+		require.Equal(t, binPath, installed)
+		binInfo2, err := os.Stat(binPath)
+		require.NoError(t, err)
+		require.Equal(t, binInfo.ModTime(), binInfo2.ModTime())
+	})
+
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		binPath := filepath.Join(t.TempDir(), "tofu")
+
+		err := installFromMirror(ctx, FlavorOpenTofu, writeMirror(t, true, signingKey), tofuVersion, binPath, openpgp.EntityList{signingKey})
+		require.ErrorContains(t, err, "checksum mismatch")
+		require.NoFileExists(t, binPath)
+	})
+
+	t.Run("SignatureMismatch", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		binPath := filepath.Join(t.TempDir(), "tofu")
+
+		err := installFromMirror(ctx, FlavorOpenTofu, writeMirror(t, false, otherKey), tofuVersion, binPath, openpgp.EntityList{signingKey})
+		require.ErrorContains(t, err, "verify signature")
+		require.NoFileExists(t, binPath)
+	})
+
+	t.Run("MissingSignature", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+		binPath := filepath.Join(t.TempDir(), "tofu")
+
+		err := installFromMirror(ctx, FlavorOpenTofu, writeMirror(t, false, nil), tofuVersion, binPath, openpgp.EntityList{signingKey})
+		require.ErrorContains(t, err, "read checksums signature")
+		require.NoFileExists(t, binPath)
+	})
+}
+
+func TestReadSigningKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	signingKey := newSigningKey(t)
+	mirror := t.TempDir()
+
+	var armored bytes.Buffer
+	w, err := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, signingKey.Serialize(w))
+	require.NoError(t, w.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(mirror, openTofuSigningKeyName), armored.Bytes(), 0o600))
+
+	keyring, err := readSigningKey(ctx, mirror, openTofuSigningKeyName, hex.EncodeToString(signingKey.PrimaryKey.Fingerprint))
+	require.NoError(t, err)
+	require.Len(t, keyring, 1)
+	require.Equal(t, signingKey.PrimaryKey.Fingerprint, keyring[0].PrimaryKey.Fingerprint)
+
+	// A mirror cannot substitute the pinned key with its own.
+	_, err = readSigningKey(ctx, mirror, openTofuSigningKeyName, openTofuSigningKeyFingerprint)
+	require.ErrorContains(t, err, "does not contain the key")
+}
+
+func newSigningKey(t *testing.T) *openpgp.Entity {
+	t.Helper()
+	entity, err := openpgp.NewEntity("Test", "", "test@coder.com", &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	require.NoError(t, err)
+	return entity
+}
@@ -0,0 +1,660 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/coder/terraform-provider-coder/provider"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-config-inspect/tfconfig"
+	"github.com/mitchellh/mapstructure"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+
+	"github.com/coder/coder/v2/provisioner"
+)
+
+// LintSeverity is the severity of a LintDiagnostic.
+type LintSeverity string
+
+const (
+	// LintSeverityError is used for problems that fail template imports or
+	// workspace builds.
+	LintSeverityError LintSeverity = "error"
+	// LintSeverityWarning is used for problems that are likely mistakes, but
+	// don't fail builds.
+	LintSeverityWarning LintSeverity = "warning"
+)
+
+// LintRule is a check performed by Lint.
+type LintRule struct {
+	ID          string
+	Description string
+}
+
+var (
+	LintRuleModule = LintRule{
+		ID:          "module",
+		Description: "The Terraform configuration must load, and its variables and workspace tags must be valid.",
+	}
+	LintRuleMissingAgent = LintRule{
+		ID:          "missing-agent",
+		Description: "Templates should define a coder_agent, and each agent must be referenced by the resource it runs on.",
+	}
+	LintRuleAppSlug = LintRule{
+		ID:          "app-slug",
+		Description: "coder_app slugs must be valid hostnames and unique per template.",
+	}
+	LintRuleParameter = LintRule{
+		ID:          "parameter",
+		Description: "coder_parameter names must be unique, and their validation blocks, options and defaults must be consistent with their type.",
+	}
+	LintRuleParameterMutability = LintRule{
+		ID:          "parameter-mutability",
+		Description: "Ephemeral coder_parameters must be mutable and have a default.",
+	}
+	LintRuleScript = LintRule{
+		ID:          "script",
+		Description: "coder_script cron schedules must be valid, and scripts must run on start, stop or a schedule.",
+	}
+
+	// LintRules are all rules checked by Lint.
+	LintRules = []LintRule{
+		LintRuleModule,
+		LintRuleMissingAgent,
+		LintRuleAppSlug,
+		LintRuleParameter,
+		LintRuleParameterMutability,
+		LintRuleScript,
+	}
+)
+
+// LintDiagnostic is a problem found in a template by Lint.
+type LintDiagnostic struct {
+	Rule     string       `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	Message  string       `json:"message"`
+	// Filename is relative to the template directory. It's empty if the
+	// problem isn't specific to a file.
+	Filename string `json:"filename,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+}
+
+func (d LintDiagnostic) String() string {
+	location := d.Filename
+	if d.Line > 0 {
+		location = fmt.Sprintf("%s:%d:%d", d.Filename, d.Line, d.Column)
+	}
+	if location != "" {
+		location += ": "
+	}
+	return fmt.Sprintf("%s%s: %s [%s]", location, d.Severity, d.Message, d.Rule)
+}
+
+// Lint checks the template in dir for problems that would otherwise only be
+// reported by a provisioner job after the template is pushed. Templates are
+// analyzed without running Terraform, so only the attributes that can be
+// evaluated statically, e.g. literals, are checked.
+func Lint(ctx context.Context, logger slog.Logger, dir string) ([]LintDiagnostic, error) {
+	if !tfconfig.IsModuleDir(dir) {
+		return nil, xerrors.Errorf("no Terraform configuration files found in %q", dir)
+	}
+	l := &linter{dir: dir}
+
+	// Load the module the same way as Parse.
+	module, diags := tfconfig.LoadModule(dir)
+	for _, diag := range diags {
+		d := LintDiagnostic{
+			Rule:     LintRuleModule.ID,
+			Severity: LintSeverityWarning,
+			Message:  diag.Summary,
+		}
+		if diag.Severity == tfconfig.DiagError {
+			d.Severity = LintSeverityError
+		}
+		if diag.Detail != "" {
+			d.Message += ": " + diag.Detail
+		}
+		if diag.Pos != nil {
+			d.Filename = l.relativePath(diag.Pos.Filename)
+			d.Line = diag.Pos.Line
+		}
+		l.diagnostics = append(l.diagnostics, d)
+	}
+	if diags.HasErrors() {
+		return l.sorted(), nil
+	}
+	if _, err := loadWorkspaceTags(ctx, logger, module); err != nil {
+		l.report(LintRuleModule, LintSeverityError, hcl.Range{}, "can't load workspace tags: %v", err)
+	}
+	if _, err := loadTerraformVariables(module); err != nil {
+		l.report(LintRuleModule, LintSeverityError, hcl.Range{}, "can't load template variables: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return nil, xerrors.Errorf("find configuration files: %w", err)
+	}
+	parser := hclparse.NewParser()
+	for _, filename := range files {
+		file, diags := parser.ParseHCLFile(filename)
+		if diags.HasErrors() {
+			// LoadModule already reported the syntax errors.
+			continue
+		}
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			switch {
+			case block.Type == "locals":
+				for name, attr := range block.Body.Attributes {
+					l.locals = append(l.locals, lintLocal{name: name, expr: attr.Expr})
+				}
+			case block.Type == "module":
+				l.blocks = append(l.blocks, lintBlock{block: block})
+			case (block.Type == "resource" || block.Type == "data") && len(block.Labels) == 2:
+				l.blocks = append(l.blocks, lintBlock{
+					block:  block,
+					typ:    block.Labels[0],
+					name:   block.Labels[1],
+					values: staticValues(block.Body),
+				})
+			}
+		}
+	}
+
+	l.lintAgents()
+	l.lintApps()
+	l.lintParameters()
+	l.lintScripts()
+	return l.sorted(), nil
+}
+
+type linter struct {
+	dir         string
+	blocks      []lintBlock
+	locals      []lintLocal
+	diagnostics []LintDiagnostic
+}
+
+// lintBlock is a resource, data source or module block of the template.
+type lintBlock struct {
+	block *hclsyntax.Block
+	// typ and name are empty for modules.
+	typ  string
+	name string
+	// values are the attributes and nested blocks that could be evaluated
+	// statically.
+	values map[string]any
+}
+
+func (b lintBlock) address() string {
+	if b.block.Type == "data" {
+		return "data." + b.typ + "." + b.name
+	}
+	return b.typ + "." + b.name
+}
+
+// attrRange returns the range of the value of an attribute, or of the block
+// header if the attribute isn't set.
+func (b lintBlock) attrRange(name string) hcl.Range {
+	if attr, ok := b.block.Body.Attributes[name]; ok {
+		return attr.Expr.Range()
+	}
+	return b.block.DefRange()
+}
+
+// hasAttr returns whether an attribute is set, even to a value that can't be
+// evaluated statically.
+func (b lintBlock) hasAttr(name string) bool {
+	_, ok := b.block.Body.Attributes[name]
+	return ok
+}
+
+// known returns whether all the attributes could be evaluated statically.
+// Absent attributes are known.
+func (b lintBlock) known(names ...string) bool {
+	for _, name := range names {
+		if _, ok := b.values[name]; b.hasAttr(name) && !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type lintLocal struct {
+	name string
+	expr hclsyntax.Expression
+}
+
+func (l *linter) report(rule LintRule, severity LintSeverity, rng hcl.Range, format string, args ...any) {
+	d := LintDiagnostic{
+		Rule:     rule.ID,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if rng.Filename != "" {
+		d.Filename = l.relativePath(rng.Filename)
+		d.Line = rng.Start.Line
+		d.Column = rng.Start.Column
+	}
+	l.diagnostics = append(l.diagnostics, d)
+}
+
+func (l *linter) relativePath(filename string) string {
+	rel, err := filepath.Rel(l.dir, filename)
+	if err != nil {
+		return filename
+	}
+	return filepath.ToSlash(rel)
+}
+
+func (l *linter) sorted() []LintDiagnostic {
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i], l.diagnostics[j]
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.diagnostics
+}
+
+func (l *linter) blocksOfType(kind, typ string) []lintBlock {
+	var blocks []lintBlock
+	for _, b := range l.blocks {
+		if b.block.Type == kind && b.typ == typ {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
+
+// lintAgents checks that agents are referenced by a resource. Agents are
+// attached to the closest resource that depends on them, and are dropped if
+// there's none.
+func (l *linter) lintAgents() {
+	agents := l.blocksOfType("resource", "coder_agent")
+	if len(agents) == 0 {
+		l.report(LintRuleMissingAgent, LintSeverityWarning, hcl.Range{},
+			"the template doesn't define a coder_agent, so workspaces can't be connected to")
+		return
+	}
+
+	// Resources may reference agents through locals.
+	localAgents := map[string][]string{}
+	for changed := true; changed; {
+		changed = false
+		for _, local := range l.locals {
+			for _, agent := range referencedAgents(local.expr.Variables(), localAgents) {
+				if !slices.Contains(localAgents[local.name], agent) {
+					localAgents[local.name] = append(localAgents[local.name], agent)
+					changed = true
+				}
+			}
+		}
+	}
+
+	referenced := map[string]bool{}
+	for _, b := range l.blocks {
+		if strings.HasPrefix(b.typ, "coder_") {
+			continue
+		}
+		_ = hclsyntax.VisitAll(b.block.Body, func(node hclsyntax.Node) hcl.Diagnostics {
+			if attr, ok := node.(*hclsyntax.Attribute); ok {
+				for _, agent := range referencedAgents(attr.Expr.Variables(), localAgents) {
+					referenced[agent] = true
+				}
+			}
+			return nil
+		})
+	}
+	for _, agent := range agents {
+		if !referenced[agent.name] {
+			l.report(LintRuleMissingAgent, LintSeverityWarning, agent.block.DefRange(),
+				"%s isn't referenced by any resource, so it won't be attached to one; use its token or init_script in the resource the agent runs on", agent.address())
+		}
+	}
+}
+
+// referencedAgents returns the names of the agents referenced by traversals,
+// directly or through locals.
+func referencedAgents(traversals []hcl.Traversal, localAgents map[string][]string) []string {
+	var agents []string
+	for _, traversal := range traversals {
+		if len(traversal) < 2 {
+			continue
+		}
+		attr, ok := traversal[1].(hcl.TraverseAttr)
+		if !ok {
+			continue
+		}
+		switch traversal.RootName() {
+		case "coder_agent":
+			agents = append(agents, attr.Name)
+		case "local":
+			agents = append(agents, localAgents[attr.Name]...)
+		}
+	}
+	return agents
+}
+
+// lintApps checks app slugs like ConvertState does.
+func (l *linter) lintApps() {
+	slugs := map[string]hcl.Range{}
+	for _, app := range l.blocksOfType("resource", "coder_app") {
+		if !app.known("slug") {
+			continue
+		}
+		var attrs agentAppAttributes
+		if err := decodeStaticValues(app.values, &attrs); err != nil {
+			continue
+		}
+		rng := app.attrRange("slug")
+		// Default to the resource name if none is set!
+		if attrs.Slug == "" {
+			attrs.Slug = app.name
+		}
+
+		if !provisioner.AppSlugRegex.MatchString(attrs.Slug) {
+			if !app.hasAttr("slug") {
+				l.report(LintRuleAppSlug, LintSeverityError, rng,
+					"%s has no slug and its name isn't a valid slug, set the slug property", app.address())
+				continue
+			}
+			l.report(LintRuleAppSlug, LintSeverityError, rng,
+				"invalid app slug %q, slugs must be lowercase alphanumeric and may contain single hyphens", attrs.Slug)
+			continue
+		}
+		if other, exists := slugs[attrs.Slug]; exists {
+			l.report(LintRuleAppSlug, LintSeverityError, rng,
+				"duplicate app slug %q, it's also used at %s:%d; slugs must be unique per template", attrs.Slug, l.relativePath(other.Filename), other.Start.Line)
+			continue
+		}
+		slugs[attrs.Slug] = rng
+	}
+}
+
+// lintParameters checks parameters like the coder provider does when they're
+// read.
+func (l *linter) lintParameters() {
+	names := map[string]hcl.Range{}
+	for _, param := range l.blocksOfType("data", "coder_parameter") {
+		var p provider.Parameter
+		if err := decodeStaticValues(param.values, &p); err != nil {
+			continue
+		}
+		if p.Type == "" {
+			p.Type = "string"
+		}
+
+		if param.known("name") && p.Name != "" {
+			if other, exists := names[p.Name]; exists {
+				l.report(LintRuleParameter, LintSeverityError, param.attrRange("name"),
+					"duplicate parameter name %q, it's also used at %s:%d; coder_parameter names must be unique", p.Name, l.relativePath(other.Filename), other.Start.Line)
+			} else {
+				names[p.Name] = param.attrRange("name")
+			}
+		}
+
+		if param.known("mutable", "ephemeral") && p.Ephemeral && !p.Mutable {
+			l.report(LintRuleParameterMutability, LintSeverityError, param.attrRange("ephemeral"),
+				"parameter can't be immutable and ephemeral, set mutable = true")
+		}
+		if param.known("ephemeral") && p.Ephemeral && !param.hasAttr("default") {
+			l.report(LintRuleParameterMutability, LintSeverityError, param.attrRange("ephemeral"),
+				"ephemeral parameter requires the default property")
+		}
+
+		if !param.known("type", "default") {
+			continue
+		}
+		if param.hasAttr("default") && p.Default != "" {
+			if err := parameterValueIsType(p.Type, p.Default); err != nil {
+				l.report(LintRuleParameter, LintSeverityError, param.attrRange("default"), "invalid default: %v", err)
+				continue
+			}
+		}
+		l.lintParameterValidation(param, p)
+		l.lintParameterOptions(param, p)
+	}
+}
+
+func (l *linter) lintParameterValidation(param lintBlock, p provider.Parameter) {
+	var validation *hclsyntax.Block
+	for _, block := range param.block.Body.Blocks {
+		if block.Type == "validation" {
+			if validation != nil {
+				l.report(LintRuleParameter, LintSeverityError, block.DefRange(), "a parameter can only have one validation block")
+				return
+			}
+			validation = block
+		}
+	}
+	if validation == nil || len(p.Validation) != 1 {
+		return
+	}
+	vb := lintBlock{block: validation, values: staticValues(validation.Body)}
+	if !vb.known("min", "max", "monotonic", "regex", "error") {
+		return
+	}
+	v := p.Validation[0]
+	v.MinDisabled = !vb.hasAttr("min")
+	v.MaxDisabled = !vb.hasAttr("max")
+
+	if !v.MinDisabled && !v.MaxDisabled && v.Min > v.Max {
+		l.report(LintRuleParameter, LintSeverityError, vb.attrRange("min"), "the minimum %d is greater than the maximum %d", v.Min, v.Max)
+		return
+	}
+	if v.Regex != "" {
+		if _, err := regexp.Compile(v.Regex); err != nil {
+			l.report(LintRuleParameter, LintSeverityError, vb.attrRange("regex"), "invalid regex %q: %v", v.Regex, err)
+			return
+		}
+	}
+
+	// The provider validates the value of a parameter along with its
+	// validation block. Without a default, check the block with a value
+	// that satisfies it.
+	value := p.Default
+	rng := param.attrRange("default")
+	if value == "" {
+		rng = validation.DefRange()
+		if p.Type == "string" && v.Regex != "" {
+			if v.Error == "" {
+				l.report(LintRuleParameter, LintSeverityError, rng, "an error must be specified with a regex validation")
+				return
+			}
+			v.Regex = ""
+		}
+		value = validValue(p.Type, v)
+	}
+	if err := v.Valid(p.Type, value); err != nil {
+		l.report(LintRuleParameter, LintSeverityError, rng, "%v", err)
+	}
+}
+
+// validValue returns a value of the type that satisfies the bounds of a
+// validation block.
+func validValue(typ string, v provider.Validation) string {
+	switch typ {
+	case "number":
+		switch {
+		case !v.MinDisabled:
+			return strconv.Itoa(v.Min)
+		case !v.MaxDisabled:
+			return strconv.Itoa(v.Max)
+		}
+		return "0"
+	case "bool":
+		return "false"
+	case "list(string)":
+		return "[]"
+	}
+	return ""
+}
+
+func (l *linter) lintParameterOptions(param lintBlock, p provider.Parameter) {
+	var optionBlocks []*hclsyntax.Block
+	for _, block := range param.block.Body.Blocks {
+		if block.Type == "option" {
+			optionBlocks = append(optionBlocks, block)
+		}
+	}
+	if len(optionBlocks) == 0 || len(optionBlocks) != len(p.Option) {
+		return
+	}
+
+	names := map[string]bool{}
+	values := map[string]bool{}
+	allKnown := true
+	for i, block := range optionBlocks {
+		ob := lintBlock{block: block, values: staticValues(block.Body)}
+		option := p.Option[i]
+		if ob.known("name") {
+			if names[option.Name] {
+				l.report(LintRuleParameter, LintSeverityError, ob.attrRange("name"), "multiple options cannot have the same name %q", option.Name)
+			}
+			names[option.Name] = true
+		}
+		if !ob.known("value") {
+			allKnown = false
+			continue
+		}
+		if values[option.Value] {
+			l.report(LintRuleParameter, LintSeverityError, ob.attrRange("value"), "multiple options cannot have the same value %q", option.Value)
+		}
+		values[option.Value] = true
+		if err := parameterValueIsType(p.Type, option.Value); err != nil {
+			l.report(LintRuleParameter, LintSeverityError, ob.attrRange("value"), "invalid option value: %v", err)
+		}
+	}
+
+	if allKnown && p.Default != "" && !values[p.Default] {
+		l.report(LintRuleParameter, LintSeverityError, param.attrRange("default"), "default value %q must be defined as one of options", p.Default)
+	}
+}
+
+// parameterValueIsType mirrors the type check of the coder provider.
+func parameterValueIsType(typ, value string) error {
+	switch typ {
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return xerrors.Errorf("%q is not a number", value)
+		}
+	case "bool":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return xerrors.Errorf("%q is not a bool", value)
+		}
+	case "list(string)":
+		var items []string
+		if err := json.Unmarshal([]byte(value), &items); err != nil {
+			return xerrors.Errorf("%q is not an array of strings", value)
+		}
+	case "string":
+		// Anything is a string!
+	default:
+		return xerrors.Errorf("invalid type %q", typ)
+	}
+	return nil
+}
+
+// lintScripts checks scripts like the coder provider does when they're
+// created.
+func (l *linter) lintScripts() {
+	for _, script := range l.blocksOfType("resource", "coder_script") {
+		var attrs agentScriptAttributes
+		if err := decodeStaticValues(script.values, &attrs); err != nil {
+			continue
+		}
+		if attrs.Cron != "" {
+			if _, err := provider.ScriptCRONParser.Parse(attrs.Cron); err != nil {
+				l.report(LintRuleScript, LintSeverityError, script.attrRange("cron"),
+					"%q is not a valid cron expression: %v", attrs.Cron, err)
+			} else if len(strings.Fields(attrs.Cron)) == 5 {
+				// The day of week is optional, so standard cron expressions
+				// are accepted but run far more often than intended.
+				l.report(LintRuleScript, LintSeverityWarning, script.attrRange("cron"),
+					"%q is parsed with seconds as the first field, add a field for seconds, e.g. %q", attrs.Cron, "0 "+attrs.Cron)
+			}
+		}
+		if script.known("run_on_start", "run_on_stop", "cron") && !attrs.RunOnStart && !attrs.RunOnStop && attrs.Cron == "" {
+			l.report(LintRuleScript, LintSeverityError, script.block.DefRange(),
+				`at least one of "run_on_start", "run_on_stop", or "cron" must be set`)
+		}
+		if script.known("run_on_start", "start_blocks_login") && attrs.StartBlocksLogin && !attrs.RunOnStart {
+			l.report(LintRuleScript, LintSeverityError, script.attrRange("start_blocks_login"),
+				`"start_blocks_login" can only be set if "run_on_start" is "true"`)
+		}
+	}
+}
+
+// staticValues evaluates the attributes of body that don't depend on
+// variables, resources or functions. Nested blocks are lists of their
+// values, like in Terraform state.
+func staticValues(body *hclsyntax.Body) map[string]any {
+	values := map[string]any{}
+	for name, attr := range body.Attributes {
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() || !val.IsWhollyKnown() || val.IsNull() {
+			continue
+		}
+		raw, err := ctyjson.SimpleJSONValue{Value: val}.MarshalJSON()
+		if err != nil {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			continue
+		}
+		values[name] = value
+	}
+	for _, block := range body.Blocks {
+		blocks, _ := values[block.Type].([]any)
+		values[block.Type] = append(blocks, staticValues(block.Body))
+	}
+	return values
+}
+
+// decodeStaticValues decodes values into the attribute structs used by
+// ConvertState. Terraform converts primitives to the type in the schema, so
+// numbers and bools are accepted for strings.
+func decodeStaticValues(values map[string]any, out any) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result: out,
+		DecodeHook: func(_ reflect.Type, to reflect.Type, data any) (any, error) {
+			if to.Kind() != reflect.String {
+				return data, nil
+			}
+			switch v := data.(type) {
+			case bool:
+				return strconv.FormatBool(v), nil
+			case float64:
+				return strconv.FormatFloat(v, 'f', -1, 64), nil
+			}
+			return data, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(values)
+}
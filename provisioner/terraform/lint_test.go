@@ -0,0 +1,477 @@
+package terraform_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/sloggers/slogtest"
+
+	"github.com/coder/coder/v2/provisioner/terraform"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	const agent = `
+resource "coder_agent" "main" {
+  os   = "linux"
+  arch = "amd64"
+}
+
+resource "null_resource" "dev" {
+  triggers = {
+    token = coder_agent.main.token
+  }
+}
+`
+
+	type diagnostic struct {
+		Rule     string
+		Severity terraform.LintSeverity
+		Filename string
+		Line     int
+		// Message is a substring of the message.
+		Message string
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		Files       map[string]string
+		Diagnostics []diagnostic
+	}{{
+		Name:  "Valid",
+		Files: map[string]string{"main.tf": agent},
+	}, {
+		Name: "SyntaxError",
+		Files: map[string]string{"main.tf": `
+resource "coder_agent" "main" {
+`},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleModule.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "main.tf",
+			Line:     2,
+			Message:  "Unclosed configuration block",
+		}},
+	}, {
+		Name: "NoAgent",
+		Files: map[string]string{"main.tf": `
+resource "null_resource" "dev" {}
+`},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleMissingAgent.ID,
+			Severity: terraform.LintSeverityWarning,
+			Message:  "doesn't define a coder_agent",
+		}},
+	}, {
+		Name: "UnreferencedAgent",
+		Files: map[string]string{"main.tf": `
+resource "coder_agent" "main" {
+  os   = "linux"
+  arch = "amd64"
+}
+
+resource "coder_app" "code" {
+  agent_id = coder_agent.main.id
+  slug     = "code"
+}
+
+resource "null_resource" "dev" {}
+`},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleMissingAgent.ID,
+			Severity: terraform.LintSeverityWarning,
+			Filename: "main.tf",
+			Line:     2,
+			Message:  "coder_agent.main isn't referenced by any resource",
+		}},
+	}, {
+		Name: "AgentReferencedThroughLocal",
+		Files: map[string]string{"main.tf": `
+resource "coder_agent" "main" {
+  os   = "linux"
+  arch = "amd64"
+}
+
+locals {
+  script = coder_agent.main.init_script
+  user_data = "#!/bin/sh\n${local.script}"
+}
+
+resource "null_resource" "dev" {
+  triggers = {
+    user_data = local.user_data
+  }
+}
+`},
+	}, {
+		Name: "AgentReferencedByModule",
+		Files: map[string]string{"main.tf": `
+resource "coder_agent" "main" {
+  os   = "linux"
+  arch = "amd64"
+}
+
+module "vm" {
+  source = "./vm"
+  token  = coder_agent.main.token
+}
+`},
+	}, {
+		Name: "AppSlugs",
+		Files: map[string]string{
+			"main.tf": agent,
+			"apps.tf": `
+resource "coder_app" "code" {
+  agent_id = coder_agent.main.id
+  slug     = "code-server"
+}
+
+resource "coder_app" "code2" {
+  agent_id = coder_agent.main.id
+  slug     = "code-server"
+}
+
+resource "coder_app" "invalid" {
+  agent_id = coder_agent.main.id
+  slug     = "Invalid_Slug"
+}
+
+resource "coder_app" "default_slug" {
+  agent_id = coder_agent.main.id
+}
+
+resource "coder_app" "dynamic" {
+  agent_id = coder_agent.main.id
+  slug     = var.slug
+}
+
+variable "slug" {
+  default = "code-server"
+}
+`,
+		},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleAppSlug.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "apps.tf",
+			Line:     9,
+			Message:  `duplicate app slug "code-server", it's also used at apps.tf:4`,
+		}, {
+			Rule:     terraform.LintRuleAppSlug.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "apps.tf",
+			Line:     14,
+			Message:  `invalid app slug "Invalid_Slug"`,
+		}, {
+			Rule:     terraform.LintRuleAppSlug.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "apps.tf",
+			Line:     17,
+			Message:  "coder_app.default_slug has no slug and its name isn't a valid slug",
+		}},
+	}, {
+		Name: "ParameterValidation",
+		Files: map[string]string{
+			"main.tf": agent,
+			"parameters.tf": `
+data "coder_parameter" "cpu" {
+  name    = "cpu"
+  type    = "number"
+  default = 2
+  validation {
+    min = 4
+    max = 8
+  }
+}
+
+data "coder_parameter" "bounds" {
+  name = "bounds"
+  type = "number"
+  validation {
+    min = 8
+    max = 4
+  }
+}
+
+data "coder_parameter" "regex" {
+  name = "regex"
+  validation {
+    regex = "^[a-z]+$"
+  }
+}
+
+data "coder_parameter" "bad_regex" {
+  name = "bad_regex"
+  validation {
+    regex = "[a-z"
+    error = "invalid"
+  }
+}
+
+data "coder_parameter" "string_min" {
+  name = "string_min"
+  type = "string"
+  validation {
+    min = 1
+  }
+}
+
+data "coder_parameter" "monotonic" {
+  name    = "monotonic"
+  type    = "number"
+  default = 1
+  validation {
+    monotonic = "sideways"
+  }
+}
+
+data "coder_parameter" "region" {
+  name    = "region"
+  default = "us"
+  validation {
+    regex = "^[a-z]+$"
+    error = "Lowercase only"
+  }
+}
+`,
+		},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     5,
+			Message:  "value 2 is less than the minimum 4",
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     16,
+			Message:  "the minimum 8 is greater than the maximum 4",
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     23,
+			Message:  "an error must be specified with a regex validation",
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     31,
+			Message:  `invalid regex "[a-z"`,
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     39,
+			Message:  "a min cannot be specified for a string type",
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     47,
+			Message:  `number monotonicity can be either "increasing" or "decreasing"`,
+		}},
+	}, {
+		Name: "ParameterOptions",
+		Files: map[string]string{
+			"main.tf": agent,
+			"parameters.tf": `
+data "coder_parameter" "region" {
+  name    = "region"
+  default = "ap"
+  option {
+    name  = "US"
+    value = "us"
+  }
+  option {
+    name  = "US"
+    value = "eu"
+  }
+  option {
+    name  = "Europe"
+    value = "eu"
+  }
+}
+
+data "coder_parameter" "size" {
+  name = "size"
+  type = "number"
+  option {
+    name  = "Small"
+    value = "small"
+  }
+}
+`,
+		},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     4,
+			Message:  `default value "ap" must be defined as one of options`,
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     10,
+			Message:  `multiple options cannot have the same name "US"`,
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     15,
+			Message:  `multiple options cannot have the same value "eu"`,
+		}, {
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     24,
+			Message:  `invalid option value: "small" is not a number`,
+		}},
+	}, {
+		Name: "ParameterMutability",
+		Files: map[string]string{
+			"main.tf": agent,
+			"parameters.tf": `
+data "coder_parameter" "immutable" {
+  name      = "immutable"
+  default   = "a"
+  ephemeral = true
+}
+
+data "coder_parameter" "required" {
+  name      = "required"
+  mutable   = true
+  ephemeral = true
+}
+
+data "coder_parameter" "ok" {
+  name      = "ok"
+  mutable   = true
+  default   = "a"
+  ephemeral = true
+}
+`,
+		},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleParameterMutability.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     5,
+			Message:  "parameter can't be immutable and ephemeral",
+		}, {
+			Rule:     terraform.LintRuleParameterMutability.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     11,
+			Message:  "ephemeral parameter requires the default property",
+		}},
+	}, {
+		Name: "DuplicateParameterNames",
+		Files: map[string]string{
+			"main.tf": agent,
+			"parameters.tf": `
+data "coder_parameter" "a" {
+  name = "region"
+}
+
+data "coder_parameter" "b" {
+  name = "region"
+}
+`,
+		},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleParameter.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "parameters.tf",
+			Line:     7,
+			Message:  `duplicate parameter name "region", it's also used at parameters.tf:3`,
+		}},
+	}, {
+		Name: "Scripts",
+		Files: map[string]string{
+			"main.tf": agent,
+			"scripts.tf": `
+resource "coder_script" "cron" {
+  agent_id     = coder_agent.main.id
+  display_name = "Backup"
+  script       = "backup.sh"
+  cron         = "0 * * * *"
+}
+
+resource "coder_script" "invalid_cron" {
+  agent_id     = coder_agent.main.id
+  display_name = "Backup"
+  script       = "backup.sh"
+  cron         = "0 0 25 * * *"
+}
+
+resource "coder_script" "never" {
+  agent_id     = coder_agent.main.id
+  display_name = "Never"
+  script       = "never.sh"
+}
+
+resource "coder_script" "blocks" {
+  agent_id           = coder_agent.main.id
+  display_name       = "Blocks"
+  script             = "stop.sh"
+  run_on_stop        = true
+  start_blocks_login = true
+}
+`,
+		},
+		Diagnostics: []diagnostic{{
+			Rule:     terraform.LintRuleScript.ID,
+			Severity: terraform.LintSeverityWarning,
+			Filename: "scripts.tf",
+			Line:     6,
+			Message:  `"0 * * * *" is parsed with seconds as the first field`,
+		}, {
+			Rule:     terraform.LintRuleScript.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "scripts.tf",
+			Line:     13,
+			Message:  `"0 0 25 * * *" is not a valid cron expression`,
+		}, {
+			Rule:     terraform.LintRuleScript.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "scripts.tf",
+			Line:     16,
+			Message:  `at least one of "run_on_start", "run_on_stop", or "cron" must be set`,
+		}, {
+			Rule:     terraform.LintRuleScript.ID,
+			Severity: terraform.LintSeverityError,
+			Filename: "scripts.tf",
+			Line:     27,
+			Message:  `"start_blocks_login" can only be set if "run_on_start" is "true"`,
+		}},
+	}} {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			for name, content := range tc.Files {
+				err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)
+				require.NoError(t, err)
+			}
+
+			diags, err := terraform.Lint(context.Background(), slogtest.Make(t, nil), dir)
+			require.NoError(t, err)
+			require.Len(t, diags, len(tc.Diagnostics), "diagnostics: %v", diags)
+			for i, expected := range tc.Diagnostics {
+				require.Equal(t, expected.Rule, diags[i].Rule)
+				require.Equal(t, expected.Severity, diags[i].Severity)
+				require.Equal(t, expected.Filename, diags[i].Filename)
+				require.Equal(t, expected.Line, diags[i].Line)
+				require.Contains(t, diags[i].Message, expected.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package terraform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"golang.org/x/mod/sumdb/dirhash"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+)
+
+// LockFileName is the name of the dependency lock file of a Terraform
+// configuration.
+const LockFileName = ".terraform.lock.hcl"
+
+// MirrorOptions configures MirrorProviders.
+type MirrorOptions struct {
+	// LockFile is the content of the dependency lock file of a template.
+	LockFile []byte
+	// Dir is the filesystem mirror to populate.
+	Dir string
+	// Platforms are the platforms to mirror providers for, e.g.
+	// "linux_amd64". Defaults to the current platform.
+	Platforms []string
+	// HTTPClient is used to download providers. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// MirroredProvider is a provider package in a filesystem mirror.
+type MirroredProvider struct {
+	Address  string
+	Version  string
+	Platform string
+	// Path is the package in the mirror.
+	Path string
+	// Cached is true if the package was already in the mirror.
+	Cached bool
+}
+
+// lockFile is the subset of the dependency lock file used to mirror
+// providers.
+type lockFile struct {
+	Providers []lockFileProvider `hcl:"provider,block"`
+}
+
+type lockFileProvider struct {
+	Address     string   `hcl:"address,label"`
+	Version     string   `hcl:"version"`
+	Constraints string   `hcl:"constraints,optional"`
+	Hashes      []string `hcl:"hashes,optional"`
+}
+
+// MirrorProviders downloads the providers selected by a dependency lock file
+// from their registries to a filesystem mirror, laid out in the packed layout
+// that Terraform installs providers from with a filesystem_mirror. Packages
+// must match a checksum recorded in the lock file, so a mirror can only be
+// populated with the providers that were locked.
+func MirrorProviders(ctx context.Context, log slog.Logger, opts MirrorOptions) ([]MirroredProvider, error) {
+	providers, err := parseLockFile(opts.LockFile)
+	if err != nil {
+		return nil, xerrors.Errorf("parse lock file: %w", err)
+	}
+	if len(opts.Platforms) == 0 {
+		opts.Platforms = []string{runtime.GOOS + "_" + runtime.GOARCH}
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+
+	var mirrored []MirroredProvider
+	for _, provider := range providers {
+		hostname, namespace, typ, err := parseProviderAddress(provider.Address)
+		if err != nil {
+			return nil, err
+		}
+		for _, platform := range opts.Platforms {
+			goos, goarch, ok := strings.Cut(platform, "_")
+			if !ok {
+				return nil, xerrors.Errorf("invalid platform %q, must be in the form os_arch", platform)
+			}
+			path := filepath.Join(opts.Dir, hostname, namespace, typ,
+				fmt.Sprintf("terraform-provider-%s_%s_%s.zip", typ, provider.Version, platform))
+			mp := MirroredProvider{
+				Address:  provider.Address,
+				Version:  provider.Version,
+				Platform: platform,
+				Path:     path,
+			}
+
+			if err := verifyProviderPackage(path, provider.Hashes); err == nil {
+				mp.Cached = true
+				mirrored = append(mirrored, mp)
+				continue
+			}
+
+			log.Debug(ctx, "mirroring provider",
+				slog.F("address", provider.Address),
+				slog.F("version", provider.Version),
+				slog.F("platform", platform))
+			err := mirrorProvider(ctx, opts.HTTPClient, hostname, namespace, typ, provider.Version, goos, goarch, path, provider.Hashes)
+			if err != nil {
+				return nil, xerrors.Errorf("mirror %s v%s (%s): %w", provider.Address, provider.Version, platform, err)
+			}
+			mirrored = append(mirrored, mp)
+		}
+	}
+	return mirrored, nil
+}
+
+func parseLockFile(content []byte) ([]lockFileProvider, error) {
+	file, diags := hclparse.NewParser().ParseHCL(content, LockFileName)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	var lf lockFile
+	diags = gohcl.DecodeBody(file.Body, nil, &lf)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	if len(lf.Providers) == 0 {
+		return nil, xerrors.New("no providers are locked")
+	}
+	return lf.Providers, nil
+}
+
+// parseProviderAddress splits a fully qualified provider address, e.g.
+// "registry.terraform.io/coder/coder".
+func parseProviderAddress(address string) (hostname, namespace, typ string, err error) {
+	parts := strings.Split(address, "/")
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		return "", "", "", xerrors.Errorf("invalid provider address %q", address)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// verifyProviderPackage returns nil if the package at path matches one of the
+// lock file hashes. Terraform records the SHA-256 of the packages of all
+// platforms as "zh:" hashes, and the hashes of the unpacked contents of
+// installed packages as "h1:" hashes.
+func verifyProviderPackage(path string, hashes []string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(hashes, "zh:"+hex.EncodeToString(h.Sum(nil))) {
+		return nil
+	}
+	h1, err := dirhash.HashZip(path, dirhash.Hash1)
+	if err != nil {
+		return xerrors.Errorf("hash package: %w", err)
+	}
+	if slices.Contains(hashes, h1) {
+		return nil
+	}
+	return xerrors.New("package doesn't match any of the hashes in the lock file")
+}
+
+// mirrorProvider downloads a provider package using the provider registry
+// protocol, and moves it to path once it has been verified.
+func mirrorProvider(ctx context.Context, client *http.Client, hostname, namespace, typ, version, goos, goarch, path string, hashes []string) error {
+	discoveryURL := &url.URL{Scheme: "https", Host: hostname, Path: "/.well-known/terraform.json"}
+	var services struct {
+		ProvidersV1 string `json:"providers.v1"`
+	}
+	err := getRegistryJSON(ctx, client, discoveryURL, &services)
+	if err != nil {
+		return xerrors.Errorf("discover registry: %w", err)
+	}
+	if services.ProvidersV1 == "" {
+		return xerrors.Errorf("%s is not a provider registry", hostname)
+	}
+	providersURL, err := discoveryURL.Parse(services.ProvidersV1)
+	if err != nil {
+		return xerrors.Errorf("parse providers url: %w", err)
+	}
+
+	downloadURL := providersURL.JoinPath(namespace, typ, version, "download", goos, goarch)
+	var download struct {
+		DownloadURL string `json:"download_url"`
+		SHASum      string `json:"shasum"`
+	}
+	err = getRegistryJSON(ctx, client, downloadURL, &download)
+	if err != nil {
+		return xerrors.Errorf("get package: %w", err)
+	}
+	packageURL, err := downloadURL.Parse(download.DownloadURL)
+	if err != nil {
+		return xerrors.Errorf("parse download url: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	res, err := getRegistry(ctx, client, packageURL)
+	if err != nil {
+		return xerrors.Errorf("download package: %w", err)
+	}
+	defer res.Body.Close()
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, h), res.Body)
+	if err != nil {
+		return xerrors.Errorf("download package: %w", err)
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); download.SHASum != "" && sum != download.SHASum {
+		return xerrors.Errorf("checksum mismatch: got %s, registry reported %s", sum, download.SHASum)
+	}
+	err = verifyProviderPackage(tmp.Name(), hashes)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func getRegistry(ctx context.Context, client *http.Client, u *url.URL) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		_ = res.Body.Close()
+		return nil, xerrors.Errorf("GET %s: unexpected status %s", u, res.Status)
+	}
+	return res, nil
+}
+
+func getRegistryJSON(ctx context.Context, client *http.Client, u *url.URL, v any) error {
+	res, err := getRegistry(ctx, client, u)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,168 @@
+package terraform_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/sumdb/dirhash"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/coder/v2/provisioner/terraform"
+)
+
+func TestMirrorProviders(t *testing.T) {
+	t.Parallel()
+
+	// providerPackage creates a provider package with a fake provider binary.
+	providerPackage := func(t *testing.T, platform string) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, err := zw.Create("terraform-provider-coder_v0.23.0")
+		require.NoError(t, err)
+		_, err = w.Write([]byte("fake provider for " + platform))
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		return buf.Bytes()
+	}
+	zh := func(pkg []byte) string {
+		sum := sha256.Sum256(pkg)
+		return "zh:" + hex.EncodeToString(sum[:])
+	}
+
+	// setupRegistry serves the coder provider over the provider registry
+	// protocol, and returns its hostname.
+	setupRegistry := func(t *testing.T, packages map[string][]byte) (string, *http.Client) {
+		t.Helper()
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/terraform.json", func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{"providers.v1": "/v1/providers/"})
+		})
+		for platform, pkg := range packages {
+			goos, goarch, _ := strings.Cut(platform, "_")
+			mux.HandleFunc(fmt.Sprintf("/v1/providers/coder/coder/0.23.0/download/%s/%s", goos, goarch), func(w http.ResponseWriter, _ *http.Request) {
+				sum := sha256.Sum256(pkg)
+				_ = json.NewEncoder(w).Encode(map[string]string{
+					"download_url": "/packages/" + platform + ".zip",
+					"shasum":       hex.EncodeToString(sum[:]),
+				})
+			})
+			mux.HandleFunc("/packages/"+platform+".zip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write(pkg)
+			})
+		}
+		srv := httptest.NewTLSServer(mux)
+		t.Cleanup(srv.Close)
+		u, err := url.Parse(srv.URL)
+		require.NoError(t, err)
+		return u.Host, srv.Client()
+	}
+
+	lockFile := func(hostname string, hashes ...string) []byte {
+		var buf bytes.Buffer
+		_, _ = fmt.Fprintf(&buf, "provider %q {\n  version     = \"0.23.0\"\n  constraints = \"~> 0.23.0\"\n  hashes = [\n", hostname+"/coder/coder")
+		for _, h := range hashes {
+			_, _ = fmt.Fprintf(&buf, "    %q,\n", h)
+		}
+		_, _ = fmt.Fprint(&buf, "  ]\n}\n")
+		return buf.Bytes()
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		linux, darwin := providerPackage(t, "linux_amd64"), providerPackage(t, "darwin_arm64")
+		hostname, client := setupRegistry(t, map[string][]byte{"linux_amd64": linux, "darwin_arm64": darwin})
+		dir := t.TempDir()
+		opts := terraform.MirrorOptions{
+			LockFile:   lockFile(hostname, zh(linux), zh(darwin)),
+			Dir:        dir,
+			Platforms:  []string{"linux_amd64", "darwin_arm64"},
+			HTTPClient: client,
+		}
+
+		mirrored, err := terraform.MirrorProviders(context.Background(), slogtest.Make(t, nil), opts)
+		require.NoError(t, err)
+		require.Len(t, mirrored, 2)
+		for _, mp := range mirrored {
+			require.False(t, mp.Cached)
+			require.Equal(t, hostname+"/coder/coder", mp.Address)
+			require.Equal(t, "0.23.0", mp.Version)
+		}
+
+		// The packages are in the packed layout of filesystem mirrors.
+		got, err := os.ReadFile(filepath.Join(dir, hostname, "coder", "coder", "terraform-provider-coder_0.23.0_linux_amd64.zip"))
+		require.NoError(t, err)
+		require.Equal(t, linux, got)
+		got, err = os.ReadFile(filepath.Join(dir, hostname, "coder", "coder", "terraform-provider-coder_0.23.0_darwin_arm64.zip"))
+		require.NoError(t, err)
+		require.Equal(t, darwin, got)
+
+		// Packages already in the mirror aren't downloaded again.
+		mirrored, err = terraform.MirrorProviders(context.Background(), slogtest.Make(t, nil), opts)
+		require.NoError(t, err)
+		require.Len(t, mirrored, 2)
+		for _, mp := range mirrored {
+			require.True(t, mp.Cached)
+		}
+	})
+
+	t.Run("H1Hash", func(t *testing.T) {
+		t.Parallel()
+
+		linux := providerPackage(t, "linux_amd64")
+		hostname, client := setupRegistry(t, map[string][]byte{"linux_amd64": linux})
+		pkgPath := filepath.Join(t.TempDir(), "provider.zip")
+		require.NoError(t, os.WriteFile(pkgPath, linux, 0o600))
+		h1, err := dirhash.HashZip(pkgPath, dirhash.Hash1)
+		require.NoError(t, err)
+
+		_, err = terraform.MirrorProviders(context.Background(), slogtest.Make(t, nil), terraform.MirrorOptions{
+			LockFile:   lockFile(hostname, h1),
+			Dir:        t.TempDir(),
+			Platforms:  []string{"linux_amd64"},
+			HTTPClient: client,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("NotLocked", func(t *testing.T) {
+		t.Parallel()
+
+		linux := providerPackage(t, "linux_amd64")
+		hostname, client := setupRegistry(t, map[string][]byte{"linux_amd64": linux})
+		dir := t.TempDir()
+
+		_, err := terraform.MirrorProviders(context.Background(), slogtest.Make(t, nil), terraform.MirrorOptions{
+			LockFile:   lockFile(hostname, zh(providerPackage(t, "other"))),
+			Dir:        dir,
+			Platforms:  []string{"linux_amd64"},
+			HTTPClient: client,
+		})
+		require.ErrorContains(t, err, "doesn't match any of the hashes in the lock file")
+		require.NoFileExists(t, filepath.Join(dir, hostname, "coder", "coder", "terraform-provider-coder_0.23.0_linux_amd64.zip"))
+	})
+
+	t.Run("InvalidLockFile", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := terraform.MirrorProviders(context.Background(), slogtest.Make(t, nil), terraform.MirrorOptions{
+			LockFile: []byte(`provider "coder/coder" {}`),
+			Dir:      t.TempDir(),
+		})
+		require.ErrorContains(t, err, "parse lock file")
+	})
+}
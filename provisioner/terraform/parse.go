@@ -17,6 +17,8 @@ import (
 	"github.com/mitchellh/go-wordwrap"
 	"golang.org/x/xerrors"
 
+	"cdr.dev/slog"
+
 	"github.com/coder/coder/v2/coderd/tracing"
 	"github.com/coder/coder/v2/provisionersdk"
 	"github.com/coder/coder/v2/provisionersdk/proto"
@@ -34,7 +36,7 @@ func (s *server) Parse(sess *provisionersdk.Session, _ *proto.ParseRequest, _ <-
 		return provisionersdk.ParseErrorf("load module: %s", formatDiagnostics(sess.WorkDirectory, diags))
 	}
 
-	workspaceTags, err := s.loadWorkspaceTags(ctx, module)
+	workspaceTags, err := loadWorkspaceTags(ctx, s.logger, module)
 	if err != nil {
 		return provisionersdk.ParseErrorf("can't load workspace tags: %v", err)
 	}
@@ -67,12 +69,12 @@ var coderWorkspaceTagsSchema = &hcl.BodySchema{
 	},
 }
 
-func (s *server) loadWorkspaceTags(ctx context.Context, module *tfconfig.Module) (map[string]string, error) {
+func loadWorkspaceTags(ctx context.Context, logger slog.Logger, module *tfconfig.Module) (map[string]string, error) {
 	workspaceTags := map[string]string{}
 
 	for _, dataResource := range module.DataResources {
 		if dataResource.Type != "coder_workspace_tags" {
-			s.logger.Debug(ctx, "skip resource as it is not a coder_workspace_tags", "resource_name", dataResource.Name, "resource_type", dataResource.Type)
+			logger.Debug(ctx, "skip resource as it is not a coder_workspace_tags", "resource_name", dataResource.Name, "resource_type", dataResource.Type)
 			continue
 		}
 
@@ -81,7 +83,7 @@ func (s *server) loadWorkspaceTags(ctx context.Context, module *tfconfig.Module)
 		parser := hclparse.NewParser()
 
 		if !strings.HasSuffix(dataResource.Pos.Filename, ".tf") {
-			s.logger.Debug(ctx, "only .tf files can be parsed", "filename", dataResource.Pos.Filename)
+			logger.Debug(ctx, "only .tf files can be parsed", "filename", dataResource.Pos.Filename)
 			continue
 		}
 		// We know in which HCL file is the data resource defined.
@@ -136,7 +138,7 @@ func (s *server) loadWorkspaceTags(ctx context.Context, module *tfconfig.Module)
 					return nil, xerrors.Errorf("can't preview the resource file: %v", err)
 				}
 
-				s.logger.Info(ctx, "workspace tag found", "key", key, "value", value)
+				logger.Info(ctx, "workspace tag found", "key", key, "value", value)
 
 				if _, ok := workspaceTags[key]; ok {
 					return nil, xerrors.Errorf(`workspace tag "%s" is defined multiple times`, key)
@@ -0,0 +1,63 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+	"golang.org/x/xerrors"
+)
+
+// pluginCacheLockFile is locked while providers are added to or removed from
+// the plugin cache. Terraform doesn't support concurrent writes to the cache,
+// but provisioners may share it.
+const pluginCacheLockFile = "plugins.lock"
+
+// lockPluginCache blocks until the plugin cache at cachePath is locked, or the
+// context is canceled.
+func lockPluginCache(ctx context.Context, cachePath string) (unlock func(), err error) {
+	if cachePath == "" {
+		return func() {}, nil
+	}
+	err = os.MkdirAll(cachePath, 0o750)
+	if err != nil {
+		return nil, xerrors.Errorf("create plugin cache: %w", err)
+	}
+
+	lockFilePath := filepath.Join(cachePath, pluginCacheLockFile)
+	lock := flock.New(lockFilePath)
+	ok, err := lock.TryLockContext(ctx, time.Millisecond*100)
+	if !ok {
+		return nil, xerrors.Errorf("could not acquire flock for %v: %w", lockFilePath, err)
+	}
+	return func() {
+		_ = lock.Close()
+	}, nil
+}
+
+// writeProviderMirrorConfig writes a Terraform CLI configuration to path that
+// installs all providers from the filesystem mirror at mirrorPath.
+func writeProviderMirrorConfig(path, mirrorPath string) error {
+	mirrorPath, err := filepath.Abs(mirrorPath)
+	if err != nil {
+		return xerrors.Errorf("unable to determine absolute path %q: %w", mirrorPath, err)
+	}
+	// Providers missing from the mirror fail to install instead of being
+	// downloaded from their registries, which is what air-gapped deployments
+	// expect.
+	config := fmt.Sprintf(`provider_installation {
+  filesystem_mirror {
+    path = %q
+  }
+}
+`, mirrorPath)
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(config), 0o600)
+}
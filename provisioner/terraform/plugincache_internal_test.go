@@ -0,0 +1,52 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestLockPluginCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	cachePath := filepath.Join(t.TempDir(), "plugins")
+
+	unlock, err := lockPluginCache(ctx, cachePath)
+	require.NoError(t, err)
+
+	// Another provisioner sharing the cache has to wait for the lock.
+	waitCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+	_, err = lockPluginCache(waitCtx, cachePath)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+	unlock, err = lockPluginCache(ctx, cachePath)
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestWriteProviderMirrorConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mirror := filepath.Join(dir, "providers")
+	configPath := filepath.Join(dir, "cache", "provider-mirror.tfrc")
+
+	err := writeProviderMirrorConfig(configPath, mirror)
+	require.NoError(t, err)
+	config, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.Contains(t, string(config), fmt.Sprintf("path = %q", mirror))
+
+	e := &executor{cliConfigPath: configPath}
+	require.Contains(t, e.basicEnv(), "TF_CLI_CONFIG_FILE="+configPath)
+}
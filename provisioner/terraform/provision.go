@@ -96,8 +96,15 @@ func (s *server) Plan(
 		}
 	}
 
-	err := CleanStaleTerraformPlugins(sess.Context(), s.cachePath, afero.NewOsFs(), time.Now(), s.logger)
+	// The plugin cache may be shared with other provisioners, so it's locked
+	// while stale plugins are removed and init installs providers to it.
+	unlockPluginCache, err := lockPluginCache(ctx, s.cachePath)
 	if err != nil {
+		return provisionersdk.PlanErrorf("lock plugin cache: %s", err)
+	}
+	err = CleanStaleTerraformPlugins(sess.Context(), s.cachePath, afero.NewOsFs(), time.Now(), s.logger)
+	if err != nil {
+		unlockPluginCache()
 		return provisionersdk.PlanErrorf("unable to clean stale Terraform plugins: %s", err)
 	}
 
@@ -109,6 +116,7 @@ func (s *server) Plan(
 	initTimings.ingest(createInitTimingsEvent(timingInitStart))
 
 	err = e.init(ctx, killCtx, sess)
+	unlockPluginCache()
 	if err != nil {
 		initTimings.ingest(createInitTimingsEvent(timingInitErrored))
 
@@ -133,6 +141,7 @@ func (s *server) Plan(
 	resp, err := e.plan(
 		ctx, killCtx, env, vars, sess,
 		request.Metadata.GetWorkspaceTransition() == proto.WorkspaceTransition_DESTROY,
+		request.GetRefreshOnly(),
 	)
 	if err != nil {
 		return provisionersdk.PlanErrorf(err.Error())
@@ -30,6 +30,7 @@ import (
 
 type provisionerServeOptions struct {
 	binaryPath  string
+	flavor      terraform.Flavor
 	exitTimeout time.Duration
 }
 
@@ -59,6 +60,7 @@ func setupProvisioner(t *testing.T, opts *provisionerServeOptions) (context.Cont
 				WorkDirectory: workDir,
 			},
 			BinaryPath:  opts.binaryPath,
+			Flavor:      opts.flavor,
 			CachePath:   cachePath,
 			ExitTimeout: opts.exitTimeout,
 		})
@@ -282,6 +284,62 @@ func TestProvision_CancelTimeout(t *testing.T) {
 	}
 }
 
+// below we exec fake_tofu.sh, which causes the kernel to execute it, and if more than
+// one process tries to do this simultaneously, it can cause "text file busy"
+// nolint: paralleltest
+func TestProvision_OpenTofu(t *testing.T) {
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+	fakeBin := filepath.Join(cwd, "testdata", "fake_tofu.sh")
+
+	dir := t.TempDir()
+	binPath := filepath.Join(dir, "tofu")
+
+	// OpenTofu 1.6 supports `graph -type=plan`, which Terraform only
+	// supports since 1.7.
+	content := fmt.Sprintf("#!/bin/sh\nexec %q %s \"$@\"\n", fakeBin, "1.6.2")
+	err = os.WriteFile(binPath, []byte(content), 0o755) //#nosec
+	require.NoError(t, err)
+
+	ctx, api := setupProvisioner(t, &provisionerServeOptions{
+		binaryPath: binPath,
+		flavor:     terraform.FlavorOpenTofu,
+	})
+	sess := configure(ctx, t, api, &proto.Config{
+		TemplateSourceArchive: makeTar(t, nil),
+	})
+
+	err = sendPlan(sess, proto.WorkspaceTransition_START)
+	require.NoError(t, err)
+
+	var logs []string
+	for {
+		msg, err := sess.Recv()
+		require.NoError(t, err)
+
+		if log := msg.GetLog(); log != nil {
+			logs = append(logs, log.Output)
+			continue
+		}
+		c := msg.GetPlan()
+		require.NotNil(t, c)
+		require.Empty(t, c.Error)
+
+		var planTimings []*proto.Timing
+		for _, timing := range c.Timings {
+			if timing.Stage == "plan" {
+				planTimings = append(planTimings, timing)
+			}
+		}
+		require.Len(t, planTimings, 1)
+		require.Equal(t, "data.coder_workspace.me", planTimings[0].Resource)
+		require.Equal(t, "coder", planTimings[0].Source)
+		break
+	}
+	require.Contains(t, logs, "OpenTofu has been successfully initialized!")
+	require.Contains(t, logs, "No changes. Your infrastructure matches the configuration.")
+}
+
 func TestProvision(t *testing.T) {
 	t.Parallel()
 
@@ -127,6 +127,9 @@ type State struct {
 	Resources             []*proto.Resource
 	Parameters            []*proto.RichParameter
 	ExternalAuthProviders []*proto.ExternalAuthProviderResource
+	// ResourceChanges is only populated when the state was converted
+	// from a plan.
+	ResourceChanges []*proto.ResourceChange
 }
 
 // ConvertState consumes Terraform state and a GraphViz representation
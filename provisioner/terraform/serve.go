@@ -21,12 +21,25 @@ import (
 type ServeOptions struct {
 	*provisionersdk.ServeOptions
 
-	// BinaryPath specifies the "terraform" binary to use.
+	// Flavor is the distribution of Terraform to run. Defaults to
+	// Terraform.
+	Flavor Flavor
+	// BinaryPath specifies the "terraform" or "tofu" binary to use.
 	// If omitted, the $PATH will attempt to find it.
 	BinaryPath string
+	// InstallMirror is the URL or local directory that Terraform is
+	// installed from when no usable binary is found. See InstallOptions.
+	InstallMirror string
 	// CachePath must not be used by multiple processes at once.
 	CachePath string
-	Tracer    trace.Tracer
+	// PluginCachePath is where providers are cached across jobs. Unlike
+	// CachePath, it may be shared by provisioners, as they lock it while
+	// installing providers. Defaults to CachePath.
+	PluginCachePath string
+	// ProviderMirror is a local directory that providers are installed from
+	// instead of their registries, e.g. as populated by MirrorProviders.
+	ProviderMirror string
+	Tracer         trace.Tracer
 
 	// ExitTimeout defines how long we will wait for a running Terraform
 	// command to exit (cleanly) if the provision was stopped. This
@@ -41,10 +54,10 @@ type ServeOptions struct {
 	ExitTimeout time.Duration
 }
 
-func absoluteBinaryPath(ctx context.Context, logger slog.Logger) (string, error) {
-	binaryPath, err := safeexec.LookPath("terraform")
+func absoluteBinaryPath(ctx context.Context, logger slog.Logger, flavor Flavor) (string, error) {
+	binaryPath, err := safeexec.LookPath(flavor.command())
 	if err != nil {
-		return "", xerrors.Errorf("Terraform binary not found: %w", err)
+		return "", xerrors.Errorf("%s binary not found: %w", flavor.DisplayName(), err)
 	}
 
 	// If the "coder" binary is in the same directory as
@@ -54,32 +67,33 @@ func absoluteBinaryPath(ctx context.Context, logger slog.Logger) (string, error)
 	// to execute this properly!
 	absoluteBinary, err := filepath.Abs(binaryPath)
 	if err != nil {
-		return "", xerrors.Errorf("Terraform binary absolute path not found: %w", err)
+		return "", xerrors.Errorf("%s binary absolute path not found: %w", flavor.DisplayName(), err)
 	}
 
 	// Checking the installed version of Terraform.
 	installedVersion, err := versionFromBinaryPath(ctx, absoluteBinary)
 	if err != nil {
-		return "", xerrors.Errorf("Terraform binary get version failed: %w", err)
+		return "", xerrors.Errorf("%s binary get version failed: %w", flavor.DisplayName(), err)
 	}
 
-	logger.Info(ctx, "detected terraform version",
+	minVersion, maxVersion := flavor.versionConstraints()
+	logger.Info(ctx, "detected "+flavor.command()+" version",
 		slog.F("installed_version", installedVersion.String()),
-		slog.F("min_version", minTerraformVersion.String()),
-		slog.F("max_version", maxTerraformVersion.String()))
+		slog.F("min_version", minVersion.String()),
+		slog.F("max_version", maxVersion.String()))
 
-	if installedVersion.LessThan(minTerraformVersion) {
-		logger.Warn(ctx, "installed terraform version too old, will download known good version to cache")
+	if installedVersion.LessThan(minVersion) {
+		logger.Warn(ctx, "installed "+flavor.command()+" version too old, will download known good version to cache")
 		return "", terraformMinorVersionMismatch
 	}
 
 	// Warn if the installed version is newer than what we've decided is the max.
 	// We used to ignore it and download our own version but this makes it easier
 	// to test out newer versions of Terraform.
-	if installedVersion.GreaterThanOrEqual(maxTerraformVersion) {
-		logger.Warn(ctx, "installed terraform version newer than expected, you may experience bugs",
+	if installedVersion.GreaterThanOrEqual(maxVersion) {
+		logger.Warn(ctx, "installed "+flavor.command()+" version newer than expected, you may experience bugs",
 			slog.F("installed_version", installedVersion.String()),
-			slog.F("max_version", maxTerraformVersion.String()))
+			slog.F("max_version", maxVersion.String()))
 	}
 
 	return absoluteBinary, nil
@@ -87,8 +101,14 @@ func absoluteBinaryPath(ctx context.Context, logger slog.Logger) (string, error)
 
 // Serve starts a dRPC server on the provided transport speaking Terraform provisioner.
 func Serve(ctx context.Context, options *ServeOptions) error {
+	if options.Flavor == "" {
+		options.Flavor = FlavorTerraform
+	}
+	if !options.Flavor.Valid() {
+		return xerrors.Errorf("unsupported terraform flavor %q", options.Flavor)
+	}
 	if options.BinaryPath == "" {
-		absoluteBinary, err := absoluteBinaryPath(ctx, options.Logger)
+		absoluteBinary, err := absoluteBinaryPath(ctx, options.Logger, options.Flavor)
 		if err != nil {
 			// This is an early exit to prevent extra execution in case the context is canceled.
 			// It generally happens in unit tests since this method is asynchronous and
@@ -97,18 +117,32 @@ func Serve(ctx context.Context, options *ServeOptions) error {
 				return xerrors.Errorf("absolute binary context canceled: %w", err)
 			}
 
-			options.Logger.Warn(ctx, "no usable terraform binary found, downloading to cache dir",
-				slog.F("terraform_version", TerraformVersion.String()),
+			options.Logger.Warn(ctx, "no usable "+options.Flavor.command()+" binary found, downloading to cache dir",
+				slog.F("terraform_version", options.Flavor.Version().String()),
 				slog.F("cache_dir", options.CachePath))
-			binPath, err := Install(ctx, options.Logger, options.CachePath, TerraformVersion)
+			binPath, err := Install(ctx, options.Logger, options.CachePath, options.Flavor.Version(), InstallOptions{
+				Flavor: options.Flavor,
+				Mirror: options.InstallMirror,
+			})
 			if err != nil {
-				return xerrors.Errorf("install terraform: %w", err)
+				return xerrors.Errorf("install %s: %w", options.Flavor.command(), err)
 			}
 			options.BinaryPath = binPath
 		} else {
 			options.BinaryPath = absoluteBinary
 		}
 	}
+	if options.PluginCachePath == "" {
+		options.PluginCachePath = options.CachePath
+	}
+	var cliConfigPath string
+	if options.ProviderMirror != "" {
+		cliConfigPath = filepath.Join(options.CachePath, "provider-mirror.tfrc")
+		err := writeProviderMirrorConfig(cliConfigPath, options.ProviderMirror)
+		if err != nil {
+			return xerrors.Errorf("write provider mirror config: %w", err)
+		}
+	}
 	if options.Tracer == nil {
 		options.Tracer = trace.NewNoopTracerProvider().Tracer("noop")
 	}
@@ -116,22 +150,27 @@ func Serve(ctx context.Context, options *ServeOptions) error {
 		options.ExitTimeout = unhanger.HungJobExitTimeout
 	}
 	return provisionersdk.Serve(ctx, &server{
-		execMut:     &sync.Mutex{},
-		binaryPath:  options.BinaryPath,
-		cachePath:   options.CachePath,
-		logger:      options.Logger,
-		tracer:      options.Tracer,
-		exitTimeout: options.ExitTimeout,
+		execMut:       &sync.Mutex{},
+		flavor:        options.Flavor,
+		binaryPath:    options.BinaryPath,
+		cachePath:     options.PluginCachePath,
+		cliConfigPath: cliConfigPath,
+		logger:        options.Logger,
+		tracer:        options.Tracer,
+		exitTimeout:   options.ExitTimeout,
 	}, options.ServeOptions)
 }
 
 type server struct {
-	execMut     *sync.Mutex
-	binaryPath  string
-	cachePath   string
-	logger      slog.Logger
-	tracer      trace.Tracer
-	exitTimeout time.Duration
+	execMut    *sync.Mutex
+	flavor     Flavor
+	binaryPath string
+	// cachePath is the plugin cache, see ServeOptions.PluginCachePath.
+	cachePath     string
+	cliConfigPath string
+	logger        slog.Logger
+	tracer        trace.Tracer
+	exitTimeout   time.Duration
 }
 
 func (s *server) startTrace(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
@@ -142,12 +181,14 @@ func (s *server) startTrace(ctx context.Context, name string, opts ...trace.Span
 
 func (s *server) executor(workdir string, stage database.ProvisionerJobTimingStage) *executor {
 	return &executor{
-		server:     s,
-		mut:        s.execMut,
-		binaryPath: s.binaryPath,
-		cachePath:  s.cachePath,
-		workdir:    workdir,
-		logger:     s.logger.Named("executor"),
-		timings:    newTimingAggregator(stage),
+		server:        s,
+		mut:           s.execMut,
+		flavor:        s.flavor,
+		binaryPath:    s.binaryPath,
+		cachePath:     s.cachePath,
+		cliConfigPath: s.cliConfigPath,
+		workdir:       workdir,
+		logger:        s.logger.Named("executor"),
+		timings:       newTimingAggregator(stage),
 	}
 }
@@ -20,6 +20,7 @@ import (
 func Test_absoluteBinaryPath(t *testing.T) {
 	tests := []struct {
 		name             string
+		flavor           Flavor
 		terraformVersion string
 		expectedErr      error
 	}{
@@ -48,6 +49,24 @@ func Test_absoluteBinaryPath(t *testing.T) {
 			terraformVersion: "version",
 			expectedErr:      xerrors.Errorf("Terraform binary get version failed: Malformed version: version"),
 		},
+		{
+			name:             "TestOpenTofuCorrectVersion",
+			flavor:           FlavorOpenTofu,
+			terraformVersion: "1.8.3",
+			expectedErr:      nil,
+		},
+		{
+			name:             "TestOpenTofuOldVersion",
+			flavor:           FlavorOpenTofu,
+			terraformVersion: "1.5.7",
+			expectedErr:      terraformMinorVersionMismatch,
+		},
+		{
+			name:             "TestOpenTofuMalformedVersion",
+			flavor:           FlavorOpenTofu,
+			terraformVersion: "version",
+			expectedErr:      xerrors.Errorf("OpenTofu binary get version failed: Malformed version: version"),
+		},
 	}
 	// nolint:paralleltest
 	for _, tt := range tests {
@@ -69,9 +88,10 @@ func Test_absoluteBinaryPath(t *testing.T) {
 			}
 			EOF`, tt.terraformVersion)
 
+			// OpenTofu reports its version in the same format.
 			// #nosec
 			err := os.WriteFile(
-				filepath.Join(tempDir, "terraform"),
+				filepath.Join(tempDir, tt.flavor.command()),
 				[]byte(terraformBinaryOutput),
 				0o770,
 			)
@@ -83,11 +103,11 @@ func Test_absoluteBinaryPath(t *testing.T) {
 
 			var expectedAbsoluteBinary string
 			if tt.expectedErr == nil {
-				expectedAbsoluteBinary = filepath.Join(tempDir, "terraform")
+				expectedAbsoluteBinary = filepath.Join(tempDir, tt.flavor.command())
 			}
 
 			ctx := testutil.Context(t, testutil.WaitShort)
-			actualAbsoluteBinary, actualErr := absoluteBinaryPath(ctx, log)
+			actualAbsoluteBinary, actualErr := absoluteBinaryPath(ctx, log, tt.flavor)
 
 			require.Equal(t, expectedAbsoluteBinary, actualAbsoluteBinary)
 			if tt.expectedErr == nil {
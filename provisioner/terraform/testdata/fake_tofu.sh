@@ -0,0 +1,46 @@
+#!/bin/sh
+
+# A fake OpenTofu binary. Unlike Terraform of the same version, OpenTofu
+# supports `graph -type=plan`, so graphing fails without it.
+
+VERSION=$1
+shift 1
+
+case "$1" in
+version)
+	cat <<-EOF
+		{
+			"terraform_version": "${VERSION}",
+			"platform": "linux_amd64",
+			"provider_selections": {},
+			"terraform_outdated": false
+		}
+	EOF
+	exit 0
+	;;
+init)
+	echo "OpenTofu has been successfully initialized!"
+	exit 0
+	;;
+plan)
+	echo "{\"@level\":\"info\",\"@message\":\"OpenTofu ${VERSION}\",\"@module\":\"tofu.ui\",\"@timestamp\":\"2024-10-10T10:02:13.102736+02:00\",\"tofu\":\"${VERSION}\",\"type\":\"version\",\"ui\":\"1.2\"}"
+	echo '{"@level":"info","@message":"data.coder_workspace.me: Refreshing...","@module":"tofu.ui","@timestamp":"2024-10-10T10:02:13.541026+02:00","hook":{"resource":{"addr":"data.coder_workspace.me","module":"","resource":"data.coder_workspace.me","implied_provider":"coder","resource_type":"coder_workspace","resource_name":"me","resource_key":null},"action":"read"},"type":"apply_start"}'
+	echo '{"@level":"info","@message":"data.coder_workspace.me: Refresh complete after 0s","@module":"tofu.ui","@timestamp":"2024-10-10T10:02:13.542915+02:00","hook":{"resource":{"addr":"data.coder_workspace.me","module":"","resource":"data.coder_workspace.me","implied_provider":"coder","resource_type":"coder_workspace","resource_name":"me","resource_key":null},"action":"read","elapsed_seconds":0},"type":"apply_complete"}'
+	echo '{"@level":"info","@message":"No changes. Your infrastructure matches the configuration.","@module":"tofu.ui","@timestamp":"2024-10-10T10:02:13.547205+02:00","changes":{"add":0,"change":0,"import":0,"remove":0,"forget":0,"operation":"plan"},"type":"change_summary"}'
+	exit 0
+	;;
+show)
+	echo "{\"format_version\":\"1.2\",\"terraform_version\":\"${VERSION}\",\"planned_values\":{\"root_module\":{}},\"configuration\":{\"root_module\":{}}}"
+	exit 0
+	;;
+graph)
+	if [ "$2" != "-type=plan" ]; then
+		echo "unsupported graph arguments: $*" >&2
+		exit 1
+	fi
+	echo "digraph {}"
+	exit 0
+	;;
+esac
+
+exit 10
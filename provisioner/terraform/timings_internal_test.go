@@ -32,6 +32,8 @@ var (
 	inputFasterThanLight []byte
 	//go:embed testdata/timings-aggregation/multiple-resource-actions.txtar
 	multipleResourceActions []byte
+	//go:embed testdata/timings-aggregation/opentofu.txtar
+	inputOpenTofu []byte
 )
 
 func TestAggregation(t *testing.T) {
@@ -69,6 +71,10 @@ func TestAggregation(t *testing.T) {
 			name:  "multiple-resource-actions",
 			input: multipleResourceActions,
 		},
+		{
+			name:  "opentofu",
+			input: inputOpenTofu,
+		},
 	}
 
 	// nolint:paralleltest // Not since go v1.22.
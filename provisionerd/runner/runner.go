@@ -640,13 +640,14 @@ type templateImportProvision struct {
 	Resources             []*sdkproto.Resource
 	Parameters            []*sdkproto.RichParameter
 	ExternalAuthProviders []*sdkproto.ExternalAuthProviderResource
+	ResourceChanges       []*sdkproto.ResourceChange
 }
 
 // Performs a dry-run provision when importing a template.
 // This is used to detect resources that would be provisioned for a workspace in various states.
 // It doesn't define values for rich parameters as they're unknown during template import.
 func (r *Runner) runTemplateImportProvision(ctx context.Context, variableValues []*sdkproto.VariableValue, metadata *sdkproto.Metadata) (*templateImportProvision, error) {
-	return r.runTemplateImportProvisionWithRichParameters(ctx, variableValues, nil, metadata)
+	return r.runTemplateImportProvisionWithRichParameters(ctx, variableValues, nil, nil, metadata, false)
 }
 
 // Performs a dry-run provision with provided rich parameters.
@@ -655,24 +656,30 @@ func (r *Runner) runTemplateImportProvisionWithRichParameters(
 	ctx context.Context,
 	variableValues []*sdkproto.VariableValue,
 	richParameterValues []*sdkproto.RichParameterValue,
+	externalAuthProviders []*sdkproto.ExternalAuthProvider,
 	metadata *sdkproto.Metadata,
+	refreshOnly bool,
 ) (*templateImportProvision, error) {
 	ctx, span := r.startTrace(ctx, tracing.FuncName())
 	defer span.End()
 
 	var stage string
-	switch metadata.WorkspaceTransition {
-	case sdkproto.WorkspaceTransition_START:
+	switch {
+	case refreshOnly:
+		stage = "Detecting drifted resources"
+	case metadata.WorkspaceTransition == sdkproto.WorkspaceTransition_START:
 		stage = "Detecting persistent resources"
-	case sdkproto.WorkspaceTransition_STOP:
+	case metadata.WorkspaceTransition == sdkproto.WorkspaceTransition_STOP:
 		stage = "Detecting ephemeral resources"
 	}
 	// use the notStopped so that if we attempt to gracefully cancel, the stream will still be available for us
 	// to send the cancel to the provisioner
 	err := r.session.Send(&sdkproto.Request{Type: &sdkproto.Request_Plan{Plan: &sdkproto.PlanRequest{
-		Metadata:            metadata,
-		RichParameterValues: richParameterValues,
-		VariableValues:      variableValues,
+		Metadata:              metadata,
+		RichParameterValues:   richParameterValues,
+		VariableValues:        variableValues,
+		ExternalAuthProviders: externalAuthProviders,
+		RefreshOnly:           refreshOnly,
 	}}})
 	if err != nil {
 		return nil, xerrors.Errorf("start provision: %w", err)
@@ -731,6 +738,7 @@ func (r *Runner) runTemplateImportProvisionWithRichParameters(
 				Resources:             c.Resources,
 				Parameters:            c.Parameters,
 				ExternalAuthProviders: c.ExternalAuthProviders,
+				ResourceChanges:       c.ResourceChanges,
 			}, nil
 		default:
 			return nil, xerrors.Errorf("invalid message type %q received from provisioner",
@@ -752,7 +760,9 @@ func (r *Runner) runTemplateDryRun(ctx context.Context) (*proto.CompletedJob, *p
 	if metadata.WorkspaceName == "" {
 		metadata.WorkspaceName = "dryrun"
 	}
-	metadata.WorkspaceOwner = r.job.UserName
+	if metadata.WorkspaceOwner == "" {
+		metadata.WorkspaceOwner = r.job.UserName
+	}
 	if metadata.WorkspaceOwner == "" {
 		metadata.WorkspaceOwner = "dryrunner"
 	}
@@ -771,8 +781,11 @@ func (r *Runner) runTemplateDryRun(ctx context.Context) (*proto.CompletedJob, *p
 		metadata.WorkspaceOwnerId = id.String()
 	}
 
+	// The state is only set when the dry-run plans against an existing
+	// workspace, in which case the plan reports what would change.
 	failedJob := r.configure(&sdkproto.Config{
 		TemplateSourceArchive: r.job.GetTemplateSourceArchive(),
+		State:                 r.job.GetTemplateDryRun().GetState(),
 	})
 	if failedJob != nil {
 		return nil, failedJob
@@ -782,7 +795,9 @@ func (r *Runner) runTemplateDryRun(ctx context.Context) (*proto.CompletedJob, *p
 	provision, err := r.runTemplateImportProvisionWithRichParameters(ctx,
 		r.job.GetTemplateDryRun().GetVariableValues(),
 		r.job.GetTemplateDryRun().GetRichParameterValues(),
+		r.job.GetTemplateDryRun().GetExternalAuthProviders(),
 		metadata,
+		r.job.GetTemplateDryRun().GetRefreshOnly(),
 	)
 	if err != nil {
 		return nil, r.failedJobf("run dry-run provision job: %s", err)
@@ -792,7 +807,8 @@ func (r *Runner) runTemplateDryRun(ctx context.Context) (*proto.CompletedJob, *p
 		JobId: r.job.JobId,
 		Type: &proto.CompletedJob_TemplateDryRun_{
 			TemplateDryRun: &proto.CompletedJob_TemplateDryRun{
-				Resources: provision.Resources,
+				Resources:       provision.Resources,
+				ResourceChanges: provision.ResourceChanges,
 			},
 		},
 	}, nil
@@ -0,0 +1,110 @@
+package provisionersdk
+
+import (
+	"encoding/base64"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/coder/coder/v2/provisionersdk/proto"
+)
+
+const (
+	// RedactedValue replaces secrets in provisioner logs.
+	RedactedValue = "***REDACTED***"
+
+	// minSecretLength is the length below which values aren't redacted.
+	// Masking every occurrence of a short value would mangle the logs
+	// without protecting anything.
+	minSecretLength = 4
+)
+
+// Redactor masks secrets in provisioner logs. Each secret is also masked in
+// its base64 and URL-encoded forms, and each line of a multi-line secret is
+// masked on its own, since provisioners log output line by line.
+type Redactor struct {
+	secrets  []string
+	replacer *strings.Replacer
+}
+
+// NewRedactor returns a Redactor that masks the given secrets.
+func NewRedactor(secrets ...string) *Redactor {
+	r := &Redactor{}
+	r.Add(secrets...)
+	return r
+}
+
+// Add adds secrets to be masked.
+func (r *Redactor) Add(secrets ...string) {
+	for _, secret := range secrets {
+		forms := []string{secret}
+		for _, line := range strings.Split(secret, "\n") {
+			forms = append(forms, strings.TrimSpace(line))
+		}
+		forms = append(forms,
+			base64.StdEncoding.EncodeToString([]byte(secret)),
+			base64.RawStdEncoding.EncodeToString([]byte(secret)),
+			base64.URLEncoding.EncodeToString([]byte(secret)),
+			base64.RawURLEncoding.EncodeToString([]byte(secret)),
+			url.QueryEscape(secret),
+			url.PathEscape(secret),
+		)
+		for _, form := range forms {
+			if len(form) < minSecretLength || slices.Contains(r.secrets, form) {
+				continue
+			}
+			r.secrets = append(r.secrets, form)
+		}
+	}
+
+	// strings.Replacer prefers the first matching old string at each
+	// position, so the longest secrets go first to mask them entirely rather
+	// than a secret they contain.
+	slices.SortStableFunc(r.secrets, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	oldnew := make([]string, 0, len(r.secrets)*2)
+	for _, secret := range r.secrets {
+		oldnew = append(oldnew, secret, RedactedValue)
+	}
+	r.replacer = strings.NewReplacer(oldnew...)
+}
+
+// Redact returns s with all secrets masked.
+func (r *Redactor) Redact(s string) string {
+	if r == nil || len(r.secrets) == 0 {
+		return s
+	}
+	return r.replacer.Replace(s)
+}
+
+// PlanSecrets returns the values of a plan request that must not appear in
+// logs: sensitive template variables and rich parameters, external auth tokens
+// and the secrets of the workspace owner.
+func PlanSecrets(req *proto.PlanRequest) []string {
+	secrets := MetadataSecrets(req.GetMetadata())
+	for _, p := range req.GetRichParameterValues() {
+		if p.GetSensitive() {
+			secrets = append(secrets, p.GetValue())
+		}
+	}
+	for _, v := range req.GetVariableValues() {
+		if v.GetSensitive() {
+			secrets = append(secrets, v.GetValue())
+		}
+	}
+	for _, p := range req.GetExternalAuthProviders() {
+		secrets = append(secrets, p.GetAccessToken())
+	}
+	return secrets
+}
+
+// MetadataSecrets returns the secrets of the workspace owner in the metadata
+// of a request.
+func MetadataSecrets(metadata *proto.Metadata) []string {
+	return []string{
+		metadata.GetWorkspaceOwnerOidcAccessToken(),
+		metadata.GetWorkspaceOwnerSessionToken(),
+		metadata.GetWorkspaceOwnerSshPrivateKey(),
+	}
+}
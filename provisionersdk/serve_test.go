@@ -131,6 +131,89 @@ func TestProvisionerSDK(t *testing.T) {
 			require.NoError(t, err)
 		}
 	})
+
+	t.Run("RedactSecrets", func(t *testing.T) {
+		t.Parallel()
+		client, server := drpc.MemTransportPipe()
+		defer client.Close()
+		defer server.Close()
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+		go func() {
+			err := provisionersdk.Serve(ctx, echoingServer{}, &provisionersdk.ServeOptions{
+				Listener:      server,
+				WorkDirectory: t.TempDir(),
+			})
+			assert.NoError(t, err)
+		}()
+
+		api := proto.NewDRPCProvisionerClient(client)
+		s, err := api.Session(ctx)
+		require.NoError(t, err)
+		err = s.Send(&proto.Request{Type: &proto.Request_Config{Config: &proto.Config{}}})
+		require.NoError(t, err)
+
+		err = s.Send(&proto.Request{Type: &proto.Request_Plan{Plan: &proto.PlanRequest{
+			Metadata: &proto.Metadata{WorkspaceOwnerSessionToken: "session-token"},
+			RichParameterValues: []*proto.RichParameterValue{
+				{Name: "otp", Value: "482913", Sensitive: true},
+				{Name: "size", Value: "large"},
+			},
+			VariableValues: []*proto.VariableValue{
+				{Name: "password", Value: "hunter22", Sensitive: true},
+				{Name: "region", Value: "eu-west-1"},
+			},
+			ExternalAuthProviders: []*proto.ExternalAuthProvider{
+				{Id: "github", AccessToken: "gho_token"},
+			},
+		}}})
+		require.NoError(t, err)
+		logs, complete := recvLogs(t, s)
+		require.Equal(t, []string{
+			"token ***REDACTED***",
+			"password ***REDACTED***",
+			"region eu-west-1",
+			"github ***REDACTED***",
+			"otp ***REDACTED***",
+			"size large",
+		}, logs)
+		require.NotNil(t, complete.GetPlan())
+
+		// Apply only receives the metadata, but the secrets of the plan must
+		// still be masked.
+		err = s.Send(&proto.Request{Type: &proto.Request_Apply{Apply: &proto.ApplyRequest{
+			Metadata: &proto.Metadata{WorkspaceOwnerSessionToken: "session-token"},
+		}}})
+		require.NoError(t, err)
+		logs, complete = recvLogs(t, s)
+		require.Equal(t, []string{
+			"token ***REDACTED***",
+			"password ***REDACTED***",
+			"region eu-west-1",
+			"github ***REDACTED***",
+			"otp ***REDACTED***",
+			"size large",
+		}, logs)
+		// So must the error the job fails with.
+		require.Equal(t, "apply failed: password ***REDACTED***", complete.GetApply().GetError())
+	})
+}
+
+// recvLogs returns the logs received until the request completes, and the
+// response that completed it.
+func recvLogs(t *testing.T, s proto.DRPCProvisioner_SessionClient) ([]string, *proto.Response) {
+	t.Helper()
+	var logs []string
+	for {
+		msg, err := s.Recv()
+		require.NoError(t, err)
+		if log := msg.GetLog(); log != nil {
+			logs = append(logs, log.Output)
+			continue
+		}
+		return logs, msg
+	}
 }
 
 type unimplementedServer struct{}
@@ -146,3 +229,30 @@ func (unimplementedServer) Plan(_ *provisionersdk.Session, _ *proto.PlanRequest,
 func (unimplementedServer) Apply(_ *provisionersdk.Session, _ *proto.ApplyRequest, _ <-chan struct{}) *proto.ApplyComplete {
 	return &proto.ApplyComplete{Error: "unimplemented"}
 }
+
+// echoingServer logs the secrets it's given, like a careless Terraform
+// provider, and fails applies with one of them.
+type echoingServer struct{}
+
+func (echoingServer) Parse(_ *provisionersdk.Session, _ *proto.ParseRequest, _ <-chan struct{}) *proto.ParseComplete {
+	return &proto.ParseComplete{}
+}
+
+func (echoingServer) Plan(s *provisionersdk.Session, _ *proto.PlanRequest, _ <-chan struct{}) *proto.PlanComplete {
+	echoSecrets(s)
+	return &proto.PlanComplete{}
+}
+
+func (echoingServer) Apply(s *provisionersdk.Session, _ *proto.ApplyRequest, _ <-chan struct{}) *proto.ApplyComplete {
+	echoSecrets(s)
+	return &proto.ApplyComplete{Error: "apply failed: password hunter22"}
+}
+
+func echoSecrets(s *provisionersdk.Session) {
+	s.ProvisionLog(proto.LogLevel_INFO, "token session-token")
+	s.ProvisionLog(proto.LogLevel_INFO, "password hunter22")
+	s.ProvisionLog(proto.LogLevel_INFO, "region eu-west-1")
+	s.ProvisionLog(proto.LogLevel_INFO, "github gho_token")
+	s.ProvisionLog(proto.LogLevel_INFO, "otp 482913")
+	s.ProvisionLog(proto.LogLevel_INFO, "size large")
+}
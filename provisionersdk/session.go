@@ -38,9 +38,10 @@ type protoServer struct {
 func (p *protoServer) Session(stream proto.DRPCProvisioner_SessionStream) error {
 	sessID := uuid.New().String()
 	s := &Session{
-		Logger: p.opts.Logger.With(slog.F("session_id", sessID)),
-		stream: stream,
-		server: p.server,
+		Logger:   p.opts.Logger.With(slog.F("session_id", sessID)),
+		stream:   stream,
+		server:   p.server,
+		redactor: NewRedactor(),
 	}
 
 	err := CleanStaleSessions(s.Context(), p.opts.WorkDirectory, afero.NewOsFs(), time.Now(), s.Logger)
@@ -146,6 +147,9 @@ func (s *Session) handleRequests() error {
 			resp.Type = &proto.Response_Parse{Parse: complete}
 		}
 		if plan := req.GetPlan(); plan != nil {
+			// Handle secret redaction centrally too, so that providers echoing
+			// sensitive values don't leak them into the job logs.
+			s.redactor.Add(PlanSecrets(plan)...)
 			r := &request[*proto.PlanRequest, *proto.PlanComplete]{
 				req:      plan,
 				session:  s,
@@ -156,6 +160,9 @@ func (s *Session) handleRequests() error {
 			if err != nil {
 				return err
 			}
+			// Errors carry the output of the provisioner too, and become the
+			// error message of the job.
+			complete.Error = s.redactor.Redact(complete.Error)
 			resp.Type = &proto.Response_Plan{Plan: complete}
 			if complete.Error == "" {
 				planned = true
@@ -165,6 +172,7 @@ func (s *Session) handleRequests() error {
 			if !planned {
 				return xerrors.New("cannot apply before successful plan")
 			}
+			s.redactor.Add(MetadataSecrets(apply.GetMetadata())...)
 			r := &request[*proto.ApplyRequest, *proto.ApplyComplete]{
 				req:      apply,
 				session:  s,
@@ -175,6 +183,7 @@ func (s *Session) handleRequests() error {
 			if err != nil {
 				return err
 			}
+			complete.Error = s.redactor.Redact(complete.Error)
 			resp.Type = &proto.Response_Apply{Apply: complete}
 		}
 		err := s.stream.Send(resp)
@@ -193,6 +202,9 @@ type Session struct {
 	server   Server
 	stream   proto.DRPCProvisioner_SessionStream
 	logLevel int32
+	// redactor masks the secrets of the requests in logs. It's only updated
+	// between requests, while no logs are sent.
+	redactor *Redactor
 }
 
 func (s *Session) Context() context.Context {
@@ -290,6 +302,7 @@ func (s *Session) ProvisionLog(level proto.LogLevel, output string) {
 		return
 	}
 
+	output = s.redactor.Redact(output)
 	err := s.stream.Send(&proto.Response{Type: &proto.Response_Log{Log: &proto.Log{
 		Level:  level,
 		Output: output,
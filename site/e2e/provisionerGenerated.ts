@@ -83,6 +83,8 @@ export interface RichParameter {
 export interface RichParameterValue {
 	name: string;
 	value: string;
+	/** sensitive values are redacted from the job logs. */
+	sensitive: boolean;
 }
 
 /** VariableValue holds the key/value mapping of a Terraform variable. */
@@ -276,6 +278,12 @@ export interface PlanRequest {
 	richParameterValues: RichParameterValue[];
 	variableValues: VariableValue[];
 	externalAuthProviders: ExternalAuthProvider[];
+	/**
+	 * refresh_only plans to update the state to match the remote objects
+	 * instead of the configuration. The resource changes then describe
+	 * how the remote objects drifted from the state.
+	 */
+	refreshOnly: boolean;
 }
 
 /** PlanComplete indicates a request to plan completed. */
@@ -285,6 +293,26 @@ export interface PlanComplete {
 	parameters: RichParameter[];
 	externalAuthProviders: ExternalAuthProviderResource[];
 	timings: Timing[];
+	resourceChanges: ResourceChange[];
+}
+
+/**
+ * ResourceChange describes what a plan will do to a single resource that
+ * exists in, or is about to be added to, the workspace state.
+ */
+export interface ResourceChange {
+	address: string;
+	type: string;
+	name: string;
+	action: ResourceChange_Action;
+}
+
+export enum ResourceChange_Action {
+	CREATE = 0,
+	UPDATE = 1,
+	REPLACE = 2,
+	DESTROY = 3,
+	UNRECOGNIZED = -1,
 }
 
 /**
@@ -455,6 +483,9 @@ export const RichParameterValue = {
 		if (message.value !== "") {
 			writer.uint32(18).string(message.value);
 		}
+		if (message.sensitive === true) {
+			writer.uint32(24).bool(message.sensitive);
+		}
 		return writer;
 	},
 };
@@ -965,6 +996,9 @@ export const PlanRequest = {
 		for (const v of message.externalAuthProviders) {
 			ExternalAuthProvider.encode(v!, writer.uint32(34).fork()).ldelim();
 		}
+		if (message.refreshOnly === true) {
+			writer.uint32(40).bool(message.refreshOnly);
+		}
 		return writer;
 	},
 };
@@ -992,6 +1026,30 @@ export const PlanComplete = {
 		for (const v of message.timings) {
 			Timing.encode(v!, writer.uint32(50).fork()).ldelim();
 		}
+		for (const v of message.resourceChanges) {
+			ResourceChange.encode(v!, writer.uint32(58).fork()).ldelim();
+		}
+		return writer;
+	},
+};
+
+export const ResourceChange = {
+	encode(
+		message: ResourceChange,
+		writer: _m0.Writer = _m0.Writer.create(),
+	): _m0.Writer {
+		if (message.address !== "") {
+			writer.uint32(10).string(message.address);
+		}
+		if (message.type !== "") {
+			writer.uint32(18).string(message.type);
+		}
+		if (message.name !== "") {
+			writer.uint32(26).string(message.name);
+		}
+		if (message.action !== 0) {
+			writer.uint32(32).int32(message.action);
+		}
 		return writer;
 	},
 };
@@ -106,12 +106,44 @@ export interface AuditLog {
 	readonly user?: User;
 }
 
+// From codersdk/audit.go
+export interface AuditLogExportRequest {
+	readonly q?: string;
+	readonly format?: AuditLogExportFormat;
+}
+
 // From codersdk/audit.go
 export interface AuditLogResponse {
 	readonly audit_logs: Readonly<Array<AuditLog>>;
 	readonly count: number;
 }
 
+// From codersdk/deployment.go
+export interface AuditLoggingConfig {
+	readonly syslog: AuditLoggingSyslogConfig;
+	readonly http: AuditLoggingHTTPConfig;
+}
+
+// From codersdk/deployment.go
+export interface AuditLoggingHTTPConfig {
+	readonly endpoint: string;
+	readonly headers: string[];
+	readonly batch_size: number;
+	readonly flush_interval: number;
+	readonly max_retries: number;
+	readonly buffer_size: number;
+	readonly spool_directory: string;
+}
+
+// From codersdk/deployment.go
+export interface AuditLoggingSyslogConfig {
+	readonly address: string;
+	readonly protocol: string;
+	readonly app_name: string;
+	readonly ca_file: string;
+	readonly insecure_skip_verify: boolean;
+}
+
 // From codersdk/audit.go
 export interface AuditLogsRequest extends Pagination {
 	readonly q?: string;
@@ -184,6 +216,35 @@ export interface ConnectionLatency {
 	readonly p95: number;
 }
 
+// From codersdk/connectionlogs.go
+export interface ConnectionLog {
+	readonly id: string;
+	readonly connect_time: string;
+	readonly organization: MinimalOrganization;
+	readonly workspace_owner_id: string;
+	readonly workspace_owner_username: string;
+	readonly workspace_id: string;
+	readonly workspace_name: string;
+	readonly agent_name: string;
+	readonly type: ConnectionType;
+	readonly ip: string;
+	readonly user?: MinimalUser;
+	readonly disconnect_time?: string;
+	readonly code?: number;
+	readonly disconnect_reason?: string;
+}
+
+// From codersdk/connectionlogs.go
+export interface ConnectionLogResponse {
+	readonly connection_logs: Readonly<Array<ConnectionLog>>;
+	readonly count: number;
+}
+
+// From codersdk/connectionlogs.go
+export interface ConnectionLogsRequest extends Pagination {
+	readonly q?: string;
+}
+
 // From codersdk/users.go
 export interface ConvertLoginRequest {
 	readonly to_type: LoginType;
@@ -225,6 +286,17 @@ export interface CreateGroupRequest {
 	readonly quota_allowance: number;
 }
 
+// From codersdk/maintenancewindows.go
+export interface CreateMaintenanceWindowRequest {
+	readonly template_id?: string;
+	readonly name: string;
+	readonly message?: string;
+	readonly schedule: string;
+	readonly duration_ms: number;
+	readonly notice_ms?: number;
+	readonly block_builds?: boolean;
+}
+
 // From codersdk/organizations.go
 export interface CreateOrganizationRequest {
 	readonly name: string;
@@ -269,6 +341,7 @@ export interface CreateTemplateRequest {
 // From codersdk/templateversions.go
 export interface CreateTemplateVersionDryRunRequest {
 	readonly workspace_name: string;
+	readonly workspace_id?: string;
 	readonly rich_parameter_values: Readonly<Array<WorkspaceBuildParameter>>;
 	readonly user_variable_values?: Readonly<Array<VariableValue>>;
 }
@@ -286,6 +359,13 @@ export interface CreateTemplateVersionRequest {
 	readonly user_variable_values?: Readonly<Array<VariableValue>>;
 }
 
+// From codersdk/templateversionrollouts.go
+export interface CreateTemplateVersionRolloutRequest {
+	readonly template_version_id: string;
+	readonly percent: number;
+	readonly group_id?: string;
+}
+
 // From codersdk/audit.go
 export interface CreateTestAuditLogRequest {
 	readonly action?: AuditAction;
@@ -325,6 +405,12 @@ export interface CreateWorkspaceBuildRequest {
 	readonly log_level?: ProvisionerLogLevel;
 }
 
+// From codersdk/workspacebulkoperations.go
+export interface CreateWorkspaceBulkOperationRequest {
+	readonly action: WorkspaceBulkOperationAction;
+	readonly search: string;
+}
+
 // From codersdk/workspaceproxy.go
 export interface CreateWorkspaceProxyRequest {
 	readonly name: string;
@@ -485,6 +571,9 @@ export interface DeploymentValues {
 	readonly cli_upgrade_message?: string;
 	readonly terms_of_service_url?: string;
 	readonly notifications?: NotificationsConfig;
+	readonly audit_logging?: AuditLoggingConfig;
+	readonly session_recording?: SessionRecordingConfig;
+	readonly workspace_drift?: WorkspaceDriftConfig;
 	readonly config?: string;
 	readonly write_config?: boolean;
 	readonly address?: string;
@@ -656,6 +745,7 @@ export interface Healthcheck {
 export interface HealthcheckConfig {
 	readonly refresh: number;
 	readonly threshold_database: number;
+	readonly threshold_pubsub: number;
 }
 
 // From codersdk/workspaceagents.go
@@ -720,6 +810,21 @@ export interface LoginWithPasswordResponse {
 	readonly session_token: string;
 }
 
+// From codersdk/maintenancewindows.go
+export interface MaintenanceWindow {
+	readonly id: string;
+	readonly organization_id: string;
+	readonly template_id?: string;
+	readonly name: string;
+	readonly message: string;
+	readonly schedule: string;
+	readonly duration_ms: number;
+	readonly notice_ms: number;
+	readonly block_builds: boolean;
+	readonly created_at: string;
+	readonly updated_at: string;
+}
+
 // From codersdk/organizations.go
 export interface MinimalOrganization {
 	readonly id: string;
@@ -771,8 +876,12 @@ export interface NotificationsConfig {
 	readonly fetch_interval: number;
 	readonly method: string;
 	readonly dispatch_timeout: number;
+	readonly digest_enabled: boolean;
+	readonly digest_window: number;
 	readonly email: NotificationsEmailConfig;
 	readonly webhook: NotificationsWebhookConfig;
+	readonly slack: NotificationsSlackConfig;
+	readonly teams: NotificationsTeamsConfig;
 }
 
 // From codersdk/deployment.go
@@ -808,6 +917,18 @@ export interface NotificationsSettings {
 	readonly notifier_paused: boolean;
 }
 
+// From codersdk/deployment.go
+export interface NotificationsSlackConfig {
+	readonly endpoint: string;
+	readonly bot_token: string;
+	readonly channel: string;
+}
+
+// From codersdk/deployment.go
+export interface NotificationsTeamsConfig {
+	readonly endpoint: string;
+}
+
 // From codersdk/deployment.go
 export interface NotificationsWebhookConfig {
 	readonly endpoint: string;
@@ -1011,6 +1132,11 @@ export interface ProvisionerConfig {
 	readonly daemon_poll_jitter: number;
 	readonly force_cancel_interval: number;
 	readonly daemon_psk: string;
+	readonly max_jobs_per_organization: number;
+	readonly max_jobs_per_user: number;
+	readonly terraform_flavor: string;
+	readonly terraform_install_mirror: string;
+	readonly terraform_provider_mirror: string;
 }
 
 // From codersdk/provisionerdaemons.go
@@ -1042,6 +1168,7 @@ export interface ProvisionerJob {
 	readonly tags: Record<string, string>;
 	readonly queue_position: number;
 	readonly queue_size: number;
+	readonly estimated_start_at?: string;
 }
 
 // From codersdk/provisionerdaemons.go
@@ -1155,6 +1282,13 @@ export interface Response {
 	readonly validations?: Readonly<Array<ValidationError>>;
 }
 
+// From codersdk/workspacesnapshots.go
+export interface RestoreWorkspaceSnapshotRequest {
+	readonly name: string;
+	readonly snapshot: WorkspaceSnapshot;
+	readonly restore_state?: boolean;
+}
+
 // From codersdk/roles.go
 export interface Role {
 	readonly name: string;
@@ -1213,6 +1347,13 @@ export interface SessionLifetime {
 	readonly max_token_lifetime?: number;
 }
 
+// From codersdk/deployment.go
+export interface SessionRecordingConfig {
+	readonly enabled: boolean;
+	readonly record_input: boolean;
+	readonly max_size: number;
+}
+
 // From codersdk/roles.go
 export interface SlimRole {
 	readonly name: string;
@@ -1274,6 +1415,8 @@ export interface Template {
 	readonly icon: string;
 	readonly default_ttl_ms: number;
 	readonly activity_bump_ms: number;
+	readonly idle_detection_enabled: boolean;
+	readonly idle_cpu_threshold_percent: number;
 	readonly autostop_requirement: TemplateAutostopRequirement;
 	readonly autostart_requirement: TemplateAutostartRequirement;
 	readonly created_by_id: string;
@@ -1451,6 +1594,28 @@ export interface TemplateVersionParameterOption {
 	readonly icon: string;
 }
 
+// From codersdk/templateversionrollouts.go
+export interface TemplateVersionRollout {
+	readonly id: string;
+	readonly template_id: string;
+	readonly template_version_id: string;
+	readonly template_version_name: string;
+	readonly status: TemplateVersionRolloutStatus;
+	readonly percent: number;
+	readonly group_id?: string;
+	readonly created_by_id: string;
+	readonly created_at: string;
+	readonly updated_at: string;
+	readonly canary_builds: TemplateVersionRolloutBuildStats;
+	readonly active_builds: TemplateVersionRolloutBuildStats;
+}
+
+// From codersdk/templateversionrollouts.go
+export interface TemplateVersionRolloutBuildStats {
+	readonly total_builds: number;
+	readonly failed_builds: number;
+}
+
 // From codersdk/templateversions.go
 export interface TemplateVersionVariable {
 	readonly name: string;
@@ -1512,6 +1677,16 @@ export interface UpdateCheckResponse {
 	readonly url: string;
 }
 
+// From codersdk/maintenancewindows.go
+export interface UpdateMaintenanceWindowRequest {
+	readonly name: string;
+	readonly message: string;
+	readonly schedule: string;
+	readonly duration_ms: number;
+	readonly notice_ms: number;
+	readonly block_builds: boolean;
+}
+
 // From codersdk/notifications.go
 export interface UpdateNotificationTemplateMethod {
 	readonly method?: string;
@@ -1544,6 +1719,8 @@ export interface UpdateTemplateMeta {
 	readonly icon?: string;
 	readonly default_ttl_ms?: number;
 	readonly activity_bump_ms?: number;
+	readonly idle_detection_enabled?: boolean;
+	readonly idle_cpu_threshold_percent?: number;
 	readonly autostop_requirement?: TemplateAutostopRequirement;
 	readonly autostart_requirement?: TemplateAutostartRequirement;
 	readonly allow_user_autostart?: boolean;
@@ -1587,6 +1764,12 @@ export interface UpdateUserQuietHoursScheduleRequest {
 	readonly schedule: string;
 }
 
+// From codersdk/workspaceacl.go
+export interface UpdateWorkspaceACL {
+	readonly user_roles?: Record<string, WorkspaceRole>;
+	readonly group_roles?: Record<string, WorkspaceRole>;
+}
+
 // From codersdk/workspaces.go
 export interface UpdateWorkspaceAutomaticUpdatesRequest {
 	readonly automatic_updates: AutomaticUpdates;
@@ -1771,11 +1954,18 @@ export interface Workspace {
 	readonly deleting_at?: string;
 	readonly dormant_at?: string;
 	readonly health: WorkspaceHealth;
+	readonly drift: WorkspaceDrift;
 	readonly automatic_updates: AutomaticUpdates;
 	readonly allow_renames: boolean;
 	readonly favorite: boolean;
 }
 
+// From codersdk/workspaceacl.go
+export interface WorkspaceACL {
+	readonly users: Readonly<Array<WorkspaceUser>>;
+	readonly groups: Readonly<Array<WorkspaceGroup>>;
+}
+
 // From codersdk/workspaceagents.go
 export interface WorkspaceAgent {
 	readonly id: string;
@@ -1812,6 +2002,22 @@ export interface WorkspaceAgent {
 	readonly startup_script_behavior: WorkspaceAgentStartupScriptBehavior;
 }
 
+// From codersdk/workspaceagentfiles.go
+export interface WorkspaceAgentDirectory {
+	readonly path: string;
+	readonly files: Readonly<Array<WorkspaceAgentFileInfo>>;
+}
+
+// From codersdk/workspaceagentfiles.go
+export interface WorkspaceAgentFileInfo {
+	readonly name: string;
+	readonly path: string;
+	readonly size: number;
+	readonly mode: string;
+	readonly mod_time: string;
+	readonly is_dir: boolean;
+}
+
 // From codersdk/workspaceagents.go
 export interface WorkspaceAgentHealth {
 	readonly healthy: boolean;
@@ -1950,6 +2156,29 @@ export interface WorkspaceBuildsRequest extends Pagination {
 	readonly since?: string;
 }
 
+// From codersdk/workspacebulkoperations.go
+export interface WorkspaceBulkOperation {
+	readonly id: string;
+	readonly initiator_id: string;
+	readonly action: WorkspaceBulkOperationAction;
+	readonly search: string;
+	readonly created_at: string;
+	readonly completed_at?: string;
+	readonly workspaces: Readonly<Array<WorkspaceBulkOperationWorkspace>>;
+}
+
+// From codersdk/workspacebulkoperations.go
+export interface WorkspaceBulkOperationWorkspace {
+	readonly workspace_id: string;
+	readonly workspace_name: string;
+	readonly workspace_owner_name: string;
+	readonly status: WorkspaceBulkOperationWorkspaceStatus;
+	readonly message?: string;
+	readonly workspace_build_id?: string;
+	readonly job_status?: ProvisionerJobStatus;
+	readonly updated_at: string;
+}
+
 // From codersdk/deployment.go
 export interface WorkspaceConnectionLatencyMS {
 	readonly P50: number;
@@ -1968,11 +2197,33 @@ export interface WorkspaceDeploymentStats {
 	readonly tx_bytes: number;
 }
 
+// From codersdk/workspaces.go
+export interface WorkspaceDrift {
+	readonly status: WorkspaceDriftStatus;
+	readonly checked_at?: string;
+	readonly resources: Readonly<Array<WorkspaceResourceChange>>;
+}
+
+// From codersdk/deployment.go
+export interface WorkspaceDriftConfig {
+	readonly check_interval: number;
+	readonly action: string;
+}
+
 // From codersdk/workspaces.go
 export interface WorkspaceFilter {
 	readonly q?: string;
 }
 
+// From codersdk/workspaceacl.go
+export interface WorkspaceGroup {
+	readonly id: string;
+	readonly name: string;
+	readonly display_name: string;
+	readonly avatar_url: string;
+	readonly role: WorkspaceRole;
+}
+
 // From codersdk/workspaces.go
 export interface WorkspaceHealth {
 	readonly healthy: boolean;
@@ -2029,6 +2280,14 @@ export interface WorkspaceResource {
 	readonly daily_cost: number;
 }
 
+// From codersdk/templateversions.go
+export interface WorkspaceResourceChange {
+	readonly address: string;
+	readonly type: string;
+	readonly name: string;
+	readonly action: WorkspaceResourceChangeAction;
+}
+
 // From codersdk/workspacebuilds.go
 export interface WorkspaceResourceMetadata {
 	readonly key: string;
@@ -2036,11 +2295,59 @@ export interface WorkspaceResourceMetadata {
 	readonly sensitive: boolean;
 }
 
+// From codersdk/workspacesessionrecordings.go
+export interface WorkspaceSessionRecording {
+	readonly id: string;
+	readonly workspace_id: string;
+	readonly agent_id: string;
+	readonly type: WorkspaceSessionRecordingType;
+	readonly command: string;
+	readonly started_at: string;
+	readonly ended_at: string;
+	readonly input_recorded: boolean;
+	readonly truncated: boolean;
+	readonly size: number;
+}
+
+// From codersdk/workspacesnapshots.go
+export interface WorkspaceSnapshot {
+	readonly version: number;
+	readonly created_at: string;
+	readonly workspace_id: string;
+	readonly workspace_name: string;
+	readonly owner_name: string;
+	readonly organization_id: string;
+	readonly template_id: string;
+	readonly template_name: string;
+	readonly template_version_id: string;
+	readonly template_version_name: string;
+	readonly build_number: number;
+	readonly transition: WorkspaceTransition;
+	readonly rich_parameter_values: Readonly<Array<WorkspaceBuildParameter>>;
+	readonly agent_metadata: Readonly<Array<WorkspaceSnapshotAgentMetadata>>;
+	readonly state?: string;
+}
+
+// From codersdk/workspacesnapshots.go
+export interface WorkspaceSnapshotAgentMetadata {
+	readonly agent_name: string;
+	readonly key: string;
+	readonly display_name: string;
+	readonly value: string;
+	readonly error?: string;
+	readonly collected_at: string;
+}
+
 // From codersdk/workspaces.go
 export interface WorkspaceTimings {
 	readonly provisioner_timings: Readonly<Array<ProvisionerTiming>>;
 }
 
+// From codersdk/workspaceacl.go
+export interface WorkspaceUser extends MinimalUser {
+	readonly role: WorkspaceRole;
+}
+
 // From codersdk/workspaces.go
 export interface WorkspacesRequest extends Pagination {
 	readonly q?: string;
@@ -2064,13 +2371,21 @@ export const AgentSubsystems: AgentSubsystem[] = ["envbox", "envbuilder", "exect
 export type AuditAction = "create" | "delete" | "login" | "logout" | "register" | "start" | "stop" | "write"
 export const AuditActions: AuditAction[] = ["create", "delete", "login", "logout", "register", "start", "stop", "write"]
 
+// From codersdk/audit.go
+export type AuditLogExportFormat = "csv" | "jsonl"
+export const AuditLogExportFormats: AuditLogExportFormat[] = ["csv", "jsonl"]
+
 // From codersdk/workspaces.go
 export type AutomaticUpdates = "always" | "never"
 export const AutomaticUpdateses: AutomaticUpdates[] = ["always", "never"]
 
 // From codersdk/workspacebuilds.go
-export type BuildReason = "autostart" | "autostop" | "initiator"
-export const BuildReasons: BuildReason[] = ["autostart", "autostop", "initiator"]
+export type BuildReason = "autostart" | "autostop" | "drift" | "initiator"
+export const BuildReasons: BuildReason[] = ["autostart", "autostop", "drift", "initiator"]
+
+// From codersdk/connectionlogs.go
+export type ConnectionType = "app" | "jetbrains" | "port_forward" | "reconnecting_pty" | "ssh" | "vscode"
+export const ConnectionTypes: ConnectionType[] = ["app", "jetbrains", "port_forward", "reconnecting_pty", "ssh", "vscode"]
 
 // From codersdk/workspaceagents.go
 export type DisplayApp = "port_forwarding_helper" | "ssh_helper" | "vscode" | "vscode_insiders" | "web_terminal"
@@ -2161,8 +2476,8 @@ export type RBACResource = "*" | "api_key" | "assign_org_role" | "assign_role" |
 export const RBACResources: RBACResource[] = ["*", "api_key", "assign_org_role", "assign_role", "audit_log", "crypto_key", "debug_info", "deployment_config", "deployment_stats", "file", "group", "group_member", "idpsync_settings", "license", "notification_preference", "notification_template", "oauth2_app", "oauth2_app_code_token", "oauth2_app_secret", "organization", "organization_member", "provisioner_daemon", "provisioner_keys", "replicas", "system", "tailnet_coordinator", "template", "user", "workspace", "workspace_dormant", "workspace_proxy"]
 
 // From codersdk/audit.go
-export type ResourceType = "api_key" | "convert_login" | "custom_role" | "git_ssh_key" | "group" | "health_settings" | "license" | "notifications_settings" | "oauth2_provider_app" | "oauth2_provider_app_secret" | "organization" | "template" | "template_version" | "user" | "workspace" | "workspace_build" | "workspace_proxy"
-export const ResourceTypes: ResourceType[] = ["api_key", "convert_login", "custom_role", "git_ssh_key", "group", "health_settings", "license", "notifications_settings", "oauth2_provider_app", "oauth2_provider_app_secret", "organization", "template", "template_version", "user", "workspace", "workspace_build", "workspace_proxy"]
+export type ResourceType = "api_key" | "convert_login" | "custom_role" | "git_ssh_key" | "group" | "health_settings" | "license" | "notifications_settings" | "oauth2_provider_app" | "oauth2_provider_app_secret" | "organization" | "template" | "template_version" | "template_version_rollout" | "user" | "workspace" | "workspace_build" | "workspace_bulk_operation" | "workspace_proxy"
+export const ResourceTypes: ResourceType[] = ["api_key", "convert_login", "custom_role", "git_ssh_key", "group", "health_settings", "license", "notifications_settings", "oauth2_provider_app", "oauth2_provider_app_secret", "organization", "template", "template_version", "template_version_rollout", "user", "workspace", "workspace_build", "workspace_bulk_operation", "workspace_proxy"]
 
 // From codersdk/serversentevents.go
 export type ServerSentEventType = "data" | "error" | "ping"
@@ -2180,6 +2495,10 @@ export const TemplateInsightsSections: TemplateInsightsSection[] = ["interval_re
 export type TemplateRole = "" | "admin" | "use"
 export const TemplateRoles: TemplateRole[] = ["", "admin", "use"]
 
+// From codersdk/templateversionrollouts.go
+export type TemplateVersionRolloutStatus = "canary" | "promoted" | "rolled_back"
+export const TemplateVersionRolloutStatuses: TemplateVersionRolloutStatus[] = ["canary", "promoted", "rolled_back"]
+
 // From codersdk/templateversions.go
 export type TemplateVersionWarning = "UNSUPPORTED_WORKSPACES"
 export const TemplateVersionWarnings: TemplateVersionWarning[] = ["UNSUPPORTED_WORKSPACES"]
@@ -2224,6 +2543,30 @@ export const WorkspaceAppHealths: WorkspaceAppHealth[] = ["disabled", "healthy",
 export type WorkspaceAppSharingLevel = "authenticated" | "owner" | "public"
 export const WorkspaceAppSharingLevels: WorkspaceAppSharingLevel[] = ["authenticated", "owner", "public"]
 
+// From codersdk/workspacebulkoperations.go
+export type WorkspaceBulkOperationAction = "delete" | "start" | "stop" | "update"
+export const WorkspaceBulkOperationActions: WorkspaceBulkOperationAction[] = ["delete", "start", "stop", "update"]
+
+// From codersdk/workspacebulkoperations.go
+export type WorkspaceBulkOperationWorkspaceStatus = "enqueued" | "failed" | "pending" | "skipped"
+export const WorkspaceBulkOperationWorkspaceStatuses: WorkspaceBulkOperationWorkspaceStatus[] = ["enqueued", "failed", "pending", "skipped"]
+
+// From codersdk/workspaces.go
+export type WorkspaceDriftStatus = "drifted" | "in_sync" | "unknown"
+export const WorkspaceDriftStatuses: WorkspaceDriftStatus[] = ["drifted", "in_sync", "unknown"]
+
+// From codersdk/templateversions.go
+export type WorkspaceResourceChangeAction = "create" | "destroy" | "replace" | "update"
+export const WorkspaceResourceChangeActions: WorkspaceResourceChangeAction[] = ["create", "destroy", "replace", "update"]
+
+// From codersdk/workspaceacl.go
+export type WorkspaceRole = "" | "admin" | "use"
+export const WorkspaceRoles: WorkspaceRole[] = ["", "admin", "use"]
+
+// From codersdk/workspacesessionrecordings.go
+export type WorkspaceSessionRecordingType = "reconnecting_pty" | "ssh"
+export const WorkspaceSessionRecordingTypes: WorkspaceSessionRecordingType[] = ["reconnecting_pty", "ssh"]
+
 // From codersdk/workspacebuilds.go
 export type WorkspaceStatus = "canceled" | "canceling" | "deleted" | "deleting" | "failed" | "pending" | "running" | "starting" | "stopped" | "stopping"
 export const WorkspaceStatuses: WorkspaceStatus[] = ["canceled", "canceling", "deleted", "deleting", "failed", "pending", "running", "starting", "stopped", "stopping"]
@@ -2303,11 +2646,29 @@ export interface DatabaseReport extends BaseReport {
 	readonly threshold_ms: number;
 }
 
+// From healthsdk/healthsdk.go
+export interface ExternalAuthReport extends BaseReport {
+	readonly items: Readonly<Array<ExternalAuthReportItem>>;
+}
+
+// From healthsdk/healthsdk.go
+export interface ExternalAuthReportItem {
+	readonly id: string;
+	readonly type: string;
+	readonly warnings: Readonly<Array<HealthMessage>>;
+}
+
 // From healthsdk/healthsdk.go
 export interface HealthSettings {
 	readonly dismissed_healthchecks: Readonly<Array<HealthSection>>;
 }
 
+// From healthsdk/healthsdk.go
+export interface HealthcheckHistoryRequest {
+	readonly section?: HealthSection;
+	readonly limit?: number;
+}
+
 // From healthsdk/healthsdk.go
 export interface HealthcheckReport {
 	readonly time: string;
@@ -2319,9 +2680,34 @@ export interface HealthcheckReport {
 	readonly database: DatabaseReport;
 	readonly workspace_proxy: WorkspaceProxyReport;
 	readonly provisioner_daemons: ProvisionerDaemonsReport;
+	readonly pubsub: PubsubReport;
+	readonly notifications: NotificationsReport;
+	readonly external_auth: ExternalAuthReport;
 	readonly coder_version: string;
 }
 
+// From healthsdk/healthsdk.go
+export interface HealthcheckSectionResult {
+	readonly time: string;
+	readonly section: HealthSection;
+	readonly severity: HealthSeverity;
+	readonly warnings: Readonly<Array<HealthMessage>>;
+	readonly error?: string;
+}
+
+// From healthsdk/healthsdk.go
+export interface NotificationsReport extends BaseReport {
+	readonly enabled: boolean;
+	readonly items: Readonly<Array<NotificationsReportItem>>;
+}
+
+// From healthsdk/healthsdk.go
+export interface NotificationsReportItem {
+	readonly method: string;
+	readonly default: boolean;
+	readonly warnings: Readonly<Array<HealthMessage>>;
+}
+
 // From healthsdk/healthsdk.go
 export interface ProvisionerDaemonsReport extends BaseReport {
 	readonly items: Readonly<Array<ProvisionerDaemonsReportItem>>;
@@ -2333,6 +2719,13 @@ export interface ProvisionerDaemonsReportItem {
 	readonly warnings: Readonly<Array<HealthMessage>>;
 }
 
+// From healthsdk/healthsdk.go
+export interface PubsubReport extends BaseReport {
+	readonly latency: string;
+	readonly latency_ms: number;
+	readonly threshold_ms: number;
+}
+
 // From healthsdk/healthsdk.go
 export interface STUNReport {
 	readonly Enabled: boolean;
@@ -2359,8 +2752,8 @@ export interface WorkspaceProxyReport extends BaseReport {
 }
 
 // From healthsdk/healthsdk.go
-export type HealthSection = "AccessURL" | "DERP" | "Database" | "ProvisionerDaemons" | "Websocket" | "WorkspaceProxy"
-export const HealthSections: HealthSection[] = ["AccessURL", "DERP", "Database", "ProvisionerDaemons", "Websocket", "WorkspaceProxy"]
+export type HealthSection = "AccessURL" | "DERP" | "Database" | "ExternalAuth" | "Notifications" | "ProvisionerDaemons" | "Pubsub" | "Websocket" | "WorkspaceProxy"
+export const HealthSections: HealthSection[] = ["AccessURL", "DERP", "Database", "ExternalAuth", "Notifications", "ProvisionerDaemons", "Pubsub", "Websocket", "WorkspaceProxy"]
 
 // The code below is generated from coderd/healthcheck/health.
 
@@ -2371,8 +2764,8 @@ export interface HealthMessage {
 }
 
 // From health/model.go
-export type HealthCode = "EACS01" | "EACS02" | "EACS03" | "EACS04" | "EDB01" | "EDB02" | "EDERP01" | "EDERP02" | "EPD01" | "EPD02" | "EPD03" | "EUNKNOWN" | "EWP01" | "EWP02" | "EWP04" | "EWS01" | "EWS02" | "EWS03"
-export const HealthCodes: HealthCode[] = ["EACS01", "EACS02", "EACS03", "EACS04", "EDB01", "EDB02", "EDERP01", "EDERP02", "EPD01", "EPD02", "EPD03", "EUNKNOWN", "EWP01", "EWP02", "EWP04", "EWS01", "EWS02", "EWS03"]
+export type HealthCode = "EACS01" | "EACS02" | "EACS03" | "EACS04" | "EDB01" | "EDB02" | "EDERP01" | "EDERP02" | "EEXT01" | "EEXT02" | "ENOT01" | "EPD01" | "EPD02" | "EPD03" | "EPS01" | "EPS02" | "EUNKNOWN" | "EWP01" | "EWP02" | "EWP04" | "EWS01" | "EWS02" | "EWS03"
+export const HealthCodes: HealthCode[] = ["EACS01", "EACS02", "EACS03", "EACS04", "EDB01", "EDB02", "EDERP01", "EDERP02", "EEXT01", "EEXT02", "ENOT01", "EPD01", "EPD02", "EPD03", "EPS01", "EPS02", "EUNKNOWN", "EWP01", "EWP02", "EWP04", "EWS01", "EWS02", "EWS03"]
 
 // From health/model.go
 export type HealthSeverity = "error" | "ok" | "warning"
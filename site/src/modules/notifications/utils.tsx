@@ -1,19 +1,25 @@
+import ChatIcon from "@mui/icons-material/ChatOutlined";
 import EmailIcon from "@mui/icons-material/EmailOutlined";
+import ForumIcon from "@mui/icons-material/ForumOutlined";
 import WebhookIcon from "@mui/icons-material/WebhookOutlined";
 
 // TODO: This should be provided by the auto generated types from codersdk
-const notificationMethods = ["smtp", "webhook"] as const;
+const notificationMethods = ["smtp", "webhook", "slack", "teams"] as const;
 
 export type NotificationMethod = (typeof notificationMethods)[number];
 
 export const methodIcons: Record<NotificationMethod, typeof EmailIcon> = {
 	smtp: EmailIcon,
 	webhook: WebhookIcon,
+	slack: ChatIcon,
+	teams: ForumIcon,
 };
 
 export const methodLabels: Record<NotificationMethod, string> = {
 	smtp: "SMTP",
 	webhook: "Webhook",
+	slack: "Slack",
+	teams: "Microsoft Teams",
 };
 
 export const castNotificationMethod = (value: string) => {
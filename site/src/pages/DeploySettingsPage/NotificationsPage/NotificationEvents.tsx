@@ -60,6 +60,26 @@ export const NotificationEvents: FC<NotificationEventsProps> = ({
 		"hello",
 	]);
 
+	// Slack
+	const hasSlackNotifications = Object.values(templatesByGroup)
+		.flat()
+		.some((t) => t.method === "slack");
+	const slackValues = deploymentValues.notifications?.slack ?? {};
+	// The bot token is a secret and is not returned by the API, so the channel
+	// is used to determine whether the Web API is configured.
+	const isSlackConfigured =
+		requiredFieldsArePresent(slackValues, ["endpoint"]) ||
+		requiredFieldsArePresent(slackValues, ["channel"]);
+
+	// Microsoft Teams
+	const hasTeamsNotifications = Object.values(templatesByGroup)
+		.flat()
+		.some((t) => t.method === "teams");
+	const teamsValues = deploymentValues.notifications?.teams ?? {};
+	const isTeamsConfigured = requiredFieldsArePresent(teamsValues, [
+		"endpoint",
+	]);
+
 	return (
 		<Stack spacing={4}>
 			{hasWebhookNotifications && !isWebhookConfigured && (
@@ -102,6 +122,47 @@ export const NotificationEvents: FC<NotificationEventsProps> = ({
 				</Alert>
 			)}
 
+			{hasSlackNotifications && !isSlackConfigured && (
+				<Alert
+					severity="warning"
+					actions={
+						<Button
+							variant="text"
+							size="small"
+							component="a"
+							target="_blank"
+							rel="noreferrer"
+							href={docs("/admin/notifications#slack")}
+						>
+							Read the docs
+						</Button>
+					}
+				>
+					Slack notifications are enabled but not properly configured.
+				</Alert>
+			)}
+
+			{hasTeamsNotifications && !isTeamsConfigured && (
+				<Alert
+					severity="warning"
+					actions={
+						<Button
+							variant="text"
+							size="small"
+							component="a"
+							target="_blank"
+							rel="noreferrer"
+							href={docs("/admin/notifications#microsoft-teams")}
+						>
+							Read the docs
+						</Button>
+					}
+				>
+					Microsoft Teams notifications are enabled but not properly
+					configured.
+				</Alert>
+			)}
+
 			{Object.entries(templatesByGroup).map(([group, templates]) => (
 				<Card
 					key={group}
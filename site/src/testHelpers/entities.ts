@@ -1327,6 +1327,10 @@ export const MockWorkspace: TypesGen.Workspace = {
 		healthy: true,
 		failing_agents: [],
 	},
+	drift: {
+		status: "unknown",
+		resources: [],
+	},
 	automatic_updates: "never",
 	allow_renames: true,
 	favorite: false,
@@ -3655,6 +3659,39 @@ export const MockHealth: TypesGen.HealthcheckReport = {
 			},
 		],
 	},
+	pubsub: {
+		severity: "ok",
+		warnings: [],
+		dismissed: false,
+		latency: "2.5ms",
+		latency_ms: 2,
+		threshold_ms: 100,
+	},
+	notifications: {
+		severity: "ok",
+		warnings: [],
+		dismissed: false,
+		enabled: true,
+		items: [
+			{
+				method: "smtp",
+				default: true,
+				warnings: [],
+			},
+		],
+	},
+	external_auth: {
+		severity: "ok",
+		warnings: [],
+		dismissed: false,
+		items: [
+			{
+				id: "github",
+				type: "github",
+				warnings: [],
+			},
+		],
+	},
 	coder_version: MockBuildInfo.version,
 };
 
@@ -3804,6 +3841,44 @@ export const DeploymentHealthUnhealthy: TypesGen.HealthcheckReport = {
 			},
 		],
 	},
+	pubsub: {
+		severity: "ok",
+		warnings: [],
+		dismissed: false,
+		latency: "2.5ms",
+		latency_ms: 2,
+		threshold_ms: 100,
+	},
+	notifications: {
+		severity: "ok",
+		warnings: [],
+		dismissed: false,
+		enabled: false,
+		items: [],
+	},
+	external_auth: {
+		severity: "error",
+		warnings: [
+			{
+				message:
+					'External auth provider "github": token endpoint rejected the client credentials',
+				code: "EEXT02",
+			},
+		],
+		dismissed: false,
+		items: [
+			{
+				id: "github",
+				type: "github",
+				warnings: [
+					{
+						message: "token endpoint rejected the client credentials",
+						code: "EEXT02",
+					},
+				],
+			},
+		],
+	},
 };
 
 export const MockHealthSettings: TypesGen.HealthSettings = {
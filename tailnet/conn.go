@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/netip"
@@ -24,6 +25,7 @@ import (
 	"tailscale.com/net/connstats"
 	"tailscale.com/net/netmon"
 	"tailscale.com/net/netns"
+	"tailscale.com/net/tsaddr"
 	"tailscale.com/net/tsdial"
 	"tailscale.com/net/tstun"
 	"tailscale.com/tailcfg"
@@ -106,8 +108,17 @@ type Options struct {
 	ClientType proto.TelemetryEvent_ClientType
 	// TelemetrySink is optional.
 	TelemetrySink TelemetrySink
+	// ForwardedTCPCallback is called when a TCP connection from the tailnet
+	// is forwarded to a local port that the Conn does not listen on itself.
+	// The returned function is called once the connection is closed.
+	// Optional.
+	ForwardedTCPCallback ForwardedTCPCallback
 }
 
+// ForwardedTCPCallback is called with the addresses of a TCP connection that
+// is forwarded to a local port.
+type ForwardedTCPCallback func(src, dst netip.AddrPort) (closed func())
+
 // TelemetrySink allows tailnet.Conn to send network telemetry to the Coder
 // server.
 type TelemetrySink interface {
@@ -283,6 +294,7 @@ func NewConn(options *Options) (conn *Conn, err error) {
 		nodeUpdater:     nodeUp,
 		telemetrySink:   options.TelemetrySink,
 		telemetryStore:  telemetryStore,
+		forwardedTCP:    options.ForwardedTCPCallback,
 		createdAt:       time.Now(),
 		watchCtx:        ctx,
 		watchCancel:     ctxCancel,
@@ -382,6 +394,8 @@ type Conn struct {
 
 	trafficStats *connstats.Statistics
 	lastNetInfo  *tailcfg.NetInfo
+
+	forwardedTCP ForwardedTCPCallback
 }
 
 func (c *Conn) GetNetInfo() *tailcfg.NetInfo {
@@ -681,7 +695,7 @@ func (c *Conn) forwardTCP(src, dst netip.AddrPort) (handler func(net.Conn), opts
 	ln, ok := c.listeners[listenKey{"tcp", "", fmt.Sprint(dst.Port())}]
 	c.mutex.Unlock()
 	if !ok {
-		return nil, nil, false
+		return c.forwardLocalTCP(logger, src, dst)
 	}
 	// See: https://github.com/tailscale/tailscale/blob/c7cea825aea39a00aca71ea02bab7266afc03e7c/wgengine/netstack/netstack.go#L888
 	if dst.Port() == WorkspaceAgentSSHPort || dst.Port() == 22 {
@@ -707,6 +721,43 @@ func (c *Conn) forwardTCP(src, dst netip.AddrPort) (handler func(net.Conn), opts
 	}, opts, true
 }
 
+// forwardLocalTCP forwards connections to local ports itself when
+// ForwardedTCPCallback is set, so that the callback can observe when the
+// connection closes. Otherwise, netstack forwards the connection.
+func (c *Conn) forwardLocalTCP(logger slog.Logger, src, dst netip.AddrPort) (handler func(net.Conn), opts []tcpip.SettableSocketOption, intercept bool) {
+	if c.forwardedTCP == nil || !tsaddr.IsTailscaleIP(dst.Addr()) {
+		return nil, nil, false
+	}
+	// Like netstack, dial before accepting the connection so that the client
+	// receives a RST if nothing listens on the port.
+	dialer := net.Dialer{Timeout: 5 * time.Second}
+	local, err := dialer.Dial("tcp", netip.AddrPortFrom(netip.AddrFrom4([4]byte{127, 0, 0, 1}), dst.Port()).String())
+	if err != nil {
+		local, err = dialer.Dial("tcp", netip.AddrPortFrom(netip.IPv6Loopback(), dst.Port()).String())
+	}
+	if err != nil {
+		logger.Debug(context.Background(), "could not connect to local port", slog.Error(err))
+		return nil, nil, true
+	}
+	return func(conn net.Conn) {
+		closed := c.forwardedTCP(src, dst)
+		defer closed()
+		defer local.Close()
+		defer conn.Close()
+
+		copyDone := make(chan struct{}, 2)
+		go func() {
+			_, _ = io.Copy(local, conn)
+			copyDone <- struct{}{}
+		}()
+		go func() {
+			_, _ = io.Copy(conn, local)
+			copyDone <- struct{}{}
+		}()
+		<-copyDone
+	}, nil, true
+}
+
 // SetConnStatsCallback sets a callback to be called after maxPeriod or
 // maxConns, whichever comes first. Multiple calls overwrites the callback.
 func (c *Conn) SetConnStatsCallback(maxPeriod time.Duration, maxConns int, dump func(start, end time.Time, virtual, physical map[netlogtype.Connection]netlogtype.Counts)) {
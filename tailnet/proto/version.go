@@ -6,7 +6,7 @@ import (
 
 const (
 	CurrentMajor = 2
-	CurrentMinor = 2
+	CurrentMinor = 4
 )
 
 var CurrentVersion = apiversion.New(CurrentMajor, CurrentMinor)